@@ -0,0 +1,75 @@
+package mcproto
+
+import (
+	"sort"
+	"sync"
+)
+
+// PacketKey identifies a packet type on the wire.
+type PacketKey struct {
+	Mode ConnectionMode
+	Dir  Direction
+	ID   int32
+}
+
+// PacketStat holds traffic totals of a packet type.
+// Bytes counts uncompressed payload sizes, including the packet ID.
+type PacketStat struct {
+	PacketKey
+	Name  string
+	Count uint64
+	Bytes uint64
+}
+
+// Accounting records per-packet-type traffic of one or more Conns.
+// It is safe for concurrent use.
+type Accounting struct {
+	mu    sync.Mutex
+	stats map[PacketKey]*PacketStat
+}
+
+// Record adds a packet of size bytes to the totals of key.
+func (a *Accounting) Record(key PacketKey, name string, size int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.stats == nil {
+		a.stats = make(map[PacketKey]*PacketStat)
+	}
+	s, ok := a.stats[key]
+	if !ok {
+		s = &PacketStat{PacketKey: key}
+		a.stats[key] = s
+	}
+	if s.Name == "" {
+		s.Name = name
+	}
+	s.Count++
+	s.Bytes += uint64(size)
+}
+
+// Snapshot returns the recorded totals, sorted by bytes in descending order.
+func (a *Accounting) Snapshot() []PacketStat {
+	a.mu.Lock()
+	v := make([]PacketStat, 0, len(a.stats))
+	for _, s := range a.stats {
+		v = append(v, *s)
+	}
+	a.mu.Unlock()
+
+	sort.Slice(v, func(i, j int) bool {
+		if v[i].Bytes != v[j].Bytes {
+			return v[i].Bytes > v[j].Bytes
+		}
+		return v[i].Count > v[j].Count
+	})
+	return v
+}
+
+// Reset clears all recorded totals.
+func (a *Accounting) Reset() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.stats = nil
+}
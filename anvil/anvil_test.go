@@ -0,0 +1,643 @@
+package anvil
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gstoney/mcproto/chunk"
+	"github.com/gstoney/mcproto/nbt"
+)
+
+var testBiomes = map[int32]string{0: "minecraft:badlands", 39: "minecraft:plains", 55: "minecraft:the_void"}
+
+// testResolver knows a few blocks and biomes.
+type testResolver struct{}
+
+func (testResolver) BlockState(name string, props map[string]string) (int32, bool) {
+	switch name {
+	case "minecraft:air":
+		return 0, true
+	case "minecraft:stone":
+		return 1, true
+	case "minecraft:bedrock":
+		return 79, true
+	case "minecraft:oak_log":
+		id, ok := map[string]int32{"x": 136, "y": 137, "z": 138}[props["axis"]]
+		return id, ok
+	}
+	return 0, false
+}
+
+func (testResolver) Biome(name string) (int32, bool) {
+	for id, n := range testBiomes {
+		if n == name {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+type testChunk struct {
+	x, z        int32
+	compression byte
+	root        nbt.Compound
+}
+
+// writeRegion writes chunks of a region to dir. Chunks whose compression
+// has compressionExternalFlag set are written to .mcc files.
+func writeRegion(t *testing.T, dir string, chunks ...testChunk) {
+	t.Helper()
+	header := make([]byte, 2*SectorSize)
+	var body []byte
+	for _, c := range chunks {
+		var raw bytes.Buffer
+		if err := nbt.Write(&raw, "", c.root); err != nil {
+			t.Fatal(err)
+		}
+
+		var data bytes.Buffer
+		switch c.compression &^ compressionExternalFlag {
+		case CompressionGzip:
+			w := gzip.NewWriter(&data)
+			w.Write(raw.Bytes())
+			w.Close()
+		case CompressionZlib:
+			w := zlib.NewWriter(&data)
+			w.Write(raw.Bytes())
+			w.Close()
+		default:
+			data = raw
+		}
+
+		payload := data.Bytes()
+		if c.compression&compressionExternalFlag != 0 {
+			name := filepath.Join(dir, fmt.Sprintf("c.%d.%d.mcc", c.x, c.z))
+			if err := os.WriteFile(name, payload, 0o644); err != nil {
+				t.Fatal(err)
+			}
+			payload = nil
+		}
+
+		sector := binary.BigEndian.AppendUint32(nil, uint32(len(payload)+1))
+		sector = append(sector, c.compression)
+		sector = append(sector, payload...)
+		sectors := (len(sector) + SectorSize - 1) / SectorSize
+		sector = append(sector, make([]byte, sectors*SectorSize-len(sector))...)
+
+		i := index(c.x, c.z)
+		offset := 2 + len(body)/SectorSize
+		binary.BigEndian.PutUint32(header[4*i:], uint32(offset<<8|sectors))
+		binary.BigEndian.PutUint32(header[SectorSize+4*i:], 1700000000)
+		body = append(body, sector...)
+	}
+
+	path := RegionPath(dir, chunks[0].x, chunks[0].z)
+	if err := os.WriteFile(path, append(header, body...), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testRoot returns the NBT of chunk x, z of an overworld with bedrock at
+// the bottom, an oak log above it, and plains.
+func testRoot(x, z int32, status string) nbt.Compound {
+	// 2 entries, 4 bits: index 1 at block 0, 0 elsewhere.
+	data := make([]int64, 256)
+	data[0] = 1
+	sky := bytes.Repeat([]byte{0xFF}, 2048)
+
+	return nbt.Compound{
+		"DataVersion": int32(3955),
+		"xPos":        x,
+		"zPos":        z,
+		"yPos":        int32(-4),
+		"Status":      status,
+		"isLightOn":   int8(1),
+		"sections": nbt.List{
+			nbt.Compound{"Y": int8(-5), "SkyLight": sky},
+			nbt.Compound{
+				"Y": int8(-4),
+				"block_states": nbt.Compound{
+					"palette": nbt.List{
+						nbt.Compound{"Name": "minecraft:bedrock"},
+						nbt.Compound{"Name": "minecraft:oak_log", "Properties": nbt.Compound{"axis": "y"}},
+					},
+					"data": data,
+				},
+				"biomes": nbt.Compound{
+					"palette": nbt.List{"minecraft:plains", "minecraft:the_void"},
+					"data":    []int64{math.MinInt64}, // Bit 63 set.
+				},
+				"BlockLight": make([]byte, 2048),
+			},
+			nbt.Compound{
+				"Y": int8(-3),
+				"block_states": nbt.Compound{
+					"palette": nbt.List{nbt.Compound{"Name": "minecraft:air"}},
+				},
+				"biomes": nbt.Compound{
+					"palette": nbt.List{"minecraft:plains"},
+				},
+			},
+		},
+		"block_entities": nbt.List{
+			nbt.Compound{"id": "minecraft:sign", "x": int32(0), "y": int32(-64), "z": int32(0)},
+		},
+	}
+}
+
+func TestRegion(t *testing.T) {
+	dir := t.TempDir()
+	writeRegion(t, dir,
+		testChunk{-1, 0, CompressionZlib, nbt.Compound{"n": "zlib"}},
+		testChunk{-2, 0, CompressionGzip, nbt.Compound{"n": "gzip"}},
+		testChunk{-3, 0, CompressionNone, nbt.Compound{"n": "none"}},
+		testChunk{-4, 0, CompressionZlib | compressionExternalFlag, nbt.Compound{"n": "external"}},
+		testChunk{-5, 0, CompressionLZ4, nbt.Compound{}},
+	)
+
+	r, err := OpenRegion(filepath.Join(dir, "r.-1.0.mca"))
+	if err != nil {
+		t.Fatalf("OpenRegion: %v", err)
+	}
+	defer r.Close()
+
+	for _, tc := range []struct {
+		x    int32
+		want string
+		err  error
+	}{
+		{-1, "zlib", nil},
+		{-2, "gzip", nil},
+		{-3, "none", nil},
+		{-4, "external", nil},
+		{-5, "", ErrUnsupportedCompression},
+		{-6, "", ErrNoChunk},
+	} {
+		c, err := r.ReadChunk(tc.x, 0)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("chunk %d: got error %v, want %v", tc.x, err, tc.err)
+			continue
+		}
+		if err == nil && c["n"] != tc.want {
+			t.Errorf("chunk %d: got %v, want %s", tc.x, c, tc.want)
+		}
+	}
+
+	if !r.Has(-1, 0) || r.Has(-6, 0) {
+		t.Errorf("Has: got %v, %v", r.Has(-1, 0), r.Has(-6, 0))
+	}
+	if got := r.Timestamp(-1, 0); !got.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("Timestamp: got %v", got)
+	}
+}
+
+func TestOpenRegion_Truncated(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "r.0.0.mca")
+	os.WriteFile(path, make([]byte, 100), 0o644)
+	if _, err := OpenRegion(path); err != ErrCorrupt {
+		t.Errorf("got %v, want ErrCorrupt", err)
+	}
+}
+
+func TestDecodeChunk(t *testing.T) {
+	c, err := DecodeChunk(testRoot(3, -2, StatusFull), -64, 384, testResolver{})
+	if err != nil {
+		t.Fatalf("DecodeChunk: %v", err)
+	}
+	col := c.Column
+	if col.X != 3 || col.Z != -2 || len(col.Sections) != 24 || c.DataVersion != 3955 {
+		t.Errorf("got column %d, %d with %d sections, version %d", col.X, col.Z, len(col.Sections), c.DataVersion)
+	}
+
+	for _, tc := range []struct {
+		x, y, z int
+		want    int32
+	}{
+		{0, -64, 0, 137},
+		{1, -64, 0, 79},
+		{15, -49, 15, 79},
+		{0, -48, 0, chunk.Air},
+		{0, 100, 0, chunk.Air},
+	} {
+		if got := col.Block(tc.x, tc.y, tc.z); got != tc.want {
+			t.Errorf("block at %d, %d, %d: got %d, want %d", tc.x, tc.y, tc.z, got, tc.want)
+		}
+	}
+
+	if got := col.Sections[0].Biome(0, 0, 0); got != 39 {
+		t.Errorf("biome at 0: got %d, want 39", got)
+	}
+	if got := col.Sections[0].Biome(15, 15, 15); got != 55 {
+		t.Errorf("biome at 63: got %d, want 55", got)
+	}
+
+	if c.Light == nil {
+		t.Fatal("got no light")
+	}
+	if c.Light.Sky[0] == nil || c.Light.Sky[0].Get(0, 0, 0) != 15 {
+		t.Errorf("got sky light below the world %v", c.Light.Sky[0])
+	}
+	if c.Light.Block[1] == nil || c.Light.Sky[1] != nil || c.Light.Block[2] != nil {
+		t.Errorf("got light of stored sections %v, %v, %v", c.Light.Block[1], c.Light.Sky[1], c.Light.Block[2])
+	}
+	if len(c.BlockEntities) != 1 || c.BlockEntities[0]["id"] != "minecraft:sign" {
+		t.Errorf("got block entities %v", c.BlockEntities)
+	}
+}
+
+func TestDecodeChunk_Errors(t *testing.T) {
+	unknown := testRoot(0, 0, StatusFull)
+	section := unknown["sections"].(nbt.List)[1].(nbt.Compound)
+	section["block_states"].(nbt.Compound)["palette"].(nbt.List)[0] = nbt.Compound{"Name": "mod:gizmo"}
+
+	short := testRoot(0, 0, StatusFull)
+	section = short["sections"].(nbt.List)[1].(nbt.Compound)
+	section["block_states"].(nbt.Compound)["data"] = []int64{0}
+
+	for _, tc := range []struct {
+		name string
+		root nbt.Compound
+		want error
+	}{
+		{"missing position", nbt.Compound{"sections": nbt.List{}}, ErrInvalidChunk},
+		{"unknown block", unknown, ErrUnknownBlockState},
+		{"short data", short, chunk.ErrDataLength},
+	} {
+		_, err := DecodeChunk(tc.root, -64, 384, testResolver{})
+		if !errors.Is(err, tc.want) {
+			t.Errorf("%s: got %v, want %v", tc.name, err, tc.want)
+		}
+	}
+}
+
+func TestWorld(t *testing.T) {
+	dir := t.TempDir()
+	writeRegion(t, dir,
+		testChunk{0, 0, CompressionZlib, testRoot(0, 0, StatusFull)},
+		testChunk{1, 0, CompressionZlib, testRoot(1, 0, "minecraft:features")},
+		testChunk{2, 0, CompressionZlib, testRoot(2, 0, StatusFull)},
+	)
+
+	w := &World{Dir: dir, MinY: -64, Height: 384, Resolver: testResolver{}, CacheSize: 1}
+	defer w.Close()
+
+	c, err := w.Chunk(0, 0)
+	if err != nil {
+		t.Fatalf("Chunk: %v", err)
+	}
+	if again, _ := w.Chunk(0, 0); again != c {
+		t.Errorf("chunk was not cached")
+	}
+	if _, err := w.Chunk(2, 0); err != nil {
+		t.Fatalf("Chunk: %v", err)
+	}
+	if again, _ := w.Chunk(0, 0); again == c {
+		t.Errorf("chunk was not evicted")
+	}
+
+	for _, pos := range [][2]int32{{1, 0}, {5, 0}, {-1, 0}, {100, 100}} {
+		if _, err := w.Chunk(pos[0], pos[1]); err != ErrNoChunk {
+			t.Errorf("chunk %v: got %v, want ErrNoChunk", pos, err)
+		}
+	}
+}
+
+// gatedResolver holds up the first load it resolves blocks for until
+// release is closed, and counts the loads.
+type gatedResolver struct {
+	testResolver
+	entered, release chan struct{}
+	loads            atomic.Int32
+}
+
+func (r *gatedResolver) BlockState(name string, props map[string]string) (int32, bool) {
+	if name == "minecraft:bedrock" {
+		if r.loads.Add(1) == 1 {
+			close(r.entered)
+			<-r.release
+		}
+	}
+	return r.testResolver.BlockState(name, props)
+}
+
+// TestWorld_Concurrent verifies that a slow load holds up neither loads of
+// other chunks nor callers of the same chunk, which share it.
+func TestWorld_Concurrent(t *testing.T) {
+	dir := t.TempDir()
+	writeRegion(t, dir,
+		testChunk{0, 0, CompressionZlib, testRoot(0, 0, StatusFull)},
+		testChunk{2, 0, CompressionZlib, testRoot(2, 0, StatusFull)},
+	)
+	r := &gatedResolver{entered: make(chan struct{}), release: make(chan struct{})}
+	w := &World{Dir: dir, MinY: -64, Height: 384, Resolver: r}
+	defer w.Close()
+
+	chunks := make(chan *Chunk, 2)
+	for range 2 {
+		go func() {
+			c, err := w.Chunk(0, 0)
+			if err != nil {
+				t.Errorf("Chunk: %v", err)
+			}
+			chunks <- c
+		}()
+	}
+	<-r.entered
+
+	done := make(chan error)
+	go func() {
+		_, err := w.Chunk(2, 0)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Chunk: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("load held up by another chunk")
+	}
+
+	time.Sleep(10 * time.Millisecond) // Let the second caller wait.
+	close(r.release)
+	if a, b := <-chunks, <-chunks; a == nil || a != b {
+		t.Errorf("got chunks %p and %p, want the same", a, b)
+	}
+	if n := r.loads.Load(); n != 2 {
+		t.Errorf("got %d loads, want 2", n)
+	}
+}
+
+var testNames = map[int32]nbt.Compound{
+	0:   {"Name": "minecraft:air"},
+	1:   {"Name": "minecraft:stone"},
+	79:  {"Name": "minecraft:bedrock"},
+	137: {"Name": "minecraft:oak_log", "Properties": nbt.Compound{"axis": "y"}},
+}
+
+func (testResolver) BlockStateName(id int32) (string, map[string]string, bool) {
+	e, ok := testNames[id]
+	if !ok {
+		return "", nil, false
+	}
+	var props map[string]string
+	if p, ok := e["Properties"].(nbt.Compound); ok {
+		props = map[string]string{}
+		for k, v := range p {
+			props[k] = v.(string)
+		}
+	}
+	return e["Name"].(string), props, true
+}
+
+func (testResolver) BiomeName(id int32) (string, bool) {
+	name, ok := testBiomes[id]
+	return name, ok
+}
+
+// TestEncodeChunk verifies that chunks decode to what was encoded.
+func TestEncodeChunk(t *testing.T) {
+	want, err := DecodeChunk(testRoot(3, -2, StatusFull), -64, 384, testResolver{})
+	if err != nil {
+		t.Fatalf("DecodeChunk: %v", err)
+	}
+	want.Column.SetBlock(5, 300, 5, 1)
+
+	root, err := EncodeChunk(want, testResolver{})
+	if err != nil {
+		t.Fatalf("EncodeChunk: %v", err)
+	}
+	if n := len(root["sections"].(nbt.List)); n != 25 {
+		t.Errorf("got %d sections, want 24 and 1 for light", n)
+	}
+	got, err := DecodeChunk(root, -64, 384, testResolver{})
+	if err != nil {
+		t.Fatalf("DecodeChunk: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("chunks differ after round trip")
+	}
+
+	want.Column.SetBlock(0, 0, 0, 12345)
+	if _, err := EncodeChunk(want, testResolver{}); !errors.Is(err, ErrUnknownBlockState) {
+		t.Errorf("got %v, want ErrUnknownBlockState", err)
+	}
+}
+
+func TestRegion_Write(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "r.0.0.mca")
+	r, err := OpenRegionWrite(path)
+	if err != nil {
+		t.Fatalf("OpenRegionWrite: %v", err)
+	}
+	defer r.Close()
+
+	sectors := func(x int32) (offset, n uint32) {
+		loc := r.locations[index(x, 0)]
+		return loc >> 8, loc & 0xFF
+	}
+
+	for x := range int32(3) {
+		if err := r.WriteChunk(x, 0, nbt.Compound{"x": x}); err != nil {
+			t.Fatalf("WriteChunk: %v", err)
+		}
+	}
+	if off, n := sectors(2); off != 4 || n != 1 {
+		t.Errorf("chunk 2 at %d+%d, want 4+1", off, n)
+	}
+
+	// Growing chunk 0 moves it to the end, and chunk 3 reuses its sector.
+	noise := make([]byte, 6000)
+	for i := range noise {
+		noise[i] = byte(i * i * 31 >> 3)
+	}
+	if err := r.WriteChunk(0, 0, nbt.Compound{"x": int32(0), "noise": noise}); err != nil {
+		t.Fatalf("WriteChunk: %v", err)
+	}
+	if off, _ := sectors(0); off != 5 {
+		t.Errorf("grown chunk 0 at %d, want 5", off)
+	}
+	if err := r.WriteChunk(3, 0, nbt.Compound{"x": int32(3)}); err != nil {
+		t.Fatalf("WriteChunk: %v", err)
+	}
+	if off, _ := sectors(3); off != 2 {
+		t.Errorf("chunk 3 at %d, want 2", off)
+	}
+
+	// Chunks over 255 sectors are external.
+	big := make([]byte, 1<<20+1)
+	rand.Read(big)
+	if err := r.WriteChunk(4, 0, nbt.Compound{"big": big}); err != nil {
+		t.Fatalf("WriteChunk: %v", err)
+	}
+	mcc := filepath.Join(dir, "c.4.0.mcc")
+	if _, err := os.Stat(mcc); err != nil {
+		t.Errorf("external chunk: %v", err)
+	}
+	if err := r.DeleteChunk(1, 0); err != nil {
+		t.Fatalf("DeleteChunk: %v", err)
+	}
+	r.Close()
+
+	r, err = OpenRegion(path)
+	if err != nil {
+		t.Fatalf("OpenRegion: %v", err)
+	}
+	for _, x := range []int32{0, 2, 3} {
+		c, err := r.ReadChunk(x, 0)
+		if err != nil || c["x"] != x {
+			t.Errorf("chunk %d: got %v, %v", x, c, err)
+		}
+	}
+	if c, err := r.ReadChunk(4, 0); err != nil || !bytes.Equal(c["big"].([]byte), big) {
+		t.Errorf("external chunk: got %v", err)
+	}
+	if _, err := r.ReadChunk(1, 0); err != ErrNoChunk {
+		t.Errorf("deleted chunk: got %v, want ErrNoChunk", err)
+	}
+	if err := r.WriteChunk(1, 0, nbt.Compound{}); err != ErrReadOnly {
+		t.Errorf("got %v, want ErrReadOnly", err)
+	}
+	r.Close()
+
+	// Reopened for writing, free space is found again, and shrinking an
+	// external chunk removes its file.
+	if r, err = OpenRegionWrite(path); err != nil {
+		t.Fatalf("OpenRegionWrite: %v", err)
+	}
+	if err := r.WriteChunk(4, 0, nbt.Compound{}); err != nil {
+		t.Fatalf("WriteChunk: %v", err)
+	}
+	if off, _ := sectors(4); off != 3 {
+		t.Errorf("chunk 4 at %d, want 3", off)
+	}
+	if _, err := os.Stat(mcc); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("external chunk not removed: %v", err)
+	}
+}
+
+// TestRegion_WriteExternalFailure verifies that an external chunk whose
+// region write fails leaves the previous .mcc file, and no temporary file.
+func TestRegion_WriteExternalFailure(t *testing.T) {
+	dir := t.TempDir()
+	r, err := OpenRegionWrite(filepath.Join(dir, "r.0.0.mca"))
+	if err != nil {
+		t.Fatalf("OpenRegionWrite: %v", err)
+	}
+	defer r.Close()
+
+	big := make([]byte, 1<<20+1)
+	rand.Read(big)
+	if err := r.WriteChunk(0, 0, nbt.Compound{"big": big}); err != nil {
+		t.Fatalf("WriteChunk: %v", err)
+	}
+	mcc := filepath.Join(dir, "c.0.0.mcc")
+	want, err := os.ReadFile(mcc)
+	if err != nil {
+		t.Fatalf("external chunk: %v", err)
+	}
+
+	r.f.Close()
+	rand.Read(big)
+	if err := r.WriteChunk(0, 0, nbt.Compound{"big": big}); err == nil {
+		t.Fatal("WriteChunk to a closed region succeeded")
+	}
+	if got, err := os.ReadFile(mcc); err != nil || !bytes.Equal(got, want) {
+		t.Errorf("external chunk replaced: %v", err)
+	}
+	if tmps, _ := filepath.Glob(mcc + ".tmp*"); len(tmps) != 0 {
+		t.Errorf("temporary files left: %v", tmps)
+	}
+}
+
+func TestWorld_SaveChunk(t *testing.T) {
+	dir := t.TempDir()
+	w := &World{Dir: dir, MinY: -64, Height: 384, Resolver: testResolver{}, Namer: testResolver{}}
+	defer w.Close()
+
+	if _, err := w.Chunk(40, 0); err != ErrNoChunk {
+		t.Fatalf("got %v, want ErrNoChunk", err)
+	}
+	c := &Chunk{Column: chunk.NewColumn(40, 0, -64, 384)}
+	c.Column.SetBlock(1, 2, 3, 79)
+	if err := w.SaveChunk(c); err != nil {
+		t.Fatalf("SaveChunk: %v", err)
+	}
+	if err := w.Sync(); err != nil {
+		t.Fatalf("Sync: %v", err)
+	}
+
+	got, err := w.Chunk(40, 0)
+	if err != nil {
+		t.Fatalf("Chunk: %v", err)
+	}
+	if got.Column.Block(1, 2, 3) != 79 || got.Status != StatusFull || got.DataVersion != DataVersion {
+		t.Errorf("got chunk %+v", got)
+	}
+
+	ro := &World{Dir: dir, MinY: -64, Height: 384, Resolver: testResolver{}}
+	defer ro.Close()
+	if _, err := ro.Chunk(40, 0); err != nil {
+		t.Errorf("Chunk: %v", err)
+	}
+	if err := ro.SaveChunk(c); err != ErrReadOnly {
+		t.Errorf("got %v, want ErrReadOnly", err)
+	}
+}
+
+func TestWriteLevel(t *testing.T) {
+	dir := t.TempDir()
+	read := func(name string) nbt.Compound {
+		f, err := os.Open(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		zr, err := gzip.NewReader(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, v, err := nbt.Read(zr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v.(nbt.Compound)["Data"].(nbt.Compound)
+	}
+
+	l := &Level{Name: "first", SpawnY: 64, GameType: Creative}
+	if err := WriteLevel(dir, l); err != nil {
+		t.Fatalf("WriteLevel: %v", err)
+	}
+	l.Name = "second"
+	if err := WriteLevel(dir, l); err != nil {
+		t.Fatalf("WriteLevel: %v", err)
+	}
+
+	data := read("level.dat")
+	if data["LevelName"] != "second" || data["GameType"] != Creative || data["SpawnY"] != int32(64) {
+		t.Errorf("got %v", data)
+	}
+	if data["DataVersion"] != int32(DataVersion) {
+		t.Errorf("got DataVersion %v", data["DataVersion"])
+	}
+	gen := data["WorldGenSettings"].(nbt.Compound)["dimensions"].(nbt.Compound)["minecraft:overworld"].(nbt.Compound)["generator"]
+	if gen.(nbt.Compound)["type"] != "minecraft:noise" {
+		t.Errorf("got overworld generator %v", gen)
+	}
+	if old := read("level.dat_old"); old["LevelName"] != "first" {
+		t.Errorf("got level.dat_old %v", old["LevelName"])
+	}
+}
@@ -0,0 +1,322 @@
+package anvil
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"github.com/gstoney/mcproto/chunk"
+	"github.com/gstoney/mcproto/nbt"
+)
+
+const (
+	// DataVersion is the data version of 1.21.1 worlds.
+	DataVersion = 3955
+
+	// StatusFull is the status of a fully generated chunk.
+	StatusFull = "minecraft:full"
+)
+
+var (
+	ErrUnknownBlockState = errors.New("anvil: unknown block state")
+	ErrUnknownBiome      = errors.New("anvil: unknown biome")
+	ErrInvalidChunk      = errors.New("anvil: invalid chunk data")
+)
+
+// Resolver maps the names stored in world files to network IDs.
+type Resolver interface {
+	// BlockState returns the state of block name with properties props,
+	// such as "minecraft:oak_log" with {"axis": "y"}.
+	BlockState(name string, props map[string]string) (id int32, ok bool)
+
+	// Biome returns the biome name, such as "minecraft:plains".
+	Biome(name string) (id int32, ok bool)
+}
+
+// Namer maps network IDs to the names stored in world files, the reverse
+// of Resolver.
+type Namer interface {
+	BlockStateName(id int32) (name string, props map[string]string, ok bool)
+	BiomeName(id int32) (name string, ok bool)
+}
+
+// Chunk is a chunk of a world file.
+type Chunk struct {
+	DataVersion int32
+	Status      string
+	Column      *chunk.Column
+
+	// Light is the stored light, or nil if the chunk was not lit.
+	Light *chunk.Light
+
+	// BlockEntities are stored as is, with world coordinates.
+	BlockEntities []nbt.Compound
+}
+
+// DecodeChunk decodes the NBT of a chunk saved since 1.18, in a world
+// height blocks high from minY. Sections out of the world are ignored.
+func DecodeChunk(c nbt.Compound, minY, height int, r Resolver) (*Chunk, error) {
+	x, okX := c["xPos"].(int32)
+	z, okZ := c["zPos"].(int32)
+	sections, okS := c["sections"].(nbt.List)
+	if !okX || !okZ || !okS {
+		return nil, ErrInvalidChunk
+	}
+
+	ch := &Chunk{
+		Column: chunk.NewColumn(x, z, minY, height),
+	}
+	ch.DataVersion, _ = c["DataVersion"].(int32)
+	ch.Status, _ = c["Status"].(string)
+	if lit, _ := c["isLightOn"].(int8); lit != 0 {
+		n := len(ch.Column.Sections) + 2
+		ch.Light = &chunk.Light{Sky: make([]*chunk.Nibbles, n), Block: make([]*chunk.Nibbles, n)}
+	}
+	if list, ok := c["block_entities"].(nbt.List); ok {
+		for _, be := range list {
+			if be, ok := be.(nbt.Compound); ok {
+				ch.BlockEntities = append(ch.BlockEntities, be)
+			}
+		}
+	}
+
+	for _, v := range sections {
+		sc, ok := v.(nbt.Compound)
+		if !ok {
+			return nil, ErrInvalidChunk
+		}
+		y, ok := sc["Y"].(int8)
+		if !ok {
+			return nil, ErrInvalidChunk
+		}
+		i := int(y) - minY/chunk.SectionWidth
+
+		if ch.Light != nil && i >= -1 && i <= len(ch.Column.Sections) {
+			ch.Light.Sky[i+1] = nibbles(sc["SkyLight"])
+			ch.Light.Block[i+1] = nibbles(sc["BlockLight"])
+		}
+		if i < 0 || i >= len(ch.Column.Sections) {
+			continue
+		}
+
+		s := ch.Column.Sections[i]
+		if bs, ok := sc["block_states"].(nbt.Compound); ok {
+			err := decodeContainer(bs, s.Blocks[:], 4, func(e any) (int32, error) {
+				return blockState(e, r)
+			})
+			if err != nil {
+				return nil, fmt.Errorf("section %d: %w", y, err)
+			}
+		}
+		if bs, ok := sc["biomes"].(nbt.Compound); ok {
+			err := decodeContainer(bs, s.Biomes[:], 0, func(e any) (int32, error) {
+				name, _ := e.(string)
+				id, ok := r.Biome(name)
+				if !ok {
+					return 0, fmt.Errorf("%w %s", ErrUnknownBiome, name)
+				}
+				return id, nil
+			})
+			if err != nil {
+				return nil, fmt.Errorf("section %d: %w", y, err)
+			}
+		}
+	}
+	return ch, nil
+}
+
+// decodeContainer decodes a paletted container as stored on disk: a
+// palette of names, and indices into it of at least minBits bits, absent
+// for a single entry.
+func decodeContainer(c nbt.Compound, values []int32, minBits int, resolve func(any) (int32, error)) error {
+	palette, ok := c["palette"].(nbt.List)
+	if !ok || len(palette) == 0 {
+		return ErrInvalidChunk
+	}
+	ids := make([]int32, len(palette))
+	for i, e := range palette {
+		id, err := resolve(e)
+		if err != nil {
+			return err
+		}
+		ids[i] = id
+	}
+
+	if len(ids) == 1 {
+		for i := range values {
+			values[i] = ids[0]
+		}
+		return nil
+	}
+
+	data, _ := c["data"].([]int64)
+	b := max(bits.Len(uint(len(ids)-1)), minBits)
+	if err := chunk.UnpackLongs(data, b, values); err != nil {
+		return err
+	}
+	for i, v := range values {
+		if int(v) >= len(ids) {
+			return chunk.ErrPaletteIndex
+		}
+		values[i] = ids[v]
+	}
+	return nil
+}
+
+func blockState(e any, r Resolver) (int32, error) {
+	c, _ := e.(nbt.Compound)
+	name, _ := c["Name"].(string)
+
+	var props map[string]string
+	if p, ok := c["Properties"].(nbt.Compound); ok {
+		props = make(map[string]string, len(p))
+		for k, v := range p {
+			props[k], _ = v.(string)
+		}
+	}
+
+	id, ok := r.BlockState(name, props)
+	if !ok {
+		return 0, fmt.Errorf("%w %s%v", ErrUnknownBlockState, name, props)
+	}
+	return id, nil
+}
+
+// nibbles returns a stored light array, or nil if absent.
+func nibbles(v any) *chunk.Nibbles {
+	b, ok := v.([]byte)
+	if !ok || len(b) != len(chunk.Nibbles{}) {
+		return nil
+	}
+	return (*chunk.Nibbles)(b)
+}
+
+// EncodeChunk returns the NBT of c as saved by 1.21.1. Light is saved only
+// if c.Light is set, otherwise vanilla lights the chunk when loading it.
+// Heightmaps are left for vanilla to compute.
+func EncodeChunk(c *Chunk, n Namer) (nbt.Compound, error) {
+	col := c.Column
+	minSection := col.MinY / chunk.SectionWidth
+
+	bySection := make(map[int]nbt.Compound)
+	section := func(y int) nbt.Compound {
+		sc, ok := bySection[y]
+		if !ok {
+			sc = nbt.Compound{"Y": int8(y)}
+			bySection[y] = sc
+		}
+		return sc
+	}
+
+	for i, s := range col.Sections {
+		blocks, err := encodeContainer(s.Blocks[:], 4, func(id int32) (any, error) {
+			name, props, ok := n.BlockStateName(id)
+			if !ok {
+				return nil, fmt.Errorf("%w %d", ErrUnknownBlockState, id)
+			}
+			e := nbt.Compound{"Name": name}
+			if len(props) > 0 {
+				p := make(nbt.Compound, len(props))
+				for k, v := range props {
+					p[k] = v
+				}
+				e["Properties"] = p
+			}
+			return e, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		biomes, err := encodeContainer(s.Biomes[:], 0, func(id int32) (any, error) {
+			name, ok := n.BiomeName(id)
+			if !ok {
+				return nil, fmt.Errorf("%w %d", ErrUnknownBiome, id)
+			}
+			return name, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		sc := section(minSection + i)
+		sc["block_states"] = blocks
+		sc["biomes"] = biomes
+	}
+
+	var lit int8
+	if c.Light != nil {
+		lit = 1
+		for i := range len(col.Sections) + 2 {
+			if sky := c.Light.Sky[i]; sky != nil {
+				section(minSection + i - 1)["SkyLight"] = sky[:]
+			}
+			if block := c.Light.Block[i]; block != nil {
+				section(minSection + i - 1)["BlockLight"] = block[:]
+			}
+		}
+	}
+
+	sections := make(nbt.List, 0, len(bySection))
+	for y := minSection - 1; y <= minSection+len(col.Sections); y++ {
+		if sc, ok := bySection[y]; ok {
+			sections = append(sections, sc)
+		}
+	}
+
+	blockEntities := make(nbt.List, len(c.BlockEntities))
+	for i, be := range c.BlockEntities {
+		blockEntities[i] = be
+	}
+
+	version, status := c.DataVersion, c.Status
+	if version == 0 {
+		version = DataVersion
+	}
+	if status == "" {
+		status = StatusFull
+	}
+	return nbt.Compound{
+		"DataVersion":    version,
+		"xPos":           col.X,
+		"zPos":           col.Z,
+		"yPos":           int32(minSection),
+		"Status":         status,
+		"LastUpdate":     int64(0),
+		"InhabitedTime":  int64(0),
+		"isLightOn":      lit,
+		"sections":       sections,
+		"block_entities": blockEntities,
+		"block_ticks":    nbt.List{},
+		"fluid_ticks":    nbt.List{},
+		"PostProcessing": nbt.List{},
+		"structures":     nbt.Compound{"References": nbt.Compound{}, "starts": nbt.Compound{}},
+	}, nil
+}
+
+// encodeContainer encodes values as a paletted container stored on disk,
+// the reverse of decodeContainer.
+func encodeContainer(values []int32, minBits int, name func(int32) (any, error)) (nbt.Compound, error) {
+	var palette nbt.List
+	index := make(map[int32]int32)
+	indices := make([]int32, len(values))
+	for i, v := range values {
+		idx, ok := index[v]
+		if !ok {
+			e, err := name(v)
+			if err != nil {
+				return nil, err
+			}
+			idx = int32(len(palette))
+			index[v] = idx
+			palette = append(palette, e)
+		}
+		indices[i] = idx
+	}
+
+	c := nbt.Compound{"palette": palette}
+	if len(palette) > 1 {
+		b := max(bits.Len(uint(len(palette)-1)), minBits)
+		c["data"] = chunk.PackLongs(indices, b)
+	}
+	return c, nil
+}
@@ -0,0 +1,126 @@
+package anvil
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gstoney/mcproto/nbt"
+)
+
+// Game modes.
+const (
+	Survival int32 = iota
+	Creative
+	Adventure
+	Spectator
+)
+
+// Level holds the settings of a world saved in level.dat.
+type Level struct {
+	Name     string
+	Seed     int64
+	GameType int32
+
+	SpawnX, SpawnY, SpawnZ int32
+
+	Hardcore      bool
+	AllowCommands bool
+	Difficulty    int8 // 0 peaceful to 3 hard.
+
+	Time    int64 // Ticks the world has run.
+	DayTime int64 // Time of day in ticks, 0 at sunrise.
+
+	// Generator is the overworld generator, such as
+	// {"type": "minecraft:flat", "settings": {...}}. Vanilla noise terrain
+	// is used if nil.
+	Generator nbt.Compound
+}
+
+// NBT returns l as saved by 1.21.1.
+func (l *Level) NBT() nbt.Compound {
+	overworld := l.Generator
+	if overworld == nil {
+		overworld = noiseGenerator("overworld", nbt.Compound{"type": "minecraft:multi_noise", "preset": "minecraft:overworld"})
+	}
+
+	return nbt.Compound{"Data": nbt.Compound{
+		"DataVersion": int32(DataVersion),
+		"version":     int32(19133), // Anvil
+		"Version": nbt.Compound{
+			"Id":       int32(DataVersion),
+			"Name":     "1.21.1",
+			"Series":   "main",
+			"Snapshot": false,
+		},
+		"LevelName":     l.Name,
+		"GameType":      l.GameType,
+		"SpawnX":        l.SpawnX,
+		"SpawnY":        l.SpawnY,
+		"SpawnZ":        l.SpawnZ,
+		"SpawnAngle":    float32(0),
+		"hardcore":      l.Hardcore,
+		"allowCommands": l.AllowCommands,
+		"Difficulty":    l.Difficulty,
+		"Time":          l.Time,
+		"DayTime":       l.DayTime,
+		"LastPlayed":    time.Now().UnixMilli(),
+		"initialized":   true,
+		"GameRules":     nbt.Compound{},
+		"DataPacks": nbt.Compound{
+			"Enabled":  nbt.List{"vanilla"},
+			"Disabled": nbt.List{},
+		},
+		"WorldGenSettings": nbt.Compound{
+			"seed":              l.Seed,
+			"generate_features": true,
+			"bonus_chest":       false,
+			"dimensions": nbt.Compound{
+				"minecraft:overworld": nbt.Compound{"type": "minecraft:overworld", "generator": overworld},
+				"minecraft:the_nether": nbt.Compound{
+					"type":      "minecraft:the_nether",
+					"generator": noiseGenerator("nether", nbt.Compound{"type": "minecraft:multi_noise", "preset": "minecraft:nether"}),
+				},
+				"minecraft:the_end": nbt.Compound{
+					"type":      "minecraft:the_end",
+					"generator": noiseGenerator("end", nbt.Compound{"type": "minecraft:the_end"}),
+				},
+			},
+		},
+	}}
+}
+
+func noiseGenerator(settings string, biomes nbt.Compound) nbt.Compound {
+	return nbt.Compound{
+		"type":         "minecraft:noise",
+		"settings":     "minecraft:" + settings,
+		"biome_source": biomes,
+	}
+}
+
+// WriteLevel saves l to the level.dat of the world in dir, keeping the
+// previous file as level.dat_old as vanilla does.
+func WriteLevel(dir string, l *Level) error {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := nbt.Write(zw, "", l.NBT()); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, "level.dat")
+	old, err := os.ReadFile(path)
+	if err == nil {
+		err = writeFileAtomic(path+"_old", old)
+	}
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return writeFileAtomic(path, buf.Bytes())
+}
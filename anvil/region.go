@@ -0,0 +1,172 @@
+// Package anvil reads and writes worlds in the Anvil format of vanilla.
+//
+// A world's region directory holds region files named r.X.Z.mca, each
+// holding the 32×32 chunks of a region. A region file starts with a table
+// of chunk locations, in 4 KiB sectors, and a table of modification
+// timestamps; each chunk is a compressed NBT compound.
+package anvil
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gstoney/mcproto/nbt"
+)
+
+// SectorSize is the unit of allocation of region files.
+const SectorSize = 4096
+
+// Compression schemes of chunk payloads.
+const (
+	CompressionGzip         byte = 1
+	CompressionZlib         byte = 2
+	CompressionNone         byte = 3
+	CompressionLZ4          byte = 4
+	CompressionCustom       byte = 127
+	compressionExternalFlag byte = 128
+)
+
+var (
+	ErrNoChunk                = errors.New("anvil: chunk not present")
+	ErrCorrupt                = errors.New("anvil: corrupt region file")
+	ErrUnsupportedCompression = errors.New("anvil: unsupported compression")
+)
+
+// RegionPath returns the path of the region file in dir holding chunk x, z.
+func RegionPath(dir string, x, z int32) string {
+	return filepath.Join(dir, fmt.Sprintf("r.%d.%d.mca", x>>5, z>>5))
+}
+
+// Region is an open region file.
+type Region struct {
+	f          *os.File
+	dir        string
+	locations  [1024]uint32
+	timestamps [1024]int32
+
+	used []bool // Sectors in use, if open for writing.
+}
+
+// OpenRegion opens the region file at path for reading.
+func OpenRegion(path string) (*Region, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return newRegion(f, path)
+}
+
+// newRegion reads the tables of f, closing it on failure.
+func newRegion(f *os.File, path string) (*Region, error) {
+	r := &Region{f: f, dir: filepath.Dir(path)}
+	header := make([]byte, 2*SectorSize)
+	if _, err := f.ReadAt(header, 0); err != nil {
+		f.Close()
+		if err == io.EOF {
+			err = ErrCorrupt
+		}
+		return nil, err
+	}
+	for i := range r.locations {
+		r.locations[i] = binary.BigEndian.Uint32(header[4*i:])
+		r.timestamps[i] = int32(binary.BigEndian.Uint32(header[SectorSize+4*i:]))
+	}
+	return r, nil
+}
+
+// Close closes the file.
+func (r *Region) Close() error {
+	return r.f.Close()
+}
+
+// index returns the table index of chunk x, z, taken modulo 32.
+func index(x, z int32) int {
+	return int(x&31) + int(z&31)*32
+}
+
+// Has reports whether chunk x, z is present.
+func (r *Region) Has(x, z int32) bool {
+	return r.locations[index(x, z)] != 0
+}
+
+// Timestamp returns when chunk x, z was last saved.
+func (r *Region) Timestamp(x, z int32) time.Time {
+	return time.Unix(int64(r.timestamps[index(x, z)]), 0)
+}
+
+// ReadChunk reads the NBT of chunk x, z. It returns ErrNoChunk if the
+// chunk is not present. LZ4 and custom compression are not supported.
+func (r *Region) ReadChunk(x, z int32) (nbt.Compound, error) {
+	loc := r.locations[index(x, z)]
+	if loc == 0 {
+		return nil, ErrNoChunk
+	}
+	offset, sectors := int64(loc>>8)*SectorSize, int(loc&0xFF)
+
+	var header [5]byte
+	if _, err := r.f.ReadAt(header[:], offset); err != nil {
+		return nil, ErrCorrupt
+	}
+	length := int(binary.BigEndian.Uint32(header[:]))
+	compression := header[4]
+
+	var data []byte
+	if compression&compressionExternalFlag != 0 {
+		compression &^= compressionExternalFlag
+		var err error
+		name := fmt.Sprintf("c.%d.%d.mcc", x, z)
+		if data, err = os.ReadFile(filepath.Join(r.dir, name)); err != nil {
+			return nil, err
+		}
+	} else {
+		if length < 1 || length+4 > sectors*SectorSize {
+			return nil, ErrCorrupt
+		}
+		data = make([]byte, length-1)
+		if _, err := r.f.ReadAt(data, offset+5); err != nil {
+			return nil, ErrCorrupt
+		}
+	}
+	return decodeChunkNBT(compression, data)
+}
+
+func decodeChunkNBT(compression byte, data []byte) (nbt.Compound, error) {
+	var rd io.Reader = bytes.NewReader(data)
+	switch compression {
+	case CompressionGzip:
+		zr, err := gzip.NewReader(rd)
+		if err != nil {
+			return nil, err
+		}
+		rd = zr
+	case CompressionZlib:
+		zr, err := zlib.NewReader(rd)
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		rd = zr
+	case CompressionNone:
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedCompression, compression)
+	}
+
+	_, v, err := nbt.Read(bufio.NewReader(rd))
+	if err != nil {
+		return nil, err
+	}
+	c, ok := v.(nbt.Compound)
+	if !ok {
+		return nil, ErrCorrupt
+	}
+	return c, nil
+}
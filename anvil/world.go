@@ -0,0 +1,248 @@
+package anvil
+
+import (
+	"container/list"
+	"errors"
+	"io/fs"
+	"os"
+	"sync"
+)
+
+// DefaultCacheSize is the number of chunks a World keeps decoded.
+const DefaultCacheSize = 1024
+
+// World loads chunks from the region files of a world lazily, keeping the
+// most recently used ones decoded. It is safe for concurrent use.
+type World struct {
+	Dir      string // Region directory, such as "world/region".
+	MinY     int    // -64 for the overworld.
+	Height   int    // 384 for the overworld.
+	Resolver Resolver
+
+	// Namer allows saving chunks with SaveChunk. Region files are opened
+	// read-only if it is nil.
+	Namer Namer
+
+	// CacheSize is the number of decoded chunks kept, DefaultCacheSize
+	// if zero.
+	CacheSize int
+
+	mu      sync.Mutex // Guards the maps and the cache.
+	regions map[[2]int32]*Region
+	chunks  map[[2]int32]*list.Element
+	lru     list.List
+	loading map[[2]int32]*loading
+
+	// io is held for reading while reading region files, and for writing
+	// while writing or closing them.
+	io sync.RWMutex
+}
+
+type cached struct {
+	pos   [2]int32
+	chunk *Chunk
+	err   error
+}
+
+// loading is a chunk load in progress, awaited by other callers of Chunk
+// for the same chunk.
+type loading struct {
+	done  chan struct{}
+	chunk *Chunk
+	err   error
+}
+
+// Chunk returns chunk x, z. It returns ErrNoChunk if the chunk was not
+// saved or is not fully generated.
+//
+// Chunks are loaded without holding up other calls, and concurrent calls
+// for the same chunk share a single load.
+func (w *World) Chunk(x, z int32) (*Chunk, error) {
+	pos := [2]int32{x, z}
+
+	w.mu.Lock()
+	if e, ok := w.chunks[pos]; ok {
+		w.lru.MoveToFront(e)
+		c := e.Value.(*cached)
+		w.mu.Unlock()
+		return c.chunk, c.err
+	}
+	if l, ok := w.loading[pos]; ok {
+		w.mu.Unlock()
+		<-l.done
+		return l.chunk, l.err
+	}
+
+	l := &loading{done: make(chan struct{})}
+	defer close(l.done)
+	if w.loading == nil {
+		w.loading = make(map[[2]int32]*loading)
+	}
+	w.loading[pos] = l
+	r, err := w.region(x, z, false)
+	w.mu.Unlock()
+
+	if err == nil {
+		l.chunk, l.err = w.load(r, x, z)
+	} else {
+		l.err = err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.loading[pos] != l {
+		// Evicted or saved meanwhile, the result may be stale.
+		return l.chunk, l.err
+	}
+	delete(w.loading, pos)
+	if l.err != nil && !errors.Is(l.err, ErrNoChunk) {
+		// Only absence is cached; other errors may be transient.
+		return nil, l.err
+	}
+
+	if w.chunks == nil {
+		w.chunks = make(map[[2]int32]*list.Element)
+	}
+	w.chunks[pos] = w.lru.PushFront(&cached{pos, l.chunk, l.err})
+	size := w.CacheSize
+	if size <= 0 {
+		size = DefaultCacheSize
+	}
+	for w.lru.Len() > size {
+		e := w.lru.Back()
+		delete(w.chunks, e.Value.(*cached).pos)
+		w.lru.Remove(e)
+	}
+	return l.chunk, l.err
+}
+
+// load reads and decodes chunk x, z of r.
+func (w *World) load(r *Region, x, z int32) (*Chunk, error) {
+	w.io.RLock()
+	root, err := r.ReadChunk(x, z)
+	w.io.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	c, err := DecodeChunk(root, w.MinY, w.Height, w.Resolver)
+	if err != nil {
+		return nil, err
+	}
+	if c.Status != StatusFull {
+		return nil, ErrNoChunk
+	}
+	return c, nil
+}
+
+// region returns the open region file holding chunk x, z, creating it if
+// create is set. w.mu must be held.
+func (w *World) region(x, z int32, create bool) (*Region, error) {
+	pos := [2]int32{x >> 5, z >> 5}
+	if r := w.regions[pos]; r != nil {
+		return r, nil
+	} else if _, ok := w.regions[pos]; ok && !create {
+		return nil, ErrNoChunk
+	}
+
+	path := RegionPath(w.Dir, x, z)
+	var r *Region
+	var err error
+	switch {
+	case create:
+		r, err = OpenRegionWrite(path)
+	case w.Namer != nil:
+		if _, err = os.Stat(path); err == nil {
+			r, err = OpenRegionWrite(path)
+		}
+	default:
+		r, err = OpenRegion(path)
+	}
+	if errors.Is(err, fs.ErrNotExist) {
+		r, err = nil, ErrNoChunk
+	} else if err != nil {
+		return nil, err
+	}
+	if w.regions == nil {
+		w.regions = make(map[[2]int32]*Region)
+	}
+	w.regions[pos] = r
+	return r, err
+}
+
+// SaveChunk saves c, replacing the cached chunk. It requires Namer.
+func (w *World) SaveChunk(c *Chunk) error {
+	if w.Namer == nil {
+		return ErrReadOnly
+	}
+	root, err := EncodeChunk(c, w.Namer)
+	if err != nil {
+		return err
+	}
+
+	x, z := c.Column.X, c.Column.Z
+	w.mu.Lock()
+	r, err := w.region(x, z, true)
+	w.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	w.io.Lock()
+	err = r.WriteChunk(x, z, root)
+	w.io.Unlock()
+	if err != nil {
+		return err
+	}
+	w.Evict(x, z)
+	return nil
+}
+
+// Sync commits the region files written to stable storage.
+func (w *World) Sync() (err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.io.RLock()
+	defer w.io.RUnlock()
+	for _, r := range w.regions {
+		if r != nil && r.used != nil {
+			if serr := r.Sync(); err == nil {
+				err = serr
+			}
+		}
+	}
+	return
+}
+
+// Evict drops chunk x, z from the cache, so that it is read again.
+func (w *World) Evict(x, z int32) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.evict(x, z)
+}
+
+func (w *World) evict(x, z int32) {
+	pos := [2]int32{x, z}
+	if e, ok := w.chunks[pos]; ok {
+		delete(w.chunks, pos)
+		w.lru.Remove(e)
+	}
+	delete(w.loading, pos)
+}
+
+// Close closes the region files and empties the cache.
+func (w *World) Close() (err error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.io.Lock()
+	defer w.io.Unlock()
+	for _, r := range w.regions {
+		if r != nil {
+			if cerr := r.Close(); err == nil {
+				err = cerr
+			}
+		}
+	}
+	w.regions, w.chunks, w.loading = nil, nil, nil
+	w.lru.Init()
+	return
+}
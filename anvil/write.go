@@ -0,0 +1,248 @@
+package anvil
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gstoney/mcproto/nbt"
+)
+
+var ErrReadOnly = errors.New("anvil: region opened read-only")
+
+// maxSectors is the largest chunk stored in a region file; larger chunks
+// go to an external .mcc file.
+const maxSectors = 255
+
+// OpenRegionWrite opens the region file at path for reading and writing,
+// creating it if needed.
+func OpenRegionWrite(path string) (*Region, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if fi.Size() == 0 {
+		if _, err := f.WriteAt(make([]byte, 2*SectorSize), 0); err != nil {
+			f.Close()
+			return nil, err
+		}
+	}
+	r, err := newRegion(f, path)
+	if err != nil {
+		return nil, err
+	}
+
+	size := max(int((fi.Size()+SectorSize-1)/SectorSize), 2)
+	r.used = make([]bool, size)
+	r.used[0], r.used[1] = true, true
+	for _, loc := range r.locations {
+		offset, sectors := int(loc>>8), int(loc&0xFF)
+		for i := offset; i < offset+sectors && i < size; i++ {
+			r.used[i] = true
+		}
+	}
+	return r, nil
+}
+
+// WriteChunk saves c as chunk x, z, compressed with zlib.
+//
+// The chunk is written to free sectors before the location table is
+// updated, so that a write interrupted midway leaves the previous version
+// of the chunk in place. Chunks too large for the region go to a temporary
+// file, renamed to the external .mcc file once the table is updated.
+// A Region must not be written concurrently.
+func (r *Region) WriteChunk(x, z int32, c nbt.Compound) error {
+	if r.used == nil {
+		return ErrReadOnly
+	}
+
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	if err := nbt.Write(zw, "", c); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	compression := CompressionZlib
+	payload := buf.Bytes()
+	external := filepath.Join(r.dir, fmt.Sprintf("c.%d.%d.mcc", x, z))
+	var tmp string
+	if 5+len(payload) > maxSectors*SectorSize {
+		var err error
+		if tmp, err = writeTemp(external, payload); err != nil {
+			return err
+		}
+		// Removing fails harmlessly once tmp is renamed.
+		defer os.Remove(tmp)
+		compression |= compressionExternalFlag
+		payload = nil
+	}
+
+	sectors := (5 + len(payload) + SectorSize - 1) / SectorSize
+	data := make([]byte, sectors*SectorSize)
+	binary.BigEndian.PutUint32(data, uint32(len(payload)+1))
+	data[4] = compression
+	copy(data[5:], payload)
+
+	offset := r.allocate(sectors)
+	if _, err := r.f.WriteAt(data, int64(offset)*SectorSize); err != nil {
+		r.free(offset, sectors)
+		return err
+	}
+
+	// The .mcc file is put in place first: it is ignored next to an entry
+	// that is not external, but an external entry needs it.
+	if tmp != "" {
+		if err := os.Rename(tmp, external); err != nil {
+			r.free(offset, sectors)
+			return err
+		}
+	}
+
+	i := index(x, z)
+	old, oldTimestamp := r.locations[i], r.timestamps[i]
+	if err := r.setEntry(i, uint32(offset<<8|sectors), int32(time.Now().Unix())); err != nil {
+		r.setEntry(i, old, oldTimestamp)
+		r.free(offset, sectors)
+		return err
+	}
+	r.free(int(old>>8), int(old&0xFF))
+
+	if tmp != "" {
+		return nil
+	}
+	if err := os.Remove(external); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// DeleteChunk removes chunk x, z, freeing its sectors.
+func (r *Region) DeleteChunk(x, z int32) error {
+	if r.used == nil {
+		return ErrReadOnly
+	}
+	i := index(x, z)
+	old := r.locations[i]
+	if err := r.setEntry(i, 0, 0); err != nil {
+		return err
+	}
+	r.free(int(old>>8), int(old&0xFF))
+
+	external := filepath.Join(r.dir, fmt.Sprintf("c.%d.%d.mcc", x, z))
+	if err := os.Remove(external); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// Sync commits the file to stable storage.
+func (r *Region) Sync() error {
+	return r.f.Sync()
+}
+
+// allocate reserves the first run of n free sectors, growing the file if
+// there is none.
+func (r *Region) allocate(n int) int {
+	run := 0
+	for i, used := range r.used {
+		if used {
+			run = 0
+			continue
+		}
+		if run++; run == n {
+			start := i - n + 1
+			for j := start; j <= i; j++ {
+				r.used[j] = true
+			}
+			return start
+		}
+	}
+
+	start := len(r.used) - run
+	for range n - run {
+		r.used = append(r.used, true)
+	}
+	for j := start; j < start+run; j++ {
+		r.used[j] = true
+	}
+	return start
+}
+
+func (r *Region) free(offset, n int) {
+	for i := offset; i < offset+n && i < len(r.used); i++ {
+		if i >= 2 {
+			r.used[i] = false
+		}
+	}
+}
+
+// setEntry updates entry i of the location and timestamp tables.
+func (r *Region) setEntry(i int, location uint32, timestamp int32) error {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], location)
+	if _, err := r.f.WriteAt(b[:], int64(4*i)); err != nil {
+		return err
+	}
+	r.locations[i] = location
+
+	binary.BigEndian.PutUint32(b[:], uint32(timestamp))
+	if _, err := r.f.WriteAt(b[:], int64(SectorSize+4*i)); err != nil {
+		return err
+	}
+	r.timestamps[i] = timestamp
+	return nil
+}
+
+// writeFileAtomic replaces the file at path with data, through a
+// temporary file renamed over it.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := writeTemp(path, data)
+	if err != nil {
+		return err
+	}
+	if err = os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+	}
+	return err
+}
+
+// writeTemp writes data to a new temporary file next to path, for the
+// caller to rename over path, and returns its name.
+func writeTemp(path string, data []byte) (name string, err error) {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(f.Name())
+		}
+	}()
+
+	if _, err = f.Write(data); err != nil {
+		f.Close()
+		return
+	}
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return
+	}
+	if err = f.Close(); err != nil {
+		return
+	}
+	return f.Name(), nil
+}
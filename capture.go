@@ -0,0 +1,236 @@
+package mcproto
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/gstoney/mcproto/packet"
+)
+
+// Capture files start with captureMagic followed by a version byte,
+// then a sequence of records:
+//
+//	Timestamp  Long (unix nanoseconds)
+//	Direction  Byte
+//	Mode       Byte (ConnectionMode)
+//	Threshold  Int (compression threshold, -1 if disabled)
+//	Frame      VarInt length prefixed raw frame, as seen on the wire
+const (
+	captureMagic   = "MCCAP"
+	captureVersion = 1
+
+	captureChunkLen = 1 << 16
+)
+
+var ErrInvalidCapture = errors.New("invalid capture file")
+
+// CaptureRecord is a single frame recorded from a session.
+// Frame holds the frame body without its length prefix,
+// compressed if the threshold was active.
+type CaptureRecord struct {
+	Time      time.Time
+	Dir       Direction
+	Mode      ConnectionMode
+	Threshold int
+	Frame     []byte
+}
+
+// CaptureWriter writes records in capture format. It is safe for
+// concurrent use.
+type CaptureWriter struct {
+	mu sync.Mutex
+	w  *bufio.Writer
+}
+
+// NewCaptureWriter writes the capture header to w and returns a writer
+// for the records that follow.
+func NewCaptureWriter(w io.Writer) (*CaptureWriter, error) {
+	bw := bufio.NewWriter(w)
+	bw.WriteString(captureMagic)
+	bw.WriteByte(captureVersion)
+	if err := bw.Flush(); err != nil {
+		return nil, err
+	}
+	return &CaptureWriter{w: bw}, nil
+}
+
+// WriteRecord appends rec to the capture.
+func (c *CaptureWriter) WriteRecord(rec CaptureRecord) (err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err = packet.WriteLong(c.w, rec.Time.UnixNano()); err != nil {
+		return
+	}
+	c.w.WriteByte(byte(rec.Dir))
+	c.w.WriteByte(byte(rec.Mode))
+	if err = packet.WriteInt(c.w, int32(rec.Threshold)); err != nil {
+		return
+	}
+	if err = packet.WriteVarInt(c.w, int32(len(rec.Frame))); err != nil {
+		return
+	}
+	if _, err = c.w.Write(rec.Frame); err != nil {
+		return
+	}
+	return c.w.Flush()
+}
+
+// CaptureReader reads records in capture format.
+type CaptureReader struct {
+	r *bufio.Reader
+}
+
+// NewCaptureReader validates the capture header of r and returns a reader
+// for the records that follow.
+func NewCaptureReader(r io.Reader) (*CaptureReader, error) {
+	br := bufio.NewReader(r)
+	header := make([]byte, len(captureMagic)+1)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, ErrInvalidCapture
+	}
+	if string(header[:len(captureMagic)]) != captureMagic || header[len(captureMagic)] != captureVersion {
+		return nil, ErrInvalidCapture
+	}
+	return &CaptureReader{br}, nil
+}
+
+// Next returns the next record, or io.EOF at the end of the capture.
+func (c *CaptureReader) Next() (rec CaptureRecord, err error) {
+	if _, err = c.r.Peek(1); err != nil {
+		return
+	}
+
+	ts, err := packet.ReadLong(c.r)
+	if err != nil {
+		return rec, io.ErrUnexpectedEOF
+	}
+	rec.Time = time.Unix(0, ts)
+
+	var b [2]byte
+	if _, err = io.ReadFull(c.r, b[:]); err != nil {
+		return rec, io.ErrUnexpectedEOF
+	}
+	rec.Dir = Direction(b[0])
+	rec.Mode = ConnectionMode(b[1])
+
+	threshold, err := packet.ReadInt(c.r)
+	if err != nil {
+		return rec, io.ErrUnexpectedEOF
+	}
+	rec.Threshold = int(threshold)
+
+	length, err := packet.ReadVarInt(c.r)
+	if err == io.EOF {
+		return rec, io.ErrUnexpectedEOF
+	} else if err != nil {
+		return
+	}
+	if length < 0 {
+		return rec, ErrInvalidCapture
+	}
+
+	// Grow the frame as it is read, so a forged length fails on EOF
+	// rather than exhausting memory.
+	n := int(length)
+	rec.Frame = make([]byte, 0, min(n, captureChunkLen))
+	for len(rec.Frame) < n {
+		m := min(n-len(rec.Frame), captureChunkLen)
+		rec.Frame = append(rec.Frame, make([]byte, m)...)
+		if _, err = io.ReadFull(c.r, rec.Frame[len(rec.Frame)-m:]); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return
+		}
+	}
+	return
+}
+
+// FrameTap observes raw frames passing through a Transport.
+//
+// TapFrame is called with the frame body, without its length prefix,
+// before the frame is decoded or after it is encoded. The frame slice is
+// only valid during the call.
+type FrameTap interface {
+	TapFrame(outbound bool, threshold int, frame []byte)
+}
+
+// Recorder is a FrameTap writing frames of a session to a capture.
+type Recorder struct {
+	w *CaptureWriter
+
+	// Session provides the mode recorded with each frame.
+	Session *Session
+	// Outbound is the direction of frames sent by the tapped Transport.
+	Outbound Direction
+
+	// Err holds the first error writing the capture.
+	Err error
+}
+
+// NewRecorder returns a Recorder writing to w. Install it on a Transport
+// with SetTap.
+func NewRecorder(w *CaptureWriter, s *Session, outbound Direction) *Recorder {
+	return &Recorder{w: w, Session: s, Outbound: outbound}
+}
+
+func (r *Recorder) TapFrame(outbound bool, threshold int, frame []byte) {
+	if r.Err != nil {
+		return
+	}
+
+	dir := r.Outbound
+	if !outbound {
+		dir = dir.Opposite()
+	}
+	r.Err = r.w.WriteRecord(CaptureRecord{
+		Time:      time.Now(),
+		Dir:       dir,
+		Mode:      r.Session.Mode,
+		Threshold: threshold,
+		Frame:     frame,
+	})
+}
+
+// Replayer feeds captured frames of one direction back through
+// Transport.Recv, applying the recorded compression threshold.
+type Replayer struct {
+	r   *CaptureReader
+	dir Direction
+	src bytes.Buffer
+
+	Transport Transport
+}
+
+// NewReplayer returns a Replayer for frames of r travelling in dir.
+func NewReplayer(r *CaptureReader, dir Direction, cfg TransportConfig) *Replayer {
+	rp := &Replayer{r: r, dir: dir}
+	rp.Transport = NewTransport(&rp.src, nil, cfg)
+	return rp
+}
+
+// Next returns the next record in the replayed direction, and its payload
+// as received through Transport.Recv. The payload must be consumed or
+// discarded before calling Next again.
+func (rp *Replayer) Next() (rec CaptureRecord, r PayloadReader, err error) {
+	for {
+		if rec, err = rp.r.Next(); err != nil {
+			return
+		}
+		if rec.Dir == rp.dir {
+			break
+		}
+	}
+
+	packet.WriteVarInt(&rp.src, int32(len(rec.Frame)))
+	rp.src.Write(rec.Frame)
+	rp.Transport.CompressionThreshold = rec.Threshold
+
+	r, err = rp.Transport.Recv()
+	return
+}
@@ -0,0 +1,210 @@
+package mcproto
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gstoney/mcproto/packet"
+)
+
+// openCapture opens testdata/name for reading. The file is closed when the
+// test finishes.
+func openCapture(t *testing.T, name string) *CaptureReader {
+	t.Helper()
+
+	f, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("open capture: %v", err)
+	}
+	t.Cleanup(func() { f.Close() })
+
+	r, err := NewCaptureReader(f)
+	if err != nil {
+		t.Fatalf("read capture %s: %v", name, err)
+	}
+	return r
+}
+
+// TestCapture_RecordAndReplay verifies that frames tapped on both
+// directions of a Transport are replayed with identical payloads.
+func TestCapture_RecordAndReplay(t *testing.T) {
+	var wire, file bytes.Buffer
+	tr := NewTransport(&wire, &wire, defaultConfig())
+	s := Session{Mode: Config}
+
+	cw, err := NewCaptureWriter(&file)
+	if err != nil {
+		t.Fatalf("NewCaptureWriter: %v", err)
+	}
+	rec := NewRecorder(cw, &s, Clientbound)
+	tr.SetTap(rec)
+
+	payloads := [][]byte{
+		[]byte("uncompressed"),
+		bytes.Repeat([]byte("compressed "), 20),
+	}
+	for i, p := range payloads {
+		tr.CompressionThreshold = 64*i - 1
+		tr.Send(p)
+	}
+	for i, want := range payloads {
+		tr.CompressionThreshold = 64*i - 1
+		pr, err := tr.Recv()
+		if err != nil {
+			t.Fatalf("Recv[%d]: %v", i, err)
+		}
+		if got, _ := io.ReadAll(pr); !bytes.Equal(got, want) {
+			t.Errorf("Recv[%d]: got %q, want %q", i, got, want)
+		}
+	}
+	if rec.Err != nil {
+		t.Fatalf("Recorder: %v", rec.Err)
+	}
+
+	// Sent frames are recorded as Clientbound, received ones as Serverbound.
+	for _, dir := range []Direction{Clientbound, Serverbound} {
+		cr, err := NewCaptureReader(bytes.NewReader(file.Bytes()))
+		if err != nil {
+			t.Fatalf("NewCaptureReader: %v", err)
+		}
+		replayCapture(t, NewReplayer(cr, dir, defaultConfig()), payloads)
+	}
+}
+
+func replayCapture(t *testing.T, rp *Replayer, payloads [][]byte) {
+	t.Helper()
+
+	for i, want := range payloads {
+		rec, pr, err := rp.Next()
+		if err != nil {
+			t.Fatalf("Next[%d]: %v", i, err)
+		}
+		if rec.Mode != Config {
+			t.Errorf("Next[%d]: got mode %v, want Config", i, rec.Mode)
+		}
+		got, err := io.ReadAll(pr)
+		if err != nil {
+			t.Fatalf("ReadAll[%d]: %v", i, err)
+		}
+		if err := pr.Close(); err != nil {
+			t.Fatalf("Close[%d]: %v", i, err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("payload[%d]: got %q, want %q", i, got, want)
+		}
+	}
+
+	if _, _, err := rp.Next(); err != io.EOF {
+		t.Errorf("Next: got %v, want io.EOF", err)
+	}
+}
+
+// TestCapture_ReplayRegistryData verifies decoding of a compressed Registry
+// Data frame captured from a real server.
+func TestCapture_ReplayRegistryData(t *testing.T) {
+	rp := NewReplayer(openCapture(t, "config_registry.mcap"), Clientbound, defaultConfig())
+
+	_, pr, err := rp.Next()
+	if err != nil {
+		t.Fatalf("Next: %v", err)
+	}
+	got, err := io.ReadAll(pr)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if err := pr.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	if !bytes.Equal(got, payload_compressed_reg) {
+		t.Errorf("payload mismatch")
+	}
+
+	r := bufio.NewReader(bytes.NewReader(got))
+	id, _ := packet.ReadVarInt(r)
+	var rd packet.RegistryData
+	if id != rd.ID() {
+		t.Fatalf("got packet 0x%02x, want Registry Data", id)
+	}
+	if err := rd.Decode(r); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if rd.Registry != "minecraft:painting_variant" || len(rd.Entries) != 51 {
+		t.Errorf("got %s with %d entries", rd.Registry, len(rd.Entries))
+	}
+}
+
+// TestCapture_ReplayStatus verifies that captured status exchange decodes
+// with the registries of the recorded modes.
+func TestCapture_ReplayStatus(t *testing.T) {
+	cr := openCapture(t, "status.mcap")
+
+	var names []string
+	for {
+		rec, err := cr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+
+		r := bufio.NewReader(bytes.NewReader(rec.Frame))
+		id, _ := packet.ReadVarInt(r)
+		p := Registry(rec.Mode, rec.Dir)[id]()
+		if err := p.Decode(r); err != nil {
+			t.Fatalf("Decode %T: %v", p, err)
+		}
+		if r.Buffered() > 0 {
+			t.Errorf("%T not fully decoded", p)
+		}
+		names = append(names, PacketName(p))
+	}
+
+	want := []string{"HandshakePacket", "StatusReqPacket", "StatusRespPacket"}
+	if len(names) != len(want) {
+		t.Fatalf("got %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Errorf("packet[%d]: got %s, want %s", i, names[i], want[i])
+		}
+	}
+}
+
+// TestCaptureReader_Truncated verifies that a record cut off anywhere, or
+// claiming more data than the file holds, is reported as unexpected EOF.
+func TestCaptureReader_Truncated(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewCaptureWriter(&buf)
+	if err != nil {
+		t.Fatalf("NewCaptureWriter: %v", err)
+	}
+	if err = w.WriteRecord(CaptureRecord{Threshold: -1, Frame: make([]byte, 200)}); err != nil {
+		t.Fatalf("WriteRecord: %v", err)
+	}
+	file := buf.Bytes()
+	header := len(captureMagic) + 1
+
+	for n := header; n < len(file); n++ {
+		r, err := NewCaptureReader(bytes.NewReader(file[:n]))
+		if err != nil {
+			t.Fatalf("NewCaptureReader: %v", err)
+		}
+		_, err = r.Next()
+		if want := io.ErrUnexpectedEOF; n == header && err != io.EOF || n > header && err != want {
+			t.Errorf("cut at %d: got %v", n, err)
+		}
+	}
+
+	// A length of 2 GiB followed by nothing.
+	forged := append(file[:header+14:header+14], 0xff, 0xff, 0xff, 0xff, 0x07)
+	r, _ := NewCaptureReader(bytes.NewReader(forged))
+	if _, err = r.Next(); err != io.ErrUnexpectedEOF {
+		t.Errorf("forged length: got %v", err)
+	}
+}
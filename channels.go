@@ -0,0 +1,141 @@
+package mcproto
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/gstoney/mcproto/packet"
+)
+
+// BrandChannel carries the name of the client or server software,
+// such as "vanilla".
+const BrandChannel = "minecraft:brand"
+
+// A ChannelHandler handles the payload of a plugin message received on c.
+type ChannelHandler func(c *Conn, data []byte) error
+
+// Channels routes incoming plugin messages to handlers registered by
+// channel Identifier. It is safe for concurrent use.
+//
+// Set it as Conn.Channels to have ReadPacket dispatch plugin messages of
+// Config and Play mode. Messages on channels without a handler are
+// returned by ReadPacket as usual.
+type Channels struct {
+	mu       sync.RWMutex
+	handlers map[string]ChannelHandler
+}
+
+// Handle registers h for channel, replacing any previous handler.
+// A nil h removes the handler.
+func (r *Channels) Handle(channel string, h ChannelHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if h == nil {
+		delete(r.handlers, channel)
+		return
+	}
+	if r.handlers == nil {
+		r.handlers = make(map[string]ChannelHandler)
+	}
+	r.handlers[channel] = h
+}
+
+// HandleBrand registers f for brand messages.
+func (r *Channels) HandleBrand(f func(c *Conn, brand string) error) {
+	r.Handle(BrandChannel, func(c *Conn, data []byte) error {
+		brand, err := DecodeBrand(data)
+		if err != nil {
+			return err
+		}
+		return f(c, brand)
+	})
+}
+
+// Registered returns the channels with a handler, in no particular order.
+func (r *Channels) Registered() (channels []string) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for ch := range r.handlers {
+		channels = append(channels, ch)
+	}
+	return
+}
+
+// Dispatch calls the handler of p's channel if p is a plugin message.
+// handled reports whether a handler was called.
+func (r *Channels) Dispatch(c *Conn, p packet.Packet) (handled bool, err error) {
+	channel, data, ok := PluginMessage(p)
+	if !ok {
+		return false, nil
+	}
+
+	r.mu.RLock()
+	h := r.handlers[channel]
+	r.mu.RUnlock()
+
+	if h == nil {
+		return false, nil
+	}
+	return true, h(c, data)
+}
+
+// PluginMessage returns the channel and payload of p if p is a plugin
+// message of Config or Play mode, in either direction.
+func PluginMessage(p packet.Packet) (channel string, data []byte, ok bool) {
+	switch p := p.(type) {
+	case *packet.ConfigClientboundPluginMessage:
+		return p.Channel, p.Data, true
+	case *packet.ConfigServerboundPluginMessage:
+		return p.Channel, p.Data, true
+	case *packet.PlayClientboundPluginMessage:
+		return p.Channel, p.Data, true
+	case *packet.PlayServerboundPluginMessage:
+		return p.Channel, p.Data, true
+	}
+	return "", nil, false
+}
+
+// SendPluginMessage sends data on channel in the current mode, which must
+// be Config or Play.
+func (c *Conn) SendPluginMessage(channel string, data []byte) error {
+	var p packet.Packet
+	switch {
+	case c.Session.Mode == Config && c.Outbound == Clientbound:
+		p = &packet.ConfigClientboundPluginMessage{Channel: channel, Data: data}
+	case c.Session.Mode == Config:
+		p = &packet.ConfigServerboundPluginMessage{Channel: channel, Data: data}
+	case c.Session.Mode == Play && c.Outbound == Clientbound:
+		p = &packet.PlayClientboundPluginMessage{Channel: channel, Data: data}
+	case c.Session.Mode == Play:
+		p = &packet.PlayServerboundPluginMessage{Channel: channel, Data: data}
+	default:
+		return ErrInvalidMode
+	}
+	return c.WritePacket(p)
+}
+
+// SendBrand sends brand on BrandChannel.
+func (c *Conn) SendBrand(brand string) error {
+	return c.SendPluginMessage(BrandChannel, EncodeBrand(brand))
+}
+
+// EncodeBrand returns the payload of a brand message, a String.
+func EncodeBrand(brand string) []byte {
+	var buf bytes.Buffer
+	packet.WriteString(&buf, brand)
+	return buf.Bytes()
+}
+
+// DecodeBrand reads the payload of a brand message.
+func DecodeBrand(data []byte) (brand string, err error) {
+	r := bytes.NewReader(data)
+	if brand, err = packet.ReadString(r); err != nil {
+		return
+	}
+	if r.Len() > 0 {
+		err = ErrNotExhausted
+	}
+	return
+}
@@ -0,0 +1,68 @@
+package mcproto
+
+import (
+	"testing"
+)
+
+// TestChannels_Dispatch verifies that ReadPacket hands plugin messages to
+// registered handlers and returns those on other channels.
+func TestChannels_Dispatch(t *testing.T) {
+	for _, mode := range []ConnectionMode{Config, Play} {
+		t.Run(mode.String(), func(t *testing.T) {
+			server, client := loginPipe(t)
+			server.Session.Mode = mode
+			client.Session.Mode = mode
+
+			var brand string
+			var echoed []byte
+			server.Channels = &Channels{}
+			server.Channels.HandleBrand(func(c *Conn, b string) error {
+				brand = b
+				return nil
+			})
+			server.Channels.Handle("test:echo", func(c *Conn, data []byte) error {
+				echoed = data
+				return c.SendPluginMessage("test:echo", data)
+			})
+
+			go func() {
+				client.SendBrand("vanilla")
+				client.SendPluginMessage("test:echo", []byte("ping"))
+				client.ReadPacket()
+				client.SendPluginMessage("test:other", []byte("data"))
+			}()
+
+			p, err := server.ReadPacket()
+			if err != nil {
+				t.Fatalf("ReadPacket: %v", err)
+			}
+			channel, data, ok := PluginMessage(p)
+			if !ok || channel != "test:other" || string(data) != "data" {
+				t.Errorf("got %s %q, want unhandled test:other message", PacketName(p), data)
+			}
+			if brand != "vanilla" {
+				t.Errorf("got brand %q, want vanilla", brand)
+			}
+			if string(echoed) != "ping" {
+				t.Errorf("got echo payload %q, want ping", echoed)
+			}
+		})
+	}
+}
+
+func TestBrand(t *testing.T) {
+	data := EncodeBrand("vanilla")
+	if got, err := DecodeBrand(data); err != nil || got != "vanilla" {
+		t.Errorf("DecodeBrand: got %q %v", got, err)
+	}
+	if _, err := DecodeBrand(append(data, 0)); err != ErrNotExhausted {
+		t.Errorf("DecodeBrand with trailing data: got %v, want ErrNotExhausted", err)
+	}
+}
+
+func TestSendPluginMessage_InvalidMode(t *testing.T) {
+	server, _ := loginPipe(t)
+	if err := server.SendPluginMessage("test:x", nil); err != ErrInvalidMode {
+		t.Errorf("got %v, want ErrInvalidMode", err)
+	}
+}
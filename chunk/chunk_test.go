@@ -0,0 +1,330 @@
+package chunk
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/gstoney/mcproto/packet"
+)
+
+func TestKind_Bits(t *testing.T) {
+	for _, tc := range []struct {
+		kind     Kind
+		distinct int
+		want     int
+	}{
+		{BlockStates, 1, 0},
+		{BlockStates, 2, 4},
+		{BlockStates, 16, 4},
+		{BlockStates, 17, 5},
+		{BlockStates, 256, 8},
+		{BlockStates, 257, 15},
+		{Biomes, 1, 0},
+		{Biomes, 2, 1},
+		{Biomes, 5, 3},
+		{Biomes, 9, 6},
+		{BiomesOf(65), 9, 7},
+	} {
+		if got := tc.kind.Bits(tc.distinct); got != tc.want {
+			t.Errorf("Bits(%d) of %+v: got %d, want %d", tc.distinct, tc.kind, got, tc.want)
+		}
+	}
+}
+
+func TestWriteContainer_SingleValue(t *testing.T) {
+	var buf bytes.Buffer
+	values := make([]int32, BiomeVolume)
+	for i := range values {
+		values[i] = 39
+	}
+	if err := WriteContainer(&buf, Biomes, values); err != nil {
+		t.Fatalf("WriteContainer: %v", err)
+	}
+	if want := []byte{0, 39, 0}; !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("got %x, want %x", buf.Bytes(), want)
+	}
+}
+
+// TestWriteContainer_Packing verifies that entries are packed from the
+// low bits, without spanning longs.
+func TestWriteContainer_Packing(t *testing.T) {
+	values := make([]int32, BiomeVolume)
+	for i := range values {
+		values[i] = int32(i % 5)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteContainer(&buf, Biomes, values); err != nil {
+		t.Fatalf("WriteContainer: %v", err)
+	}
+
+	// 3 bits, palette 0..4, 21 entries per long.
+	want := []byte{3, 5, 0, 1, 2, 3, 4, 4}
+	long := []byte{0, 0, 0, 0, 0, 0, 0, 0}
+	var l uint64
+	for i := range 21 {
+		l |= uint64(i%5) << (i * 3)
+	}
+	for i := range long {
+		long[i] = byte(l >> (56 - 8*i))
+	}
+	if got := buf.Bytes()[:len(want)+8]; !bytes.Equal(got, append(want, long...)) {
+		t.Errorf("got %x, want %x%x", got, want, long)
+	}
+	if got, want := buf.Len(), len(want)+4*8; got != want {
+		t.Errorf("got %d bytes, want %d", got, want)
+	}
+}
+
+// TestSection_RoundTrip verifies that sections decode to what was
+// encoded, through every palette.
+func TestSection_RoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		distinct int32
+		bits     byte
+	}{
+		{"single", 1, 0},
+		{"indirect 4 bits", 3, 4},
+		{"indirect 6 bits", 40, 6},
+		{"direct", 1000, 15},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := new(Section)
+			for i := range s.Blocks {
+				s.Blocks[i] = int32(i) % tc.distinct * 7
+			}
+			s.FillBiome(39)
+			s.SetBiome(15, 15, 15, 1)
+
+			var buf bytes.Buffer
+			if err := s.Encode(&buf); err != nil {
+				t.Fatalf("Encode: %v", err)
+			}
+			if got := buf.Bytes()[2]; got != tc.bits {
+				t.Errorf("got %d bits per entry, want %d", got, tc.bits)
+			}
+
+			got := new(Section)
+			if err := got.Decode(bytes.NewReader(buf.Bytes())); err != nil {
+				t.Fatalf("Decode: %v", err)
+			}
+			if *got != *s {
+				t.Errorf("sections differ after round trip")
+			}
+		})
+	}
+}
+
+func TestSection_Accessors(t *testing.T) {
+	s := new(Section)
+	s.Fill(1)
+	s.SetBlock(3, 5, 7, Air)
+	s.SetBiome(5, 0, 9, 2)
+
+	if got := s.Block(3, 5, 7); got != Air {
+		t.Errorf("Block: got %d", got)
+	}
+	if got := s.Blocks[5*256+7*16+3]; got != Air {
+		t.Errorf("Blocks index: got %d", got)
+	}
+	if got := s.BlockCount(); got != SectionVolume-1 {
+		t.Errorf("BlockCount: got %d", got)
+	}
+	if got := s.Biome(4, 3, 11); got != 2 {
+		t.Errorf("Biome: got %d", got)
+	}
+}
+
+func TestSections(t *testing.T) {
+	bottom, top := new(Section), new(Section)
+	bottom.Fill(79)
+	bottom.SetBlock(0, 15, 0, 10)
+
+	data, err := EncodeSections([]*Section{bottom, top})
+	if err != nil {
+		t.Fatalf("EncodeSections: %v", err)
+	}
+	sections, err := DecodeSections(data, 2)
+	if err != nil {
+		t.Fatalf("DecodeSections: %v", err)
+	}
+	if *sections[0] != *bottom || *sections[1] != *top {
+		t.Errorf("sections differ after round trip")
+	}
+
+	if _, err := DecodeSections(data, 1); err != ErrTrailingData {
+		t.Errorf("got %v, want ErrTrailingData", err)
+	}
+}
+
+func TestReadContainer_Errors(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{"bits", []byte{33}, ErrInvalidBits},
+		{"empty palette", []byte{1, 0}, ErrPaletteLength},
+		{"single with data", []byte{0, 1, 1, 0, 0, 0, 0, 0, 0, 0, 0}, ErrDataLength},
+		{"short data", []byte{1, 2, 5, 6, 0}, ErrDataLength},
+		{"palette index", []byte{2, 2, 5, 6, 2, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 0}, ErrPaletteIndex},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			values := make([]int32, BiomeVolume)
+			err := ReadContainer(bytes.NewReader(tc.data), Biomes, values)
+			if !errors.Is(err, tc.want) {
+				t.Errorf("got %v, want %v", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestWriteContainer_ValueRange(t *testing.T) {
+	values := make([]int32, BiomeVolume)
+	for i := range values {
+		values[i] = int32(i) * 2
+	}
+	if err := WriteContainer(new(bytes.Buffer), Biomes, values); err != ErrValueRange {
+		t.Errorf("got %v, want ErrValueRange", err)
+	}
+}
+
+func TestColumn_Heightmaps(t *testing.T) {
+	c := NewColumn(0, 0, -64, 384)
+	c.Sections[0].Fill(1)
+	c.SetBlock(3, 100, 5, 1)
+
+	hm := c.Heightmaps(SolidBlocks{})
+	for _, name := range []string{MotionBlocking, WorldSurface} {
+		data, ok := hm[name].([]int64)
+		if !ok || len(data) != 37 {
+			t.Fatalf("%s: got %v, want 37 longs", name, hm[name])
+		}
+		h, err := HeightmapOf(data, 384)
+		if err != nil {
+			t.Fatalf("HeightmapOf: %v", err)
+		}
+		if got := h[5*16+3]; got != 165 {
+			t.Errorf("%s at 3, 5: got %d, want 165", name, got)
+		}
+		if got := h[0]; got != 16 {
+			t.Errorf("%s at 0, 0: got %d, want 16", name, got)
+		}
+	}
+
+	if _, err := HeightmapOf(make([]int64, 36), 384); err != ErrDataLength {
+		t.Errorf("got %v, want ErrDataLength", err)
+	}
+}
+
+// TestColumn_Light verifies light under a roof, lit by a single emitter.
+func TestColumn_Light(t *testing.T) {
+	const stone, torch = 1, 2
+	c := NewColumn(0, 0, 0, 32)
+	for z := range 16 {
+		for x := range 16 {
+			c.SetBlock(x, 0, z, stone)
+			c.SetBlock(x, 20, z, stone)
+		}
+	}
+	c.SetBlock(8, 10, 8, torch)
+
+	l := c.Light(SolidBlocks{Emitters: map[int32]int{torch: 14}})
+	if len(l.Sky) != 4 || len(l.Block) != 4 {
+		t.Fatalf("got %d sky and %d block sections, want 4", len(l.Sky), len(l.Block))
+	}
+
+	for _, tc := range []struct {
+		name    string
+		n       *Nibbles
+		x, y, z int
+		want    int
+	}{
+		{"sky above roof", l.Sky[2], 0, 5, 0, 15},
+		{"sky in roof", l.Sky[2], 0, 4, 0, 0},
+		{"sky under roof", l.Sky[1], 8, 10, 8, 0},
+		{"sky above world", l.Sky[3], 0, 0, 0, 15},
+		{"emitter", l.Block[1], 8, 10, 8, 14},
+		{"next to emitter", l.Block[1], 9, 10, 8, 13},
+		{"4 blocks away", l.Block[1], 8, 10, 12, 10},
+		{"diagonal", l.Block[1], 10, 11, 9, 10},
+		{"next section", l.Block[2], 8, 0, 8, 8},
+		{"in floor", l.Block[1], 8, 0, 8, 0},
+	} {
+		if got := tc.n.Get(tc.x, tc.y, tc.z); got != tc.want {
+			t.Errorf("%s: got %d, want %d", tc.name, got, tc.want)
+		}
+	}
+
+	d := l.Data()
+	for _, tc := range []struct {
+		name string
+		got  []int64
+		want int64
+	}{
+		{"sky", d.SkyLightMask, 0b1100},
+		{"empty sky", d.EmptySkyLightMask, 0b0011},
+		{"block", d.BlockLightMask, 0b0110},
+		{"empty block", d.EmptyBlockLightMask, 0b1001},
+	} {
+		if !slices.Equal(tc.got, []int64{tc.want}) {
+			t.Errorf("%s mask: got %b, want %b", tc.name, tc.got, tc.want)
+		}
+	}
+	if len(d.SkyLight) != 2 || len(d.BlockLight) != 2 {
+		t.Errorf("got %d sky and %d block arrays, want 2", len(d.SkyLight), len(d.BlockLight))
+	}
+}
+
+func TestNibbles(t *testing.T) {
+	var n Nibbles
+	n.Set(1, 0, 0, 7)
+	n.Set(0, 0, 0, 15)
+	n.Set(1, 0, 0, 9)
+	if n[0] != 0x9F {
+		t.Errorf("got %x, want 9f", n[0])
+	}
+	if got := n.Get(1, 0, 0); got != 9 {
+		t.Errorf("got %d, want 9", got)
+	}
+}
+
+// TestChunkData verifies that a column survives Chunk Data encoding.
+func TestChunkData(t *testing.T) {
+	c := NewColumn(2, -3, -64, 384)
+	c.Sections[0].Fill(79)
+	c.Sections[0].FillBiome(39)
+
+	p, err := c.ChunkData(SolidBlocks{})
+	if err != nil {
+		t.Fatalf("ChunkData: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := p.Encode(&buf); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+	r := bytes.NewReader(buf.Bytes())
+	if id, err := packet.ReadVarInt(r); err != nil || id != p.ID() {
+		t.Fatalf("got ID %d, %v", id, err)
+	}
+	got := new(packet.ChunkDataAndUpdateLight)
+	if err := got.Decode(r); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if !reflect.DeepEqual(got, p) {
+		t.Errorf("got %+v, want %+v", got.Light, p.Light)
+	}
+
+	sections, err := DecodeSections(got.Data, 24)
+	if err != nil {
+		t.Fatalf("DecodeSections: %v", err)
+	}
+	if sections[0].Block(0, 0, 0) != 79 || sections[0].Biome(0, 0, 0) != 39 {
+		t.Errorf("got bottom section %d, %d", sections[0].Block(0, 0, 0), sections[0].Biome(0, 0, 0))
+	}
+}
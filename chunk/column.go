@@ -0,0 +1,95 @@
+package chunk
+
+import "github.com/gstoney/mcproto/packet"
+
+// Column is a chunk: the sections of a 16-block wide column of the world,
+// from the bottom up.
+type Column struct {
+	X, Z     int32
+	MinY     int // Y of the lowest block, a multiple of 16.
+	Sections []*Section
+}
+
+// NewColumn returns an empty column of chunk x, z, spanning height blocks
+// from minY, such as -64 and 384 for the overworld.
+func NewColumn(x, z int32, minY, height int) *Column {
+	c := &Column{X: x, Z: z, MinY: minY, Sections: make([]*Section, height/SectionWidth)}
+	for i := range c.Sections {
+		c.Sections[i] = new(Section)
+	}
+	return c
+}
+
+// Height returns the number of blocks the column spans.
+func (c *Column) Height() int {
+	return len(c.Sections) * SectionWidth
+}
+
+// Block returns the block state at x, z in [0, 16) and world height y.
+// Blocks out of the column are Air.
+func (c *Column) Block(x, y, z int) int32 {
+	y -= c.MinY
+	if y < 0 || y >= c.Height() {
+		return Air
+	}
+	return c.Sections[y/SectionWidth].Block(x, y%SectionWidth, z)
+}
+
+// SetBlock sets the block state at x, z in [0, 16) and world height y,
+// which must be within the column.
+func (c *Column) SetBlock(x, y, z int, state int32) {
+	y -= c.MinY
+	c.Sections[y/SectionWidth].SetBlock(x, y%SectionWidth, z, state)
+}
+
+// Blocks describes block states, for heightmaps and light.
+type Blocks interface {
+	IsAir(state int32) bool
+
+	// BlocksMotion reports whether entities collide with the block, or it
+	// holds fluid, as for the MOTION_BLOCKING heightmap.
+	BlocksMotion(state int32) bool
+
+	// Opacity is the light lost through the block, from 0 to 15.
+	Opacity(state int32) int
+
+	// Emission is the light the block emits, from 0 to 15.
+	Emission(state int32) int
+}
+
+// SolidBlocks describes every state other than Air as a full opaque
+// block, emitting the light of Emitters.
+type SolidBlocks struct {
+	Emitters map[int32]int
+}
+
+func (b SolidBlocks) IsAir(state int32) bool        { return state == Air }
+func (b SolidBlocks) BlocksMotion(state int32) bool { return state != Air }
+
+func (b SolidBlocks) Opacity(state int32) int {
+	if state == Air {
+		return 0
+	}
+	return 15
+}
+
+func (b SolidBlocks) Emission(state int32) int {
+	return b.Emitters[state]
+}
+
+// ChunkData returns the Chunk Data packet of c, with heightmaps and light
+// computed from b.
+func (c *Column) ChunkData(b Blocks) (*packet.ChunkDataAndUpdateLight, error) {
+	data, err := EncodeSections(c.Sections)
+	if err != nil {
+		return nil, err
+	}
+	return &packet.ChunkDataAndUpdateLight{
+		ChunkX:        c.X,
+		ChunkZ:        c.Z,
+		Heightmaps:    c.Heightmaps(b),
+		Data:          data,
+		BlockEntities: []packet.BlockEntity{},
+		Light:         c.Light(b).Data(),
+	}, nil
+}
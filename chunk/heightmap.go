@@ -0,0 +1,52 @@
+package chunk
+
+import "github.com/gstoney/mcproto/nbt"
+
+// Heightmap types sent to the client.
+const (
+	MotionBlocking = "MOTION_BLOCKING"
+	WorldSurface   = "WORLD_SURFACE"
+)
+
+// Heightmap holds, for each column indexed by z*16+x, the height above
+// the bottom of the world of the block above the highest matching block,
+// or 0 if no block matches.
+type Heightmap [SectionWidth * SectionWidth]int32
+
+// Heightmap computes the heightmap of blocks matching match.
+func (c *Column) Heightmap(match func(state int32) bool) (h Heightmap) {
+	for z := range SectionWidth {
+		for x := range SectionWidth {
+			for y := c.Height() - 1; y >= 0; y-- {
+				if match(c.Block(x, c.MinY+y, z)) {
+					h[z*SectionWidth+x] = int32(y + 1)
+					break
+				}
+			}
+		}
+	}
+	return
+}
+
+// Heightmaps returns the MOTION_BLOCKING and WORLD_SURFACE heightmaps, as
+// sent in Chunk Data.
+func (c *Column) Heightmaps(b Blocks) nbt.Compound {
+	motion := c.Heightmap(b.BlocksMotion)
+	surface := c.Heightmap(func(state int32) bool { return !b.IsAir(state) })
+	return nbt.Compound{
+		MotionBlocking: motion.Longs(c.Height()),
+		WorldSurface:   surface.Longs(c.Height()),
+	}
+}
+
+// Longs packs h for a world height blocks high.
+func (h *Heightmap) Longs(height int) []int64 {
+	return PackLongs(h[:], ceilLog2(height+1))
+}
+
+// HeightmapOf unpacks data, packed by Longs for a world height blocks
+// high.
+func HeightmapOf(data []int64, height int) (h Heightmap, err error) {
+	err = UnpackLongs(data, ceilLog2(height+1), h[:])
+	return
+}
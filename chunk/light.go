@@ -0,0 +1,161 @@
+package chunk
+
+import (
+	"github.com/gstoney/mcproto/packet"
+)
+
+// MaxLight is the highest light level.
+const MaxLight = 15
+
+// Nibbles holds a light level per block of a section, two per byte with
+// the lower index in the low nibble.
+type Nibbles [SectionVolume / 2]byte
+
+// Get returns the level at x, y, z, each in [0, 16).
+func (n *Nibbles) Get(x, y, z int) int {
+	i := (y*SectionWidth+z)*SectionWidth + x
+	return int(n[i/2] >> (i % 2 * 4) & 0xF)
+}
+
+// Set sets the level at x, y, z, each in [0, 16).
+func (n *Nibbles) Set(x, y, z, level int) {
+	i := (y*SectionWidth+z)*SectionWidth + x
+	shift := i % 2 * 4
+	n[i/2] = n[i/2]&^(0xF<<shift) | byte(level)<<shift
+}
+
+// Light holds the sky and block light of a column. Both have a section
+// below and above the column's sections, as the client expects. Sections
+// of unknown light are nil, and left for the client to work out.
+type Light struct {
+	Sky, Block []*Nibbles
+}
+
+// Light computes the light of the column, within it alone.
+//
+// Sky light is full down to the first block with any opacity in each
+// column of blocks; block light starts at emitters. Both then spread to
+// neighbouring blocks, losing the block's opacity and at least 1 per
+// block.
+func (c *Column) Light(b Blocks) *Light {
+	height := c.Height()
+	opacity := make([]int8, height*SectionWidth*SectionWidth)
+	sky := make([]int8, len(opacity))
+	block := make([]int8, len(opacity))
+	var skyQueue, blockQueue []int32
+
+	for y := range height {
+		for z := range SectionWidth {
+			for x := range SectionWidth {
+				i := (y*SectionWidth+z)*SectionWidth + x
+				state := c.Block(x, c.MinY+y, z)
+				opacity[i] = int8(min(b.Opacity(state), MaxLight))
+				if e := b.Emission(state); e > 0 {
+					block[i] = int8(min(e, MaxLight))
+					blockQueue = append(blockQueue, int32(i))
+				}
+			}
+		}
+	}
+
+	for z := range SectionWidth {
+		for x := range SectionWidth {
+			for y := height - 1; y >= 0; y-- {
+				i := (y*SectionWidth+z)*SectionWidth + x
+				if opacity[i] > 0 {
+					break
+				}
+				sky[i] = MaxLight
+				skyQueue = append(skyQueue, int32(i))
+			}
+		}
+	}
+
+	spread(sky, opacity, height, skyQueue)
+	spread(block, opacity, height, blockQueue)
+
+	sections := len(c.Sections)
+	l := &Light{
+		Sky:   make([]*Nibbles, sections+2),
+		Block: make([]*Nibbles, sections+2),
+	}
+	above := new(Nibbles)
+	for i := range above {
+		above[i] = 0xFF
+	}
+	l.Sky[0], l.Sky[sections+1] = new(Nibbles), above
+	l.Block[0], l.Block[sections+1] = new(Nibbles), new(Nibbles)
+	for s := range sections {
+		l.Sky[s+1] = nibbles(sky[s*SectionVolume : (s+1)*SectionVolume])
+		l.Block[s+1] = nibbles(block[s*SectionVolume : (s+1)*SectionVolume])
+	}
+	return l
+}
+
+// spread propagates levels from queue, breadth first, through blocks of
+// a column height blocks high.
+func spread(levels, opacity []int8, height int, queue []int32) {
+	const (
+		dx = 1
+		dz = SectionWidth
+		dy = SectionWidth * SectionWidth
+	)
+	for len(queue) > 0 {
+		i := int(queue[0])
+		queue = queue[1:]
+		x, z, y := i%SectionWidth, i/dz%SectionWidth, i/dy
+
+		for _, n := range [6]struct {
+			ok bool
+			d  int
+		}{
+			{x > 0, -dx}, {x < SectionWidth-1, dx},
+			{z > 0, -dz}, {z < SectionWidth-1, dz},
+			{y > 0, -dy}, {y < height-1, dy},
+		} {
+			if !n.ok {
+				continue
+			}
+			j := i + n.d
+			level := levels[i] - max(opacity[j], 1)
+			if level > levels[j] {
+				levels[j] = level
+				queue = append(queue, int32(j))
+			}
+		}
+	}
+}
+
+func nibbles(levels []int8) *Nibbles {
+	n := new(Nibbles)
+	for i, l := range levels {
+		n[i/2] |= byte(l) << (i % 2 * 4)
+	}
+	return n
+}
+
+// Data returns l as sent in Chunk Data and Update Light. Sections without
+// light are marked empty rather than sent.
+func (l *Light) Data() (d packet.LightData) {
+	d.SkyLightMask, d.EmptySkyLightMask, d.SkyLight = lightArrays(l.Sky)
+	d.BlockLightMask, d.EmptyBlockLightMask, d.BlockLight = lightArrays(l.Block)
+	return
+}
+
+func lightArrays(sections []*Nibbles) (mask, empty []int64, arrays [][]byte) {
+	mask = make([]int64, (len(sections)+63)/64)
+	empty = make([]int64, len(mask))
+	arrays = [][]byte{}
+	for i, n := range sections {
+		if n == nil {
+			continue
+		}
+		if *n == (Nibbles{}) {
+			empty[i/64] |= 1 << (i % 64)
+			continue
+		}
+		mask[i/64] |= 1 << (i % 64)
+		arrays = append(arrays, n[:])
+	}
+	return
+}
@@ -0,0 +1,228 @@
+package chunk
+
+import (
+	"errors"
+	"math/bits"
+
+	"github.com/gstoney/mcproto/packet"
+)
+
+var (
+	ErrInvalidBits   = errors.New("chunk: invalid bits per entry")
+	ErrPaletteLength = errors.New("chunk: invalid palette length")
+	ErrPaletteIndex  = errors.New("chunk: palette index out of range")
+	ErrDataLength    = errors.New("chunk: data array length mismatch")
+	ErrValueRange    = errors.New("chunk: value out of range of the global palette")
+)
+
+// Kind describes a paletted container: how many entries it holds, and how
+// many bits per entry its palettes use.
+type Kind struct {
+	Size int // Number of entries.
+
+	// Indirect palettes use between MinBits and MaxBits bits per entry.
+	MinBits, MaxBits int
+
+	// DirectBits is the number of bits per entry of the direct palette,
+	// enough for every ID of the registry.
+	DirectBits int
+}
+
+var (
+	// BlockStates is the kind of the block-state container of a section,
+	// for the 26684 block states of 1.21.1.
+	BlockStates = Kind{Size: SectionVolume, MinBits: 4, MaxBits: 8, DirectBits: 15}
+
+	// Biomes is the kind of the biome container of a section, for the 64
+	// vanilla biomes. Servers adding biomes need a larger DirectBits, see
+	// BiomesOf.
+	Biomes = BiomesOf(64)
+)
+
+// BiomesOf returns the kind of the biome container for a biome registry of
+// n entries.
+func BiomesOf(n int) Kind {
+	return Kind{Size: BiomeVolume, MinBits: 1, MaxBits: 3, DirectBits: ceilLog2(n)}
+}
+
+// Bits returns the bits per entry used to encode distinct different
+// values: 0 for a single value, the indirect bits if they fit, or the
+// direct bits.
+func (k Kind) Bits(distinct int) int {
+	if distinct <= 1 {
+		return 0
+	}
+	b := max(ceilLog2(distinct), k.MinBits)
+	if b > k.MaxBits {
+		return k.DirectBits
+	}
+	return b
+}
+
+func ceilLog2(n int) int {
+	if n <= 1 {
+		return 0
+	}
+	return bits.Len(uint(n - 1))
+}
+
+// dataLength returns the number of longs holding size entries of b bits.
+// Entries do not span longs.
+func dataLength(size, b int) int {
+	perLong := 64 / b
+	return (size + perLong - 1) / perLong
+}
+
+// WriteContainer writes values, of length k.Size, as a paletted container
+// with the fewest bits per entry.
+func WriteContainer(w packet.Writer, k Kind, values []int32) (err error) {
+	var palette []int32
+	index := make(map[int32]int32)
+	for _, v := range values {
+		if _, ok := index[v]; !ok {
+			index[v] = int32(len(palette))
+			palette = append(palette, v)
+		}
+	}
+
+	b := k.Bits(len(palette))
+	if err = w.WriteByte(byte(b)); err != nil {
+		return
+	}
+
+	switch {
+	case b == 0:
+		var v int32
+		if len(values) > 0 {
+			v = values[0]
+		}
+		if err = packet.WriteVarInt(w, v); err != nil {
+			return
+		}
+		return packet.WriteVarInt(w, 0)
+	case b <= k.MaxBits:
+		if err = packet.WritePrefixedArray(w, palette, packet.WriteVarInt); err != nil {
+			return
+		}
+	default:
+		index = nil
+		for _, v := range palette {
+			if v < 0 || v >= 1<<b {
+				return ErrValueRange
+			}
+		}
+	}
+
+	if index != nil {
+		indices := make([]int32, len(values))
+		for i, v := range values {
+			indices[i] = index[v]
+		}
+		values = indices
+	}
+	return packet.WritePrefixedArray(w, PackLongs(values, b), packet.WriteLong)
+}
+
+// PackLongs packs values of b bits each into longs, from the least
+// significant bits and without spanning longs, as in paletted containers,
+// heightmaps and world files.
+func PackLongs(values []int32, b int) []int64 {
+	data := make([]int64, dataLength(len(values), b))
+	perLong := 64 / b
+	for i, v := range values {
+		data[i/perLong] |= int64(v) << (i % perLong * b)
+	}
+	return data
+}
+
+// UnpackLongs unpacks data, packed by PackLongs, into values. It fails if
+// data does not hold exactly len(values) entries.
+func UnpackLongs(data []int64, b int, values []int32) error {
+	if b <= 0 || b > 32 {
+		return ErrInvalidBits
+	}
+	if len(data) != dataLength(len(values), b) {
+		return ErrDataLength
+	}
+	perLong := 64 / b
+	mask := uint64(1)<<b - 1
+	for i := range values {
+		values[i] = int32(uint64(data[i/perLong]) >> (i % perLong * b) & mask)
+	}
+	return nil
+}
+
+// ReadContainer reads a paletted container of kind k into values, of
+// length k.Size. The bits per entry of the encoding are accepted as is,
+// even if they differ from those WriteContainer would choose.
+func ReadContainer(r packet.Reader, k Kind, values []int32) (err error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return
+	}
+	if b > 32 {
+		return ErrInvalidBits
+	}
+
+	var palette []int32
+	switch {
+	case b == 0:
+		v, err := packet.ReadVarInt(r)
+		if err != nil {
+			return err
+		}
+		for i := range values {
+			values[i] = v
+		}
+	case int(b) <= k.MaxBits:
+		n, err := packet.ReadVarInt(r)
+		if err != nil {
+			return err
+		}
+		if n <= 0 || int(n) > k.Size {
+			return ErrPaletteLength
+		}
+		palette = make([]int32, n)
+		for i := range palette {
+			if palette[i], err = packet.ReadVarInt(r); err != nil {
+				return err
+			}
+		}
+	}
+
+	n, err := packet.ReadVarInt(r)
+	if err != nil {
+		return
+	}
+	if b == 0 {
+		if n != 0 {
+			return ErrDataLength
+		}
+		return nil
+	}
+	if int(n) != dataLength(len(values), int(b)) {
+		return ErrDataLength
+	}
+
+	perLong := 64 / int(b)
+	mask := uint64(1)<<b - 1
+	var long uint64
+	for i := range values {
+		if i%perLong == 0 {
+			l, err := packet.ReadLong(r)
+			if err != nil {
+				return err
+			}
+			long = uint64(l)
+		}
+		v := int32(long >> (i % perLong * int(b)) & mask)
+		if palette != nil {
+			if int(v) >= len(palette) {
+				return ErrPaletteIndex
+			}
+			v = palette[v]
+		}
+		values[i] = v
+	}
+	return nil
+}
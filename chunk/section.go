@@ -0,0 +1,148 @@
+// Package chunk encodes chunk sections in the 1.21.1 network format, as
+// carried by Chunk Data.
+//
+// A section is a 16×16×16 cube of block states, with biomes stored per
+// 4×4×4 cell. Both are sent as paletted containers, whose encoding
+// depends on the number of distinct values:
+//
+//   - a single value is sent alone, with 0 bits per entry;
+//   - a few values use an indirect palette, entries being indices into it;
+//   - otherwise entries are registry IDs, the direct palette.
+//
+// Entries are packed into longs from the least significant bits, without
+// spanning longs.
+package chunk
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/gstoney/mcproto/packet"
+)
+
+const (
+	SectionWidth  = 16
+	SectionVolume = SectionWidth * SectionWidth * SectionWidth
+	BiomeWidth    = 4
+	BiomeVolume   = BiomeWidth * BiomeWidth * BiomeWidth
+)
+
+// Air is the block state ID of minecraft:air.
+const Air int32 = 0
+
+var ErrTrailingData = errors.New("chunk: trailing data after sections")
+
+// Section holds the block states and biomes of a 16×16×16 section,
+// indexed by (y*16+z)*16+x and (y*4+z)*4+x respectively.
+type Section struct {
+	Blocks [SectionVolume]int32
+	Biomes [BiomeVolume]int32
+}
+
+// Block returns the block state at x, y, z, each in [0, 16).
+func (s *Section) Block(x, y, z int) int32 {
+	return s.Blocks[(y*SectionWidth+z)*SectionWidth+x]
+}
+
+// SetBlock sets the block state at x, y, z, each in [0, 16).
+func (s *Section) SetBlock(x, y, z int, state int32) {
+	s.Blocks[(y*SectionWidth+z)*SectionWidth+x] = state
+}
+
+// Biome returns the biome of the cell holding block x, y, z.
+func (s *Section) Biome(x, y, z int) int32 {
+	return s.Biomes[(y/4*BiomeWidth+z/4)*BiomeWidth+x/4]
+}
+
+// SetBiome sets the biome of the cell holding block x, y, z.
+func (s *Section) SetBiome(x, y, z int, biome int32) {
+	s.Biomes[(y/4*BiomeWidth+z/4)*BiomeWidth+x/4] = biome
+}
+
+// Fill sets every block to state.
+func (s *Section) Fill(state int32) {
+	for i := range s.Blocks {
+		s.Blocks[i] = state
+	}
+}
+
+// FillBiome sets every cell to biome.
+func (s *Section) FillBiome(biome int32) {
+	for i := range s.Biomes {
+		s.Biomes[i] = biome
+	}
+}
+
+// BlockCount returns the number of blocks other than Air. Vanilla also
+// excludes cave_air and void_air; counting them only costs the client
+// some rendering work.
+func (s *Section) BlockCount() (n int) {
+	for _, b := range s.Blocks {
+		if b != Air {
+			n++
+		}
+	}
+	return
+}
+
+// Encode writes s using the BlockStates and Biomes kinds.
+func (s *Section) Encode(w packet.Writer) error {
+	return s.EncodeKind(w, Biomes)
+}
+
+// EncodeKind writes s, with biome IDs of kind biomes.
+func (s *Section) EncodeKind(w packet.Writer, biomes Kind) (err error) {
+	if err = packet.WriteUnsignedShort(w, uint16(s.BlockCount())); err != nil {
+		return
+	}
+	if err = WriteContainer(w, BlockStates, s.Blocks[:]); err != nil {
+		return
+	}
+	return WriteContainer(w, biomes, s.Biomes[:])
+}
+
+// Decode reads s using the BlockStates and Biomes kinds. The block count
+// is not checked.
+func (s *Section) Decode(r packet.Reader) error {
+	return s.DecodeKind(r, Biomes)
+}
+
+// DecodeKind reads s, with biome IDs of kind biomes.
+func (s *Section) DecodeKind(r packet.Reader, biomes Kind) (err error) {
+	if _, err = packet.ReadUnsignedShort(r); err != nil {
+		return
+	}
+	if err = ReadContainer(r, BlockStates, s.Blocks[:]); err != nil {
+		return
+	}
+	return ReadContainer(r, biomes, s.Biomes[:])
+}
+
+// EncodeSections returns sections encoded one after another, from the
+// bottom of the world, as in the data of Chunk Data.
+func EncodeSections(sections []*Section) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, s := range sections {
+		if err := s.Encode(&buf); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeSections decodes n sections from data. The number of sections is
+// not part of the data: it follows from the height of the dimension.
+func DecodeSections(data []byte, n int) ([]*Section, error) {
+	r := bytes.NewReader(data)
+	sections := make([]*Section, n)
+	for i := range sections {
+		sections[i] = new(Section)
+		if err := sections[i].Decode(r); err != nil {
+			return nil, err
+		}
+	}
+	if r.Len() > 0 {
+		return nil, ErrTrailingData
+	}
+	return sections, nil
+}
@@ -0,0 +1,100 @@
+package mcproto
+
+import (
+	"github.com/gstoney/mcproto/packet"
+)
+
+// ChatMode is the kind of chat messages a client displays.
+type ChatMode int32
+
+const (
+	ChatEnabled ChatMode = iota
+	ChatCommandsOnly
+	ChatHidden
+)
+
+// MainHand is the hand a player holds items with.
+type MainHand int32
+
+const (
+	LeftHand MainHand = iota
+	RightHand
+)
+
+// Skin parts, as bits of ClientInfo.SkinParts.
+const (
+	SkinCape byte = 1 << iota
+	SkinJacket
+	SkinLeftSleeve
+	SkinRightSleeve
+	SkinLeftPants
+	SkinRightPants
+	SkinHat
+)
+
+// ClientInfo holds the settings a client sends with Client Information,
+// in Config and Play mode.
+type ClientInfo struct {
+	Locale        string // Such as "en_us".
+	ViewDistance  int    // In chunks.
+	ChatMode      ChatMode
+	ChatColors    bool
+	SkinParts     byte
+	MainHand      MainHand
+	TextFiltering bool
+	AllowListing  bool // Whether the player may appear in the status player sample.
+}
+
+// ClientInfoOf returns the settings carried by p, a Client Information
+// packet of Config or Play mode.
+func ClientInfoOf(p packet.Packet) (ci ClientInfo, ok bool) {
+	switch p := p.(type) {
+	case *packet.ConfigClientInformation:
+		return ClientInfo{
+			Locale:        p.Locale,
+			ViewDistance:  int(p.ViewDistance),
+			ChatMode:      ChatMode(p.ChatMode),
+			ChatColors:    p.ChatColors,
+			SkinParts:     p.DisplayedSkinParts,
+			MainHand:      MainHand(p.MainHand),
+			TextFiltering: p.EnableTextFiltering,
+			AllowListing:  p.AllowServerListings,
+		}, true
+	case *packet.PlayClientInformation:
+		return ClientInfo{
+			Locale:        p.Locale,
+			ViewDistance:  int(p.ViewDistance),
+			ChatMode:      ChatMode(p.ChatMode),
+			ChatColors:    p.ChatColors,
+			SkinParts:     p.DisplayedSkinParts,
+			MainHand:      MainHand(p.MainHand),
+			TextFiltering: p.EnableTextFiltering,
+			AllowListing:  p.AllowServerListings,
+		}, true
+	}
+	return
+}
+
+// SetClientInfo stores ci, calling OnClientInfo if it differs from the
+// current settings.
+func (s *Session) SetClientInfo(ci ClientInfo) {
+	prev := s.ClientInfo
+	if prev != nil && *prev == ci {
+		return
+	}
+
+	s.ClientInfo = &ci
+	if s.OnClientInfo != nil {
+		s.OnClientInfo(s, prev)
+	}
+}
+
+// ViewDistance returns the client's view distance clamped to limit, or
+// limit if the client has not sent its settings yet. Vanilla clients
+// render at least 2 chunks.
+func (s *Session) ViewDistance(limit int) int {
+	if s.ClientInfo == nil {
+		return limit
+	}
+	return min(max(2, s.ClientInfo.ViewDistance), limit)
+}
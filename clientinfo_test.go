@@ -0,0 +1,70 @@
+package mcproto
+
+import (
+	"testing"
+
+	"github.com/gstoney/mcproto/packet"
+)
+
+// TestConn_ClientInfo verifies that Client Information read in Play mode
+// updates the Session, notifying only of changes.
+func TestConn_ClientInfo(t *testing.T) {
+	server, client := loginPipe(t)
+	server.Session.Mode = Play
+	client.Session.Mode = Play
+
+	var changes []string
+	server.Session.OnClientInfo = func(s *Session, prev *ClientInfo) {
+		from := "none"
+		if prev != nil {
+			from = prev.Locale
+		}
+		changes = append(changes, from+" -> "+s.ClientInfo.Locale)
+	}
+
+	go func() {
+		for _, locale := range []string{"en_us", "en_us", "ko_kr"} {
+			client.WritePacket(&packet.PlayClientInformation{
+				Locale:       locale,
+				ViewDistance: 12,
+				MainHand:     int32(RightHand),
+			})
+		}
+	}()
+
+	for range 3 {
+		p, err := server.ReadPacket()
+		if _, ok := p.(*packet.PlayClientInformation); !ok {
+			t.Fatalf("got %v %v, want Client Information", p, err)
+		}
+	}
+
+	want := []string{"none -> en_us", "en_us -> ko_kr"}
+	if len(changes) != len(want) || changes[0] != want[0] || changes[1] != want[1] {
+		t.Errorf("got changes %v, want %v", changes, want)
+	}
+	if ci := server.Session.ClientInfo; ci.ViewDistance != 12 || ci.MainHand != RightHand {
+		t.Errorf("got %+v", ci)
+	}
+}
+
+func TestSession_ViewDistance(t *testing.T) {
+	tcs := []struct {
+		desc   string
+		client *ClientInfo
+		want   int
+	}{
+		{desc: "Unknown", want: 10},
+		{desc: "Below limit", client: &ClientInfo{ViewDistance: 6}, want: 6},
+		{desc: "Above limit", client: &ClientInfo{ViewDistance: 32}, want: 10},
+		{desc: "Below minimum", client: &ClientInfo{ViewDistance: 0}, want: 2},
+	}
+	for _, tC := range tcs {
+		t.Run(tC.desc, func(t *testing.T) {
+			s := Session{ClientInfo: tC.client}
+			if got := s.ViewDistance(10); got != tC.want {
+				t.Errorf("got %d, want %d", got, tC.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"io"
+	"log"
+	"net"
+	"testing"
+
+	"github.com/gstoney/mcproto"
+	"github.com/gstoney/mcproto/packet"
+)
+
+func testConfig() mcproto.TransportConfig {
+	return mcproto.TransportConfig{MaxPacketLen: 1 << 21, MaxDecompressedLen: 1 << 23}
+}
+
+// TestLimbo drives a client from the handshake through configuration into
+// Play, until it receives the first chunk batch of the void world.
+func TestLimbo(t *testing.T) {
+	w, err := newWorld("void")
+	if err != nil {
+		t.Fatalf("newWorld: %v", err)
+	}
+	lb := &limbo{
+		world:        w,
+		maxPlayers:   10,
+		viewDistance: 2,
+		log:          log.New(io.Discard, "", 0),
+		players:      make(map[*player]bool),
+	}
+	e := &mcproto.Establisher{Config: testConfig(), CompressionThreshold: 256}
+
+	sc, cc := net.Pipe()
+	defer cc.Close()
+	done := make(chan error, 1)
+	go func() {
+		defer sc.Close()
+		s, tr, err := e.Establish(sc)
+		if err != nil {
+			done <- err
+			return
+		}
+		done <- lb.handle(&s, &tr)
+	}()
+
+	cs := &mcproto.Session{Conn: cc}
+	ct := mcproto.NewTransport(cc, cc, testConfig())
+	client := mcproto.NewConn(cs, &ct, mcproto.Serverbound)
+
+	// net.Pipe is unbuffered, so answers are written from their own
+	// goroutine. Packets switching modes are written while the server
+	// awaits them, with write.
+	out := make(chan packet.Packet, 8)
+	defer close(out)
+	go func() {
+		for p := range out {
+			client.WritePacket(p)
+		}
+	}()
+	write := func(p packet.Packet) {
+		t.Helper()
+		if err := client.WritePacket(p); err != nil {
+			t.Fatalf("WritePacket: %v", err)
+		}
+		client.SetMode(mcproto.NextMode(cs.Mode, p))
+	}
+
+	write(&packet.HandshakePacket{ProtocolVersion: 767, ServerAddr: "localhost", ServerPort: 25565, RequestType: 2})
+	write(&packet.LoginStart{Name: "Steve", PlayerUUID: mcproto.OfflineUUID("Steve")})
+
+	read := func() packet.Packet {
+		t.Helper()
+		p, err := client.ReadPacket()
+		if err != nil {
+			t.Fatalf("ReadPacket: %v", err)
+		}
+		return p
+	}
+
+	for {
+		p := read()
+		if sc, ok := p.(*packet.SetCompression); ok {
+			ct.CompressionThreshold = int(sc.Threshold)
+			continue
+		}
+		success, ok := p.(*packet.LoginSuccess)
+		if !ok {
+			t.Fatalf("got %T, want Login Success", p)
+		}
+		if success.Username != "Steve" || success.UUID != mcproto.OfflineUUID("Steve") {
+			t.Errorf("got %+v", success)
+		}
+		break
+	}
+	write(&packet.LoginAcknowledge{})
+
+	registries := 0
+	for cs.Mode != mcproto.Play {
+		switch p := read().(type) {
+		case *packet.ConfigClientboundKnownPacks:
+			out <- &packet.ConfigServerboundKnownPacks{Packs: p.Packs}
+		case *packet.RegistryData:
+			registries++
+		case *packet.ConfigClientboundKeepAlive:
+			out <- &packet.ConfigServerboundKeepAlive{KeepAliveID: p.KeepAliveID}
+		case *packet.FinishConfiguration:
+			write(&packet.FinishConfigurationAcknowledge{})
+		case *packet.ConfigDisconnect:
+			t.Fatalf("disconnected: %s", p.Reason)
+		}
+	}
+	if registries == 0 {
+		t.Error("no registries received")
+	}
+
+	login, ok := read().(*packet.PlayLogin)
+	if !ok {
+		t.Fatal("first Play packet is not Login")
+	}
+	if login.IsFlat || login.DimensionName != dimension || login.GameMode != w.gameMode {
+		t.Errorf("got login %+v", login)
+	}
+
+	chunks := 0
+	for {
+		p := read()
+		if _, ok := p.(*packet.ChunkDataAndUpdateLight); ok {
+			chunks++
+		}
+		if f, ok := p.(*packet.ChunkBatchFinished); ok {
+			if int(f.BatchSize) != chunks || chunks == 0 {
+				t.Errorf("got batch of %d, %d chunks received", f.BatchSize, chunks)
+			}
+			break
+		}
+	}
+
+	cc.Close()
+	if err := <-done; err == nil {
+		t.Error("handle returned no error after the client left")
+	}
+}
@@ -0,0 +1,212 @@
+// Command limbo is a minimal Minecraft 1.21.1 server holding players in
+// an empty or superflat world, such as a fallback behind a proxy while
+// the backends restart.
+//
+// Usage:
+//
+//	limbo [-listen addr] [-world void|preset] [-forwarding none|legacy|modern -secret s]
+//
+// Players log in in offline mode, or as forwarded by a proxy in front that
+// authenticates them. They are configured with the vanilla registries and
+// spawned in the world, where they may chat with each other until they
+// disconnect.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"sync"
+
+	"github.com/gstoney/mcproto"
+	"github.com/gstoney/mcproto/anvil"
+	"github.com/gstoney/mcproto/chunk"
+	"github.com/gstoney/mcproto/packet"
+	"github.com/gstoney/mcproto/registry"
+	"github.com/gstoney/mcproto/worldgen"
+)
+
+func main() {
+	listen := flag.String("listen", ":25565", "address to accept clients on")
+	world := flag.String("world", "void", `"void", or a superflat preset such as "`+worldgen.ClassicFlat+`"`)
+	motd := flag.String("motd", "A Minecraft limbo", "description shown in the server list")
+	maxPlayers := flag.Int("max-players", 100, "player count shown in the server list")
+	viewDistance := flag.Int("view-distance", 8, "maximum view distance, in chunks")
+	compression := flag.Int("compression", 256, "compression threshold in bytes, 0 to disable")
+	forwarding := flag.String("forwarding", "none", "player forwarding of the proxy in front: none, legacy or modern")
+	secret := flag.String("secret", "", "secret of modern forwarding")
+	flag.Parse()
+
+	w, err := newWorld(*world)
+	if err != nil {
+		fatal(err)
+	}
+	e := &mcproto.Establisher{
+		Config: mcproto.TransportConfig{
+			MaxPacketLen:       1 << 21,
+			MaxDecompressedLen: 1 << 23,
+		},
+		CompressionThreshold: *compression,
+		ForwardingSecret:     []byte(*secret),
+	}
+	switch *forwarding {
+	case "none":
+	case "legacy":
+		e.Forwarding = mcproto.LegacyForwarding
+	case "modern":
+		e.Forwarding = mcproto.ModernForwarding
+		if *secret == "" {
+			fatal(errors.New("modern forwarding requires -secret"))
+		}
+	default:
+		fatal(fmt.Errorf("unknown forwarding %q", *forwarding))
+	}
+
+	lb := &limbo{
+		world:        w,
+		maxPlayers:   *maxPlayers,
+		viewDistance: *viewDistance,
+		log:          log.New(os.Stdout, "", log.LstdFlags),
+		players:      make(map[*player]bool),
+	}
+	e.Status = lb.status(*motd)
+
+	l, err := net.Listen("tcp", *listen)
+	if err != nil {
+		fatal(err)
+	}
+	s := &mcproto.Server{
+		SessionEstablisher: e.Establish,
+		SessionHandler:     lb.handle,
+	}
+	lb.log.Printf("listening on %s, world %s", l.Addr(), *world)
+	if err := s.Serve(l); err != nil {
+		fatal(err)
+	}
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "limbo:", err)
+	os.Exit(1)
+}
+
+// world is the terrain players spawn in.
+type world struct {
+	gameMode byte
+	spawnY   float64
+	flat     bool // superflat, with its lower horizon and fog
+
+	// chunk is the Chunk Data of every chunk, as they are all alike.
+	chunk *packet.ChunkDataAndUpdateLight
+}
+
+// load is the mcproto.ChunkLoader of w.
+func (w *world) load(x, z int32) (*packet.ChunkDataAndUpdateLight, error) {
+	c := *w.chunk
+	c.ChunkX, c.ChunkZ = x, z
+	return &c, nil
+}
+
+// Dimension of the overworld, which players spawn in.
+const (
+	dimension = "minecraft:overworld"
+	minY      = -64
+	height    = 384
+)
+
+// newWorld returns the void, or the superflat world of preset.
+func newWorld(preset string) (*world, error) {
+	biomes := registry.Vanilla().Registry("minecraft:worldgen/biome")
+	var (
+		w   = &world{}
+		gen worldgen.Generator
+	)
+	if preset == "void" {
+		gen = &worldgen.Void{
+			Biome:     int32(biomes.Index("minecraft:the_void")),
+			BiomeName: "minecraft:the_void",
+		}
+		// Players would fall forever otherwise.
+		w.gameMode, w.spawnY = byte(anvil.Spectator), 64
+	} else {
+		f, err := worldgen.ParseFlat(preset, worldgen.VanillaResolver(biomes))
+		if err != nil {
+			return nil, err
+		}
+		gen = f
+		w.gameMode, w.spawnY = byte(anvil.Adventure), float64(minY+f.Height())
+		w.flat = true
+	}
+
+	var err error
+	w.chunk, err = worldgen.Generate(gen, 0, 0, minY, height).ChunkData(chunk.SolidBlocks{})
+	return w, err
+}
+
+// limbo holds the players online.
+type limbo struct {
+	world        *world
+	maxPlayers   int
+	viewDistance int
+	log          *log.Logger
+
+	mu       sync.Mutex
+	players  map[*player]bool
+	entityID int32
+}
+
+// status returns the status response of the server list.
+func (lb *limbo) status(motd string) func(s *mcproto.Session) string {
+	return func(s *mcproto.Session) string {
+		lb.mu.Lock()
+		online := len(lb.players)
+		lb.mu.Unlock()
+
+		b, _ := json.Marshal(map[string]any{
+			"version":     map[string]any{"name": "1.21.1", "protocol": 767},
+			"players":     map[string]any{"max": lb.maxPlayers, "online": online},
+			"description": map[string]any{"text": motd},
+		})
+		return string(b)
+	}
+}
+
+func (lb *limbo) nextEntityID() int32 {
+	lb.mu.Lock()
+	defer lb.mu.Unlock()
+	lb.entityID++
+	return lb.entityID
+}
+
+// join adds p to the players receiving chat.
+func (lb *limbo) join(p *player) {
+	lb.mu.Lock()
+	defer lb.mu.Unlock()
+	lb.players[p] = true
+}
+
+func (lb *limbo) leave(p *player) {
+	lb.mu.Lock()
+	defer lb.mu.Unlock()
+	delete(lb.players, p)
+}
+
+// broadcast sends a system chat message to every player.
+func (lb *limbo) broadcast(text string) {
+	lb.mu.Lock()
+	players := make([]*player, 0, len(lb.players))
+	for p := range lb.players {
+		players = append(players, p)
+	}
+	lb.mu.Unlock()
+
+	for _, p := range players {
+		if err := p.message(text); err != nil {
+			lb.log.Printf("%s: broadcast: %v", p.conn.Session.Name, err)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package main
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gstoney/mcproto"
+	"github.com/gstoney/mcproto/packet"
+	"github.com/gstoney/mcproto/registry"
+	"github.com/gstoney/mcproto/tags"
+	"github.com/gstoney/mcproto/vanilla"
+)
+
+const (
+	tickInterval      = time.Second / 20
+	keepAliveInterval = 15 * time.Second
+	keepAliveTimeout  = 30 * time.Second
+)
+
+var errTimedOut = errors.New("keep alive timed out")
+
+// player is a player in Play mode.
+type player struct {
+	conn *mcproto.Conn
+
+	keepAliveID   int64
+	keepAliveSent time.Time
+}
+
+// message sends text to the chat of p.
+func (p *player) message(text string) error {
+	return p.conn.WritePacket(&packet.SystemChatMessage{Content: text})
+}
+
+// handle configures a session established by the Establisher and plays
+// it until the client disconnects.
+func (lb *limbo) handle(s *mcproto.Session, t *mcproto.Transport) error {
+	conn := mcproto.NewConn(s, t, mcproto.Clientbound)
+	steps := mcproto.VanillaSteps("limbo", registry.Vanilla(), tags.Vanilla(), vanilla.Resolver{}.EntryID)
+	if err := mcproto.NewConfigurator(conn, steps...).Run(); err != nil {
+		lb.log.Printf("%s (%s): configuration: %v", s.Name, s.RemoteAddr, err)
+		return err
+	}
+
+	lb.log.Printf("%s (%s, %s) logged in", s.Name, s.PlayerUUID, s.RemoteAddr)
+	err := lb.play(&player{conn: conn})
+	lb.log.Printf("%s left: %v", s.Name, err)
+	return err
+}
+
+// play spawns p in the world and serves it until the connection fails.
+func (lb *limbo) play(p *player) error {
+	w := lb.world
+	dimensionType := registry.Vanilla().Registry("minecraft:dimension_type").Index(dimension)
+	err := p.conn.WritePacket(&packet.PlayLogin{
+		EntityID:           lb.nextEntityID(),
+		DimensionNames:     []string{dimension},
+		MaxPlayers:         int32(lb.maxPlayers),
+		ViewDistance:       int32(lb.viewDistance),
+		SimulationDistance: int32(lb.viewDistance),
+		DimensionType:      int32(dimensionType),
+		DimensionName:      dimension,
+		GameMode:           w.gameMode,
+		PreviousGameMode:   0xFF,
+		IsFlat:             w.flat,
+	})
+	if err != nil {
+		return err
+	}
+
+	spawn := packet.Position{Y: int16(w.spawnY)}
+	for _, sp := range []packet.Packet{
+		&packet.SetDefaultSpawnPosition{Location: spawn},
+		&packet.GameEvent{Event: packet.GameEventStartWaitingForChunks},
+		&packet.SynchronizePlayerPosition{X: 0.5, Y: w.spawnY, Z: 0.5, TeleportID: 1},
+	} {
+		if err = p.conn.WritePacket(sp); err != nil {
+			return err
+		}
+	}
+
+	cs := mcproto.NewChunkStreamer(p.conn, lb.viewDistance, w.load)
+	if err = cs.MoveTo(0.5, 0.5); err != nil {
+		return err
+	}
+
+	name := p.conn.Session.Name
+	lb.broadcast(name + " joined the limbo")
+	lb.join(p)
+	defer func() {
+		lb.leave(p)
+		lb.broadcast(name + " left the limbo")
+	}()
+
+	packets, next, errc := p.read()
+	defer close(next)
+	tick := time.NewTicker(tickInterval)
+	defer tick.Stop()
+	keepAlive := time.NewTicker(keepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case pk := <-packets:
+			err = lb.handlePacket(p, cs, pk)
+			next <- struct{}{}
+		case err = <-errc:
+		case <-tick.C:
+			err = cs.Tick()
+		case now := <-keepAlive.C:
+			err = lb.keepAlive(p, now)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// read reads packets of p from its own goroutine, sending each on packets
+// and waiting on next before reading on. Settings the Conn stores on the
+// Session are thus only changed while the packet loop waits.
+func (p *player) read() (packets <-chan packet.Packet, next chan<- struct{}, errc <-chan error) {
+	pc, nc, ec := make(chan packet.Packet), make(chan struct{}), make(chan error, 1)
+	go func() {
+		for {
+			pk, err := p.conn.ReadPacket()
+			var unknown *mcproto.UnknownPacketError
+			if errors.As(err, &unknown) {
+				continue
+			}
+			if err != nil {
+				ec <- err
+				return
+			}
+			select {
+			case pc <- pk:
+			case <-nc:
+				return // Closed by the packet loop.
+			}
+			if _, ok := <-nc; !ok {
+				return
+			}
+		}
+	}()
+	return pc, nc, ec
+}
+
+func (lb *limbo) handlePacket(p *player, cs *mcproto.ChunkStreamer, pk packet.Packet) error {
+	if handled, err := cs.Handle(pk); handled {
+		return err
+	}
+
+	switch pk := pk.(type) {
+	case *packet.PlayServerboundKeepAlive:
+		if pk.KeepAliveID != p.keepAliveID {
+			return mcproto.ErrKeepAliveMismatch
+		}
+		p.keepAliveID = 0
+	case *packet.PlayClientInformation:
+		// The view distance may have changed.
+		return cs.Refresh()
+	case *packet.ChatMessage:
+		lb.broadcast("<" + p.conn.Session.Name + "> " + pk.Message)
+	case *packet.ChatCommand:
+		return p.message("There are no commands in the limbo.")
+	}
+	return nil
+}
+
+// keepAlive sends a Keep Alive to p, failing if the last one is
+// unanswered for too long.
+func (lb *limbo) keepAlive(p *player, now time.Time) error {
+	if p.keepAliveID != 0 {
+		if now.Sub(p.keepAliveSent) < keepAliveTimeout {
+			return nil
+		}
+		if err := p.conn.WritePacket(&packet.PlayDisconnect{Reason: "Timed out"}); err != nil {
+			lb.log.Printf("%s: disconnect: %v", p.conn.Session.Name, err)
+		}
+		return errTimedOut
+	}
+
+	p.keepAliveID, p.keepAliveSent = now.UnixMilli(), now
+	return p.conn.WritePacket(&packet.PlayClientboundKeepAlive{KeepAliveID: p.keepAliveID})
+}
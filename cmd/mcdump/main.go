@@ -0,0 +1,225 @@
+// Command mcdump decodes a capture file recorded by mcproto.Recorder,
+// printing one line per frame.
+//
+// Usage:
+//
+//	mcdump [-json] [-dir serverbound|clientbound] capture.mcap
+//
+// Frames are decoded in the connection mode of their record. The mode is
+// also tracked through handshake, login and configuration transitions,
+// and records disagreeing with it are flagged in the output, as are
+// frames that cannot be decoded or whose payload is not fully consumed
+// by decoding.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gstoney/mcproto"
+	"github.com/gstoney/mcproto/packet"
+)
+
+type entry struct {
+	Time     time.Time     `json:"time"`
+	Dir      string        `json:"dir"`
+	Mode     string        `json:"mode"`
+	ID       int32         `json:"id"`
+	Name     string        `json:"name,omitempty"`
+	Size     int32         `json:"size"`
+	Packet   packet.Packet `json:"fields,omitempty"`
+	Leftover int32         `json:"leftover,omitempty"`
+	Error    string        `json:"error,omitempty"`
+	Warning  string        `json:"warning,omitempty"`
+}
+
+const usage = "usage: mcdump [-json] [-dir serverbound|clientbound] capture.mcap"
+
+func main() {
+	asJSON := flag.Bool("json", false, "print JSON lines instead of text")
+	dirFilter := flag.String("dir", "", "only print frames of this direction (serverbound or clientbound)")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if d := *dirFilter; d != "" &&
+		!strings.EqualFold(d, mcproto.Serverbound.String()) &&
+		!strings.EqualFold(d, mcproto.Clientbound.String()) {
+		fmt.Fprintf(os.Stderr, "mcdump: invalid -dir %q\n%s\n", d, usage)
+		os.Exit(2)
+	}
+
+	f, err := os.Open(flag.Arg(0))
+	if err != nil {
+		fatal(err)
+	}
+	defer f.Close()
+
+	out := bufio.NewWriter(os.Stdout)
+	err = dump(out, f, *asJSON, *dirFilter)
+	out.Flush()
+	if err != nil {
+		fatal(err)
+	}
+}
+
+// dump writes the frames of the capture read from r to w, as text or
+// JSON lines. A non-empty dir only writes the frames of that direction.
+func dump(w io.Writer, r io.Reader, asJSON bool, dir string) error {
+	cr, err := mcproto.NewCaptureReader(r)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(w)
+
+	d := newDumper()
+
+	for first := true; ; first = false {
+		rec, err := cr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if first {
+			d.mode = rec.Mode
+		}
+
+		e := d.decode(rec)
+		if dir != "" && !strings.EqualFold(dir, e.Dir) {
+			continue
+		}
+		if asJSON {
+			enc.Encode(e)
+		} else {
+			printEntry(w, e)
+		}
+	}
+}
+
+// dumper decodes records in order, tracking the connection mode.
+type dumper struct {
+	cfg  mcproto.TransportConfig
+	mode mcproto.ConnectionMode
+	src  bytes.Buffer
+	br   bufio.Reader
+}
+
+func newDumper() *dumper {
+	return &dumper{
+		cfg: mcproto.TransportConfig{
+			MaxPacketLen:       1 << 21,
+			MaxDecompressedLen: 1 << 23,
+		},
+	}
+}
+
+func (d *dumper) decode(rec mcproto.CaptureRecord) (e entry) {
+	e.Time = rec.Time
+	e.Dir = rec.Dir.String()
+	e.Mode = rec.Mode.String()
+	e.ID = -1
+
+	if rec.Mode != d.mode {
+		e.Warning = fmt.Sprintf("recorded in %s mode, tracked %s", rec.Mode, d.mode)
+		d.mode = rec.Mode
+	}
+
+	// A fresh Transport per frame applies the recorded threshold
+	// and keeps a malformed frame from affecting the next one.
+	d.src.Reset()
+	packet.WriteVarInt(&d.src, int32(len(rec.Frame)))
+	d.src.Write(rec.Frame)
+	t := mcproto.NewTransport(&d.src, nil, d.cfg)
+	t.CompressionThreshold = rec.Threshold
+
+	pr, err := t.Recv()
+	if err != nil {
+		e.Error = err.Error()
+		return
+	}
+	e.Size = pr.Remaining()
+
+	d.br.Reset(pr)
+	if e.ID, err = packet.ReadVarInt(&d.br); err != nil {
+		e.Error = err.Error()
+		return
+	}
+
+	factory, ok := mcproto.Registry(rec.Mode, rec.Dir)[e.ID]
+	if !ok {
+		e.Error = "unknown packet"
+		e.Leftover = int32(d.br.Buffered()) + pr.Remaining()
+		return
+	}
+
+	p := factory()
+	e.Name = mcproto.PacketName(p)
+	if err = p.Decode(&d.br); err != nil {
+		e.Error = err.Error()
+		return
+	}
+	e.Packet = p
+
+	if e.Leftover = int32(d.br.Buffered()) + pr.Remaining(); e.Leftover > 0 {
+		e.Error = "payload not fully consumed"
+	} else if err = pr.Close(); err != nil {
+		e.Error = err.Error()
+	}
+
+	d.mode = mcproto.NextMode(rec.Mode, p)
+	return
+}
+
+func printEntry(w io.Writer, e entry) {
+	arrow := "C->S"
+	if e.Dir == mcproto.Clientbound.String() {
+		arrow = "S->C"
+	}
+
+	fmt.Fprintf(w, "%s %s %-9s", e.Time.UTC().Format("15:04:05.000"), arrow, e.Mode)
+	if e.ID >= 0 {
+		fmt.Fprintf(w, " 0x%02X", e.ID)
+	}
+	if e.Name != "" {
+		fmt.Fprintf(w, " %s", e.Name)
+	}
+	fmt.Fprintf(w, " (%d bytes)", e.Size)
+	if e.Packet != nil {
+		fmt.Fprintf(w, " %s", fieldString(e.Packet))
+	}
+	if e.Error != "" {
+		fmt.Fprintf(w, " !! %s", e.Error)
+		if e.Leftover > 0 {
+			fmt.Fprintf(w, " (%d bytes left)", e.Leftover)
+		}
+	}
+	if e.Warning != "" {
+		fmt.Fprintf(w, " ?? %s", e.Warning)
+	}
+	fmt.Fprintln(w)
+}
+
+// fieldString formats field values of p as a JSON object.
+func fieldString(p packet.Packet) string {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Sprintf("%+v", p)
+	}
+	return string(b)
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "mcdump:", err)
+	os.Exit(1)
+}
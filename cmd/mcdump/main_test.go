@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gstoney/mcproto"
+)
+
+// TestDump verifies that the checked-in captures decode without errors or
+// mode disagreements, in text and as JSON lines.
+func TestDump(t *testing.T) {
+	captures, err := filepath.Glob("../../testdata/*.mcap")
+	if err != nil || len(captures) == 0 {
+		t.Fatalf("no captures: %v", err)
+	}
+	for _, name := range captures {
+		t.Run(filepath.Base(name), func(t *testing.T) {
+			data, err := os.ReadFile(name)
+			if err != nil {
+				t.Fatalf("ReadFile: %v", err)
+			}
+
+			var text bytes.Buffer
+			if err := dump(&text, bytes.NewReader(data), false, ""); err != nil {
+				t.Fatalf("dump: %v", err)
+			}
+			lines := strings.Split(strings.TrimSuffix(text.String(), "\n"), "\n")
+			for _, line := range lines {
+				if strings.Contains(line, " !! ") || strings.Contains(line, " ?? ") {
+					t.Errorf("flagged frame: %s", line)
+				}
+			}
+
+			var js bytes.Buffer
+			if err := dump(&js, bytes.NewReader(data), true, ""); err != nil {
+				t.Fatalf("dump -json: %v", err)
+			}
+			dec := json.NewDecoder(&js)
+			n := 0
+			for ; dec.More(); n++ {
+				var e map[string]any
+				if err := dec.Decode(&e); err != nil {
+					t.Fatalf("JSON line %d: %v", n, err)
+				}
+				if e["name"] == nil {
+					t.Errorf("JSON line %d has no packet name", n)
+				}
+			}
+			if n != len(lines) {
+				t.Errorf("got %d JSON lines, %d text lines", n, len(lines))
+			}
+		})
+	}
+}
+
+// TestDump_Dir verifies that frames are filtered by direction, ignoring
+// case.
+func TestDump_Dir(t *testing.T) {
+	data, err := os.ReadFile("../../testdata/status.mcap")
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	var out bytes.Buffer
+	if err := dump(&out, bytes.NewReader(data), false, "ClientBound"); err != nil {
+		t.Fatalf("dump: %v", err)
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n") {
+		if !strings.Contains(line, " S->C ") {
+			t.Errorf("got serverbound frame: %s", line)
+		}
+	}
+}
+
+// TestDumper_ModeMismatch verifies that a frame is decoded in the mode of
+// its record, flagged when the tracked mode disagrees.
+func TestDumper_ModeMismatch(t *testing.T) {
+	d := newDumper()
+	d.mode = mcproto.Handshake
+	e := d.decode(mcproto.CaptureRecord{
+		Dir:       mcproto.Serverbound,
+		Mode:      mcproto.Status,
+		Threshold: -1,
+		Frame:     []byte{0x00},
+	})
+	if e.Name != "StatusReqPacket" || e.Mode != mcproto.Status.String() {
+		t.Errorf("got %s in %s mode, want StatusReqPacket in Status mode", e.Name, e.Mode)
+	}
+	if e.Warning == "" {
+		t.Error("mode mismatch not flagged")
+	}
+	if d.mode != mcproto.Status {
+		t.Errorf("tracked mode %s, want Status", d.mode)
+	}
+}
@@ -0,0 +1,45 @@
+// Command mcproxy is a debugging proxy logging the packets exchanged
+// between Minecraft clients and an offline mode server.
+//
+// Usage:
+//
+//	mcproxy [-listen addr] -backend addr
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"os"
+
+	"github.com/gstoney/mcproto"
+	"github.com/gstoney/mcproto/proxy"
+)
+
+func main() {
+	listen := flag.String("listen", "localhost:25566", "address to accept clients on")
+	backend := flag.String("backend", "localhost:25565", "address of the server to relay to")
+	flag.Parse()
+
+	l, err := net.Listen("tcp", *listen)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "mcproxy:", err)
+		os.Exit(1)
+	}
+
+	p := &proxy.Proxy{
+		Backend: *backend,
+		Config: mcproto.TransportConfig{
+			MaxPacketLen:       1 << 21,
+			MaxDecompressedLen: 1 << 23,
+		},
+		Logger: log.New(os.Stdout, "", log.Ltime|log.Lmicroseconds),
+	}
+
+	log.Printf("relaying %s -> %s", l.Addr(), *backend)
+	if err := p.Serve(l); err != nil {
+		fmt.Fprintln(os.Stderr, "mcproxy:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,194 @@
+//go:build ignore
+// +build ignore
+
+// gen_vanilla_data.go generates the tables of package vanilla from the
+// reports of the vanilla data generator:
+//
+//	java -DbundlerMainClass=net.minecraft.data.Main -jar server.jar --reports
+//	go run gen_vanilla_data.go -- generated/reports path/to/vanilla
+//
+// It reads blocks.json and registries.json of the reports directory, and
+// writes zz_generated_data.go to the package directory.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"text/template"
+)
+
+// blockReport is an entry of blocks.json.
+type blockReport struct {
+	Properties map[string][]string `json:"properties"`
+	States     []stateReport       `json:"states"`
+}
+
+type stateReport struct {
+	ID         int32             `json:"id"`
+	Default    bool              `json:"default"`
+	Properties map[string]string `json:"properties"`
+}
+
+// registryReport is an entry of registries.json.
+type registryReport struct {
+	Entries map[string]struct {
+		ProtocolID int32 `json:"protocol_id"`
+	} `json:"entries"`
+}
+
+type Property struct {
+	Name   string
+	Values []string
+}
+
+type Block struct {
+	Name                             string
+	ID                               int
+	Properties                       []Property
+	MinState, MaxState, DefaultState int32
+}
+
+type Registry struct {
+	Var   string
+	Names []string
+}
+
+func main() {
+	if len(os.Args) < 3 {
+		fmt.Println("Usage: go run gen_vanilla_data.go -- path/to/reports path/to/dir")
+		os.Exit(1)
+	}
+	reportsDir, targetDir := os.Args[len(os.Args)-2], os.Args[len(os.Args)-1]
+
+	var blockReports map[string]blockReport
+	readJSON(filepath.Join(reportsDir, "blocks.json"), &blockReports)
+	var registryReports map[string]registryReport
+	readJSON(filepath.Join(reportsDir, "registries.json"), &registryReports)
+
+	data := struct {
+		Blocks     []Block
+		Registries []Registry
+	}{Blocks: blocks(blockReports)}
+	for _, r := range []struct{ id, v string }{
+		{"minecraft:item", "items"},
+		{"minecraft:entity_type", "entityTypes"},
+		{"minecraft:fluid", "fluids"},
+		{"minecraft:game_event", "gameEvents"},
+		{"minecraft:sound_event", "soundEvents"},
+	} {
+		data.Registries = append(data.Registries, Registry{r.v, entries(r.id, registryReports[r.id])})
+	}
+
+	var buf bytes.Buffer
+	if err := template.Must(template.New("data").Parse(tmpl)).Execute(&buf, data); err != nil {
+		panic(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		panic(err)
+	}
+	outFile := filepath.Join(targetDir, "zz_generated_data.go")
+	if err = os.WriteFile(outFile, src, 0o644); err != nil {
+		panic(err)
+	}
+	fmt.Printf("Generated %s: %d blocks, %d states\n", outFile, len(data.Blocks), data.Blocks[len(data.Blocks)-1].MaxState+1)
+}
+
+func readJSON(path string, v any) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		panic(err)
+	}
+	if err = json.Unmarshal(b, v); err != nil {
+		panic(fmt.Errorf("%s: %w", path, err))
+	}
+}
+
+// blocks orders the blocks by state ID, checking that the states of each
+// are contiguous and ordered as package vanilla computes them: properties
+// sorted by name, the first most significant.
+func blocks(reports map[string]blockReport) (blocks []Block) {
+	for name, r := range reports {
+		slices.SortFunc(r.States, func(a, b stateReport) int { return int(a.ID - b.ID) })
+		b := Block{Name: name, MinState: r.States[0].ID, MaxState: r.States[len(r.States)-1].ID}
+		for p, values := range r.Properties {
+			b.Properties = append(b.Properties, Property{p, values})
+		}
+		sort.Slice(b.Properties, func(i, j int) bool { return b.Properties[i].Name < b.Properties[j].Name })
+
+		n := int32(1)
+		for _, p := range b.Properties {
+			n *= int32(len(p.Values))
+		}
+		if int32(len(r.States)) != n || b.MaxState-b.MinState+1 != n {
+			panic(fmt.Sprintf("%s: %d states from %d to %d, want %d", name, len(r.States), b.MinState, b.MaxState, n))
+		}
+		for i, s := range r.States {
+			rem := i
+			for j := len(b.Properties) - 1; j >= 0; j-- {
+				p := b.Properties[j]
+				if want := p.Values[rem%len(p.Values)]; s.Properties[p.Name] != want {
+					panic(fmt.Sprintf("%s: state %d has %s=%s, want %s", name, s.ID, p.Name, s.Properties[p.Name], want))
+				}
+				rem /= len(p.Values)
+			}
+			if s.Default {
+				b.DefaultState = s.ID
+			}
+		}
+		blocks = append(blocks, b)
+	}
+
+	sort.Slice(blocks, func(i, j int) bool { return blocks[i].MinState < blocks[j].MinState })
+	for i := range blocks {
+		blocks[i].ID = i
+		if i > 0 && blocks[i].MinState != blocks[i-1].MaxState+1 {
+			panic(fmt.Sprintf("%s: states start at %d, want %d", blocks[i].Name, blocks[i].MinState, blocks[i-1].MaxState+1))
+		}
+	}
+	if blocks[0].MinState != 0 {
+		panic("states do not start at 0")
+	}
+	return
+}
+
+// entries returns the entries of registry id in network ID order.
+func entries(id string, r registryReport) []string {
+	names := make([]string, len(r.Entries))
+	for name, e := range r.Entries {
+		if e.ProtocolID < 0 || int(e.ProtocolID) >= len(names) || names[e.ProtocolID] != "" {
+			panic(fmt.Sprintf("%s: %s has protocol ID %d", id, name, e.ProtocolID))
+		}
+		names[e.ProtocolID] = name
+	}
+	if len(names) == 0 {
+		panic(id + ": no entries")
+	}
+	return names
+}
+
+const tmpl = `// Code generated by gen_vanilla_data.go; DO NOT EDIT.
+
+package vanilla
+
+var blocks = []Block{
+{{- range .Blocks}}
+	{Name: {{printf "%q" .Name}}, ID: {{.ID}},
+	{{- if .Properties}} Properties: []Property{
+		{{- range .Properties}}{ {{- printf "%q" .Name}}, []string{ {{- range $i, $v := .Values}}{{if $i}}, {{end}}{{printf "%q" $v}}{{end}}}},{{end -}}
+	},{{end}} MinState: {{.MinState}}, MaxState: {{.MaxState}}, DefaultState: {{.DefaultState}}},
+{{- end}}
+}
+{{range .Registries}}
+var {{.Var}} = []string{
+{{- range .Names}}
+	{{printf "%q" .}},
+{{- end}}
+}
+{{end}}`
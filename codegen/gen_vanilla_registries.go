@@ -0,0 +1,228 @@
+//go:build ignore
+// +build ignore
+
+// gen_vanilla_registries.go generates the vanilla registries of package
+// registry from the data of the vanilla data generator:
+//
+//	java -DbundlerMainClass=net.minecraft.data.Main -jar server.jar --server
+//	go run gen_vanilla_registries.go -- generated/data path/to/registry
+//
+// It reads the entries of the registries sent in Registry Data under
+// minecraft of the data directory, and writes zz_generated_registries.go
+// to the package directory.
+//
+// JSON values become NBT as vanilla sends them: booleans are bytes,
+// integers ints and fractions floats, but for the few fields listed in
+// fieldTypes. Fields of biomes only used for generation are dropped.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+type Registry struct {
+	ID      string
+	Entries []Entry
+}
+
+type Entry struct {
+	Name string
+	Data string // Go expression of the nbt.Compound.
+}
+
+// registries are the directories of the registries, in the order vanilla
+// sends them.
+var registries = []string{
+	"worldgen/biome",
+	"chat_type",
+	"trim_pattern",
+	"trim_material",
+	"wolf_variant",
+	"painting_variant",
+	"dimension_type",
+	"damage_type",
+	"banner_pattern",
+	"enchantment",
+	"jukebox_song",
+}
+
+// fieldTypes are the Go types of numeric fields vanilla does not send as
+// int or float.
+var fieldTypes = map[string]string{
+	"coordinate_scale": "float64",
+	"offset":           "float64",
+	"fixed_time":       "int64",
+}
+
+// generationFields are the fields of biomes not sent to clients.
+var generationFields = []string{"carvers", "creature_spawn_probability", "features", "spawn_costs", "spawners"}
+
+func main() {
+	if len(os.Args) < 3 {
+		fmt.Println("Usage: go run gen_vanilla_registries.go -- path/to/data path/to/dir")
+		os.Exit(1)
+	}
+	dataDir, targetDir := os.Args[len(os.Args)-2], os.Args[len(os.Args)-1]
+
+	var regs []Registry
+	n := 0
+	for _, dir := range registries {
+		r := Registry{ID: "minecraft:" + dir}
+		root := filepath.Join(dataDir, "minecraft", filepath.FromSlash(dir))
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || filepath.Ext(path) != ".json" {
+				return err
+			}
+			rel, err := filepath.Rel(root, path)
+			if err != nil {
+				return err
+			}
+			name := strings.TrimSuffix(filepath.ToSlash(rel), ".json")
+			r.Entries = append(r.Entries, Entry{Name: name, Data: readEntry(path, dir)})
+			return nil
+		})
+		if err != nil {
+			panic(err)
+		}
+		if len(r.Entries) == 0 {
+			panic(root + ": no entries")
+		}
+		n += len(r.Entries)
+		regs = append(regs, r)
+	}
+
+	var buf bytes.Buffer
+	if err := template.Must(template.New("registries").Parse(tmpl)).Execute(&buf, regs); err != nil {
+		panic(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		panic(err)
+	}
+	outFile := filepath.Join(targetDir, "zz_generated_registries.go")
+	if err = os.WriteFile(outFile, src, 0o644); err != nil {
+		panic(err)
+	}
+	fmt.Printf("Generated %s: %d registries, %d entries\n", outFile, len(regs), n)
+}
+
+// readEntry returns the Go expression of the entry at path.
+func readEntry(path, dir string) string {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		panic(err)
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var v map[string]any
+	if err = d.Decode(&v); err != nil {
+		panic(fmt.Errorf("%s: %w", path, err))
+	}
+	if dir == "worldgen/biome" {
+		for _, f := range generationFields {
+			delete(v, f)
+		}
+	}
+
+	var sb strings.Builder
+	writeValue(&sb, v, "")
+	// The type of map values is elided.
+	return strings.TrimPrefix(sb.String(), "nbt.Compound")
+}
+
+// writeValue writes the Go expression of the NBT value of JSON value v,
+// the field key of a compound, or "" for list elements.
+func writeValue(sb *strings.Builder, v any, key string) {
+	switch v := v.(type) {
+	case map[string]any:
+		sb.WriteString("nbt.Compound{")
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			fmt.Fprintf(sb, "%q: ", k)
+			writeValue(sb, v[k], k)
+			sb.WriteString(", ")
+		}
+		sb.WriteString("}")
+	case []any:
+		sb.WriteString("nbt.List{")
+		for _, e := range v {
+			writeValue(sb, e, "")
+			sb.WriteString(", ")
+		}
+		sb.WriteString("}")
+	case string:
+		fmt.Fprintf(sb, "%q", v)
+	case bool:
+		fmt.Fprint(sb, v)
+	case json.Number:
+		writeNumber(sb, v, key)
+	default:
+		panic(fmt.Sprintf("%s: unsupported value %v", key, v))
+	}
+}
+
+func writeNumber(sb *strings.Builder, n json.Number, key string) {
+	typ, ok := fieldTypes[key]
+	if !ok {
+		typ = "int32"
+		if strings.ContainsAny(n.String(), ".eE") {
+			typ = "float32"
+		}
+	}
+
+	switch typ {
+	case "int32", "int64":
+		bits := 32
+		if typ == "int64" {
+			bits = 64
+		}
+		i, err := strconv.ParseInt(n.String(), 10, bits)
+		if err != nil {
+			panic(fmt.Errorf("%s: %w", key, err))
+		}
+		fmt.Fprintf(sb, "%s(%d)", typ, i)
+	default:
+		bits := 64
+		if typ == "float32" {
+			bits = 32
+		}
+		f, err := strconv.ParseFloat(n.String(), bits)
+		if err != nil {
+			panic(fmt.Errorf("%s: %w", key, err))
+		}
+		fmt.Fprintf(sb, "%s(%s)", typ, strconv.FormatFloat(f, 'g', -1, bits))
+	}
+}
+
+const tmpl = `// Code generated by gen_vanilla_registries.go; DO NOT EDIT.
+
+package registry
+
+import "github.com/gstoney/mcproto/nbt"
+
+func vanillaRegistries() []vanillaRegistry {
+	return []vanillaRegistry{
+	{{- range .}}
+		{ {{- printf "%q" .ID}}, map[string]nbt.Compound{
+		{{- range .Entries}}
+			{{printf "%q" .Name}}: {{.Data}},
+		{{- end}}
+		}},
+	{{- end}}
+	}
+}
+`
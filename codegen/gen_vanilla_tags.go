@@ -0,0 +1,142 @@
+//go:build ignore
+// +build ignore
+
+// gen_vanilla_tags.go generates the vanilla tags of package tags from the
+// data of the vanilla data generator:
+//
+//	java -DbundlerMainClass=net.minecraft.data.Main -jar server.jar --server
+//	go run gen_vanilla_tags.go -- generated/data path/to/tags
+//
+// It reads the tags of the block, item, fluid, entity_type and game_event
+// registries under minecraft/tags of the data directory, and writes
+// zz_generated_tags.go to the package directory.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// tagFile is the content of a tag file. Values are identifiers, or
+// objects with a required field for optional entries.
+type tagFile struct {
+	Values []json.RawMessage `json:"values"`
+}
+
+type tagEntry struct {
+	ID       string `json:"id"`
+	Required *bool  `json:"required"`
+}
+
+type Tag struct {
+	Registry string
+	Name     string
+	Values   []string
+}
+
+// registries are the constants of package tags naming the registries, in
+// order of the directories of the data.
+var registries = []struct{ dir, constant string }{
+	{"block", "Block"},
+	{"item", "Item"},
+	{"fluid", "Fluid"},
+	{"entity_type", "EntityType"},
+	{"game_event", "GameEvent"},
+}
+
+func main() {
+	if len(os.Args) < 3 {
+		fmt.Println("Usage: go run gen_vanilla_tags.go -- path/to/data path/to/dir")
+		os.Exit(1)
+	}
+	dataDir, targetDir := os.Args[len(os.Args)-2], os.Args[len(os.Args)-1]
+
+	var tags []Tag
+	for _, r := range registries {
+		dir := filepath.Join(dataDir, "minecraft", "tags", r.dir)
+		n := len(tags)
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() || filepath.Ext(path) != ".json" {
+				return err
+			}
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+			name := "minecraft:" + strings.TrimSuffix(filepath.ToSlash(rel), ".json")
+			tags = append(tags, Tag{Registry: r.constant, Name: name, Values: readTag(path)})
+			return nil
+		})
+		if err != nil {
+			panic(err)
+		}
+		if len(tags) == n {
+			panic(dir + ": no tags")
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := template.Must(template.New("tags").Parse(tmpl)).Execute(&buf, tags); err != nil {
+		panic(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		panic(err)
+	}
+	outFile := filepath.Join(targetDir, "zz_generated_tags.go")
+	if err = os.WriteFile(outFile, src, 0o644); err != nil {
+		panic(err)
+	}
+	fmt.Printf("Generated %s: %d tags\n", outFile, len(tags))
+}
+
+// readTag returns the values of the tag file at path, optional ones
+// suffixed with '?'.
+func readTag(path string) []string {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		panic(err)
+	}
+	var f tagFile
+	if err = json.Unmarshal(b, &f); err != nil {
+		panic(fmt.Errorf("%s: %w", path, err))
+	}
+
+	values := make([]string, 0, len(f.Values))
+	for _, raw := range f.Values {
+		var v string
+		if err := json.Unmarshal(raw, &v); err == nil {
+			values = append(values, v)
+			continue
+		}
+		var e tagEntry
+		if err := json.Unmarshal(raw, &e); err != nil || e.ID == "" {
+			panic(fmt.Sprintf("%s: invalid value %s", path, raw))
+		}
+		if e.Required != nil && !*e.Required {
+			e.ID += "?"
+		}
+		values = append(values, e.ID)
+	}
+	return values
+}
+
+const tmpl = `// Code generated by gen_vanilla_tags.go; DO NOT EDIT.
+
+package tags
+
+var vanillaTags = []vanillaTag{
+{{- range .}}
+	{ {{- .Registry}}, {{printf "%q" .Name}}, []string{
+	{{- range $i, $v := .Values}}{{if $i}}, {{end}}{{printf "%q" $v}}{{end -}}
+	}},
+{{- end}}
+}
+`
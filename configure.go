@@ -0,0 +1,325 @@
+package mcproto
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gstoney/mcproto/packet"
+	"github.com/gstoney/mcproto/registry"
+	"github.com/gstoney/mcproto/tags"
+)
+
+var (
+	ErrKeepAliveMismatch = errors.New("keep alive response does not match request")
+	ErrKeepAliveTimeout  = errors.New("keep alive timed out")
+)
+
+// DefaultKeepAliveTimeout is the default of Configurator.KeepAliveTimeout.
+const DefaultKeepAliveTimeout = 30 * time.Second
+
+// A ConfigStep performs part of the configuration phase, such as sending
+// registries. Steps read packets with cfg.ReadPacket.
+type ConfigStep func(cfg *Configurator) error
+
+// Configurator drives the configuration phase of a session, from Login
+// Acknowledged until the client acknowledges Finish Configuration.
+//
+// It runs Steps in order, then finishes configuration, switching the
+// session to Play mode. Keep Alive responses and Client Information are
+// handled whenever they arrive, and the client brand is recorded on the
+// Session, as is Client Information by Conn.
+type Configurator struct {
+	Conn  *Conn
+	Steps []ConfigStep
+
+	// KnownPacks are the packs the client knows, set by KnownPacksStep.
+	KnownPacks []packet.KnownPack
+
+	// KeepAliveTimeout is how long a Keep Alive may go unanswered before
+	// KeepAlive disconnects the client, DefaultKeepAliveTimeout if zero.
+	KeepAliveTimeout time.Duration
+
+	kaMu          sync.Mutex
+	keepAliveID   int64 // Outstanding Keep Alive, 0 if none.
+	keepAliveSent time.Time
+	timedOut      bool
+
+	pending []packet.Packet
+}
+
+// NewConfigurator creates a Configurator running steps on c, which must
+// be in Config mode.
+func NewConfigurator(c *Conn, steps ...ConfigStep) *Configurator {
+	return &Configurator{Conn: c, Steps: steps}
+}
+
+// Run runs the steps and finishes configuration.
+func (cfg *Configurator) Run() error {
+	if cfg.Conn.Session.Mode != Config {
+		return ErrInvalidMode
+	}
+
+	for _, step := range cfg.Steps {
+		if err := step(cfg); err != nil {
+			return err
+		}
+	}
+
+	if err := cfg.Conn.WritePacket(&packet.FinishConfiguration{}); err != nil {
+		return err
+	}
+	for {
+		p, err := cfg.ReadPacket()
+		if err != nil {
+			return err
+		}
+
+		switch p.(type) {
+		case *packet.FinishConfigurationAcknowledge:
+			cfg.Conn.SetMode(NextMode(Config, p))
+			return nil
+		case *packet.ConfigServerboundPluginMessage, *packet.ConfigResourcePackResponse:
+			// Unhandled plugin messages and late pack statuses are ignored.
+		default:
+			return ErrUnexpectedPacket
+		}
+	}
+}
+
+// ReadPacket returns the next packet not handled by the Configurator.
+func (cfg *Configurator) ReadPacket() (p packet.Packet, err error) {
+	for {
+		if len(cfg.pending) > 0 {
+			p, cfg.pending = cfg.pending[0], cfg.pending[1:]
+		} else if p, err = cfg.Conn.ReadPacket(); err != nil {
+			cfg.kaMu.Lock()
+			if cfg.timedOut {
+				err = ErrKeepAliveTimeout
+			}
+			cfg.kaMu.Unlock()
+			return
+		}
+
+		handled, err := cfg.handle(p)
+		if err != nil {
+			return nil, err
+		}
+		if !handled {
+			return p, nil
+		}
+	}
+}
+
+func (cfg *Configurator) handle(p packet.Packet) (handled bool, err error) {
+	switch p := p.(type) {
+	case *packet.ConfigServerboundKeepAlive:
+		cfg.kaMu.Lock()
+		defer cfg.kaMu.Unlock()
+		if p.KeepAliveID != cfg.keepAliveID {
+			return true, ErrKeepAliveMismatch
+		}
+		cfg.keepAliveID = 0
+		return true, nil
+	case *packet.ConfigClientInformation:
+		return true, nil
+	case *packet.ConfigServerboundPluginMessage:
+		if p.Channel != BrandChannel {
+			return false, nil
+		}
+		cfg.Conn.Session.Brand, err = DecodeBrand(p.Data)
+		return true, err
+	}
+	return false, nil
+}
+
+// Unread queues ps to be returned by ReadPacket before reading from the
+// connection, for steps to pass on packets they do not await.
+func (cfg *Configurator) Unread(ps ...packet.Packet) {
+	cfg.pending = append(cfg.pending, ps...)
+}
+
+// KeepAlive sends a Keep Alive unless the last one is still unanswered,
+// in which case it disconnects the client with ErrKeepAliveTimeout once
+// KeepAliveTimeout has passed. Steps waiting on the client for long, such
+// as for resource packs, should call it every 15 seconds. It may be called
+// concurrently with ReadPacket.
+func (cfg *Configurator) KeepAlive() error {
+	cfg.kaMu.Lock()
+	now := time.Now()
+	if cfg.keepAliveID != 0 {
+		timeout := cfg.KeepAliveTimeout
+		if timeout <= 0 {
+			timeout = DefaultKeepAliveTimeout
+		}
+		cfg.timedOut = now.Sub(cfg.keepAliveSent) >= timeout
+		timedOut := cfg.timedOut
+		cfg.kaMu.Unlock()
+		if !timedOut {
+			return nil
+		}
+		// Closing the connection fails a ReadPacket in progress, which
+		// then reports the timeout.
+		cfg.Conn.WritePacket(&packet.ConfigDisconnect{Reason: "Timed out"})
+		if c := cfg.Conn.Session.Conn; c != nil {
+			c.Close()
+		}
+		return ErrKeepAliveTimeout
+	}
+	id := now.UnixMilli()
+	cfg.keepAliveID, cfg.keepAliveSent = id, now
+	cfg.kaMu.Unlock()
+
+	return cfg.Conn.WritePacket(&packet.ConfigClientboundKeepAlive{KeepAliveID: id})
+}
+
+// keepAlive sends a Keep Alive every interval until stop is called. stop
+// returns once no Keep Alive is being sent.
+func (cfg *Configurator) keepAlive(interval time.Duration) (stop func()) {
+	done := make(chan struct{})
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				if cfg.KeepAlive() != nil {
+					return
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() {
+		close(done)
+		<-exited
+	}
+}
+
+// BrandStep sends the server brand.
+func BrandStep(brand string) ConfigStep {
+	return func(cfg *Configurator) error {
+		return cfg.Conn.SendBrand(brand)
+	}
+}
+
+// ClientInfoStep waits until Client Information is received, which
+// vanilla clients send when entering configuration.
+func ClientInfoStep() ConfigStep {
+	return func(cfg *Configurator) error {
+		for cfg.Conn.Session.ClientInfo == nil {
+			if _, err := cfg.ReadPacket(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// KnownPacksStep offers packs to the client, and records in
+// cfg.KnownPacks those the client knows.
+func KnownPacksStep(packs []packet.KnownPack) ConfigStep {
+	return func(cfg *Configurator) error {
+		err := cfg.Conn.WritePacket(&packet.ConfigClientboundKnownPacks{Packs: packs})
+		if err != nil {
+			return err
+		}
+
+		var other []packet.Packet
+		defer func() { cfg.Unread(other...) }()
+		for {
+			p, err := cfg.ReadPacket()
+			if err != nil {
+				return err
+			}
+			if kp, ok := p.(*packet.ConfigServerboundKnownPacks); ok {
+				cfg.KnownPacks = kp.Packs
+				return nil
+			}
+			other = append(other, p)
+		}
+	}
+}
+
+// RegistriesStep sends the registries of s, omitting data of packs in
+// cfg.KnownPacks. It follows KnownPacksStep, and disconnects clients not
+// knowing packs whose entries s lacks data for.
+func RegistriesStep(s *registry.Set) ConfigStep {
+	return func(cfg *Configurator) error {
+		return sendRegistryData(cfg.Conn, s, cfg.KnownPacks)
+	}
+}
+
+// TagsStep sends the tags of s.
+func TagsStep(s *tags.Set, ids tags.IDs) ConfigStep {
+	return func(cfg *Configurator) error {
+		return SendTags(cfg.Conn, s, ids)
+	}
+}
+
+// FeatureFlagsStep enables feature flags, such as "minecraft:vanilla".
+func FeatureFlagsStep(flags ...string) ConfigStep {
+	return func(cfg *Configurator) error {
+		return cfg.Conn.WritePacket(&packet.FeatureFlags{Flags: flags})
+	}
+}
+
+// ResourcePackStep pushes packs and waits until the client reports a
+// final status for each, keeping the connection alive meanwhile. Clients
+// declining a forced pack are disconnected, failing the step.
+func ResourcePackStep(packs ...ResourcePack) ConfigStep {
+	return func(cfg *Configurator) error {
+		var futures []*PackFuture
+		for _, pack := range packs {
+			f, err := cfg.Conn.PushResourcePack(pack)
+			if err != nil {
+				return err
+			}
+			futures = append(futures, f)
+		}
+
+		stop := cfg.keepAlive(15 * time.Second)
+		defer stop()
+
+		var other []packet.Packet
+		defer func() { cfg.Unread(other...) }()
+		for _, f := range futures {
+			for !isDone(f) {
+				p, err := cfg.ReadPacket()
+				if err != nil {
+					return err
+				}
+				switch p.(type) {
+				case *packet.ConfigResourcePackResponse:
+				default:
+					other = append(other, p)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func isDone(f *PackFuture) bool {
+	select {
+	case <-f.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+// VanillaSteps returns the steps of a vanilla server, sending the
+// registries of s and the tags of t.
+func VanillaSteps(brand string, s *registry.Set, t *tags.Set, ids tags.IDs) []ConfigStep {
+	return []ConfigStep{
+		BrandStep(brand),
+		FeatureFlagsStep("minecraft:vanilla"),
+		KnownPacksStep(s.Packs),
+		RegistriesStep(s),
+		TagsStep(t, ids),
+	}
+}
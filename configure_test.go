@@ -0,0 +1,200 @@
+package mcproto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gstoney/mcproto/packet"
+	"github.com/gstoney/mcproto/registry"
+	"github.com/gstoney/mcproto/tags"
+	"github.com/gstoney/mcproto/vanilla"
+)
+
+// TestConfigurator verifies a vanilla configuration phase, with Client
+// Information and brand sent before the Known Packs answer.
+func TestConfigurator(t *testing.T) {
+	server, client := loginPipe(t)
+	server.Session.Mode = Config
+	client.Session.Mode = Config
+
+	var custom bool
+	steps := append(VanillaSteps("mcproto", registry.Vanilla(), tags.Vanilla(), vanilla.Resolver{}.EntryID),
+		ClientInfoStep(),
+		func(cfg *Configurator) error {
+			custom = true
+			return cfg.KeepAlive()
+		})
+	cfg := NewConfigurator(server, steps...)
+
+	done := make(chan error, 1)
+	go func() { done <- cfg.Run() }()
+
+	// net.Pipe is unbuffered, so the client writes from its own goroutine.
+	out := make(chan packet.Packet, 8)
+	defer close(out)
+	go func() {
+		for p := range out {
+			client.WritePacket(p)
+		}
+	}()
+
+	out <- &packet.ConfigClientInformation{Locale: "en_us", ViewDistance: 10}
+	out <- &packet.ConfigServerboundPluginMessage{Channel: BrandChannel, Data: EncodeBrand("vanilla")}
+
+	var got []string
+	for {
+		p, err := client.ReadPacket()
+		if err != nil {
+			t.Fatalf("ReadPacket: %v", err)
+		}
+		got = append(got, PacketName(p))
+
+		switch p := p.(type) {
+		case *packet.ConfigClientboundKnownPacks:
+			out <- &packet.ConfigServerboundKnownPacks{Packs: p.Packs}
+		case *packet.ConfigClientboundKeepAlive:
+			out <- &packet.ConfigServerboundKeepAlive{KeepAliveID: p.KeepAliveID}
+		case *packet.FinishConfiguration:
+			out <- &packet.FinishConfigurationAcknowledge{}
+		}
+		if _, ok := p.(*packet.FinishConfiguration); ok {
+			break
+		}
+	}
+
+	if err := <-done; err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if server.Session.Mode != Play {
+		t.Errorf("got mode %v, want Play", server.Session.Mode)
+	}
+	if server.Session.Brand != "vanilla" {
+		t.Errorf("got brand %q, want vanilla", server.Session.Brand)
+	}
+	if ci := server.Session.ClientInfo; ci == nil || ci.Locale != "en_us" {
+		t.Errorf("got client info %+v", ci)
+	}
+	if len(cfg.KnownPacks) != 1 || !custom {
+		t.Errorf("got known packs %v, custom step run %v", cfg.KnownPacks, custom)
+	}
+
+	want := []string{"ConfigClientboundPluginMessage", "FeatureFlags", "ConfigClientboundKnownPacks"}
+	for range registry.Vanilla().Registries {
+		want = append(want, "RegistryData")
+	}
+	want = append(want, "ConfigUpdateTags", "ConfigClientboundKeepAlive", "FinishConfiguration")
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("packet[%d]: got %s, want %s", i, got[i], want[i])
+		}
+	}
+}
+
+// TestConfigurator_NoKnownPacks verifies that a client knowing no packs
+// receives every vanilla registry, with data for each entry.
+func TestConfigurator_NoKnownPacks(t *testing.T) {
+	server, client := loginPipe(t)
+	server.Session.Mode = Config
+	client.Session.Mode = Config
+
+	s := registry.Vanilla()
+	cfg := NewConfigurator(server, KnownPacksStep(s.Packs), RegistriesStep(s))
+	done := make(chan error, 1)
+	go func() { done <- cfg.Run() }()
+
+	if p, err := client.ReadPacket(); err != nil {
+		t.Fatalf("got %v %v, want Known Packs", p, err)
+	}
+	go client.WritePacket(&packet.ConfigServerboundKnownPacks{})
+
+	for _, r := range s.Registries {
+		p, err := client.ReadPacket()
+		rd, ok := p.(*packet.RegistryData)
+		if !ok {
+			t.Fatalf("got %v %v, want Registry Data of %s", p, err, r.ID)
+		}
+		if rd.Registry != r.ID || len(rd.Entries) != len(r.Entries) {
+			t.Errorf("got %s with %d entries, want %s with %d", rd.Registry, len(rd.Entries), r.ID, len(r.Entries))
+		}
+		for _, e := range rd.Entries {
+			if !e.Data.Exists {
+				t.Errorf("%s %s: no data", rd.Registry, e.ID)
+			}
+		}
+	}
+
+	if p, err := client.ReadPacket(); err != nil {
+		t.Fatalf("got %v %v, want Finish Configuration", p, err)
+	} else if _, ok := p.(*packet.FinishConfiguration); !ok {
+		t.Fatalf("got %s, want Finish Configuration", PacketName(p))
+	}
+	go client.WritePacket(&packet.FinishConfigurationAcknowledge{})
+	if err := <-done; err != nil {
+		t.Errorf("Run: %v", err)
+	}
+}
+
+// TestConfigurator_KeepAlive verifies that no Keep Alive is sent while one
+// is outstanding, so a late answer still matches, and that a client not
+// answering is disconnected once KeepAliveTimeout passes.
+func TestConfigurator_KeepAlive(t *testing.T) {
+	server, client := loginPipe(t)
+	server.Session.Mode = Config
+	client.Session.Mode = Config
+	cfg := NewConfigurator(server)
+	cfg.KeepAliveTimeout = 20 * time.Millisecond
+
+	received := make(chan packet.Packet, 8)
+	go func() {
+		for {
+			p, err := client.ReadPacket()
+			if err != nil {
+				close(received)
+				return
+			}
+			received <- p
+		}
+	}()
+
+	if err := cfg.KeepAlive(); err != nil {
+		t.Fatalf("KeepAlive: %v", err)
+	}
+	ka, ok := (<-received).(*packet.ConfigClientboundKeepAlive)
+	if !ok {
+		t.Fatal("expected ConfigClientboundKeepAlive")
+	}
+	if err := cfg.KeepAlive(); err != nil {
+		t.Fatalf("KeepAlive while outstanding: %v", err)
+	}
+	if _, err := cfg.handle(&packet.ConfigServerboundKeepAlive{KeepAliveID: ka.KeepAliveID}); err != nil {
+		t.Fatalf("late answer: %v", err)
+	}
+
+	// Unanswered from here on.
+	time.Sleep(time.Millisecond) // IDs are in milliseconds.
+	if err := cfg.KeepAlive(); err != nil {
+		t.Fatalf("KeepAlive: %v", err)
+	}
+	if next, ok := (<-received).(*packet.ConfigClientboundKeepAlive); !ok || next.KeepAliveID == ka.KeepAliveID {
+		t.Fatalf("expected a new ConfigClientboundKeepAlive, got %+v", next)
+	}
+
+	readErr := make(chan error, 1)
+	go func() {
+		_, err := cfg.ReadPacket()
+		readErr <- err
+	}()
+	time.Sleep(2 * cfg.KeepAliveTimeout)
+	if err := cfg.KeepAlive(); err != ErrKeepAliveTimeout {
+		t.Fatalf("got %v, want ErrKeepAliveTimeout", err)
+	}
+	if _, ok := (<-received).(*packet.ConfigDisconnect); !ok {
+		t.Error("expected ConfigDisconnect")
+	}
+	if err := <-readErr; err != ErrKeepAliveTimeout {
+		t.Errorf("ReadPacket: got %v, want ErrKeepAliveTimeout", err)
+	}
+}
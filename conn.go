@@ -0,0 +1,269 @@
+package mcproto
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/gstoney/mcproto/packet"
+)
+
+// Direction is the direction a packet travels in.
+type Direction byte
+
+const (
+	Serverbound Direction = iota
+	Clientbound
+)
+
+func (d Direction) String() string {
+	if d == Serverbound {
+		return "Serverbound"
+	}
+	return "Clientbound"
+}
+
+// Opposite returns the other direction.
+func (d Direction) Opposite() Direction {
+	if d == Serverbound {
+		return Clientbound
+	}
+	return Serverbound
+}
+
+var handshakeRegistry = packet.Registry{
+	0: func() packet.Packet { return &packet.HandshakePacket{} },
+}
+
+// Registry returns the packet registry used in mode for packets travelling
+// in dir, or nil if there is none.
+func Registry(mode ConnectionMode, dir Direction) packet.Registry {
+	switch mode {
+	case Handshake:
+		if dir == Serverbound {
+			return handshakeRegistry
+		}
+	case Status:
+		if dir == Serverbound {
+			return packet.StatusServerboundRegistry
+		}
+		return packet.StatusClientboundRegistry
+	case Login, Transfer:
+		if dir == Serverbound {
+			return packet.LoginServerboundRegistry
+		}
+		return packet.LoginClientboundRegistry
+	case Config:
+		if dir == Serverbound {
+			return packet.ConfigServerboundRegistry
+		}
+		return packet.ConfigClientboundRegistry
+	case Play:
+		if dir == Serverbound {
+			return packet.PlayServerboundRegistry
+		}
+		return packet.PlayClientboundRegistry
+	}
+	return nil
+}
+
+// NextMode returns the mode following p, a packet travelling in mode.
+// Both ends switch modes on the serverbound packets below, right after
+// the packet is sent or received:
+//
+//	HandshakePacket                -> Status, Login or Transfer
+//	LoginAcknowledge               -> Config
+//	FinishConfigurationAcknowledge -> Play
+//	StartConfigurationAcknowledge  -> Config
+//
+// Any other packet leaves the mode unchanged.
+func NextMode(mode ConnectionMode, p packet.Packet) ConnectionMode {
+	switch p := p.(type) {
+	case *packet.HandshakePacket:
+		if mode == Handshake {
+			switch m := ConnectionMode(p.RequestType); m {
+			case Status, Login, Transfer:
+				return m
+			}
+		}
+	case *packet.LoginAcknowledge:
+		if mode == Login || mode == Transfer {
+			return Config
+		}
+	case *packet.FinishConfigurationAcknowledge:
+		if mode == Config {
+			return Play
+		}
+	case *packet.StartConfigurationAcknowledge:
+		if mode == Play {
+			return Config
+		}
+	}
+	return mode
+}
+
+// PacketName returns the type name of p, for logging and accounting.
+func PacketName(p packet.Packet) string {
+	t := reflect.TypeOf(p)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+// UnknownPacketError is returned by Conn.ReadPacket when the registry of
+// the current mode has no packet with the received ID.
+// The payload is discarded.
+type UnknownPacketError struct {
+	Mode ConnectionMode
+	Dir  Direction
+	ID   int32
+}
+
+func (e *UnknownPacketError) Error() string {
+	return fmt.Sprintf("unknown %s %s packet 0x%02x", e.Mode, e.Dir, e.ID)
+}
+
+// Conn reads and writes whole packets over a Transport, choosing
+// registries by the mode of its Session.
+type Conn struct {
+	Session   *Session
+	Transport *Transport
+
+	// Outbound is the direction of packets written by this end,
+	// Clientbound for servers.
+	Outbound Direction
+
+	// Accounting, if set, records every packet read or written.
+	Accounting *Accounting
+
+	// Channels, if set, handles plugin messages read by ReadPacket.
+	Channels *Channels
+
+	br bufio.Reader
+
+	wmu sync.Mutex
+	buf bytes.Buffer
+
+	packs resourcePacks
+}
+
+// NewConn creates a Conn writing packets in the outbound direction.
+func NewConn(s *Session, t *Transport, outbound Direction) *Conn {
+	return &Conn{
+		Session:   s,
+		Transport: t,
+		Outbound:  outbound,
+	}
+}
+
+// ReadPacket receives a frame and decodes its packet.
+//
+// Unknown packet IDs are reported with *UnknownPacketError, and payloads
+// not fully consumed by decoding with ErrNotExhausted. In both cases the
+// frame is discarded so the next call reads the following packet.
+//
+// Client Information read is stored on the Session, and Resource Pack
+// Responses resolve futures of PushResourcePack. Plugin messages
+// handled by Channels are not returned; ReadPacket reads on, unless the
+// handler fails.
+func (c *Conn) ReadPacket() (p packet.Packet, err error) {
+	for {
+		if p, err = c.readPacket(); err != nil {
+			return
+		}
+		if ci, ok := ClientInfoOf(p); ok {
+			c.Session.SetClientInfo(ci)
+		}
+		if err = c.packs.update(c, p); err != nil {
+			return nil, err
+		}
+		if c.Channels == nil {
+			return
+		}
+
+		handled, err := c.Channels.Dispatch(c, p)
+		if err != nil {
+			return nil, err
+		}
+		if !handled {
+			return p, nil
+		}
+	}
+}
+
+func (c *Conn) readPacket() (p packet.Packet, err error) {
+	pr, err := c.Transport.Recv()
+	if err != nil {
+		return nil, err
+	}
+	size := pr.Remaining()
+	dir := c.Outbound.Opposite()
+
+	c.br.Reset(pr)
+	id, err := packet.ReadVarInt(&c.br)
+	if err != nil {
+		pr.Discard()
+		return nil, err
+	}
+
+	factory, ok := Registry(c.Session.Mode, dir)[id]
+	if !ok {
+		pr.Discard()
+		c.account(dir, id, "", size)
+		return nil, &UnknownPacketError{c.Session.Mode, dir, id}
+	}
+
+	p = factory()
+	if err = p.Decode(&c.br); err != nil {
+		pr.Discard()
+		return nil, err
+	}
+	if c.br.Buffered() > 0 || pr.Remaining() > 0 {
+		pr.Discard()
+		return nil, ErrNotExhausted
+	}
+	if err = pr.Close(); err != nil {
+		return nil, err
+	}
+
+	c.account(dir, id, PacketName(p), size)
+	return p, nil
+}
+
+// WritePacket encodes p and sends it as a single frame. It may be called
+// concurrently with itself and ReadPacket.
+func (c *Conn) WritePacket(p packet.Packet) (err error) {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+
+	c.buf.Reset()
+	if err = p.Encode(&c.buf); err != nil {
+		return
+	}
+	size := c.buf.Len()
+
+	if err = c.Transport.Send(c.buf.Bytes()); err != nil {
+		return
+	}
+
+	c.account(c.Outbound, p.ID(), PacketName(p), int32(size))
+	return
+}
+
+// SetMode switches the Session to mode. Packets written concurrently, such
+// as Keep Alives, are accounted in the mode before or after the switch.
+func (c *Conn) SetMode(mode ConnectionMode) {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	c.Session.Mode = mode
+}
+
+func (c *Conn) account(dir Direction, id int32, name string, size int32) {
+	if c.Accounting == nil {
+		return
+	}
+	c.Accounting.Record(PacketKey{c.Session.Mode, dir, id}, name, int(size))
+}
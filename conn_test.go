@@ -0,0 +1,146 @@
+package mcproto
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gstoney/mcproto/packet"
+)
+
+// TestConn_Roundtrip verifies that a packet written by one end is decoded
+// by the other end with the registry of the session mode.
+func TestConn_Roundtrip(t *testing.T) {
+	var buf bytes.Buffer
+	tr := NewTransport(&buf, &buf, defaultConfig())
+	s := Session{Mode: Login}
+
+	client := NewConn(&s, &tr, Serverbound)
+	server := NewConn(&s, &tr, Clientbound)
+
+	want := packet.LoginStart{Name: "Steve", PlayerUUID: uuid.New()}
+	if err := client.WritePacket(&want); err != nil {
+		t.Fatalf("WritePacket: %v", err)
+	}
+
+	p, err := server.ReadPacket()
+	if err != nil {
+		t.Fatalf("ReadPacket: %v", err)
+	}
+	got, ok := p.(*packet.LoginStart)
+	if !ok {
+		t.Fatalf("got %T, want *packet.LoginStart", p)
+	}
+	if *got != want {
+		t.Errorf("got %+v, want %+v", *got, want)
+	}
+}
+
+// TestConn_ReadErrors verifies that unknown and partially decoded packets
+// are reported, and that the following packet is still readable.
+func TestConn_ReadErrors(t *testing.T) {
+	var buf bytes.Buffer
+	tr := NewTransport(&buf, &buf, defaultConfig())
+	s := Session{Mode: Status}
+	c := NewConn(&s, &tr, Clientbound)
+
+	tr.Send([]byte{0x7f})
+	tr.Send([]byte{0x00, 0xff})
+	c.Outbound = Serverbound
+	c.WritePacket(&packet.StatusRespPacket{Response: "{}"})
+	c.Outbound = Clientbound
+
+	_, err := c.ReadPacket()
+	var unknown *UnknownPacketError
+	if !errors.As(err, &unknown) || unknown.ID != 0x7f {
+		t.Errorf("ReadPacket: got %v, want UnknownPacketError for 0x7f", err)
+	}
+
+	if _, err = c.ReadPacket(); err != ErrNotExhausted {
+		t.Errorf("ReadPacket: got %v, want ErrNotExhausted", err)
+	}
+
+	c.Outbound = Serverbound
+	p, err := c.ReadPacket()
+	if err != nil {
+		t.Fatalf("ReadPacket: %v", err)
+	}
+	if resp, ok := p.(*packet.StatusRespPacket); !ok || resp.Response != "{}" {
+		t.Errorf("got %+v, want StatusRespPacket", p)
+	}
+}
+
+// TestConn_Accounting verifies that packets are counted by mode, direction
+// and ID, and that Reset clears the totals.
+func TestConn_Accounting(t *testing.T) {
+	var buf bytes.Buffer
+	var acc Accounting
+	tr := NewTransport(&buf, &buf, defaultConfig())
+	s := Session{Mode: Status}
+
+	client := NewConn(&s, &tr, Serverbound)
+	client.Accounting = &acc
+	server := NewConn(&s, &tr, Clientbound)
+	server.Accounting = &acc
+
+	for i := 0; i < 3; i++ {
+		client.WritePacket(&packet.PingReqPacket{Timestamp: int64(i)})
+		if _, err := server.ReadPacket(); err != nil {
+			t.Fatalf("ReadPacket: %v", err)
+		}
+	}
+	client.WritePacket(&packet.StatusReqPacket{})
+
+	stats := acc.Snapshot()
+	if len(stats) != 2 {
+		t.Fatalf("got %d entries, want 2", len(stats))
+	}
+	ping := stats[0]
+	want := PacketKey{Status, Serverbound, 1}
+	if ping.PacketKey != want || ping.Name != "PingReqPacket" {
+		t.Errorf("got %+v, want key %+v", ping, want)
+	}
+	// 3 writes and 3 reads of 9 bytes each.
+	if ping.Count != 6 || ping.Bytes != 54 {
+		t.Errorf("got count=%d bytes=%d, want 6, 54", ping.Count, ping.Bytes)
+	}
+
+	acc.Reset()
+	if n := len(acc.Snapshot()); n != 0 {
+		t.Errorf("got %d entries after Reset, want 0", n)
+	}
+}
+
+// TestConn_SetMode verifies that the mode may be switched while packets
+// are written from another goroutine, as Keep Alives are during
+// configuration. Run with -race.
+func TestConn_SetMode(t *testing.T) {
+	var buf bytes.Buffer
+	var acc Accounting
+	tr := NewTransport(nil, &buf, defaultConfig())
+	s := Session{Mode: Config}
+	c := NewConn(&s, &tr, Clientbound)
+	c.Accounting = &acc
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range 100 {
+			c.WritePacket(&packet.ConfigClientboundKeepAlive{KeepAliveID: 1})
+		}
+	}()
+	c.SetMode(Play)
+	<-done
+
+	var n int
+	for _, st := range acc.Snapshot() {
+		if st.Mode != Config && st.Mode != Play {
+			t.Errorf("accounted in %v", st.Mode)
+		}
+		n += int(st.Count)
+	}
+	if n != 100 || s.Mode != Play {
+		t.Errorf("got %d packets accounted, mode %v, want 100 in Play", n, s.Mode)
+	}
+}
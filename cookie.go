@@ -0,0 +1,93 @@
+package mcproto
+
+import (
+	"errors"
+
+	"github.com/gstoney/mcproto/packet"
+)
+
+// MaxCookieSize is the largest cookie payload clients store and send.
+const MaxCookieSize = packet.MaxCookieSize
+
+var (
+	// ErrCookieTooLarge is also returned by ReadPacket for Cookie Responses
+	// announcing a larger payload, before it is read.
+	ErrCookieTooLarge = packet.ErrCookieTooLarge
+	ErrInvalidMode    = errors.New("packet not available in connection mode")
+)
+
+// StoreCookie asks the client to store payload under key. Clients keep
+// cookies across transfers, so the next server can retrieve them.
+// It is available in Config and Play mode.
+func (c *Conn) StoreCookie(key string, payload []byte) error {
+	if len(payload) > MaxCookieSize {
+		return ErrCookieTooLarge
+	}
+	switch c.Session.Mode {
+	case Config:
+		return c.WritePacket(&packet.ConfigStoreCookie{Key: key, Payload: payload})
+	case Play:
+		return c.WritePacket(&packet.PlayStoreCookie{Key: key, Payload: payload})
+	}
+	return ErrInvalidMode
+}
+
+// RequestCookie asks the client for the cookie stored under key.
+// The client answers with a Cookie Response packet of the current mode,
+// which ParseCookieResponse reads. During login, LoginQuery also awaits
+// the response.
+func (c *Conn) RequestCookie(key string) error {
+	switch c.Session.Mode {
+	case Login, Transfer:
+		return c.WritePacket(&packet.LoginCookieRequest{Key: key})
+	case Config:
+		return c.WritePacket(&packet.ConfigCookieRequest{Key: key})
+	case Play:
+		return c.WritePacket(&packet.PlayCookieRequest{Key: key})
+	}
+	return ErrInvalidMode
+}
+
+// ParseCookieResponse returns the cookie carried by a Cookie Response of
+// any mode. payload is nil if the client had no cookie under key.
+//
+// Cookie Responses read by Conn.ReadPacket are within MaxCookieSize, as
+// larger payloads fail to decode; p is checked again for packets built
+// otherwise.
+func ParseCookieResponse(p packet.Packet) (key string, payload []byte, err error) {
+	var v packet.Optional[[]byte]
+	switch p := p.(type) {
+	case *packet.LoginCookieResponse:
+		key, v = p.Key, p.Payload
+	case *packet.ConfigCookieResponse:
+		key, v = p.Key, p.Payload
+	case *packet.PlayCookieResponse:
+		key, v = p.Key, p.Payload
+	default:
+		return "", nil, ErrUnexpectedPacket
+	}
+
+	if len(v.Item) > MaxCookieSize {
+		return key, nil, ErrCookieTooLarge
+	}
+	if v.Exists {
+		payload = v.Item
+		if payload == nil {
+			payload = []byte{}
+		}
+	}
+	return
+}
+
+// Transfer tells the client to disconnect and connect to host:port,
+// keeping its cookies. The client's handshake carries the Transfer intent.
+// It is available in Config and Play mode.
+func (c *Conn) Transfer(host string, port int) error {
+	switch c.Session.Mode {
+	case Config:
+		return c.WritePacket(&packet.ConfigTransfer{Host: host, Port: int32(port)})
+	case Play:
+		return c.WritePacket(&packet.PlayTransfer{Host: host, Port: int32(port)})
+	}
+	return ErrInvalidMode
+}
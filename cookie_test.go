@@ -0,0 +1,113 @@
+package mcproto
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gstoney/mcproto/packet"
+)
+
+// TestConn_Cookies verifies storing, requesting and transferring in each
+// mode, and that unavailable modes are refused.
+func TestConn_Cookies(t *testing.T) {
+	for _, mode := range []ConnectionMode{Config, Play} {
+		t.Run(mode.String(), func(t *testing.T) {
+			server, client := loginPipe(t)
+			server.Session.Mode = mode
+			client.Session.Mode = mode
+
+			go func() {
+				server.StoreCookie("test:token", []byte("abc"))
+				server.RequestCookie("test:token")
+				server.Transfer("play.example.com", 25566)
+			}()
+
+			var got []string
+			for range 3 {
+				p, err := client.ReadPacket()
+				if err != nil {
+					t.Fatalf("ReadPacket: %v", err)
+				}
+				got = append(got, PacketName(p))
+				if p, ok := p.(*packet.ConfigTransfer); ok && (p.Host != "play.example.com" || p.Port != 25566) {
+					t.Errorf("got transfer to %s:%d", p.Host, p.Port)
+				}
+			}
+			want := []string{mode.String() + "StoreCookie", mode.String() + "CookieRequest", mode.String() + "Transfer"}
+			for i := range want {
+				if got[i] != want[i] {
+					t.Errorf("packet[%d]: got %s, want %s", i, got[i], want[i])
+				}
+			}
+		})
+	}
+
+	server, _ := loginPipe(t)
+	if err := server.Transfer("example.com", 25565); err != ErrInvalidMode {
+		t.Errorf("Transfer in Login: got %v, want ErrInvalidMode", err)
+	}
+	server.Session.Mode = Config
+	if err := server.StoreCookie("test:big", make([]byte, MaxCookieSize+1)); err != ErrCookieTooLarge {
+		t.Errorf("StoreCookie: got %v, want ErrCookieTooLarge", err)
+	}
+}
+
+// TestCookieResponse_TooLarge verifies that a Cookie Response announcing
+// a payload over MaxCookieSize fails on its length, before the payload
+// is read.
+func TestCookieResponse_TooLarge(t *testing.T) {
+	var buf bytes.Buffer
+	packet.WriteIdentifier(&buf, "test:big")
+	packet.WriteBoolean(&buf, true)
+	packet.WriteVarInt(&buf, 1<<30) // no payload follows
+
+	var p packet.ConfigCookieResponse
+	if err := p.Decode(&buf); err != ErrCookieTooLarge {
+		t.Errorf("got %v, want ErrCookieTooLarge", err)
+	}
+}
+
+func TestParseCookieResponse(t *testing.T) {
+	tcs := []struct {
+		desc      string
+		p         packet.Packet
+		payload   []byte
+		expectErr error
+	}{
+		{
+			desc:    "Present",
+			p:       &packet.PlayCookieResponse{Key: "k", Payload: packet.Optional[[]byte]{Exists: true, Item: []byte("v")}},
+			payload: []byte("v"),
+		},
+		{
+			desc:    "Present but empty",
+			p:       &packet.ConfigCookieResponse{Key: "k", Payload: packet.Optional[[]byte]{Exists: true}},
+			payload: []byte{},
+		},
+		{
+			desc: "Absent",
+			p:    &packet.LoginCookieResponse{Key: "k"},
+		},
+		{
+			desc:      "Too large",
+			p:         &packet.ConfigCookieResponse{Key: "k", Payload: packet.Optional[[]byte]{Exists: true, Item: make([]byte, MaxCookieSize+1)}},
+			expectErr: ErrCookieTooLarge,
+		},
+		{
+			desc:      "Not a cookie response",
+			p:         &packet.LoginAcknowledge{},
+			expectErr: ErrUnexpectedPacket,
+		},
+	}
+	for _, tC := range tcs {
+		t.Run(tC.desc, func(t *testing.T) {
+			_, payload, err := ParseCookieResponse(tC.p)
+			if err != tC.expectErr {
+				t.Fatalf("got error %v, want %v", err, tC.expectErr)
+			}
+			if (payload == nil) != (tC.payload == nil) || !bytes.Equal(payload, tC.payload) {
+				t.Errorf("got payload %q, want %q", payload, tC.payload)
+			}
+		})
+	}
+}
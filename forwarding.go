@@ -0,0 +1,169 @@
+package mcproto
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/gstoney/mcproto/packet"
+)
+
+// ForwardingMode selects how a proxy in front of a server forwards the
+// player's address and profile.
+type ForwardingMode byte
+
+const (
+	NoForwarding ForwardingMode = iota
+	// LegacyForwarding appends the address, UUID and properties to the
+	// handshake's server address, separated by null bytes (BungeeCord).
+	LegacyForwarding
+	// ModernForwarding sends them in a signed login plugin response on the
+	// velocity:player_info channel (Velocity).
+	ModernForwarding
+)
+
+// ModernForwardingChannel is the login plugin channel of modern forwarding.
+const ModernForwardingChannel = "velocity:player_info"
+
+// ModernForwardingVersion is the modern forwarding version this package
+// requests and sends, which carries no player chat keys.
+const ModernForwardingVersion = 1
+
+var (
+	ErrNotForwarded        = errors.New("connection was not forwarded by a proxy")
+	ErrForwardingSignature = errors.New("invalid forwarding signature")
+	ErrForwardingVersion   = errors.New("unsupported forwarding version")
+	ErrNoForwardingSecret  = errors.New("modern forwarding requires a secret")
+)
+
+// ForwardedPlayer holds the player information a proxy forwards.
+type ForwardedPlayer struct {
+	Address    string // Client IP address
+	PlayerUUID uuid.UUID
+	Name       string
+	Properties []packet.GameProfileProperty
+}
+
+type legacyProperty struct {
+	Name      string `json:"name"`
+	Value     string `json:"value"`
+	Signature string `json:"signature,omitempty"`
+}
+
+// EncodeLegacyForwarding returns the handshake server address carrying
+// f in legacy (BungeeCord) format.
+func EncodeLegacyForwarding(host string, f ForwardedPlayer) string {
+	props := make([]legacyProperty, len(f.Properties))
+	for i, p := range f.Properties {
+		props[i] = legacyProperty{p.Name, p.Value, p.Signature.Item}
+	}
+	b, _ := json.Marshal(props)
+
+	return strings.Join([]string{
+		host,
+		f.Address,
+		strings.ReplaceAll(f.PlayerUUID.String(), "-", ""),
+		string(b),
+	}, "\x00")
+}
+
+// DecodeLegacyForwarding splits a handshake server address in legacy
+// (BungeeCord) format into the original host and forwarded player.
+// The forwarded name is left empty, as it is sent in Login Start.
+func DecodeLegacyForwarding(serverAddr string) (host string, f ForwardedPlayer, err error) {
+	parts := strings.Split(serverAddr, "\x00")
+	if len(parts) < 3 {
+		return serverAddr, f, ErrNotForwarded
+	}
+	host, f.Address = parts[0], parts[1]
+
+	if f.PlayerUUID, err = uuid.Parse(parts[2]); err != nil {
+		return
+	}
+
+	if len(parts) > 3 {
+		var props []legacyProperty
+		if err = json.Unmarshal([]byte(parts[3]), &props); err != nil {
+			return
+		}
+		for _, p := range props {
+			f.Properties = append(f.Properties, packet.GameProfileProperty{
+				Name:      p.Name,
+				Value:     p.Value,
+				Signature: packet.Optional[string]{Exists: p.Signature != "", Item: p.Signature},
+			})
+		}
+	}
+	return
+}
+
+// EncodeModernForwarding returns the login plugin response data carrying
+// f in modern (Velocity) format, signed with secret.
+func EncodeModernForwarding(secret []byte, f ForwardedPlayer) []byte {
+	var buf bytes.Buffer
+	buf.Write(make([]byte, sha256.Size))
+
+	packet.WriteVarInt(&buf, ModernForwardingVersion)
+	packet.WriteString(&buf, f.Address)
+	packet.WriteUUID(&buf, f.PlayerUUID)
+	packet.WriteString(&buf, f.Name)
+	packet.WritePrefixedArray(&buf, f.Properties, packet.WriteGameProfileProperty)
+
+	b := buf.Bytes()
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(b[sha256.Size:])
+	mac.Sum(b[:0])
+	return b
+}
+
+// DecodeModernForwarding verifies the signature of login plugin response
+// data in modern (Velocity) format and decodes the forwarded player.
+// An empty secret fails with ErrNoForwardingSecret, as anyone could sign
+// with it.
+func DecodeModernForwarding(secret []byte, data []byte) (f ForwardedPlayer, err error) {
+	if len(secret) == 0 {
+		return f, ErrNoForwardingSecret
+	}
+	if len(data) < sha256.Size {
+		return f, ErrForwardingSignature
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(data[sha256.Size:])
+	if !hmac.Equal(mac.Sum(nil), data[:sha256.Size]) {
+		return f, ErrForwardingSignature
+	}
+
+	r := bufio.NewReader(bytes.NewReader(data[sha256.Size:]))
+	version, err := packet.ReadVarInt(r)
+	if err != nil {
+		return
+	}
+	if version < ModernForwardingVersion {
+		return f, ErrForwardingVersion
+	}
+	if f.Address, err = packet.ReadString(r); err != nil {
+		return
+	}
+	if f.PlayerUUID, err = packet.ReadUUID(r); err != nil {
+		return
+	}
+	if f.Name, err = packet.ReadString(r); err != nil {
+		return
+	}
+	// Data of versions above ModernForwardingVersion follows the
+	// properties, and is ignored.
+	f.Properties, err = packet.ReadPrefixedArray(r, packet.ReadGameProfileProperty)
+	return
+}
+
+// forwardedAddr returns the address of a forwarded client, without port
+// as proxies forward only the IP.
+func forwardedAddr(ip string) net.Addr {
+	return &net.TCPAddr{IP: net.ParseIP(ip)}
+}
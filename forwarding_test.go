@@ -0,0 +1,173 @@
+package mcproto
+
+import (
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gstoney/mcproto/packet"
+)
+
+var testForwarded = ForwardedPlayer{
+	Address:    "203.0.113.7",
+	PlayerUUID: uuid.MustParse("069a79f4-44e9-4726-a5be-fca90e38aaf5"),
+	Name:       "Notch",
+	Properties: []packet.GameProfileProperty{
+		{Name: "textures", Value: "e30=", Signature: packet.Optional[string]{Exists: true, Item: "c2ln"}},
+	},
+}
+
+func TestLegacyForwarding(t *testing.T) {
+	addr := EncodeLegacyForwarding("mc.example.com", testForwarded)
+
+	host, got, err := DecodeLegacyForwarding(addr)
+	if err != nil {
+		t.Fatalf("DecodeLegacyForwarding: %v", err)
+	}
+	if host != "mc.example.com" {
+		t.Errorf("got host %q", host)
+	}
+
+	want := testForwarded
+	want.Name = ""
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	if _, _, err = DecodeLegacyForwarding("mc.example.com"); err != ErrNotForwarded {
+		t.Errorf("plain address: got %v, want ErrNotForwarded", err)
+	}
+}
+
+func TestModernForwarding(t *testing.T) {
+	secret := []byte("secret")
+	data := EncodeModernForwarding(secret, testForwarded)
+
+	got, err := DecodeModernForwarding(secret, data)
+	if err != nil {
+		t.Fatalf("DecodeModernForwarding: %v", err)
+	}
+	if !reflect.DeepEqual(got, testForwarded) {
+		t.Errorf("got %+v, want %+v", got, testForwarded)
+	}
+
+	if _, err = DecodeModernForwarding([]byte("other"), data); err != ErrForwardingSignature {
+		t.Errorf("wrong secret: got %v, want ErrForwardingSignature", err)
+	}
+	if _, err = DecodeModernForwarding(nil, EncodeModernForwarding(nil, testForwarded)); err != ErrNoForwardingSecret {
+		t.Errorf("empty secret: got %v, want ErrNoForwardingSecret", err)
+	}
+}
+
+// TestEstablisher_ModernForwardingNoSecret verifies that modern forwarding
+// without a secret fails before reading from the connection.
+func TestEstablisher_ModernForwardingNoSecret(t *testing.T) {
+	sc, cc := net.Pipe()
+	defer sc.Close()
+	defer cc.Close()
+
+	e := Establisher{Config: defaultConfig(), Forwarding: ModernForwarding}
+	if _, _, err := e.Establish(sc); err != ErrNoForwardingSecret {
+		t.Errorf("got %v, want ErrNoForwardingSecret", err)
+	}
+}
+
+// TestEstablisher_ModernForwarding verifies that the session of a login
+// forwarded with a valid signature carries the forwarded player.
+func TestEstablisher_ModernForwarding(t *testing.T) {
+	sc, cc := net.Pipe()
+	defer cc.Close()
+
+	secret := []byte("secret")
+	e := Establisher{Config: defaultConfig(), Forwarding: ModernForwarding, ForwardingSecret: secret}
+	done := make(chan Session, 1)
+	go func() {
+		s, _, err := e.Establish(sc)
+		if err != nil {
+			t.Errorf("Establish: %v", err)
+		}
+		done <- s
+	}()
+
+	var cs Session
+	ct := NewTransport(cc, cc, defaultConfig())
+	client := NewConn(&cs, &ct, Serverbound)
+
+	client.WritePacket(&packet.HandshakePacket{ProtocolVersion: 767, ServerAddr: "localhost", ServerPort: 25565, RequestType: 2})
+	cs.Mode = Login
+	client.WritePacket(&packet.LoginStart{Name: "Notch"})
+
+	p, err := client.ReadPacket()
+	req, ok := p.(*packet.LoginPluginRequest)
+	if !ok || req.Channel != ModernForwardingChannel {
+		t.Fatalf("got %v %v, want forwarding request", p, err)
+	}
+	client.WritePacket(&packet.LoginPluginResponse{
+		MessageID:  req.MessageID,
+		Successful: true,
+		Data:       EncodeModernForwarding(secret, testForwarded),
+	})
+
+	p, err = client.ReadPacket()
+	success, ok := p.(*packet.LoginSuccess)
+	if !ok {
+		t.Fatalf("got %v %v, want LoginSuccess", p, err)
+	}
+	if success.UUID != testForwarded.PlayerUUID || len(success.Properties) != 1 {
+		t.Errorf("got %+v, want forwarded profile", success)
+	}
+	client.WritePacket(&packet.LoginAcknowledge{})
+
+	s := <-done
+	if s.RemoteAddr.String() != "203.0.113.7:0" {
+		t.Errorf("got RemoteAddr %s, want forwarded address", s.RemoteAddr)
+	}
+}
+
+// TestEstablisher_ModernForwardingTimeout verifies that a login whose
+// forwarding query is never answered, as from a client connecting
+// directly, fails once ForwardingTimeout passes.
+func TestEstablisher_ModernForwardingTimeout(t *testing.T) {
+	sc, cc := net.Pipe()
+	defer cc.Close()
+
+	e := Establisher{
+		Config:            defaultConfig(),
+		Forwarding:        ModernForwarding,
+		ForwardingSecret:  []byte("secret"),
+		ForwardingTimeout: 50 * time.Millisecond,
+	}
+	done := make(chan error, 1)
+	go func() {
+		_, _, err := e.Establish(sc)
+		done <- err
+	}()
+
+	var cs Session
+	ct := NewTransport(cc, cc, defaultConfig())
+	client := NewConn(&cs, &ct, Serverbound)
+
+	client.WritePacket(&packet.HandshakePacket{ProtocolVersion: 767, ServerAddr: "localhost", ServerPort: 25565, RequestType: 2})
+	cs.Mode = Login
+	client.WritePacket(&packet.LoginStart{Name: "Notch"})
+
+	if p, err := client.ReadPacket(); err != nil {
+		t.Fatalf("got %v %v, want forwarding request", p, err)
+	}
+	if p, err := client.ReadPacket(); err != nil {
+		t.Fatalf("got %v %v, want LoginDisconnect", p, err)
+	} else if _, ok := p.(*packet.LoginDisconnect); !ok {
+		t.Errorf("got %T, want LoginDisconnect", p)
+	}
+
+	select {
+	case err := <-done:
+		if err != ErrQueryTimeout {
+			t.Errorf("got %v, want ErrQueryTimeout", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Establish did not time out")
+	}
+}
@@ -114,6 +114,7 @@ type compressedPayload struct {
 	zr        io.ReadCloser
 	fr        *FrameReader
 	remaining int32
+	stats     *TransportStats
 }
 
 func (p *compressedPayload) Read(b []byte) (n int, err error) {
@@ -129,6 +130,7 @@ func (p *compressedPayload) Read(b []byte) (n int, err error) {
 	if err != nil {
 		if err == io.EOF && p.remaining > 0 {
 			err = ErrZlibPayloadUnderrun
+			p.stats.countErr(err)
 		}
 	}
 	return
@@ -146,6 +148,12 @@ func (p *compressedPayload) Discard() (n int32, err error) {
 }
 
 func (p *compressedPayload) Close() (err error) {
+	err = p.close()
+	p.stats.countErr(err)
+	return
+}
+
+func (p *compressedPayload) close() (err error) {
 	if p.remaining > 0 {
 		return ErrNotExhausted
 	}
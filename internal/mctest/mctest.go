@@ -0,0 +1,62 @@
+// Package mctest provides helpers for tests driving Minecraft connections.
+package mctest
+
+import (
+	"net"
+	"testing"
+
+	"github.com/gstoney/mcproto"
+	"github.com/gstoney/mcproto/packet"
+)
+
+// Config returns the TransportConfig of test connections.
+func Config() mcproto.TransportConfig {
+	return mcproto.TransportConfig{
+		MaxPacketLen:       1 << 20,
+		MaxDecompressedLen: 1 << 21,
+	}
+}
+
+// Peer is one end of a connection driven by a test, tracking modes the
+// way both ends of a real connection do.
+//
+// Failures are reported with Errorf, so a Peer may be driven from a
+// goroutine other than the test's. Read returns nil after a failure.
+type Peer struct {
+	T         testing.TB
+	Session   mcproto.Session
+	Transport mcproto.Transport
+	Conn      *mcproto.Conn
+}
+
+// NewPeer creates a Peer on c writing packets in the outbound direction.
+func NewPeer(t testing.TB, c net.Conn, outbound mcproto.Direction) *Peer {
+	pr := &Peer{T: t, Transport: mcproto.NewTransport(c, c, Config())}
+	pr.Conn = mcproto.NewConn(&pr.Session, &pr.Transport, outbound)
+	return pr
+}
+
+// Write sends p, following a mode transition p starts.
+func (pr *Peer) Write(p packet.Packet) {
+	pr.T.Helper()
+	if err := pr.Conn.WritePacket(p); err != nil {
+		pr.T.Errorf("WritePacket %T: %v", p, err)
+	}
+	if pr.Conn.Outbound == mcproto.Serverbound {
+		pr.Session.Mode = mcproto.NextMode(pr.Session.Mode, p)
+	}
+}
+
+// Read receives a packet, following a mode transition it starts.
+func (pr *Peer) Read() packet.Packet {
+	pr.T.Helper()
+	p, err := pr.Conn.ReadPacket()
+	if err != nil {
+		pr.T.Errorf("ReadPacket: %v", err)
+		return nil
+	}
+	if pr.Conn.Outbound == mcproto.Clientbound {
+		pr.Session.Mode = mcproto.NextMode(pr.Session.Mode, p)
+	}
+	return p
+}
@@ -0,0 +1,250 @@
+package mcproto
+
+import (
+	"crypto/md5"
+	"encoding/json"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gstoney/mcproto/packet"
+)
+
+var (
+	// ErrStatusServed is returned by Establisher.Establish after answering
+	// a status request, as no session follows.
+	ErrStatusServed     = errors.New("status request served")
+	ErrUnexpectedPacket = errors.New("unexpected packet")
+	ErrUnsupportedMode  = errors.New("unsupported handshake intent")
+	ErrTransferRefused  = errors.New("transfers are not accepted")
+)
+
+// DefaultForwardingTimeout is the default of Establisher.ForwardingTimeout.
+const DefaultForwardingTimeout = 10 * time.Second
+
+// OfflineUUID returns the UUID offline mode servers assign to name,
+// a version 3 UUID of "OfflinePlayer:<name>".
+func OfflineUUID(name string) uuid.UUID {
+	sum := md5.Sum([]byte("OfflinePlayer:" + name))
+	sum[6] = sum[6]&0x0f | 0x30
+	sum[8] = sum[8]&0x3f | 0x80
+	return uuid.UUID(sum)
+}
+
+// Establisher performs the handshake and an offline mode login on new
+// connections.
+// Its Establish method is a SessionEstablisher, and EstablishTracked a
+// TrackedEstablisher.
+type Establisher struct {
+	Config TransportConfig
+
+	// CompressionThreshold is sent to clients before Login Success.
+	// Zero disables compression.
+	CompressionThreshold int
+
+	// Status returns the status response JSON for a status request.
+	// Status requests are refused if nil.
+	Status func(s *Session) string
+
+	// Forwarding requires logins to be forwarded by a proxy, which sets
+	// the session's RemoteAddr, PlayerUUID and Properties.
+	Forwarding ForwardingMode
+	// ForwardingSecret verifies ModernForwarding, which fails every
+	// connection with ErrNoForwardingSecret if it is empty.
+	ForwardingSecret []byte
+	// ForwardingTimeout bounds the wait for the proxy to answer the
+	// ModernForwarding query, DefaultForwardingTimeout if zero.
+	ForwardingTimeout time.Duration
+
+	// AcceptTransfers allows logins of clients transferred from another
+	// server, whose handshake carries the Transfer intent.
+	AcceptTransfers bool
+
+	// Query, if set, is called once the player is identified, before
+	// Login Success. It may send plugin and cookie requests with q, and
+	// fails the login by returning an error.
+	Query func(s *Session, q *LoginQuery) error
+}
+
+// Establish reads the handshake of c, answering a status request or
+// logging the client in. On success, the returned Session is in Config mode.
+func (e *Establisher) Establish(c net.Conn) (s Session, t Transport, err error) {
+	return e.EstablishTracked(c, nil)
+}
+
+// EstablishTracked is Establish with the Transport counting its traffic
+// into stats, if not nil. It is a TrackedEstablisher.
+func (e *Establisher) EstablishTracked(c net.Conn, stats *TransportStats) (s Session, t Transport, err error) {
+	if e.Forwarding == ModernForwarding && len(e.ForwardingSecret) == 0 {
+		return s, t, ErrNoForwardingSecret
+	}
+	s = Session{
+		Conn:       c,
+		LocalAddr:  c.LocalAddr(),
+		RemoteAddr: c.RemoteAddr(),
+	}
+	cfg := e.Config
+	cfg.Stats = stats
+	t = NewTransport(c, c, cfg)
+	conn := NewConn(&s, &t, Clientbound)
+
+	p, err := conn.ReadPacket()
+	if err != nil {
+		return
+	}
+	hs, ok := p.(*packet.HandshakePacket)
+	if !ok {
+		return s, t, ErrUnexpectedPacket
+	}
+	s.ProtocolVersion = int(hs.ProtocolVersion)
+	s.ServerAddr = hs.ServerAddr
+	s.ServerPort = hs.ServerPort
+	s.Intent = int(hs.RequestType)
+	s.Mode = NextMode(s.Mode, hs)
+
+	var legacyErr error
+	if e.Forwarding == LegacyForwarding {
+		var f ForwardedPlayer
+		if s.ServerAddr, f, legacyErr = DecodeLegacyForwarding(hs.ServerAddr); legacyErr == nil {
+			s.RemoteAddr = forwardedAddr(f.Address)
+			s.PlayerUUID = f.PlayerUUID
+			s.Properties = f.Properties
+		}
+	}
+
+	switch s.Mode {
+	case Status:
+		if e.Status == nil {
+			return s, t, ErrUnsupportedMode
+		}
+		err = e.serveStatus(conn)
+		if err == nil {
+			err = ErrStatusServed
+		}
+	case Login, Transfer:
+		if s.Mode == Transfer && !e.AcceptTransfers {
+			disconnect(conn, "Transfers are disabled on this server.")
+			return s, t, ErrTransferRefused
+		}
+		if legacyErr != nil {
+			disconnect(conn, "This server requires you to connect through a proxy.")
+			return s, t, legacyErr
+		}
+		err = e.login(conn)
+	default:
+		err = ErrUnsupportedMode
+	}
+	return
+}
+
+func (e *Establisher) serveStatus(conn *Conn) error {
+	for {
+		p, err := conn.ReadPacket()
+		if err != nil {
+			return err
+		}
+
+		switch p := p.(type) {
+		case *packet.StatusReqPacket:
+			err = conn.WritePacket(&packet.StatusRespPacket{Response: e.Status(conn.Session)})
+		case *packet.PingReqPacket:
+			return conn.WritePacket(&packet.PingRespPacket{Timestamp: p.Timestamp})
+		default:
+			return ErrUnexpectedPacket
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (e *Establisher) login(conn *Conn) error {
+	s := conn.Session
+
+	p, err := conn.ReadPacket()
+	if err != nil {
+		return err
+	}
+	start, ok := p.(*packet.LoginStart)
+	if !ok {
+		return ErrUnexpectedPacket
+	}
+	s.Name = start.Name
+	q := NewLoginQuery(conn)
+
+	switch e.Forwarding {
+	case NoForwarding:
+		s.PlayerUUID = OfflineUUID(start.Name)
+	case ModernForwarding:
+		if err = e.modernForwarding(q, s); err != nil {
+			disconnect(conn, "This server requires you to connect through a proxy.")
+			return err
+		}
+	}
+
+	if e.Query != nil {
+		if err = e.Query(s, q); err != nil {
+			return err
+		}
+	}
+
+	if e.CompressionThreshold > 0 {
+		err = conn.WritePacket(&packet.SetCompression{Threshold: int32(e.CompressionThreshold)})
+		if err != nil {
+			return err
+		}
+		conn.Transport.CompressionThreshold = e.CompressionThreshold
+	}
+
+	err = conn.WritePacket(&packet.LoginSuccess{
+		UUID:       s.PlayerUUID,
+		Username:   s.Name,
+		Properties: s.Properties,
+	})
+	if err != nil {
+		return err
+	}
+
+	if p, err = conn.ReadPacket(); err != nil {
+		return err
+	}
+	if _, ok := p.(*packet.LoginAcknowledge); !ok {
+		return ErrUnexpectedPacket
+	}
+	s.Mode = NextMode(s.Mode, p)
+	return nil
+}
+
+// modernForwarding queries the proxy for the forwarded player and applies
+// it to the session.
+func (e *Establisher) modernForwarding(q *LoginQuery, s *Session) error {
+	timeout := e.ForwardingTimeout
+	if timeout <= 0 {
+		timeout = DefaultForwardingTimeout
+	}
+	data, ok, err := q.QueryPlugin(ModernForwardingChannel, []byte{ModernForwardingVersion}, timeout)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNotForwarded
+	}
+
+	f, err := DecodeModernForwarding(e.ForwardingSecret, data)
+	if err != nil {
+		return err
+	}
+
+	s.RemoteAddr = forwardedAddr(f.Address)
+	s.PlayerUUID = f.PlayerUUID
+	s.Name = f.Name
+	s.Properties = f.Properties
+	return nil
+}
+
+// disconnect sends a Login Disconnect with a plain text reason.
+func disconnect(conn *Conn, reason string) error {
+	b, _ := json.Marshal(map[string]string{"text": reason})
+	return conn.WritePacket(&packet.LoginDisconnect{Reason: string(b)})
+}
@@ -0,0 +1,144 @@
+package mcproto
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gstoney/mcproto/packet"
+)
+
+func TestOfflineUUID(t *testing.T) {
+	want := uuid.MustParse("b50ad385-829d-3141-a216-7e7d7539ba7f")
+	if got := OfflineUUID("Notch"); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+// TestEstablisher_Login verifies that an offline mode login with
+// compression leaves the session in Config mode.
+func TestEstablisher_Login(t *testing.T) {
+	sc, cc := net.Pipe()
+	defer cc.Close()
+
+	e := Establisher{Config: defaultConfig(), CompressionThreshold: 256}
+	type result struct {
+		s   Session
+		t   Transport
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		s, t, err := e.Establish(sc)
+		done <- result{s, t, err}
+	}()
+
+	var cs Session
+	ct := NewTransport(cc, cc, defaultConfig())
+	client := NewConn(&cs, &ct, Serverbound)
+
+	hs := &packet.HandshakePacket{ProtocolVersion: 767, ServerAddr: "localhost", ServerPort: 25565, RequestType: 2}
+	client.WritePacket(hs)
+	cs.Mode = NextMode(cs.Mode, hs)
+	client.WritePacket(&packet.LoginStart{Name: "Notch"})
+
+	p, err := client.ReadPacket()
+	if sc, ok := p.(*packet.SetCompression); !ok || sc.Threshold != 256 {
+		t.Fatalf("got %v %v, want SetCompression", p, err)
+	}
+	ct.CompressionThreshold = 256
+
+	p, err = client.ReadPacket()
+	success, ok := p.(*packet.LoginSuccess)
+	if !ok {
+		t.Fatalf("got %v %v, want LoginSuccess", p, err)
+	}
+	if success.UUID != OfflineUUID("Notch") {
+		t.Errorf("got UUID %s, want offline UUID", success.UUID)
+	}
+	client.WritePacket(&packet.LoginAcknowledge{})
+
+	r := <-done
+	if r.err != nil {
+		t.Fatalf("Establish: %v", r.err)
+	}
+	if r.s.Mode != Config || r.s.Name != "Notch" || r.s.ProtocolVersion != 767 {
+		t.Errorf("got session %+v", r.s)
+	}
+	if r.t.CompressionThreshold != 256 {
+		t.Errorf("got threshold %d, want 256", r.t.CompressionThreshold)
+	}
+}
+
+// TestEstablisher_Transfer verifies that transferred clients are refused
+// unless AcceptTransfers is set, and may be asked for their cookies.
+func TestEstablisher_Transfer(t *testing.T) {
+	for _, accept := range []bool{false, true} {
+		sc, cc := net.Pipe()
+
+		e := Establisher{
+			Config:          defaultConfig(),
+			AcceptTransfers: accept,
+			Query: func(s *Session, q *LoginQuery) error {
+				payload, ok, err := q.RequestCookie("test:origin", time.Second)
+				if err == nil && (!ok || string(payload) != "lobby") {
+					t.Errorf("got cookie %q %v", payload, ok)
+				}
+				return err
+			},
+		}
+		done := make(chan error, 1)
+		go func() {
+			_, _, err := e.Establish(sc)
+			done <- err
+		}()
+
+		var cs Session
+		ct := NewTransport(cc, cc, defaultConfig())
+		client := NewConn(&cs, &ct, Serverbound)
+
+		hs := &packet.HandshakePacket{ProtocolVersion: 767, ServerAddr: "localhost", ServerPort: 25565, RequestType: 3}
+		client.WritePacket(hs)
+		cs.Mode = NextMode(cs.Mode, hs)
+		// A refused client is disconnected before LoginStart is read.
+		go client.WritePacket(&packet.LoginStart{Name: "Notch"})
+
+		for {
+			p, err := client.ReadPacket()
+			if err != nil {
+				t.Fatalf("accept=%v: ReadPacket: %v", accept, err)
+			}
+			if req, ok := p.(*packet.LoginCookieRequest); ok {
+				client.WritePacket(&packet.LoginCookieResponse{
+					Key:     req.Key,
+					Payload: packet.Optional[[]byte]{Exists: true, Item: []byte("lobby")},
+				})
+				continue
+			}
+			if _, ok := p.(*packet.LoginDisconnect); ok {
+				if accept {
+					t.Errorf("transfer refused")
+				}
+				break
+			}
+			if _, ok := p.(*packet.LoginSuccess); ok {
+				if !accept {
+					t.Errorf("transfer accepted")
+				}
+				client.WritePacket(&packet.LoginAcknowledge{})
+				break
+			}
+		}
+
+		err := <-done
+		if accept && err != nil {
+			t.Errorf("Establish: %v", err)
+		}
+		if !accept && err != ErrTransferRefused {
+			t.Errorf("Establish: got %v, want ErrTransferRefused", err)
+		}
+		sc.Close()
+		cc.Close()
+	}
+}
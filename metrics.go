@@ -0,0 +1,121 @@
+package mcproto
+
+import (
+	"bufio"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// Metrics aggregates TransportStats of a Server's connections and exposes
+// them in the Prometheus text exposition format.
+//
+// Metrics implements http.Handler, so it can be mounted on a local mux:
+//
+//	m := &mcproto.Metrics{}
+//	srv := mcproto.Server{Metrics: m, TrackedEstablisher: e.EstablishTracked, ...}
+//	http.Handle("/metrics", m)
+type Metrics struct {
+	mu     sync.Mutex
+	live   map[*TransportStats]struct{}
+	closed StatsSnapshot
+	total  uint64
+}
+
+// Track adds stats of an active connection to the aggregate.
+func (m *Metrics) Track(stats *TransportStats) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.live == nil {
+		m.live = make(map[*TransportStats]struct{})
+	}
+	m.live[stats] = struct{}{}
+	m.total++
+}
+
+// Release folds stats of a finished connection into the aggregate totals.
+func (m *Metrics) Release(stats *TransportStats) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.live[stats]; !ok {
+		return
+	}
+	delete(m.live, stats)
+	m.closed.add(stats.Snapshot())
+}
+
+// Snapshot returns totals over both active and finished connections,
+// and the number of active connections.
+func (m *Metrics) Snapshot() (v StatsSnapshot, active int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	v = m.closed
+	for stats := range m.live {
+		v.add(stats.Snapshot())
+	}
+	return v, len(m.live)
+}
+
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	v, active := m.Snapshot()
+
+	m.mu.Lock()
+	total := m.total
+	m.mu.Unlock()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	bw := bufio.NewWriter(w)
+
+	writeMetric(bw, "mcproto_connections", "gauge", "Active connections.",
+		sample{"", float64(active)})
+	writeMetric(bw, "mcproto_connections_total", "counter", "Accepted connections.",
+		sample{"", float64(total)})
+	writeMetric(bw, "mcproto_frames_total", "counter", "Frames transferred.",
+		sample{`direction="in"`, float64(v.FramesIn)},
+		sample{`direction="out"`, float64(v.FramesOut)})
+	writeMetric(bw, "mcproto_bytes_total", "counter", "Wire bytes transferred.",
+		sample{`direction="in"`, float64(v.BytesIn)},
+		sample{`direction="out"`, float64(v.BytesOut)})
+	writeMetric(bw, "mcproto_compressed_frames_total", "counter", "Frames transferred with a compressed payload.",
+		sample{`direction="in"`, float64(v.CompressedIn)},
+		sample{`direction="out"`, float64(v.CompressedOut)})
+	writeMetric(bw, "mcproto_uncompressed_frames_total", "counter", "Frames transferred with an uncompressed payload.",
+		sample{`direction="in"`, float64(v.UncompressedIn)},
+		sample{`direction="out"`, float64(v.UncompressedOut)})
+	writeMetric(bw, "mcproto_compressed_raw_bytes_total", "counter", "Uncompressed size of compressed payloads.",
+		sample{`direction="in"`, float64(v.CompressedRawBytesIn)},
+		sample{`direction="out"`, float64(v.CompressedRawBytesOut)})
+	writeMetric(bw, "mcproto_compressed_wire_bytes_total", "counter", "Wire size of compressed frames.",
+		sample{`direction="in"`, float64(v.CompressedWireBytesIn)},
+		sample{`direction="out"`, float64(v.CompressedWireBytesOut)})
+	writeMetric(bw, "mcproto_compression_ratio", "gauge", "Wire bytes per uncompressed byte of compressed frames.",
+		sample{`direction="in"`, v.CompressionRatioIn()},
+		sample{`direction="out"`, v.CompressionRatioOut()})
+	writeMetric(bw, "mcproto_decode_errors_total", "counter", "Inbound frames rejected while decoding.",
+		sample{`kind="packet_too_big"`, float64(v.ErrPacketTooBig)},
+		sample{`kind="zlib_payload_overrun"`, float64(v.ErrZlibPayloadOverrun)},
+		sample{`kind="zlib_payload_underrun"`, float64(v.ErrZlibPayloadUnderrun)},
+		sample{`kind="zlib_trailing_data"`, float64(v.ErrZlibTrailingData)},
+		sample{`kind="other"`, float64(v.ErrOther)})
+
+	bw.Flush()
+}
+
+type sample struct {
+	labels string
+	value  float64
+}
+
+func writeMetric(w *bufio.Writer, name, typ, help string, samples ...sample) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+	for _, s := range samples {
+		if s.labels == "" {
+			fmt.Fprintf(w, "%s %g\n", name, s.value)
+		} else {
+			fmt.Fprintf(w, "%s{%s} %g\n", name, s.labels, s.value)
+		}
+	}
+}
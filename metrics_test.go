@@ -0,0 +1,106 @@
+package mcproto
+
+import (
+	"io"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestMetrics_ServeHTTP verifies that the aggregate of active and released
+// connections is exposed in Prometheus text format.
+func TestMetrics_ServeHTTP(t *testing.T) {
+	var m Metrics
+
+	a, b := &TransportStats{}, &TransportStats{}
+	a.FramesIn.Add(3)
+	b.FramesIn.Add(4)
+	b.ErrPacketTooBig.Add(1)
+
+	m.Track(a)
+	m.Track(b)
+	m.Release(b)
+
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body := rec.Body.String()
+
+	for _, want := range []string{
+		"# TYPE mcproto_frames_total counter\n",
+		"mcproto_connections 1\n",
+		"mcproto_connections_total 2\n",
+		`mcproto_frames_total{direction="in"} 7` + "\n",
+		`mcproto_decode_errors_total{kind="packet_too_big"} 1` + "\n",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("missing %q in:\n%s", want, body)
+		}
+	}
+}
+
+// chanListener hands out the connections sent on it.
+type chanListener chan net.Conn
+
+func (l chanListener) Accept() (net.Conn, error) { return <-l, nil }
+func (l chanListener) Close() error              { return nil }
+func (l chanListener) Addr() net.Addr            { return &net.TCPAddr{} }
+
+// TestServer_MetricsEstablishFailure verifies that connections are counted
+// from the moment they are accepted, even when no session is established.
+func TestServer_MetricsEstablishFailure(t *testing.T) {
+	m := &Metrics{}
+	done := make(chan error)
+	srv := Server{
+		Metrics: m,
+		TrackedEstablisher: func(c net.Conn, stats *TransportStats) (s Session, tr Transport, err error) {
+			cfg := defaultConfig()
+			cfg.Stats = stats
+			tr = NewTransport(c, c, cfg)
+			var r PayloadReader
+			if r, err = tr.Recv(); err == nil {
+				io.ReadAll(r)
+				r.Close()
+				_, err = tr.Recv()
+			}
+			done <- err
+			return
+		},
+	}
+	l := make(chanListener)
+	go srv.Serve(l)
+
+	sc, cc := net.Pipe()
+	l <- sc
+	go func() {
+		cc.Write([]byte{0x01, 0x00})
+		cc.Write([]byte{0x80, 0x80, 0x80, 0x01}) // 2 MiB, over the limit
+		cc.Close()
+	}()
+	if err := <-done; err == nil {
+		t.Fatal("expected establish error")
+
+	}
+
+	for range 100 {
+		if _, active := m.Snapshot(); active == 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body := rec.Body.String()
+
+	for _, want := range []string{
+		"mcproto_connections 0\n",
+		"mcproto_connections_total 1\n",
+		`mcproto_frames_total{direction="in"} 2` + "\n",
+		`mcproto_decode_errors_total{kind="packet_too_big"} 1` + "\n",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("missing %q in:\n%s", want, body)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package nbt
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+// Read reads a named root tag, the format of files.
+func Read(r io.Reader) (name string, v any, err error) {
+	d := decoder{r: r}
+	typ, err := d.byte()
+	if err != nil {
+		return
+	}
+	if typ == TagEnd {
+		return "", nil, ErrInvalidTag
+	}
+	if name, err = d.string(); err != nil {
+		return
+	}
+	v, err = d.payload(typ, 0)
+	return
+}
+
+// ReadNetwork reads a root tag without a name, the format of packets
+// since 1.20.2. A root End tag, sent for absent values, reads as nil.
+func ReadNetwork(r io.Reader) (v any, err error) {
+	d := decoder{r: r}
+	typ, err := d.byte()
+	if err != nil || typ == TagEnd {
+		return
+	}
+	return d.payload(typ, 0)
+}
+
+// chunkLen bounds allocations made ahead of reading array contents, so
+// a forged length fails on EOF rather than exhausting memory.
+const chunkLen = 1 << 16
+
+type decoder struct {
+	r   io.Reader
+	buf [8]byte
+}
+
+func (d *decoder) payload(typ byte, depth int) (v any, err error) {
+	switch typ {
+	case TagByte:
+		b, err := d.byte()
+		return int8(b), err
+	case TagShort:
+		if err = d.full(2); err != nil {
+			return
+		}
+		return int16(binary.BigEndian.Uint16(d.buf[:])), nil
+	case TagInt:
+		return d.int()
+	case TagLong:
+		return d.long()
+	case TagFloat:
+		x, err := d.int()
+		return math.Float32frombits(uint32(x)), err
+	case TagDouble:
+		x, err := d.long()
+		return math.Float64frombits(uint64(x)), err
+	case TagByteArray:
+		n, err := d.len()
+		if err != nil {
+			return nil, err
+		}
+		b := make([]byte, 0, min(n, chunkLen))
+		for len(b) < n {
+			m := min(n-len(b), chunkLen)
+			b = append(b, make([]byte, m)...)
+			if _, err = io.ReadFull(d.r, b[len(b)-m:]); err != nil {
+				return nil, eof(err)
+			}
+		}
+		return b, nil
+	case TagString:
+		return d.string()
+	case TagList:
+		return d.list(depth + 1)
+	case TagCompound:
+		return d.compound(depth + 1)
+	case TagIntArray:
+		n, err := d.len()
+		if err != nil {
+			return nil, err
+		}
+		a := make([]int32, 0, min(n, chunkLen))
+		for range n {
+			x, err := d.int()
+			if err != nil {
+				return nil, err
+			}
+			a = append(a, x)
+		}
+		return a, nil
+	case TagLongArray:
+		n, err := d.len()
+		if err != nil {
+			return nil, err
+		}
+		a := make([]int64, 0, min(n, chunkLen))
+		for range n {
+			x, err := d.long()
+			if err != nil {
+				return nil, err
+			}
+			a = append(a, x)
+		}
+		return a, nil
+	}
+	return nil, ErrInvalidTag
+}
+
+func (d *decoder) list(depth int) (v List, err error) {
+	if depth > MaxDepth {
+		return nil, ErrMaxDepth
+	}
+
+	typ, err := d.byte()
+	if err != nil {
+		return nil, eof(err)
+	}
+	n, err := d.len()
+	if err != nil {
+		return
+	}
+	if typ == TagEnd && n > 0 {
+		return nil, ErrInvalidTag
+	}
+
+	v = make(List, 0, min(n, chunkLen))
+	for range n {
+		x, err := d.payload(typ, depth)
+		if err != nil {
+			return nil, err
+		}
+		v = append(v, x)
+	}
+	return
+}
+
+func (d *decoder) compound(depth int) (v Compound, err error) {
+	if depth > MaxDepth {
+		return nil, ErrMaxDepth
+	}
+
+	v = make(Compound)
+	for {
+		typ, err := d.byte()
+		if err != nil {
+			return nil, eof(err)
+		}
+		if typ == TagEnd {
+			return v, nil
+		}
+
+		name, err := d.string()
+		if err != nil {
+			return nil, err
+		}
+		if v[name], err = d.payload(typ, depth); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (d *decoder) full(n int) error {
+	_, err := io.ReadFull(d.r, d.buf[:n])
+	return eof(err)
+}
+
+// byte reads a tag type. A clean io.EOF is returned as is, so that
+// reading a root tag at the end of input reports io.EOF.
+func (d *decoder) byte() (byte, error) {
+	_, err := io.ReadFull(d.r, d.buf[:1])
+	return d.buf[0], err
+}
+
+func (d *decoder) int() (int32, error) {
+	if err := d.full(4); err != nil {
+		return 0, err
+	}
+	return int32(binary.BigEndian.Uint32(d.buf[:])), nil
+}
+
+func (d *decoder) long() (int64, error) {
+	if err := d.full(8); err != nil {
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint64(d.buf[:])), nil
+}
+
+func (d *decoder) len() (int, error) {
+	n, err := d.int()
+	if err == nil && n < 0 {
+		err = ErrNegativeLength
+	}
+	return int(n), err
+}
+
+func (d *decoder) string() (string, error) {
+	if err := d.full(2); err != nil {
+		return "", err
+	}
+	b := make([]byte, binary.BigEndian.Uint16(d.buf[:]))
+	if _, err := io.ReadFull(d.r, b); err != nil {
+		return "", eof(err)
+	}
+	return decodeMUTF8(b)
+}
+
+// eof reports an input ending inside a tag as io.ErrUnexpectedEOF.
+func eof(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,190 @@
+package nbt
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"sort"
+)
+
+// Write writes v as a root tag named name, the format of files.
+func Write(w io.Writer, name string, v any) error {
+	e := encoder{w: w}
+	return e.root(&name, v)
+}
+
+// WriteNetwork writes v as a root tag without a name, the format of
+// packets since 1.20.2.
+func WriteNetwork(w io.Writer, v any) error {
+	e := encoder{w: w}
+	return e.root(nil, v)
+}
+
+type encoder struct {
+	w   io.Writer
+	buf [8]byte
+}
+
+func (e *encoder) root(name *string, v any) (err error) {
+	typ, err := TypeOf(v)
+	if err != nil {
+		return
+	}
+	if err = e.byte(typ); err != nil {
+		return
+	}
+	if name != nil {
+		if err = e.string(*name); err != nil {
+			return
+		}
+	}
+	return e.payload(v, 0)
+}
+
+func (e *encoder) payload(v any, depth int) (err error) {
+	switch v := v.(type) {
+	case int8:
+		return e.byte(byte(v))
+	case bool:
+		if v {
+			return e.byte(1)
+		}
+		return e.byte(0)
+	case int16:
+		binary.BigEndian.PutUint16(e.buf[:], uint16(v))
+		_, err = e.w.Write(e.buf[:2])
+	case int32:
+		return e.int(v)
+	case int64:
+		return e.long(v)
+	case float32:
+		return e.int(int32(math.Float32bits(v)))
+	case float64:
+		return e.long(int64(math.Float64bits(v)))
+	case []byte:
+		if err = e.int(int32(len(v))); err != nil {
+			return
+		}
+		_, err = e.w.Write(v)
+	case string:
+		return e.string(v)
+	case List:
+		return e.list(v, depth+1)
+	case Compound:
+		return e.compound(v, depth+1)
+	case []int32:
+		if err = e.int(int32(len(v))); err != nil {
+			return
+		}
+		for _, x := range v {
+			if err = e.int(x); err != nil {
+				return
+			}
+		}
+	case []int64:
+		if err = e.int(int32(len(v))); err != nil {
+			return
+		}
+		for _, x := range v {
+			if err = e.long(x); err != nil {
+				return
+			}
+		}
+	default:
+		_, err = TypeOf(v)
+	}
+	return
+}
+
+func (e *encoder) list(v List, depth int) (err error) {
+	if depth > MaxDepth {
+		return ErrMaxDepth
+	}
+
+	typ := TagEnd
+	if len(v) > 0 {
+		if typ, err = TypeOf(v[0]); err != nil {
+			return
+		}
+	}
+	if err = e.byte(typ); err != nil {
+		return
+	}
+	if err = e.int(int32(len(v))); err != nil {
+		return
+	}
+
+	for _, x := range v {
+		if t, err := TypeOf(x); err != nil {
+			return err
+		} else if t != typ {
+			return ErrMixedList
+		}
+		if err = e.payload(x, depth); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// compound writes entries sorted by name, so output is deterministic.
+func (e *encoder) compound(v Compound, depth int) (err error) {
+	if depth > MaxDepth {
+		return ErrMaxDepth
+	}
+
+	names := make([]string, 0, len(v))
+	for name := range v {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		x := v[name]
+		typ, err := TypeOf(x)
+		if err != nil {
+			return err
+		}
+		if err = e.byte(typ); err != nil {
+			return err
+		}
+		if err = e.string(name); err != nil {
+			return err
+		}
+		if err = e.payload(x, depth); err != nil {
+			return err
+		}
+	}
+	return e.byte(TagEnd)
+}
+
+func (e *encoder) byte(b byte) (err error) {
+	e.buf[0] = b
+	_, err = e.w.Write(e.buf[:1])
+	return
+}
+
+func (e *encoder) int(v int32) (err error) {
+	binary.BigEndian.PutUint32(e.buf[:], uint32(v))
+	_, err = e.w.Write(e.buf[:4])
+	return
+}
+
+func (e *encoder) long(v int64) (err error) {
+	binary.BigEndian.PutUint64(e.buf[:], uint64(v))
+	_, err = e.w.Write(e.buf[:8])
+	return
+}
+
+func (e *encoder) string(s string) (err error) {
+	b := encodeMUTF8(s)
+	if len(b) > math.MaxUint16 {
+		return ErrStringTooLong
+	}
+	binary.BigEndian.PutUint16(e.buf[:], uint16(len(b)))
+	if _, err = e.w.Write(e.buf[:2]); err != nil {
+		return
+	}
+	_, err = e.w.Write(b)
+	return
+}
@@ -0,0 +1,86 @@
+package nbt
+
+import (
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Strings are serialized in Java's modified UTF-8: NUL is encoded in two
+// bytes, and characters outside the Basic Multilingual Plane as a
+// surrogate pair of three byte sequences.
+
+func encodeMUTF8(s string) []byte {
+	if isPlain(s) {
+		return []byte(s)
+	}
+
+	b := make([]byte, 0, len(s)+len(s)/2)
+	for _, r := range s {
+		switch {
+		case r == 0:
+			b = append(b, 0xC0, 0x80)
+		case r < 0x80:
+			b = append(b, byte(r))
+		case r < 0x800:
+			b = append(b, 0xC0|byte(r>>6), 0x80|byte(r&0x3F))
+		case r < 0x10000:
+			b = appendMUTF8Char(b, uint16(r))
+		default:
+			r1, r2 := utf16.EncodeRune(r)
+			b = appendMUTF8Char(b, uint16(r1))
+			b = appendMUTF8Char(b, uint16(r2))
+		}
+	}
+	return b
+}
+
+func appendMUTF8Char(b []byte, c uint16) []byte {
+	return append(b, 0xE0|byte(c>>12), 0x80|byte(c>>6&0x3F), 0x80|byte(c&0x3F))
+}
+
+func decodeMUTF8(b []byte) (string, error) {
+	if isPlain(string(b)) {
+		return string(b), nil
+	}
+
+	chars := make([]uint16, 0, len(b))
+	for i := 0; i < len(b); {
+		c := b[i]
+		switch {
+		case c < 0x80:
+			chars = append(chars, uint16(c))
+			i++
+		case c&0xE0 == 0xC0:
+			if i+1 >= len(b) || b[i+1]&0xC0 != 0x80 {
+				return "", ErrInvalidString
+			}
+			chars = append(chars, uint16(c&0x1F)<<6|uint16(b[i+1]&0x3F))
+			i += 2
+		case c&0xF0 == 0xE0:
+			if i+2 >= len(b) || b[i+1]&0xC0 != 0x80 || b[i+2]&0xC0 != 0x80 {
+				return "", ErrInvalidString
+			}
+			chars = append(chars, uint16(c&0x0F)<<12|uint16(b[i+1]&0x3F)<<6|uint16(b[i+2]&0x3F))
+			i += 3
+		default:
+			return "", ErrInvalidString
+		}
+	}
+
+	s := make([]byte, 0, len(b))
+	for _, r := range utf16.Decode(chars) {
+		s = utf8.AppendRune(s, r)
+	}
+	return string(s), nil
+}
+
+// isPlain reports whether s is ASCII without NUL, encoded the same in
+// UTF-8 and modified UTF-8.
+func isPlain(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] == 0 || s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,94 @@
+// Package nbt implements the Named Binary Tag format used by Minecraft
+// for network data and world files.
+//
+// Tags map to Go values as follows:
+//
+//	Byte      int8 (bool is written as Byte)
+//	Short     int16
+//	Int       int32
+//	Long      int64
+//	Float     float32
+//	Double    float64
+//	ByteArray []byte
+//	String    string
+//	List      List
+//	Compound  Compound
+//	IntArray  []int32
+//	LongArray []int64
+//
+// Files hold a named root tag, read and written by Read and Write. Since
+// 1.20.2, the network format omits the root name, see ReadNetwork and
+// WriteNetwork.
+package nbt
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Tag types.
+const (
+	TagEnd byte = iota
+	TagByte
+	TagShort
+	TagInt
+	TagLong
+	TagFloat
+	TagDouble
+	TagByteArray
+	TagString
+	TagList
+	TagCompound
+	TagIntArray
+	TagLongArray
+)
+
+// MaxDepth bounds the nesting of Lists and Compounds.
+const MaxDepth = 512
+
+var (
+	ErrInvalidTag      = errors.New("nbt: invalid tag type")
+	ErrMaxDepth        = errors.New("nbt: maximum depth exceeded")
+	ErrMixedList       = errors.New("nbt: list elements of different types")
+	ErrNegativeLength  = errors.New("nbt: negative length")
+	ErrInvalidString   = errors.New("nbt: invalid modified UTF-8 string")
+	ErrStringTooLong   = errors.New("nbt: string longer than 65535 bytes")
+	ErrUnsupportedType = errors.New("nbt: unsupported Go type")
+)
+
+// Compound is a collection of named tags.
+type Compound map[string]any
+
+// List is a sequence of unnamed tags of a single type.
+type List []any
+
+// TypeOf returns the tag type v is written as.
+func TypeOf(v any) (byte, error) {
+	switch v.(type) {
+	case int8, bool:
+		return TagByte, nil
+	case int16:
+		return TagShort, nil
+	case int32:
+		return TagInt, nil
+	case int64:
+		return TagLong, nil
+	case float32:
+		return TagFloat, nil
+	case float64:
+		return TagDouble, nil
+	case []byte:
+		return TagByteArray, nil
+	case string:
+		return TagString, nil
+	case List:
+		return TagList, nil
+	case Compound:
+		return TagCompound, nil
+	case []int32:
+		return TagIntArray, nil
+	case []int64:
+		return TagLongArray, nil
+	}
+	return 0, fmt.Errorf("%w %T", ErrUnsupportedType, v)
+}
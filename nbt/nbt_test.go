@@ -0,0 +1,169 @@
+package nbt
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// helloWorld is hello_world.nbt from the NBT specification.
+var helloWorld = []byte{
+	0x0a, 0x00, 0x0b, 'h', 'e', 'l', 'l', 'o', ' ', 'w', 'o', 'r', 'l', 'd',
+	0x08, 0x00, 0x04, 'n', 'a', 'm', 'e',
+	0x00, 0x09, 'B', 'a', 'n', 'a', 'n', 'r', 'a', 'm', 'a',
+	0x00,
+}
+
+func TestRead(t *testing.T) {
+	name, v, err := Read(bytes.NewReader(helloWorld))
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if name != "hello world" {
+		t.Errorf("got name %q", name)
+	}
+	if want := (Compound{"name": "Bananrama"}); !reflect.DeepEqual(v, want) {
+		t.Errorf("got %v, want %v", v, want)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Write(&buf, "hello world", Compound{"name": "Bananrama"}); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), helloWorld) {
+		t.Errorf("got %x, want %x", buf.Bytes(), helloWorld)
+	}
+}
+
+// TestNetwork_RoundTrip verifies that every tag type survives encoding.
+func TestNetwork_RoundTrip(t *testing.T) {
+	v := Compound{
+		"byte":      int8(-1),
+		"short":     int16(300),
+		"int":       int32(-70000),
+		"long":      int64(1) << 40,
+		"float":     float32(0.5),
+		"double":    float64(-2.25),
+		"bytes":     []byte{1, 2, 3},
+		"string":    "minecraft:stone",
+		"ints":      []int32{1, -1},
+		"longs":     []int64{1 << 62},
+		"empty":     List{},
+		"list":      List{Compound{"a": int32(1)}, Compound{}},
+		"nested":    List{List{"x"}, List{}},
+		"compound":  Compound{"inner": Compound{}},
+		"unicode":   "a\x00é€😀",
+		"bigString": strings.Repeat("x", 1000),
+	}
+
+	var buf bytes.Buffer
+	if err := WriteNetwork(&buf, v); err != nil {
+		t.Fatalf("WriteNetwork: %v", err)
+	}
+	got, err := ReadNetwork(&buf)
+	if err != nil {
+		t.Fatalf("ReadNetwork: %v", err)
+	}
+	if !reflect.DeepEqual(got, v) {
+		t.Errorf("got %v, want %v", got, v)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("%d bytes left unread", buf.Len())
+	}
+}
+
+func TestReadNetwork_End(t *testing.T) {
+	v, err := ReadNetwork(bytes.NewReader([]byte{TagEnd}))
+	if v != nil || err != nil {
+		t.Errorf("got %v %v, want nil", v, err)
+	}
+}
+
+var mutf8Tc = []struct {
+	desc string
+	v    string
+	ser  []byte
+}{
+	{desc: "ASCII", v: "abc", ser: []byte("abc")},
+	{desc: "NUL", v: "\x00", ser: []byte{0xc0, 0x80}},
+	{desc: "Two bytes", v: "é", ser: []byte{0xc3, 0xa9}},
+	{desc: "Three bytes", v: "€", ser: []byte{0xe2, 0x82, 0xac}},
+	{desc: "Surrogate pair", v: "😀", ser: []byte{0xed, 0xa0, 0xbd, 0xed, 0xb8, 0x80}},
+}
+
+func TestMUTF8(t *testing.T) {
+	for _, tC := range mutf8Tc {
+		t.Run(tC.desc, func(t *testing.T) {
+			if got := encodeMUTF8(tC.v); !bytes.Equal(got, tC.ser) {
+				t.Errorf("encode: got %x, want %x", got, tC.ser)
+			}
+			if got, err := decodeMUTF8(tC.ser); err != nil || got != tC.v {
+				t.Errorf("decode: got %q %v, want %q", got, err, tC.v)
+			}
+		})
+	}
+
+	if _, err := decodeMUTF8([]byte{0xe2, 0x82}); err != ErrInvalidString {
+		t.Errorf("truncated: got %v, want ErrInvalidString", err)
+	}
+}
+
+func TestWrite_Errors(t *testing.T) {
+	tcs := []struct {
+		desc      string
+		v         any
+		expectErr error
+	}{
+		{desc: "Mixed list", v: Compound{"l": List{int32(1), "a"}}, expectErr: ErrMixedList},
+		{desc: "Unsupported type", v: Compound{"u": uint32(1)}, expectErr: ErrUnsupportedType},
+		{desc: "Long string", v: strings.Repeat("x", 1<<16), expectErr: ErrStringTooLong},
+		{desc: "Too deep", v: deepList(MaxDepth + 1), expectErr: ErrMaxDepth},
+	}
+	for _, tC := range tcs {
+		t.Run(tC.desc, func(t *testing.T) {
+			if err := WriteNetwork(io.Discard, tC.v); !errors.Is(err, tC.expectErr) {
+				t.Errorf("got %v, want %v", err, tC.expectErr)
+			}
+		})
+	}
+}
+
+func TestRead_Errors(t *testing.T) {
+	var deep bytes.Buffer
+	deep.WriteByte(TagList)
+	for range MaxDepth + 1 {
+		deep.Write([]byte{TagList, 0, 0, 0, 1})
+	}
+
+	tcs := []struct {
+		desc      string
+		ser       []byte
+		expectErr error
+	}{
+		{desc: "Truncated compound", ser: []byte{TagCompound, TagInt, 0, 1, 'a', 0}, expectErr: io.ErrUnexpectedEOF},
+		{desc: "Invalid type", ser: []byte{13}, expectErr: ErrInvalidTag},
+		{desc: "Negative length", ser: []byte{TagByteArray, 0xff, 0xff, 0xff, 0xff}, expectErr: ErrNegativeLength},
+		{desc: "Forged length", ser: []byte{TagLongArray, 0x7f, 0xff, 0xff, 0xff, 0}, expectErr: io.ErrUnexpectedEOF},
+		{desc: "Too deep", ser: deep.Bytes(), expectErr: ErrMaxDepth},
+	}
+	for _, tC := range tcs {
+		t.Run(tC.desc, func(t *testing.T) {
+			if _, err := ReadNetwork(bytes.NewReader(tC.ser)); err != tC.expectErr {
+				t.Errorf("got %v, want %v", err, tC.expectErr)
+			}
+		})
+	}
+}
+
+func deepList(depth int) any {
+	var v any = List{}
+	for range depth - 1 {
+		v = List{v}
+	}
+	return v
+}
@@ -0,0 +1,278 @@
+// Package network implements a front proxy for a network of backend
+// servers, in the style of BungeeCord and Velocity.
+//
+// The proxy terminates client logins through an mcproto.Server, then logs
+// in to a backend on behalf of the player and relays packets between them.
+// Players in Play mode can be moved to another backend without
+// disconnecting, by re-entering the configuration phase.
+//
+// Backends must run in offline mode.
+package network
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/gstoney/mcproto"
+	"github.com/gstoney/mcproto/packet"
+)
+
+var (
+	ErrUnknownServer = errors.New("network: unknown server")
+	ErrOnlineMode    = errors.New("network: backend requested encryption; backends must run in offline mode")
+	ErrNotInPlay     = errors.New("network: player is not in Play mode")
+	ErrSwitching     = errors.New("network: player is already switching servers")
+	ErrDuplicateUUID = errors.New("network: a player with the same UUID is already connected")
+)
+
+// BackendDisconnectError reports a backend refusing a login.
+type BackendDisconnectError struct {
+	Server string
+	Reason string
+}
+
+func (e *BackendDisconnectError) Error() string {
+	return fmt.Sprintf("network: %s disconnected during login: %s", e.Server, e.Reason)
+}
+
+// A Proxy relays players to backend servers. Its Handle method is an
+// mcproto.SessionHandler, to be used with an mcproto.Establisher:
+//
+//	p := &network.Proxy{Servers: servers, Default: "lobby", Config: cfg}
+//	e := &mcproto.Establisher{Config: cfg}
+//	srv := mcproto.Server{SessionEstablisher: e.Establish, SessionHandler: p.Handle}
+type Proxy struct {
+	// Servers maps backend names to their addresses.
+	Servers map[string]string
+	// Default names the backend players are connected to on join.
+	Default string
+
+	Config mcproto.TransportConfig
+	Logger *log.Logger
+
+	// Forwarding passes the player's address and profile to backends,
+	// which must be configured alike.
+	Forwarding       mcproto.ForwardingMode
+	ForwardingSecret []byte
+
+	// Dial connects to backends. net.Dial is used if nil.
+	Dial func(network, addr string) (net.Conn, error)
+
+	mu      sync.Mutex
+	players map[uuid.UUID]*Player
+}
+
+// Player returns the connected player with id, or nil.
+func (p *Proxy) Player(id uuid.UUID) *Player {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.players[id]
+}
+
+// Players returns all connected players.
+func (p *Proxy) Players() []*Player {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	v := make([]*Player, 0, len(p.players))
+	for _, pl := range p.players {
+		v = append(v, pl)
+	}
+	return v
+}
+
+// Handle connects the player of s, which must be in Config mode, to the
+// default backend and relays packets until the client disconnects.
+//
+// A client logging in with the UUID of a connected player is disconnected,
+// failing with ErrDuplicateUUID; the connected player stays.
+func (p *Proxy) Handle(s *mcproto.Session, t *mcproto.Transport) error {
+	pl := &Player{
+		Session: s,
+		proxy:   p,
+		client:  t,
+		mode:    s.Mode,
+	}
+
+	if p.Player(s.PlayerUUID) != nil {
+		return p.rejectDuplicate(pl)
+	}
+	b, err := p.connect(pl, p.Default)
+	if err != nil {
+		return err
+	}
+	pl.backend = b
+
+	p.mu.Lock()
+	if p.players == nil {
+		p.players = make(map[uuid.UUID]*Player)
+	}
+	// Another login of the UUID may have completed while connecting.
+	if _, ok := p.players[s.PlayerUUID]; ok {
+		p.mu.Unlock()
+		b.conn.Close()
+		return p.rejectDuplicate(pl)
+	}
+	p.players[s.PlayerUUID] = pl
+	p.mu.Unlock()
+
+	defer func() {
+		p.mu.Lock()
+		if p.players[s.PlayerUUID] == pl {
+			delete(p.players, s.PlayerUUID)
+		}
+		p.mu.Unlock()
+		pl.close()
+	}()
+
+	go pl.pumpClientbound(b)
+	return pl.pumpServerbound()
+}
+
+// rejectDuplicate disconnects pl, whose UUID is already connected.
+func (p *Proxy) rejectDuplicate(pl *Player) error {
+	p.logf("%s: %s is already connected", pl.Session.Name, pl.Session.PlayerUUID)
+	writePacket(pl.client, &packet.ConfigDisconnect{Reason: "You are already connected to this server"})
+	pl.closeClient()
+	return ErrDuplicateUUID
+}
+
+func (p *Proxy) logf(format string, args ...any) {
+	if p.Logger != nil {
+		p.Logger.Printf(format, args...)
+	}
+}
+
+// backend is a connection to a backend server, logged in for a player.
+type backend struct {
+	name     string
+	conn     net.Conn
+	t        mcproto.Transport
+	entityID int32
+
+	// entityTypes are the types of the entities b spawned, by the IDs of
+	// b, to find entity IDs in their metadata.
+	entityTypes map[int32]int32
+}
+
+// connect dials the named backend and logs pl in, leaving the backend
+// connection in Config mode.
+func (p *Proxy) connect(pl *Player, name string) (*backend, error) {
+	addr, ok := p.Servers[name]
+	if !ok {
+		return nil, ErrUnknownServer
+	}
+	if p.Forwarding == mcproto.ModernForwarding && len(p.ForwardingSecret) == 0 {
+		return nil, mcproto.ErrNoForwardingSecret
+	}
+
+	dial := p.Dial
+	if dial == nil {
+		dial = net.Dial
+	}
+	c, err := dial("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &backend{name: name, conn: c, t: mcproto.NewTransport(c, c, p.Config)}
+	if err = p.login(pl, b, addr); err != nil {
+		c.Close()
+		return nil, err
+	}
+	return b, nil
+}
+
+func (p *Proxy) login(pl *Player, b *backend, addr string) error {
+	var s mcproto.Session
+	conn := mcproto.NewConn(&s, &b.t, mcproto.Serverbound)
+
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	port, _ := strconv.Atoi(portStr)
+
+	forwarded := mcproto.ForwardedPlayer{
+		Address:    clientIP(pl.Session.RemoteAddr),
+		PlayerUUID: pl.Session.PlayerUUID,
+		Name:       pl.Session.Name,
+		Properties: pl.Session.Properties,
+	}
+
+	hs := &packet.HandshakePacket{
+		ProtocolVersion: int32(pl.Session.ProtocolVersion),
+		ServerAddr:      host,
+		ServerPort:      uint16(port),
+		RequestType:     int32(mcproto.Login),
+	}
+	if p.Forwarding == mcproto.LegacyForwarding {
+		hs.ServerAddr = mcproto.EncodeLegacyForwarding(host, forwarded)
+	}
+	if err = conn.WritePacket(hs); err != nil {
+		return err
+	}
+	s.Mode = mcproto.NextMode(s.Mode, hs)
+
+	err = conn.WritePacket(&packet.LoginStart{
+		Name:       pl.Session.Name,
+		PlayerUUID: pl.Session.PlayerUUID,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		pk, err := conn.ReadPacket()
+		if err != nil {
+			return err
+		}
+
+		switch pk := pk.(type) {
+		case *packet.SetCompression:
+			b.t.CompressionThreshold = int(pk.Threshold)
+		case *packet.LoginSuccess:
+			return conn.WritePacket(&packet.LoginAcknowledge{})
+		case *packet.EncryptionRequest:
+			return ErrOnlineMode
+		case *packet.LoginDisconnect:
+			return &BackendDisconnectError{b.name, pk.Reason}
+		case *packet.LoginPluginRequest:
+			resp := &packet.LoginPluginResponse{MessageID: pk.MessageID}
+			if pk.Channel == mcproto.ModernForwardingChannel && p.Forwarding == mcproto.ModernForwarding {
+				resp.Successful = true
+				resp.Data = mcproto.EncodeModernForwarding(p.ForwardingSecret, forwarded)
+			}
+			if err = conn.WritePacket(resp); err != nil {
+				return err
+			}
+		case *packet.LoginCookieRequest:
+			// Cookies of the client are not relayed to backends.
+			if err = conn.WritePacket(&packet.LoginCookieResponse{Key: pk.Key}); err != nil {
+				return err
+			}
+		default:
+			return mcproto.ErrUnexpectedPacket
+		}
+	}
+}
+
+// clientIP returns the IP of addr, without port.
+func clientIP(addr net.Addr) string {
+	if tcp, ok := addr.(*net.TCPAddr); ok {
+		return tcp.IP.String()
+	}
+	if addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return addr.String()
+	}
+	return host
+}
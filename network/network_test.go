@@ -0,0 +1,194 @@
+package network
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gstoney/mcproto"
+	"github.com/gstoney/mcproto/internal/mctest"
+	"github.com/gstoney/mcproto/packet"
+)
+
+// serveBackend accepts one login on l and brings it to Play, sending
+// entityID in Login.
+func serveBackend(t *testing.T, l net.Listener, entityID int32) {
+	c, err := l.Accept()
+	if err != nil {
+		return
+	}
+	defer c.Close()
+	server := mctest.NewPeer(t, c, mcproto.Clientbound)
+
+	server.Read() // Handshake
+	start, _ := server.Read().(*packet.LoginStart)
+	if start == nil {
+		return
+	}
+	server.Write(&packet.LoginSuccess{UUID: start.PlayerUUID, Username: start.Name})
+	server.Read() // Login Acknowledged
+	server.Write(&packet.FinishConfiguration{})
+	server.Read() // Acknowledge Finish Configuration
+	server.Write(&packet.PlayLogin{EntityID: entityID, DimensionName: "minecraft:overworld"})
+
+	// Hold the connection until the proxy closes it.
+	for {
+		if _, err := server.Conn.ReadPacket(); err != nil {
+			return
+		}
+	}
+}
+
+func listen(t *testing.T) net.Listener {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("listen: %v", err)
+	}
+	t.Cleanup(func() { l.Close() })
+	return l
+}
+
+// TestProxy_Switch verifies that a player in Play is moved to another
+// backend through reconfiguration, keeping the entity ID of the first.
+func TestProxy_Switch(t *testing.T) {
+	la, lb, lp := listen(t), listen(t), listen(t)
+	go serveBackend(t, la, 100)
+	go serveBackend(t, lb, 7)
+
+	p := &Proxy{
+		Servers: map[string]string{"a": la.Addr().String(), "b": lb.Addr().String()},
+		Default: "a",
+		Config:  mctest.Config(),
+	}
+	e := &mcproto.Establisher{Config: mctest.Config()}
+	srv := mcproto.Server{SessionEstablisher: e.Establish, SessionHandler: p.Handle}
+	go srv.Serve(lp)
+
+	c, err := net.Dial("tcp", lp.Addr().String())
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer c.Close()
+	client := mctest.NewPeer(t, c, mcproto.Serverbound)
+
+	client.Write(&packet.HandshakePacket{ProtocolVersion: 767, ServerAddr: "localhost", ServerPort: 25565, RequestType: 2})
+	client.Write(&packet.LoginStart{Name: "Steve"})
+	success, ok := client.Read().(*packet.LoginSuccess)
+	if !ok {
+		t.Fatalf("expected LoginSuccess")
+	}
+	client.Write(&packet.LoginAcknowledge{})
+
+	enterPlay := func() *packet.PlayLogin {
+		t.Helper()
+		if _, ok := client.Read().(*packet.FinishConfiguration); !ok {
+			t.Fatalf("expected FinishConfiguration")
+		}
+		client.Write(&packet.FinishConfigurationAcknowledge{})
+		login, ok := client.Read().(*packet.PlayLogin)
+		if !ok {
+			t.Fatalf("expected PlayLogin")
+		}
+		return login
+	}
+	if login := enterPlay(); login.EntityID != 100 {
+		t.Fatalf("got entity ID %d, want 100", login.EntityID)
+	}
+
+	var pl *Player
+	for i := 0; pl == nil && i < 100; i++ {
+		if pl = p.Player(success.UUID); pl == nil {
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	if pl == nil {
+		t.Fatalf("player not registered")
+	}
+
+	// The client acknowledges in its own time; Connect returns once asked.
+	for i := 0; ; i++ {
+		err = pl.Connect("b")
+		if err != ErrNotInPlay || i == 100 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+
+	if _, ok := client.Read().(*packet.StartConfiguration); !ok {
+		t.Fatalf("expected StartConfiguration")
+	}
+	client.Write(&packet.StartConfigurationAcknowledge{})
+
+	if login := enterPlay(); login.EntityID != 100 {
+		t.Errorf("got entity ID %d after switch, want 100", login.EntityID)
+	}
+	if name := pl.Server(); name != "b" {
+		t.Errorf("got server %q, want b", name)
+	}
+}
+
+// TestProxy_DuplicateUUID verifies that a second login with the UUID of a
+// connected player is disconnected, leaving the first player registered.
+func TestProxy_DuplicateUUID(t *testing.T) {
+	la, lp := listen(t), listen(t)
+	go serveBackend(t, la, 100)
+
+	p := &Proxy{
+		Servers: map[string]string{"a": la.Addr().String()},
+		Default: "a",
+		Config:  mctest.Config(),
+	}
+	e := &mcproto.Establisher{Config: mctest.Config()}
+	srv := mcproto.Server{SessionEstablisher: e.Establish, SessionHandler: p.Handle}
+	go srv.Serve(lp)
+
+	join := func() (*mctest.Peer, *packet.LoginSuccess) {
+		t.Helper()
+		c, err := net.Dial("tcp", lp.Addr().String())
+		if err != nil {
+			t.Fatalf("dial: %v", err)
+		}
+		t.Cleanup(func() { c.Close() })
+		client := mctest.NewPeer(t, c, mcproto.Serverbound)
+		client.Write(&packet.HandshakePacket{ProtocolVersion: 767, ServerAddr: "localhost", ServerPort: 25565, RequestType: 2})
+		client.Write(&packet.LoginStart{Name: "Steve"})
+		success, ok := client.Read().(*packet.LoginSuccess)
+		if !ok {
+			t.Fatalf("expected LoginSuccess")
+		}
+		client.Write(&packet.LoginAcknowledge{})
+		return client, success
+	}
+
+	first, success := join()
+	if _, ok := first.Read().(*packet.FinishConfiguration); !ok {
+		t.Fatalf("expected FinishConfiguration")
+	}
+	first.Write(&packet.FinishConfigurationAcknowledge{})
+	if _, ok := first.Read().(*packet.PlayLogin); !ok {
+		t.Fatalf("expected PlayLogin")
+	}
+	var pl *Player
+	for i := 0; pl == nil && i < 100; i++ {
+		if pl = p.Player(success.UUID); pl == nil {
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	if pl == nil {
+		t.Fatalf("player not registered")
+	}
+
+	second, _ := join()
+	if _, ok := second.Read().(*packet.ConfigDisconnect); !ok {
+		t.Fatalf("expected Disconnect of the second login")
+	}
+	if _, err := second.Conn.ReadPacket(); err == nil {
+		t.Errorf("second connection still open")
+	}
+	if got := p.Player(success.UUID); got != pl {
+		t.Errorf("got player %p, want the first %p", got, pl)
+	}
+}
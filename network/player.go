@@ -0,0 +1,280 @@
+package network
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/gstoney/mcproto"
+	"github.com/gstoney/mcproto/packet"
+)
+
+// A Player is a client connected through the proxy.
+type Player struct {
+	Session *mcproto.Session
+
+	proxy   *Proxy
+	client  *mcproto.Transport
+	writeMu sync.Mutex // serializes writes to client
+
+	mu      sync.Mutex
+	mode    mcproto.ConnectionMode
+	backend *backend
+	pending *backend // target of an ongoing switch
+	closed  bool
+
+	// entityID is the player's entity ID as seen by the client, taken from
+	// the first backend. Entity IDs of later backends are mapped onto it.
+	entityID    int32
+	hasEntityID bool
+}
+
+// Server returns the name of the backend the player is connected to.
+func (pl *Player) Server() string {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	return pl.backend.name
+}
+
+// Connect moves the player, which must be in Play mode, to the named
+// backend.
+//
+// The new backend is logged in first; on failure the player stays where
+// it is. Otherwise the client is sent Start Configuration and, once it
+// acknowledges, is relayed to the new backend, which configures it anew.
+func (pl *Player) Connect(name string) error {
+	pl.mu.Lock()
+	err := pl.canSwitch()
+	pl.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	b, err := pl.proxy.connect(pl, name)
+	if err != nil {
+		return err
+	}
+
+	pl.mu.Lock()
+	if err = pl.canSwitch(); err != nil {
+		pl.mu.Unlock()
+		b.conn.Close()
+		return err
+	}
+	old := pl.backend
+	pl.pending = b
+	pl.mu.Unlock()
+
+	// Packets of the old backend still in flight are dropped by writeFrom.
+	old.conn.Close()
+
+	pl.writeMu.Lock()
+	defer pl.writeMu.Unlock()
+	return writePacket(pl.client, &packet.StartConfiguration{})
+}
+
+func (pl *Player) canSwitch() error {
+	if pl.closed {
+		return net.ErrClosed
+	}
+	if pl.pending != nil {
+		return ErrSwitching
+	}
+	if pl.mode != mcproto.Play {
+		return ErrNotInPlay
+	}
+	return nil
+}
+
+func (pl *Player) close() {
+	pl.mu.Lock()
+	pl.closed = true
+	b, pending := pl.backend, pl.pending
+	pl.mu.Unlock()
+
+	b.conn.Close()
+	if pending != nil {
+		pending.conn.Close()
+	}
+}
+
+// pumpServerbound relays client packets to the current backend until the
+// client disconnects.
+func (pl *Player) pumpServerbound() error {
+	var raw bytes.Buffer
+	for {
+		if err := readFrame(pl.client, &raw); err != nil {
+			if err == io.EOF || errors.Is(err, net.ErrClosed) {
+				err = nil
+			}
+			return err
+		}
+
+		pl.mu.Lock()
+		mode := pl.mode
+		p := decode(mode, mcproto.Serverbound, raw.Bytes())
+
+		if pl.pending != nil {
+			// The old backend is gone; wait for the client to enter
+			// configuration, then hand it to the new backend.
+			if _, ok := p.(*packet.StartConfigurationAcknowledge); ok {
+				pl.backend, pl.pending = pl.pending, nil
+				pl.mode = mcproto.Config
+				go pl.pumpClientbound(pl.backend)
+			}
+			pl.mu.Unlock()
+			continue
+		}
+
+		b := pl.backend
+		out := raw.Bytes()
+		if mode == mcproto.Play && pl.hasEntityID {
+			out = remapEntityID(out, serverboundEntityIDs, pl.entityID, b.entityID, nil)
+		}
+		if p != nil {
+			pl.mode = mcproto.NextMode(mode, p)
+		}
+		pl.mu.Unlock()
+
+		if err := b.t.Send(out); err != nil {
+			// Sends to a backend closed for a switch fail harmlessly.
+			pl.mu.Lock()
+			current := pl.backend == b && pl.pending == nil
+			pl.mu.Unlock()
+			if current {
+				return err
+			}
+		}
+	}
+}
+
+// pumpClientbound relays packets of b to the client while b is the
+// player's backend.
+func (pl *Player) pumpClientbound(b *backend) {
+	var raw bytes.Buffer
+	for {
+		if err := readFrame(&b.t, &raw); err != nil {
+			pl.backendLost(b, err)
+			return
+		}
+
+		pl.mu.Lock()
+		out := raw.Bytes()
+		if pl.mode == mcproto.Play {
+			out = pl.remapClientbound(b, out)
+		}
+		pl.mu.Unlock()
+
+		if !pl.writeFrom(b, out) {
+			return
+		}
+	}
+}
+
+// remapClientbound maps entity IDs of b onto the IDs the client knows.
+// pl.mu must be held.
+func (pl *Player) remapClientbound(b *backend, raw []byte) []byte {
+	rd := bytes.NewReader(raw)
+	id, err := packet.ReadVarInt(rd)
+	if err != nil {
+		return raw
+	}
+
+	if id == (packet.PlayLogin{}).ID() {
+		entityID, err := packet.ReadInt(rd)
+		if err != nil {
+			return raw
+		}
+		b.entityID = entityID
+		if !pl.hasEntityID {
+			pl.entityID = entityID
+			pl.hasEntityID = true
+		}
+	}
+	if b.entityTypes == nil {
+		b.entityTypes = make(map[int32]int32)
+	}
+	return remapEntityID(raw, clientboundEntityIDs, b.entityID, pl.entityID, b.entityTypes)
+}
+
+// writeFrom sends raw to the client if b is still the player's backend.
+// It reports whether b is.
+func (pl *Player) writeFrom(b *backend, raw []byte) bool {
+	pl.writeMu.Lock()
+	defer pl.writeMu.Unlock()
+
+	pl.mu.Lock()
+	current := pl.backend == b && pl.pending == nil
+	pl.mu.Unlock()
+	if !current {
+		return false
+	}
+
+	if err := pl.client.Send(raw); err != nil {
+		pl.closeClient()
+		return false
+	}
+	return true
+}
+
+// backendLost disconnects the client when its current backend fails.
+// Backends closed for a switch are ignored.
+func (pl *Player) backendLost(b *backend, err error) {
+	pl.mu.Lock()
+	current := pl.backend == b && pl.pending == nil && !pl.closed
+	pl.mu.Unlock()
+
+	if current {
+		pl.proxy.logf("%s: lost %s: %v", pl.Session.Name, b.name, err)
+		pl.closeClient()
+	}
+}
+
+func (pl *Player) closeClient() {
+	if pl.Session.Conn != nil {
+		pl.Session.Conn.Close()
+	}
+}
+
+// readFrame reads the next payload of t into buf.
+func readFrame(t *mcproto.Transport, buf *bytes.Buffer) error {
+	pr, err := t.Recv()
+	if err != nil {
+		return err
+	}
+	buf.Reset()
+	if _, err = buf.ReadFrom(pr); err != nil {
+		pr.Discard()
+		return err
+	}
+	return pr.Close()
+}
+
+func writePacket(t *mcproto.Transport, p packet.Packet) error {
+	var buf bytes.Buffer
+	if err := p.Encode(&buf); err != nil {
+		return err
+	}
+	return t.Send(buf.Bytes())
+}
+
+// decode decodes raw with the registry of mode and dir, returning nil if
+// the packet is unknown or malformed.
+func decode(mode mcproto.ConnectionMode, dir mcproto.Direction, raw []byte) packet.Packet {
+	rd := bytes.NewReader(raw)
+	id, err := packet.ReadVarInt(rd)
+	if err != nil {
+		return nil
+	}
+	factory, ok := mcproto.Registry(mode, dir)[id]
+	if !ok {
+		return nil
+	}
+	p := factory()
+	if err = p.Decode(rd); err != nil || rd.Len() > 0 {
+		return nil
+	}
+	return p
+}
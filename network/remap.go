@@ -0,0 +1,420 @@
+package network
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/gstoney/mcproto/packet"
+	"github.com/gstoney/mcproto/vanilla"
+)
+
+// A remapFn copies the fields of a Play packet from m.r to m.w, swapping
+// the entity IDs among them. Fields after the last entity ID are copied
+// by remapEntityID.
+type remapFn func(m *remapper) error
+
+// Clientbound Play packets carrying entity IDs.
+var clientboundEntityIDs = map[int32]remapFn{
+	0x01: remapSpawnEntity,
+	0x02: leadingVarIntID, // Spawn Experience Orb
+	0x03: leadingVarIntID, // Entity Animation
+	0x06: leadingVarIntID, // Set Block Destroy Stage
+	0x1A: remapDamageEvent,
+	0x1F: leadingIntID, // Entity Event
+	0x23: remapOpenHorseScreen,
+	0x24: leadingVarIntID, // Hurt Animation
+	0x2B: leadingIntID,    // Login
+	0x2E: leadingVarIntID, // Update Entity Position
+	0x2F: leadingVarIntID, // Update Entity Position and Rotation
+	0x30: leadingVarIntID, // Update Entity Rotation
+	0x3C: leadingVarIntID, // Combat Death
+	0x3F: remapLookAt,
+	0x42: remapRemoveEntities,
+	0x43: leadingVarIntID, // Remove Entity Effect
+	0x47: remapRespawn,
+	0x48: leadingVarIntID, // Set Head Rotation
+	0x52: leadingVarIntID, // Set Camera
+	0x58: remapSetEntityMetadata,
+	0x59: remapLinkEntities,
+	0x5A: leadingVarIntID, // Set Entity Velocity
+	0x5B: leadingVarIntID, // Set Equipment
+	0x5F: remapSetPassengers,
+	0x67: remapEntitySoundEffect,
+	0x6F: remapPickupItem,
+	0x70: leadingVarIntID, // Teleport Entity
+	0x75: leadingVarIntID, // Update Attributes
+	0x76: leadingVarIntID, // Entity Effect
+	0x79: leadingVarIntID, // Projectile Power
+}
+
+// Serverbound Play packets carrying entity IDs.
+var serverboundEntityIDs = map[int32]remapFn{
+	0x15: remapQueryEntityTag,
+	0x16: leadingVarIntID, // Interact
+	0x25: leadingVarIntID, // Player Command
+}
+
+// remapper swaps entity IDs from and to while copying a packet.
+type remapper struct {
+	r        *bytes.Reader
+	w        bytes.Buffer
+	from, to int32
+
+	// types are the entity types of the entities spawned, by ID before
+	// swapping, or nil if not tracked.
+	types map[int32]int32
+}
+
+// remapEntityID swaps entity IDs from and to in raw, a Play packet, if its
+// packet ID is listed in fns. Swapping both ways keeps a backend entity
+// that happens to carry the client-side ID distinct from the player.
+//
+// types, if not nil, tracks the entity types spawned, by which entity IDs
+// in their metadata are found. Packets that fail to parse are returned
+// as is.
+func remapEntityID(raw []byte, fns map[int32]remapFn, from, to int32, types map[int32]int32) []byte {
+	if from == to {
+		return raw
+	}
+
+	m := &remapper{r: bytes.NewReader(raw), from: from, to: to, types: types}
+	id, err := packet.ReadVarInt(m.r)
+	if err != nil {
+		return raw
+	}
+	fn, ok := fns[id]
+	if !ok {
+		return raw
+	}
+
+	m.w.Grow(len(raw) + 4)
+	packet.WriteVarInt(&m.w, id)
+	if err = fn(m); err != nil {
+		return raw
+	}
+	m.r.WriteTo(&m.w)
+	return m.w.Bytes()
+}
+
+func (m *remapper) swap(id int32) int32 {
+	switch id {
+	case m.from:
+		return m.to
+	case m.to:
+		return m.from
+	}
+	return id
+}
+
+// varInt copies a VarInt, returning its value.
+func (m *remapper) varInt() (v int32, err error) {
+	if v, err = packet.ReadVarInt(m.r); err != nil {
+		return
+	}
+	err = packet.WriteVarInt(&m.w, v)
+	return
+}
+
+// varIntID swaps a VarInt entity ID, returning it as read.
+func (m *remapper) varIntID() (id int32, err error) {
+	if id, err = packet.ReadVarInt(m.r); err != nil {
+		return
+	}
+	err = packet.WriteVarInt(&m.w, m.swap(id))
+	return
+}
+
+// optionalVarIntID swaps a VarInt of an entity ID plus one, 0 for none.
+func (m *remapper) optionalVarIntID() error {
+	id, err := packet.ReadVarInt(m.r)
+	if err != nil {
+		return err
+	}
+	if id > 0 {
+		id = m.swap(id-1) + 1
+	}
+	return packet.WriteVarInt(&m.w, id)
+}
+
+func (m *remapper) intID() error {
+	id, err := packet.ReadInt(m.r)
+	if err != nil {
+		return err
+	}
+	return packet.WriteInt(&m.w, m.swap(id))
+}
+
+// copyN copies n bytes.
+func (m *remapper) copyN(n int) error {
+	_, err := io.CopyN(&m.w, m.r, int64(n))
+	return err
+}
+
+// rest returns the bytes left to copy.
+func (m *remapper) rest() []byte {
+	b, _ := io.ReadAll(m.r)
+	return b
+}
+
+func leadingVarIntID(m *remapper) error {
+	_, err := m.varIntID()
+	return err
+}
+
+func leadingIntID(m *remapper) error {
+	return m.intID()
+}
+
+// projectiles are the entity types whose Spawn Entity data is the entity
+// ID of their owner.
+var projectiles = entityTypeSet(
+	"minecraft:arrow", "minecraft:spectral_arrow", "minecraft:trident",
+	"minecraft:fishing_bobber", "minecraft:snowball", "minecraft:egg",
+	"minecraft:ender_pearl", "minecraft:experience_bottle", "minecraft:potion",
+	"minecraft:fireball", "minecraft:small_fireball", "minecraft:dragon_fireball",
+	"minecraft:wither_skull", "minecraft:wind_charge", "minecraft:breeze_wind_charge",
+	"minecraft:shulker_bullet", "minecraft:llama_spit", "minecraft:firework_rocket",
+)
+
+func entityTypeSet(names ...string) map[int32]bool {
+	set := make(map[int32]bool, len(names))
+	for _, name := range names {
+		set[entityType(name)] = true
+	}
+	return set
+}
+
+func entityType(name string) int32 {
+	id, ok := vanilla.EntityTypes.ID(name)
+	if !ok {
+		panic("network: unknown entity type " + name)
+	}
+	return id
+}
+
+func remapSpawnEntity(m *remapper) error {
+	id, err := m.varIntID()
+	if err != nil {
+		return err
+	}
+	if err = m.copyN(16); err != nil { // UUID
+		return err
+	}
+	typ, err := m.varInt()
+	if err != nil {
+		return err
+	}
+	if m.types != nil {
+		m.types[id] = typ
+	}
+	if !projectiles[typ] {
+		return nil
+	}
+	if err = m.copyN(3*8 + 3); err != nil { // Position and angles
+		return err
+	}
+	_, err = m.varIntID() // Data
+	return err
+}
+
+func remapDamageEvent(m *remapper) error {
+	if _, err := m.varIntID(); err != nil {
+		return err
+	}
+	if _, err := m.varInt(); err != nil { // Source Type
+		return err
+	}
+	if err := m.optionalVarIntID(); err != nil { // Source Cause ID
+		return err
+	}
+	return m.optionalVarIntID() // Source Direct ID
+}
+
+func remapOpenHorseScreen(m *remapper) error {
+	if err := m.copyN(1); err != nil { // Window ID
+		return err
+	}
+	if _, err := m.varInt(); err != nil { // Slot Count
+		return err
+	}
+	return m.intID()
+}
+
+func remapLookAt(m *remapper) error {
+	if _, err := m.varInt(); err != nil { // Feet/Eyes
+		return err
+	}
+	if err := m.copyN(3 * 8); err != nil { // Target
+		return err
+	}
+	isEntity, err := packet.ReadBoolean(m.r)
+	if err != nil {
+		return err
+	}
+	packet.WriteBoolean(&m.w, isEntity)
+	if !isEntity {
+		return nil
+	}
+	_, err = m.varIntID()
+	return err
+}
+
+// varIntIDs swaps a prefixed array of VarInt entity IDs, returning them as
+// read.
+func (m *remapper) varIntIDs() (ids []int32, err error) {
+	n, err := m.varInt()
+	if err != nil {
+		return
+	}
+	if n < 0 || int(n) > m.r.Len() {
+		return nil, io.ErrUnexpectedEOF
+	}
+	ids = make([]int32, n)
+	for i := range ids {
+		if ids[i], err = m.varIntID(); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func remapRemoveEntities(m *remapper) error {
+	ids, err := m.varIntIDs()
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		delete(m.types, id)
+	}
+	return nil
+}
+
+// remapRespawn forgets the entities of the previous world, which the
+// client drops on respawning.
+func remapRespawn(m *remapper) error {
+	clear(m.types)
+	return nil
+}
+
+func remapLinkEntities(m *remapper) error {
+	if err := m.intID(); err != nil { // Attached Entity ID
+		return err
+	}
+	return m.intID() // Holding Entity ID
+}
+
+func remapSetPassengers(m *remapper) error {
+	if _, err := m.varIntID(); err != nil {
+		return err
+	}
+	_, err := m.varIntIDs()
+	return err
+}
+
+func remapEntitySoundEffect(m *remapper) error {
+	sound, err := m.varInt()
+	if err != nil {
+		return err
+	}
+	if sound == 0 {
+		// An inline sound event: its name and optional fixed range.
+		name, err := packet.ReadString(m.r)
+		if err != nil {
+			return err
+		}
+		packet.WriteString(&m.w, name)
+		hasRange, err := packet.ReadBoolean(m.r)
+		if err != nil {
+			return err
+		}
+		packet.WriteBoolean(&m.w, hasRange)
+		if hasRange {
+			if err = m.copyN(4); err != nil {
+				return err
+			}
+		}
+	}
+	if _, err = m.varInt(); err != nil { // Sound Category
+		return err
+	}
+	_, err = m.varIntID()
+	return err
+}
+
+func remapPickupItem(m *remapper) error {
+	if _, err := m.varIntID(); err != nil { // Collected Entity ID
+		return err
+	}
+	_, err := m.varIntID() // Collector Entity ID
+	return err
+}
+
+func remapQueryEntityTag(m *remapper) error {
+	if _, err := m.varInt(); err != nil { // Transaction ID
+		return err
+	}
+	_, err := m.varIntID()
+	return err
+}
+
+// metadataIDField is the encoding of an entity ID in entity metadata.
+type metadataIDField byte
+
+const (
+	metadataID         metadataIDField = iota + 1 // VarInt, 0 for none
+	metadataIDPlusOne                             // VarInt of the ID plus one, 0 for none
+	metadataOptionalID                            // Optional VarInt
+)
+
+// metadataEntityIDs are the metadata entries holding entity IDs, by
+// entity type and entry index.
+var metadataEntityIDs = map[int32]map[uint8]metadataIDField{
+	entityType("minecraft:fishing_bobber"):  {8: metadataIDPlusOne},                           // Hooked Entity
+	entityType("minecraft:firework_rocket"): {9: metadataOptionalID},                          // Shooter
+	entityType("minecraft:guardian"):        {17: metadataID},                                 // Target
+	entityType("minecraft:elder_guardian"):  {17: metadataID},                                 // Target
+	entityType("minecraft:wither"):          {16: metadataID, 17: metadataID, 18: metadataID}, // Head Targets
+}
+
+// remapSetEntityMetadata swaps the entity ID of Set Entity Metadata, and
+// those in the entries of entity types holding entity IDs. Metadata that
+// cannot be decoded is copied as is.
+func remapSetEntityMetadata(m *remapper) error {
+	id, err := m.varIntID()
+	if err != nil {
+		return err
+	}
+	typ, ok := m.types[id]
+	if !ok {
+		return nil
+	}
+	fields := metadataEntityIDs[typ]
+	if fields == nil {
+		return nil
+	}
+
+	rest := m.rest()
+	md, err := packet.ReadEntityMetadata(bytes.NewReader(rest))
+	if err != nil {
+		m.w.Write(rest)
+		return nil
+	}
+	for i, e := range md {
+		switch fields[e.Index] {
+		case metadataID:
+			if v, ok := e.Value.(int32); ok && v != 0 {
+				md[i].Value = m.swap(v)
+			}
+		case metadataIDPlusOne:
+			if v, ok := e.Value.(int32); ok && v > 0 {
+				md[i].Value = m.swap(v-1) + 1
+			}
+		case metadataOptionalID:
+			if v, ok := e.Value.(packet.Optional[int32]); ok && v.Exists {
+				v.Item = m.swap(v.Item)
+				md[i].Value = v
+			}
+		}
+	}
+	return packet.WriteEntityMetadata(&m.w, md)
+}
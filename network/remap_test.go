@@ -0,0 +1,372 @@
+package network
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gstoney/mcproto/packet"
+)
+
+// Entity IDs of the remap tests: the player on the backend and as the
+// client knows it, and another entity.
+const (
+	backendID int32 = 7
+	clientID  int32 = 100
+	otherID   int32 = 42
+)
+
+// Each case of remapTc encodes a packet, passing entity IDs through id, so
+// that the packet remapped equals the packet encoded with swapped IDs.
+var remapTc = []struct {
+	desc   string
+	fns    map[int32]remapFn
+	types  map[int32]int32
+	encode func(w *bytes.Buffer, id func(int32) int32)
+}{
+	{
+		desc: "Spawn Entity",
+		fns:  clientboundEntityIDs,
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x01)
+			packet.WriteVarInt(w, id(otherID))
+			packet.WriteUUID(w, uuid.UUID{1})
+			packet.WriteVarInt(w, entityType("minecraft:arrow"))
+			w.Write(make([]byte, 3*8+3))
+			packet.WriteVarInt(w, id(backendID)) // Owner
+			w.Write(make([]byte, 3*2))
+		},
+	},
+	{
+		desc: "Spawn Entity not a projectile",
+		fns:  clientboundEntityIDs,
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x01)
+			packet.WriteVarInt(w, id(backendID))
+			packet.WriteUUID(w, uuid.UUID{1})
+			packet.WriteVarInt(w, entityType("minecraft:falling_block"))
+			w.Write(make([]byte, 3*8+3))
+			packet.WriteVarInt(w, backendID) // Block state, not remapped
+			w.Write(make([]byte, 3*2))
+		},
+	},
+	{
+		desc: "Spawn Experience Orb",
+		fns:  clientboundEntityIDs,
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x02)
+			packet.WriteVarInt(w, id(clientID))
+			w.Write(make([]byte, 3*8+2))
+		},
+	},
+	{
+		desc: "Entity Animation",
+		fns:  clientboundEntityIDs,
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x03)
+			packet.WriteVarInt(w, id(backendID))
+			w.WriteByte(0)
+		},
+	},
+	{
+		desc: "Set Block Destroy Stage",
+		fns:  clientboundEntityIDs,
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x06)
+			packet.WriteVarInt(w, id(backendID))
+			packet.WritePosition(w, packet.Position{X: 1, Y: 2, Z: 3})
+			w.WriteByte(5)
+		},
+	},
+	{
+		desc: "Damage Event",
+		fns:  clientboundEntityIDs,
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x1A)
+			packet.WriteVarInt(w, id(backendID))
+			packet.WriteVarInt(w, 3)
+			packet.WriteVarInt(w, id(otherID)+1)
+			packet.WriteVarInt(w, id(clientID)+1)
+			packet.WriteBoolean(w, false)
+		},
+	},
+	{
+		desc: "Entity Event",
+		fns:  clientboundEntityIDs,
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x1F)
+			packet.WriteInt(w, id(backendID))
+			w.WriteByte(9)
+		},
+	},
+	{
+		desc: "Open Horse Screen",
+		fns:  clientboundEntityIDs,
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x23)
+			w.WriteByte(1)
+			packet.WriteVarInt(w, 17)
+			packet.WriteInt(w, id(clientID))
+		},
+	},
+	{
+		desc: "Login",
+		fns:  clientboundEntityIDs,
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x2B)
+			packet.WriteInt(w, id(backendID))
+			packet.WriteBoolean(w, false)
+		},
+	},
+	{
+		desc: "Combat Death",
+		fns:  clientboundEntityIDs,
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x3C)
+			packet.WriteVarInt(w, id(backendID))
+			packet.WriteNBT(w, "died")
+		},
+	},
+	{
+		desc: "Look At entity",
+		fns:  clientboundEntityIDs,
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x3F)
+			packet.WriteVarInt(w, 1)
+			w.Write(make([]byte, 3*8))
+			packet.WriteBoolean(w, true)
+			packet.WriteVarInt(w, id(clientID))
+			packet.WriteVarInt(w, 0)
+		},
+	},
+	{
+		desc: "Look At position",
+		fns:  clientboundEntityIDs,
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x3F)
+			packet.WriteVarInt(w, 1)
+			w.Write(make([]byte, 3*8))
+			packet.WriteBoolean(w, false)
+		},
+	},
+	{
+		desc:  "Remove Entities",
+		fns:   clientboundEntityIDs,
+		types: map[int32]int32{otherID: 1},
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x42)
+			packet.WritePrefixedArray(w, []int32{id(otherID), id(clientID), id(backendID)}, packet.WriteVarInt)
+		},
+	},
+	{
+		desc: "Set Camera",
+		fns:  clientboundEntityIDs,
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x52)
+			packet.WriteVarInt(w, id(backendID))
+		},
+	},
+	{
+		desc:  "Set Entity Metadata of a fishing bobber",
+		fns:   clientboundEntityIDs,
+		types: map[int32]int32{otherID: entityType("minecraft:fishing_bobber")},
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x58)
+			packet.WriteVarInt(w, id(otherID))
+			packet.WriteEntityMetadata(w, packet.EntityMetadata{
+				{Index: 0, Type: packet.MetadataByte, Value: byte(0)},
+				{Index: 8, Type: packet.MetadataVarInt, Value: id(backendID) + 1},
+				{Index: 9, Type: packet.MetadataBoolean, Value: false},
+			})
+		},
+	},
+	{
+		desc:  "Set Entity Metadata of a firework rocket",
+		fns:   clientboundEntityIDs,
+		types: map[int32]int32{otherID: entityType("minecraft:firework_rocket")},
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x58)
+			packet.WriteVarInt(w, id(otherID))
+			packet.WriteEntityMetadata(w, packet.EntityMetadata{
+				{Index: 9, Type: packet.MetadataOptionalVarInt, Value: packet.Optional[int32]{Exists: true, Item: id(clientID)}},
+			})
+		},
+	},
+	{
+		desc:  "Set Entity Metadata of a wither",
+		fns:   clientboundEntityIDs,
+		types: map[int32]int32{otherID: entityType("minecraft:wither")},
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x58)
+			packet.WriteVarInt(w, id(otherID))
+			packet.WriteEntityMetadata(w, packet.EntityMetadata{
+				{Index: 16, Type: packet.MetadataVarInt, Value: id(backendID)},
+				{Index: 17, Type: packet.MetadataVarInt, Value: int32(0)},
+				{Index: 18, Type: packet.MetadataVarInt, Value: id(otherID)},
+			})
+		},
+	},
+	{
+		desc:  "Set Entity Metadata of another entity",
+		fns:   clientboundEntityIDs,
+		types: map[int32]int32{backendID: entityType("minecraft:player")},
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x58)
+			packet.WriteVarInt(w, id(backendID))
+			packet.WriteEntityMetadata(w, packet.EntityMetadata{
+				{Index: 8, Type: packet.MetadataVarInt, Value: backendID + 1}, // Not an entity ID
+			})
+		},
+	},
+	{
+		desc: "Link Entities",
+		fns:  clientboundEntityIDs,
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x59)
+			packet.WriteInt(w, id(otherID))
+			packet.WriteInt(w, id(backendID))
+		},
+	},
+	{
+		desc: "Set Passengers",
+		fns:  clientboundEntityIDs,
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x5F)
+			packet.WriteVarInt(w, id(otherID))
+			packet.WritePrefixedArray(w, []int32{id(backendID), id(clientID)}, packet.WriteVarInt)
+		},
+	},
+	{
+		desc: "Entity Sound Effect",
+		fns:  clientboundEntityIDs,
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x67)
+			packet.WriteVarInt(w, 0)
+			packet.WriteString(w, "minecraft:entity.player.burp")
+			packet.WriteBoolean(w, true)
+			packet.WriteFloat(w, 16)
+			packet.WriteVarInt(w, 7)
+			packet.WriteVarInt(w, id(backendID))
+			w.Write(make([]byte, 4+4+8))
+		},
+	},
+	{
+		desc: "Pickup Item",
+		fns:  clientboundEntityIDs,
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x6F)
+			packet.WriteVarInt(w, id(otherID))
+			packet.WriteVarInt(w, id(backendID))
+			packet.WriteVarInt(w, 3)
+		},
+	},
+	{
+		desc: "Projectile Power",
+		fns:  clientboundEntityIDs,
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x79)
+			packet.WriteVarInt(w, id(clientID))
+			packet.WriteDouble(w, 0.1)
+		},
+	},
+	{
+		desc: "Query Entity Tag",
+		fns:  serverboundEntityIDs,
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x15)
+			packet.WriteVarInt(w, 1)
+			packet.WriteVarInt(w, id(backendID))
+		},
+	},
+	{
+		desc: "Interact",
+		fns:  serverboundEntityIDs,
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x16)
+			packet.WriteVarInt(w, id(clientID))
+			packet.WriteVarInt(w, 1)
+			packet.WriteBoolean(w, false)
+		},
+	},
+	{
+		desc: "Player Command",
+		fns:  serverboundEntityIDs,
+		encode: func(w *bytes.Buffer, id func(int32) int32) {
+			packet.WriteVarInt(w, 0x25)
+			packet.WriteVarInt(w, id(backendID))
+			packet.WriteVarInt(w, 0)
+			packet.WriteVarInt(w, 0)
+		},
+	},
+}
+
+func TestRemapEntityID(t *testing.T) {
+	same := func(id int32) int32 { return id }
+	swap := func(id int32) int32 {
+		switch id {
+		case backendID:
+			return clientID
+		case clientID:
+			return backendID
+		}
+		return id
+	}
+
+	for _, tc := range remapTc {
+		t.Run(tc.desc, func(t *testing.T) {
+			var in, want bytes.Buffer
+			tc.encode(&in, same)
+			tc.encode(&want, swap)
+
+			types := make(map[int32]int32)
+			for id, typ := range tc.types {
+				types[id] = typ
+			}
+			got := remapEntityID(in.Bytes(), tc.fns, backendID, clientID, types)
+			if !bytes.Equal(got, want.Bytes()) {
+				t.Errorf("expected %x, got %x", want.Bytes(), got)
+			}
+		})
+	}
+}
+
+// TestRemapEntityID_Types verifies that spawned entities are tracked for
+// their metadata until removed.
+func TestRemapEntityID_Types(t *testing.T) {
+	var spawn bytes.Buffer
+	packet.WriteVarInt(&spawn, 0x01)
+	packet.WriteVarInt(&spawn, otherID)
+	packet.WriteUUID(&spawn, uuid.UUID{1})
+	packet.WriteVarInt(&spawn, entityType("minecraft:fishing_bobber"))
+	spawn.Write(make([]byte, 3*8+3))
+	packet.WriteVarInt(&spawn, backendID)
+	spawn.Write(make([]byte, 3*2))
+
+	types := make(map[int32]int32)
+	remapEntityID(spawn.Bytes(), clientboundEntityIDs, backendID, clientID, types)
+	if types[otherID] != entityType("minecraft:fishing_bobber") {
+		t.Fatalf("got types %v, want the fishing bobber", types)
+	}
+
+	var remove bytes.Buffer
+	packet.WriteVarInt(&remove, 0x42)
+	packet.WritePrefixedArray(&remove, []int32{otherID}, packet.WriteVarInt)
+	remapEntityID(remove.Bytes(), clientboundEntityIDs, backendID, clientID, types)
+	if len(types) != 0 {
+		t.Errorf("got types %v after removal, want none", types)
+	}
+}
+
+// TestRemapEntityID_Malformed verifies that packets failing to parse are
+// relayed as is.
+func TestRemapEntityID_Malformed(t *testing.T) {
+	var b bytes.Buffer
+	packet.WriteVarInt(&b, 0x5F)
+	packet.WriteVarInt(&b, backendID)
+	packet.WriteVarInt(&b, 1000) // More passengers than follow
+	packet.WriteVarInt(&b, backendID)
+
+	if got := remapEntityID(b.Bytes(), clientboundEntityIDs, backendID, clientID, nil); !bytes.Equal(got, b.Bytes()) {
+		t.Errorf("expected %x as is, got %x", b.Bytes(), got)
+	}
+}
@@ -0,0 +1,293 @@
+package packet
+
+import (
+	"github.com/google/uuid"
+	"github.com/gstoney/mcproto/nbt"
+)
+
+// @gen:r,w,regclient
+type ConfigCookieRequest struct {
+	Key string `field:"Identifier"`
+}
+
+func (p ConfigCookieRequest) ID() int32 {
+	return 0x00
+}
+
+// @gen:r,w,regclient
+type ConfigClientboundPluginMessage struct {
+	Channel string `field:"Identifier"`
+	Data    []byte `field:"ByteArray"`
+}
+
+func (p ConfigClientboundPluginMessage) ID() int32 {
+	return 0x01
+}
+
+// @gen:r,w,regclient
+type ConfigDisconnect struct {
+	Reason any `field:"NBT"` // Text component
+}
+
+func (p ConfigDisconnect) ID() int32 {
+	return 0x02
+}
+
+// @gen:r,w,regclient
+type ConfigRemoveResourcePack struct {
+	UUID Optional[uuid.UUID] `field:"Optional" write:"WriteUUID" read:"ReadUUID"` // All packs if absent
+}
+
+func (p ConfigRemoveResourcePack) ID() int32 {
+	return 0x08
+}
+
+// @gen:r,w,regclient
+type ConfigAddResourcePack struct {
+	UUID          uuid.UUID     `field:"UUID"`
+	URL           string        `field:"String"`
+	Hash          string        `field:"String"` // Hex SHA-1 of the pack
+	Forced        bool          `field:"Boolean"`
+	PromptMessage Optional[any] `field:"Optional" write:"WriteNBT" read:"ReadNBT"` // Text component
+}
+
+func (p ConfigAddResourcePack) ID() int32 {
+	return 0x09
+}
+
+// @gen:r,w,regserver
+type ConfigResourcePackResponse struct {
+	UUID   uuid.UUID `field:"UUID"`
+	Result int32     `field:"VarInt"`
+}
+
+func (p ConfigResourcePackResponse) ID() int32 {
+	return 0x06
+}
+
+// @gen:r,w,regclient
+type FinishConfiguration struct{}
+
+func (p FinishConfiguration) ID() int32 {
+	return 0x03
+}
+
+// @gen:r,w,regclient
+type ConfigClientboundKeepAlive struct {
+	KeepAliveID int64 `field:"Long"`
+}
+
+func (p ConfigClientboundKeepAlive) ID() int32 {
+	return 0x04
+}
+
+// @gen:r,w,regclient
+type ConfigStoreCookie struct {
+	Key     string `field:"Identifier"`
+	Payload []byte `field:"Cookie"`
+}
+
+func (p ConfigStoreCookie) ID() int32 {
+	return 0x0A
+}
+
+// @gen:r,w,regclient
+type ConfigTransfer struct {
+	Host string `field:"String"`
+	Port int32  `field:"VarInt"`
+}
+
+func (p ConfigTransfer) ID() int32 {
+	return 0x0B
+}
+
+// @gen:r,w,regclient
+type FeatureFlags struct {
+	Flags []string `field:"PrefixedArray" write:"WriteIdentifier" read:"ReadIdentifier"`
+}
+
+func (p FeatureFlags) ID() int32 {
+	return 0x0C
+}
+
+// @gen:r,w,regserver
+type ConfigClientInformation struct {
+	Locale              string `field:"String"`
+	ViewDistance        byte   `field:"Byte"`
+	ChatMode            int32  `field:"VarInt"`
+	ChatColors          bool   `field:"Boolean"`
+	DisplayedSkinParts  byte   `field:"Byte"`
+	MainHand            int32  `field:"VarInt"`
+	EnableTextFiltering bool   `field:"Boolean"`
+	AllowServerListings bool   `field:"Boolean"`
+}
+
+func (p ConfigClientInformation) ID() int32 {
+	return 0x00
+}
+
+// @gen:r,w,regserver
+type ConfigCookieResponse struct {
+	Key     string           `field:"Identifier"`
+	Payload Optional[[]byte] `field:"Optional" inner:"Cookie"`
+}
+
+func (p ConfigCookieResponse) ID() int32 {
+	return 0x01
+}
+
+// @gen:r,w,regserver
+type ConfigServerboundPluginMessage struct {
+	Channel string `field:"Identifier"`
+	Data    []byte `field:"ByteArray"`
+}
+
+func (p ConfigServerboundPluginMessage) ID() int32 {
+	return 0x02
+}
+
+// @gen:r,w,regserver
+type FinishConfigurationAcknowledge struct{}
+
+func (p FinishConfigurationAcknowledge) ID() int32 {
+	return 0x03
+}
+
+// @gen:r,w,regserver
+type ConfigServerboundKeepAlive struct {
+	KeepAliveID int64 `field:"Long"`
+}
+
+func (p ConfigServerboundKeepAlive) ID() int32 {
+	return 0x04
+}
+
+// RegistryEntry is an entry of a registry sent in Registry Data. Data is
+// omitted for entries of a pack known to the client.
+type RegistryEntry struct {
+	ID   string
+	Data Optional[nbt.Compound]
+}
+
+func WriteRegistryEntry(w Writer, v RegistryEntry) (err error) {
+	if err = WriteIdentifier(w, v.ID); err != nil {
+		return
+	}
+	return WriteOptional(w, v.Data, WriteNBTCompound)
+}
+
+func ReadRegistryEntry(r Reader) (v RegistryEntry, err error) {
+	if v.ID, err = ReadIdentifier(r); err != nil {
+		return
+	}
+	v.Data, err = ReadOptional(r, ReadNBTCompound)
+	return
+}
+
+// @gen:r,w,regclient
+type RegistryData struct {
+	Registry string          `field:"Identifier"`
+	Entries  []RegistryEntry `field:"PrefixedArray" write:"WriteRegistryEntry" read:"ReadRegistryEntry"`
+}
+
+func (p RegistryData) ID() int32 {
+	return 0x07
+}
+
+// KnownPack identifies a data pack both ends may have, such as
+// minecraft:core of the game version.
+type KnownPack struct {
+	Namespace string
+	ID        string
+	Version   string
+}
+
+func WriteKnownPack(w Writer, v KnownPack) (err error) {
+	if err = WriteString(w, v.Namespace); err != nil {
+		return
+	}
+	if err = WriteString(w, v.ID); err != nil {
+		return
+	}
+	return WriteString(w, v.Version)
+}
+
+func ReadKnownPack(r Reader) (v KnownPack, err error) {
+	if v.Namespace, err = ReadString(r); err != nil {
+		return
+	}
+	if v.ID, err = ReadString(r); err != nil {
+		return
+	}
+	v.Version, err = ReadString(r)
+	return
+}
+
+// @gen:r,w,regclient
+type ConfigClientboundKnownPacks struct {
+	Packs []KnownPack `field:"PrefixedArray" write:"WriteKnownPack" read:"ReadKnownPack"`
+}
+
+func (p ConfigClientboundKnownPacks) ID() int32 {
+	return 0x0E
+}
+
+// @gen:r,w,regserver
+type ConfigServerboundKnownPacks struct {
+	Packs []KnownPack `field:"PrefixedArray" write:"WriteKnownPack" read:"ReadKnownPack"`
+}
+
+func (p ConfigServerboundKnownPacks) ID() int32 {
+	return 0x07
+}
+
+// Tag is a named set of registry entries, by network ID.
+type Tag struct {
+	Name    string
+	Entries []int32
+}
+
+func WriteTag(w Writer, v Tag) (err error) {
+	if err = WriteIdentifier(w, v.Name); err != nil {
+		return
+	}
+	return WritePrefixedArray(w, v.Entries, WriteVarInt)
+}
+
+func ReadTag(r Reader) (v Tag, err error) {
+	if v.Name, err = ReadIdentifier(r); err != nil {
+		return
+	}
+	v.Entries, err = ReadPrefixedArray(r, ReadVarInt)
+	return
+}
+
+// RegistryTags holds the tags of a registry.
+type RegistryTags struct {
+	Registry string
+	Tags     []Tag
+}
+
+func WriteRegistryTags(w Writer, v RegistryTags) (err error) {
+	if err = WriteIdentifier(w, v.Registry); err != nil {
+		return
+	}
+	return WritePrefixedArray(w, v.Tags, WriteTag)
+}
+
+func ReadRegistryTags(r Reader) (v RegistryTags, err error) {
+	if v.Registry, err = ReadIdentifier(r); err != nil {
+		return
+	}
+	v.Tags, err = ReadPrefixedArray(r, ReadTag)
+	return
+}
+
+// @gen:r,w,regclient
+type ConfigUpdateTags struct {
+	Registries []RegistryTags `field:"PrefixedArray" write:"WriteRegistryTags" read:"ReadRegistryTags"`
+}
+
+func (p ConfigUpdateTags) ID() int32 {
+	return 0x0D
+}
@@ -2,11 +2,14 @@ package packet
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"io"
+	"math"
 
 	"github.com/google/uuid"
+	"github.com/gstoney/mcproto/nbt"
 )
 
 type WriteFn[T any] func(Writer, T) error
@@ -83,6 +86,15 @@ func ReadUnsignedShort(r Reader) (v uint16, err error) {
 	return
 }
 
+func WriteShort(w Writer, v int16) (err error) {
+	return binary.Write(w, binary.BigEndian, v)
+}
+
+func ReadShort(r Reader) (v int16, err error) {
+	u, err := ReadUnsignedShort(r)
+	return int16(u), err
+}
+
 func WriteInt(w Writer, v int32) (err error) {
 	return binary.Write(w, binary.BigEndian, v)
 }
@@ -111,6 +123,34 @@ func ReadLong(r Reader) (v int64, err error) {
 	return
 }
 
+func WriteFloat(w Writer, v float32) (err error) {
+	return binary.Write(w, binary.BigEndian, v)
+}
+
+func ReadFloat(r Reader) (v float32, err error) {
+	b, err := readN(r, 4)
+	if err != nil {
+		return
+	}
+
+	v = math.Float32frombits(binary.BigEndian.Uint32(b))
+	return
+}
+
+func WriteDouble(w Writer, v float64) (err error) {
+	return binary.Write(w, binary.BigEndian, v)
+}
+
+func ReadDouble(r Reader) (v float64, err error) {
+	b, err := readN(r, 8)
+	if err != nil {
+		return
+	}
+
+	v = math.Float64frombits(binary.BigEndian.Uint64(b))
+	return
+}
+
 var ErrVarIntTooLong = errors.New("VarInt is too long")
 
 func WriteVarInt(w Writer, v int32) error {
@@ -158,6 +198,51 @@ func ReadVarInt(r Reader) (int32, error) {
 	return v, ErrVarIntTooLong
 }
 
+var ErrVarLongTooLong = errors.New("VarLong is too long")
+
+func WriteVarLong(w Writer, v int64) error {
+	uv := uint64(v)
+	for {
+		b := byte(uv & 0x7F)
+		uv >>= 7
+
+		if uv != 0 {
+			b |= 0x80
+		}
+
+		if err := w.WriteByte(b); err != nil {
+			return err
+		}
+
+		if uv == 0 {
+			return nil
+		}
+	}
+}
+
+func ReadVarLong(r Reader) (int64, error) {
+	var v int64
+	var shift uint
+
+	for n := 0; n < 10; n++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return v, err
+		}
+
+		v |= int64(b&0x7F) << shift
+		shift += 7
+
+		if (b & 0x80) == 0 {
+			return v, nil
+		}
+	}
+	return v, ErrVarLongTooLong
+}
+
 var ErrNegativeLength = errors.New("negative length")
 
 func WriteString(w Writer, v string) (err error) {
@@ -189,6 +274,117 @@ func ReadString(r Reader) (v string, err error) {
 	return
 }
 
+// Identifier is a namespaced location such as "minecraft:stone",
+// serialized as a String.
+func WriteIdentifier(w Writer, v string) (err error) {
+	return WriteString(w, v)
+}
+
+func ReadIdentifier(r Reader) (v string, err error) {
+	return ReadString(r)
+}
+
+// ByteArray is a byte array whose length is not serialized, but inferred
+// from the packet length. It must be the last field of a packet.
+func WriteByteArray(w Writer, v []byte) (err error) {
+	_, err = w.Write(v)
+	return
+}
+
+// ReadByteArray reads until r is exhausted.
+func ReadByteArray(r Reader) (v []byte, err error) {
+	return io.ReadAll(r)
+}
+
+// PrefixedByteArray is a byte array prefixed with its length as a VarInt.
+func WritePrefixedByteArray(w Writer, v []byte) (err error) {
+	if err = WriteVarInt(w, int32(len(v))); err != nil {
+		return
+	}
+	_, err = w.Write(v)
+	return
+}
+
+func ReadPrefixedByteArray(r Reader) (v []byte, err error) {
+	length, err := ReadVarInt(r)
+	if err != nil {
+		return
+	}
+	if length < 0 {
+		err = ErrNegativeLength
+		return
+	}
+
+	buf, err := readN(r, int(length))
+	// readN may return a view into r's buffer.
+	v = bytes.Clone(buf)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return
+}
+
+// MaxCookieSize is the largest cookie payload clients store and send.
+const MaxCookieSize = 5120
+
+var ErrCookieTooLarge = errors.New("cookie payload exceeds 5 KiB")
+
+// Cookie is a cookie payload, a PrefixedByteArray of at most
+// MaxCookieSize bytes. The length is checked before the payload is read.
+func WriteCookie(w Writer, v []byte) (err error) {
+	if len(v) > MaxCookieSize {
+		return ErrCookieTooLarge
+	}
+	return WritePrefixedByteArray(w, v)
+}
+
+func ReadCookie(r Reader) (v []byte, err error) {
+	length, err := ReadVarInt(r)
+	if err != nil {
+		return
+	}
+	if length < 0 {
+		return nil, ErrNegativeLength
+	}
+	if length > MaxCookieSize {
+		return nil, ErrCookieTooLarge
+	}
+
+	buf, err := readN(r, int(length))
+	v = bytes.Clone(buf)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return
+}
+
+// NBT is a tag in the network NBT format, see nbt.ReadNetwork.
+func WriteNBT(w Writer, v any) (err error) {
+	return nbt.WriteNetwork(w, v)
+}
+
+func ReadNBT(r Reader) (v any, err error) {
+	return nbt.ReadNetwork(r)
+}
+
+var ErrNotCompound = errors.New("NBT tag is not a compound")
+
+func WriteNBTCompound(w Writer, v nbt.Compound) (err error) {
+	return nbt.WriteNetwork(w, v)
+}
+
+func ReadNBTCompound(r Reader) (v nbt.Compound, err error) {
+	tag, err := nbt.ReadNetwork(r)
+	if err != nil {
+		return
+	}
+	v, ok := tag.(nbt.Compound)
+	if !ok {
+		err = ErrNotCompound
+	}
+	return
+}
+
 // Position's serialized form is composed of X, Z which are 26 bits each, and 12 bits of Y.
 // Thus, unintended content can be written when the values are out of range
 type Position struct {
@@ -214,9 +410,10 @@ func ReadPosition(r Reader) (v Position, err error) {
 
 	packed := binary.BigEndian.Uint64(b)
 
-	v.X = int32((packed >> 38) & 0x3FFFFFF)
-	v.Z = int32((packed >> 12) & 0x3FFFFFF)
-	v.Y = int16(packed & 0xFFF)
+	// shift each field to the top and back to sign-extend it
+	v.X = int32(int64(packed) >> 38)
+	v.Z = int32(int64(packed<<26) >> 38)
+	v.Y = int16(int64(packed<<52) >> 52)
 	return
 }
 
@@ -305,3 +502,118 @@ func ReadOptional[T any](r Reader, read ReadFn[T]) (v Optional[T], err error) {
 	}
 	return
 }
+
+// Holder[T] is an entry of a registry, given by ID or defined inline.
+//
+// Serialized Holder[T] is a VarInt of ID+1, or 0 followed by the inline
+// value T.
+type Holder[T any] struct {
+	ID     int32 // ID in the registry, if Inline is nil
+	Inline *T
+}
+
+func WriteHolder[T any](w Writer, v Holder[T], write WriteFn[T]) (err error) {
+	if v.Inline == nil {
+		return WriteVarInt(w, v.ID+1)
+	}
+	if err = WriteVarInt(w, 0); err != nil {
+		return
+	}
+	return write(w, *v.Inline)
+}
+
+func ReadHolder[T any](r Reader, read ReadFn[T]) (v Holder[T], err error) {
+	var id int32
+	if id, err = ReadVarInt(r); err != nil {
+		return
+	}
+	if id != 0 {
+		v.ID = id - 1
+		return
+	}
+
+	var inline T
+	if inline, err = read(r); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return
+	}
+	v.Inline = &inline
+	return
+}
+
+// IDSet is a set of entries of a registry, given by tag or by IDs.
+//
+// Serialized IDSet is a VarInt of len(IDs)+1 followed by the IDs, or 0
+// followed by the tag identifier.
+type IDSet struct {
+	Tag string // Tag identifier, without '#', if IDs is nil
+	IDs []int32
+}
+
+func WriteIDSet(w Writer, v IDSet) (err error) {
+	if v.IDs == nil {
+		if err = WriteVarInt(w, 0); err != nil {
+			return
+		}
+		return WriteIdentifier(w, v.Tag)
+	}
+	if err = WriteVarInt(w, int32(len(v.IDs))+1); err != nil {
+		return
+	}
+	for _, id := range v.IDs {
+		if err = WriteVarInt(w, id); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func ReadIDSet(r Reader) (v IDSet, err error) {
+	var n int32
+	if n, err = ReadVarInt(r); err != nil {
+		return
+	}
+	if n == 0 {
+		v.Tag, err = ReadIdentifier(r)
+		return
+	}
+	if n < 0 {
+		return v, ErrNegativeLength
+	}
+
+	v.IDs = make([]int32, 0, min(n-1, 1024))
+	for range n - 1 {
+		var id int32
+		if id, err = ReadVarInt(r); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return
+		}
+		v.IDs = append(v.IDs, id)
+	}
+	return
+}
+
+// GlobalPos is a position in a dimension.
+type GlobalPos struct {
+	Dimension string // Identifier
+	Position  Position
+}
+
+func WriteGlobalPos(w Writer, v GlobalPos) (err error) {
+	if err = WriteIdentifier(w, v.Dimension); err != nil {
+		return
+	}
+	return WritePosition(w, v.Position)
+}
+
+func ReadGlobalPos(r Reader) (v GlobalPos, err error) {
+	if v.Dimension, err = ReadIdentifier(r); err != nil {
+		return
+	}
+	v.Position, err = ReadPosition(r)
+	return
+}
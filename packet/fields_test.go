@@ -181,6 +181,59 @@ var stringTc = []TestCase[string]{
 	},
 }
 
+func TestFloat(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteFloat(&buf, 1.5); err != nil {
+		t.Fatalf("WriteFloat failed: %v", err)
+	}
+	if err := WriteDouble(&buf, -0.25); err != nil {
+		t.Fatalf("WriteDouble failed: %v", err)
+	}
+	want := []byte{0x3f, 0xc0, 0, 0, 0xbf, 0xd0, 0, 0, 0, 0, 0, 0}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Fatalf("expected %x, got %x", want, buf.Bytes())
+	}
+
+	r := bytes.NewReader(want)
+	if f, err := ReadFloat(r); err != nil || f != 1.5 {
+		t.Errorf("ReadFloat expected 1.5, got %v, %v", f, err)
+	}
+	if d, err := ReadDouble(r); err != nil || d != -0.25 {
+		t.Errorf("ReadDouble expected -0.25, got %v, %v", d, err)
+	}
+	if _, err := ReadDouble(bytes.NewReader(want[:4])); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("ReadDouble expected io.ErrUnexpectedEOF, got %v", err)
+	}
+}
+
+func TestPosition(t *testing.T) {
+	tc := []struct {
+		desc string
+		v    Position
+		ser  []byte
+	}{
+		{"origin", Position{}, []byte{0, 0, 0, 0, 0, 0, 0, 0}},
+		{"mixed signs", Position{X: 18357644, Y: 831, Z: -20882616}, []byte{0x46, 0x07, 0x63, 0x2c, 0x15, 0xb4, 0x83, 0x3f}},
+		{"all -1", Position{X: -1, Y: -1, Z: -1}, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+		{"minimum", Position{X: -1 << 25, Y: -1 << 11, Z: -1 << 25}, []byte{0x80, 0, 0, 0x20, 0, 0, 0x08, 0}},
+		{"maximum", Position{X: 1<<25 - 1, Y: 1<<11 - 1, Z: 1<<25 - 1}, []byte{0x7f, 0xff, 0xff, 0xdf, 0xff, 0xff, 0xf7, 0xff}},
+	}
+	for _, tt := range tc {
+		t.Run(tt.desc, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := WritePosition(&buf, tt.v); err != nil {
+				t.Fatalf("WritePosition failed: %v", err)
+			}
+			if !bytes.Equal(buf.Bytes(), tt.ser) {
+				t.Errorf("expected %x, got %x", tt.ser, buf.Bytes())
+			}
+			if v, err := ReadPosition(&buf); err != nil || v != tt.v {
+				t.Errorf("ReadPosition expected %+v, got %+v, %v", tt.v, v, err)
+			}
+		})
+	}
+}
+
 func TestWriteString(t *testing.T) {
 	buf := bytes.NewBuffer(make([]byte, 0))
 	for _, tC := range stringTc {
@@ -320,6 +373,56 @@ func TestReadPrefixedArray(t *testing.T) {
 	}
 }
 
+func TestWritePrefixedByteArray(t *testing.T) {
+	buf := bytes.NewBuffer(make([]byte, 0))
+	for _, tC := range pArrayTc {
+		if tC.expectErr != nil {
+			continue
+		}
+
+		t.Run(tC.desc, func(t *testing.T) {
+			err := WritePrefixedByteArray(buf, tC.v)
+			if err != nil {
+				t.Fatalf("WritePrefixedByteArray failed: %v", err)
+			}
+
+			if !bytes.Equal(buf.Bytes(), tC.ser) {
+				t.Errorf("WritePrefixedByteArray expected %x, got %x", tC.ser, buf.Bytes())
+			}
+		})
+		buf.Reset()
+	}
+}
+
+func TestReadPrefixedByteArray(t *testing.T) {
+	for _, tC := range pArrayTc {
+		t.Run(tC.desc, func(t *testing.T) {
+			r := bytes.NewReader(tC.ser)
+
+			got, err := ReadPrefixedByteArray(r)
+
+			if tC.expectErr != nil {
+				if !errors.Is(err, tC.expectErr) {
+					t.Errorf("ReadPrefixedByteArray expected error %v, but got error %v", tC.expectErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ReadPrefixedByteArray failed: %v", err)
+			}
+
+			if !bytes.Equal(got, tC.v) {
+				t.Errorf("ReadPrefixedByteArray expected %x, got %x", tC.v, got)
+			}
+
+			if r.Len() != 0 {
+				t.Errorf("Reader did not consume all bytes. %d bytes remaining.", r.Len())
+			}
+		})
+	}
+}
+
 var optionalTc = []TestCase[Optional[byte]]{
 	{
 		desc: "Value is Present",
@@ -401,3 +504,39 @@ func TestReadOptional(t *testing.T) {
 		})
 	}
 }
+
+func TestChatMessage(t *testing.T) {
+	sig := bytes.Repeat([]byte{0xab}, MessageSignatureLen)
+	want := ChatMessage{
+		Message:      "hello",
+		Timestamp:    1700000000000,
+		Salt:         -7,
+		Signature:    Optional[[]byte]{Exists: true, Item: sig},
+		MessageCount: 2,
+		Acknowledged: AcknowledgedMessages{0x03, 0, 0x08},
+	}
+	var buf bytes.Buffer
+	if err := want.Encode(&buf); err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	if id, err := ReadVarInt(&buf); err != nil || id != want.ID() {
+		t.Fatalf("expected ID %d, got %d, %v", want.ID(), id, err)
+	}
+	if n := buf.Len(); n != 1+5+8+8+1+MessageSignatureLen+1+3 {
+		t.Errorf("unexpected length %d", n)
+	}
+
+	var got ChatMessage
+	if err := got.Decode(&buf); err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	if got.Message != want.Message || got.Salt != want.Salt || got.Acknowledged != want.Acknowledged ||
+		!bytes.Equal(got.Signature.Item, sig) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+
+	want.Signature.Item = sig[:8]
+	if err := want.Encode(&buf); !errors.Is(err, ErrSignatureLength) {
+		t.Errorf("expected ErrSignatureLength, got %v", err)
+	}
+}
@@ -24,6 +24,17 @@ func (p EncryptionResponse) ID() int32 {
 	return 1
 }
 
+// @gen:r,w,regserver
+type LoginPluginResponse struct {
+	MessageID  int32  `field:"VarInt"`
+	Successful bool   `field:"Boolean"`
+	Data       []byte `field:"ByteArray"` // Only present if Successful
+}
+
+func (p LoginPluginResponse) ID() int32 {
+	return 2
+}
+
 // @gen:r,w,regserver
 type LoginAcknowledge struct{}
 
@@ -31,6 +42,16 @@ func (p LoginAcknowledge) ID() int32 {
 	return 3
 }
 
+// @gen:r,w,regserver
+type LoginCookieResponse struct {
+	Key     string           `field:"Identifier"`
+	Payload Optional[[]byte] `field:"Optional" inner:"Cookie"`
+}
+
+func (p LoginCookieResponse) ID() int32 {
+	return 4
+}
+
 // @gen:r,w,regclient
 type LoginDisconnect struct {
 	Reason string `field:"String"` // JSON Text Component
@@ -52,13 +73,15 @@ func (p EncryptionRequest) ID() int32 {
 	return 1
 }
 
-type gameProfileProperty struct {
+// GameProfileProperty is a property of a player profile, such as
+// "textures" holding the skin.
+type GameProfileProperty struct {
 	Name      string
 	Value     string
 	Signature Optional[string]
 }
 
-func writeGameProfileProperty(w Writer, v gameProfileProperty) (err error) {
+func WriteGameProfileProperty(w Writer, v GameProfileProperty) (err error) {
 	if err = WriteString(w, v.Name); err != nil {
 		return
 	}
@@ -69,7 +92,7 @@ func writeGameProfileProperty(w Writer, v gameProfileProperty) (err error) {
 	return
 }
 
-func readGameProfileProperty(r Reader) (v gameProfileProperty, err error) {
+func ReadGameProfileProperty(r Reader) (v GameProfileProperty, err error) {
 	v.Name, err = ReadString(r)
 	if err != nil {
 		return
@@ -86,7 +109,7 @@ func readGameProfileProperty(r Reader) (v gameProfileProperty, err error) {
 type LoginSuccess struct {
 	UUID              uuid.UUID             `field:"UUID"`
 	Username          string                `field:"String"`
-	Properties        []gameProfileProperty `field:"PrefixedArray" write:"writeGameProfileProperty" read:"readGameProfileProperty"`
+	Properties        []GameProfileProperty `field:"PrefixedArray" write:"WriteGameProfileProperty" read:"ReadGameProfileProperty"`
 	StrictErrHandling bool                  `field:"Boolean"`
 }
 
@@ -102,3 +125,23 @@ type SetCompression struct {
 func (p SetCompression) ID() int32 {
 	return 3
 }
+
+// @gen:r,w,regclient
+type LoginPluginRequest struct {
+	MessageID int32  `field:"VarInt"`
+	Channel   string `field:"Identifier"`
+	Data      []byte `field:"ByteArray"`
+}
+
+func (p LoginPluginRequest) ID() int32 {
+	return 4
+}
+
+// @gen:r,w,regclient
+type LoginCookieRequest struct {
+	Key string `field:"Identifier"`
+}
+
+func (p LoginCookieRequest) ID() int32 {
+	return 5
+}
@@ -0,0 +1,277 @@
+package packet
+
+import (
+	"errors"
+	"io"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUnknownMetadataType = errors.New("unknown entity metadata type")
+	ErrMetadataValue       = errors.New("entity metadata value does not match its type")
+)
+
+// Entity metadata types, the entity data serializers of 1.21.1.
+const (
+	MetadataByte int32 = iota
+	MetadataVarInt
+	MetadataVarLong
+	MetadataFloat
+	MetadataString
+	MetadataText
+	MetadataOptionalText
+	MetadataSlot
+	MetadataBoolean
+	MetadataRotations
+	MetadataPosition
+	MetadataOptionalPosition
+	MetadataDirection
+	MetadataOptionalUUID
+	MetadataBlockState
+	MetadataOptionalBlockState
+	MetadataNBT
+	MetadataParticle
+	MetadataParticles
+	MetadataVillagerData
+	MetadataOptionalVarInt
+	MetadataPose
+	MetadataCatVariant
+	MetadataWolfVariant
+	MetadataFrogVariant
+	MetadataOptionalGlobalPos
+	MetadataPaintingVariant
+	MetadataSnifferState
+	MetadataArmadilloState
+	MetadataVector3
+	MetadataQuaternion
+)
+
+// metadataEnd is the index ending entity metadata.
+const metadataEnd = 0xff
+
+// MetadataEntry is a value of the metadata of an entity, such as the item
+// shown by an item frame. The Go type of Value depends on Type:
+//
+//	Byte                            byte
+//	VarInt, Direction, Pose         int32
+//	BlockState, OptionalBlockState  int32, 0 for none with the latter
+//	CatVariant, WolfVariant, ...    int32, ID in the registry
+//	SnifferState, ArmadilloState    int32
+//	VarLong                         int64
+//	Float                           float32
+//	String                          string
+//	Text, NBT                       any, an NBT tag
+//	OptionalText                    Optional[any]
+//	Slot                            Slot
+//	Boolean                         bool
+//	Rotations, Vector3              [3]float32
+//	Quaternion                      [4]float32
+//	Position                        Position
+//	OptionalPosition                Optional[Position]
+//	OptionalUUID                    Optional[uuid.UUID]
+//	VillagerData                    VillagerData
+//	OptionalVarInt                  Optional[int32]
+//	OptionalGlobalPos               Optional[GlobalPos]
+//	Particle, Particles             []byte, encoded beforehand
+//
+// As particles are not length prefixed, metadata holding them can be
+// written but is never read.
+type MetadataEntry struct {
+	Index uint8
+	Type  int32
+	Value any
+}
+
+// EntityMetadata are the entries of the metadata of an entity, or those
+// changed.
+type EntityMetadata []MetadataEntry
+
+// VillagerData is the look of a villager or zombie villager.
+type VillagerData struct {
+	Type       int32 // ID in the minecraft:villager_type registry
+	Profession int32 // ID in the minecraft:villager_profession registry
+	Level      int32
+}
+
+func WriteVillagerData(w Writer, v VillagerData) (err error) {
+	for _, i := range []int32{v.Type, v.Profession, v.Level} {
+		if err = WriteVarInt(w, i); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func ReadVillagerData(r Reader) (v VillagerData, err error) {
+	for _, i := range []*int32{&v.Type, &v.Profession, &v.Level} {
+		if *i, err = ReadVarInt(r); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func WriteEntityMetadata(w Writer, v EntityMetadata) (err error) {
+	for _, e := range v {
+		c, ok := metadataCodecs[e.Type]
+		if !ok || e.Index == metadataEnd {
+			return ErrUnknownMetadataType
+		}
+		if err = w.WriteByte(e.Index); err != nil {
+			return
+		}
+		if err = WriteVarInt(w, e.Type); err != nil {
+			return
+		}
+		if err = c.write(w, e.Value); err != nil {
+			return
+		}
+	}
+	return w.WriteByte(metadataEnd)
+}
+
+// ReadEntityMetadata reads entity metadata. Metadata holding particles,
+// or values of a type unknown to 1.21.1, fails with
+// ErrUnknownMetadataType.
+func ReadEntityMetadata(r Reader) (v EntityMetadata, err error) {
+	for {
+		var e MetadataEntry
+		if e.Index, err = r.ReadByte(); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return
+		}
+		if e.Index == metadataEnd {
+			return
+		}
+		if e.Type, err = ReadVarInt(r); err != nil {
+			return
+		}
+		c, ok := metadataCodecs[e.Type]
+		if !ok || c.read == nil {
+			return v, ErrUnknownMetadataType
+		}
+		if e.Value, err = c.read(r); err != nil {
+			return
+		}
+		v = append(v, e)
+	}
+}
+
+// metadataCodec writes and reads the values of a metadata type, read
+// being nil for types that cannot be read.
+type metadataCodec struct {
+	write func(Writer, any) error
+	read  func(Reader) (any, error)
+}
+
+func metadataCodecOf[T any](write WriteFn[T], read ReadFn[T]) metadataCodec {
+	c := metadataCodec{write: func(w Writer, v any) error {
+		t, ok := v.(T)
+		if !ok {
+			return ErrMetadataValue
+		}
+		return write(w, t)
+	}}
+	if read != nil {
+		c.read = func(r Reader) (any, error) { return read(r) }
+	}
+	return c
+}
+
+func writeFloats(w Writer, v []float32) error {
+	for _, f := range v {
+		if err := WriteFloat(w, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readFloats(r Reader, v []float32) (err error) {
+	for i := range v {
+		if v[i], err = ReadFloat(r); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func writeVector3(w Writer, v [3]float32) error { return writeFloats(w, v[:]) }
+func readVector3(r Reader) (v [3]float32, err error) {
+	err = readFloats(r, v[:])
+	return
+}
+
+func writeQuaternion(w Writer, v [4]float32) error { return writeFloats(w, v[:]) }
+func readQuaternion(r Reader) (v [4]float32, err error) {
+	err = readFloats(r, v[:])
+	return
+}
+
+// writeOptionalVarInt writes v as a VarInt of v+1, or 0 if absent.
+func writeOptionalVarInt(w Writer, v Optional[int32]) error {
+	if !v.Exists {
+		return WriteVarInt(w, 0)
+	}
+	return WriteVarInt(w, v.Item+1)
+}
+
+func readOptionalVarInt(r Reader) (v Optional[int32], err error) {
+	var i int32
+	if i, err = ReadVarInt(r); err != nil || i == 0 {
+		return
+	}
+	return Optional[int32]{Exists: true, Item: i - 1}, nil
+}
+
+var metadataCodecs map[int32]metadataCodec
+
+func init() {
+	varInt := metadataCodecOf(WriteVarInt, ReadVarInt)
+	metadataCodecs = map[int32]metadataCodec{
+		MetadataByte:               metadataCodecOf(WriteByte, ReadByte),
+		MetadataVarInt:             varInt,
+		MetadataVarLong:            metadataCodecOf(WriteVarLong, ReadVarLong),
+		MetadataFloat:              metadataCodecOf(WriteFloat, ReadFloat),
+		MetadataString:             metadataCodecOf(WriteString, ReadString),
+		MetadataText:               metadataCodecOf(WriteNBT, ReadNBT),
+		MetadataSlot:               metadataCodecOf(WriteSlot, ReadSlot),
+		MetadataBoolean:            metadataCodecOf(WriteBoolean, ReadBoolean),
+		MetadataRotations:          metadataCodecOf(writeVector3, readVector3),
+		MetadataPosition:           metadataCodecOf(WritePosition, ReadPosition),
+		MetadataDirection:          varInt,
+		MetadataBlockState:         varInt,
+		MetadataOptionalBlockState: varInt,
+		MetadataNBT:                metadataCodecOf(WriteNBT, ReadNBT),
+		MetadataParticle:           metadataCodecOf(WriteByteArray, nil),
+		MetadataParticles:          metadataCodecOf(WriteByteArray, nil),
+
+		MetadataVillagerData:    metadataCodecOf(WriteVillagerData, ReadVillagerData),
+		MetadataOptionalVarInt:  metadataCodecOf(writeOptionalVarInt, readOptionalVarInt),
+		MetadataPose:            varInt,
+		MetadataCatVariant:      varInt,
+		MetadataWolfVariant:     varInt,
+		MetadataFrogVariant:     varInt,
+		MetadataPaintingVariant: varInt,
+		MetadataSnifferState:    varInt,
+		MetadataArmadilloState:  varInt,
+		MetadataVector3:         metadataCodecOf(writeVector3, readVector3),
+		MetadataQuaternion:      metadataCodecOf(writeQuaternion, readQuaternion),
+
+		MetadataOptionalText: metadataCodecOf(
+			func(w Writer, v Optional[any]) error { return WriteOptional(w, v, WriteNBT) },
+			func(r Reader) (Optional[any], error) { return ReadOptional(r, ReadNBT) }),
+		MetadataOptionalPosition: metadataCodecOf(
+			func(w Writer, v Optional[Position]) error { return WriteOptional(w, v, WritePosition) },
+			func(r Reader) (Optional[Position], error) { return ReadOptional(r, ReadPosition) }),
+		MetadataOptionalUUID: metadataCodecOf(
+			func(w Writer, v Optional[uuid.UUID]) error { return WriteOptional(w, v, WriteUUID) },
+			func(r Reader) (Optional[uuid.UUID], error) { return ReadOptional(r, ReadUUID) }),
+		MetadataOptionalGlobalPos: metadataCodecOf(
+			func(w Writer, v Optional[GlobalPos]) error { return WriteOptional(w, v, WriteGlobalPos) },
+			func(r Reader) (Optional[GlobalPos], error) { return ReadOptional(r, ReadGlobalPos) }),
+	}
+}
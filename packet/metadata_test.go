@@ -0,0 +1,58 @@
+package packet
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestSetEntityMetadata(t *testing.T) {
+	want := SetEntityMetadata{EntityID: 42, Metadata: EntityMetadata{
+		{0, MetadataByte, byte(0x20)},
+		{1, MetadataVarInt, int32(300)},
+		{2, MetadataOptionalText, Optional[any]{}},
+		{8, MetadataSlot, Slot{Count: 1, ItemID: 800, Add: []Component{Damage{5}}}},
+		{9, MetadataBoolean, true},
+		{10, MetadataOptionalPosition, Optional[Position]{Exists: true, Item: Position{X: -5, Y: -64, Z: 7}}},
+		{11, MetadataOptionalUUID, Optional[uuid.UUID]{Exists: true, Item: uuid.MustParse("069a79f4-44e9-4726-a5be-fca90e38aaf5")}},
+		{12, MetadataVillagerData, VillagerData{Type: 2, Profession: 5, Level: 3}},
+		{13, MetadataOptionalVarInt, Optional[int32]{Exists: true, Item: 0}},
+		{14, MetadataOptionalGlobalPos, Optional[GlobalPos]{}},
+		{15, MetadataQuaternion, [4]float32{0, 0, 0, 1}},
+	}}
+	var buf bytes.Buffer
+	if err := want.Encode(&buf); err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	if id, err := ReadVarInt(&buf); err != nil || id != want.ID() {
+		t.Fatalf("expected ID %d, got %d, %v", want.ID(), id, err)
+	}
+	var got SetEntityMetadata
+	if err := got.Decode(&buf); err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestEntityMetadata_Errors(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteEntityMetadata(&buf, EntityMetadata{{0, MetadataSlot, int32(1)}}); !errors.Is(err, ErrMetadataValue) {
+		t.Errorf("mismatched value: expected %v, got %v", ErrMetadataValue, err)
+	}
+	if err := WriteEntityMetadata(&buf, EntityMetadata{{0, MetadataQuaternion + 1, nil}}); !errors.Is(err, ErrUnknownMetadataType) {
+		t.Errorf("unknown type: expected %v, got %v", ErrUnknownMetadataType, err)
+	}
+
+	buf.Reset()
+	if err := WriteEntityMetadata(&buf, EntityMetadata{{3, MetadataParticle, []byte{0x01}}}); err != nil {
+		t.Fatalf("WriteEntityMetadata failed: %v", err)
+	}
+	if _, err := ReadEntityMetadata(&buf); !errors.Is(err, ErrUnknownMetadataType) {
+		t.Errorf("particle: expected %v, got %v", ErrUnknownMetadataType, err)
+	}
+}
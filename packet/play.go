@@ -0,0 +1,592 @@
+package packet
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/gstoney/mcproto/nbt"
+)
+
+// @gen:r,w,regclient
+type PlayClientboundKeepAlive struct {
+	KeepAliveID int64 `field:"Long"`
+}
+
+func (p PlayClientboundKeepAlive) ID() int32 {
+	return 0x26
+}
+
+// @gen:r,w,regclient
+type StartConfiguration struct{}
+
+func (p StartConfiguration) ID() int32 {
+	return 0x69
+}
+
+// @gen:r,w,regserver
+type StartConfigurationAcknowledge struct{}
+
+func (p StartConfigurationAcknowledge) ID() int32 {
+	return 0x0C
+}
+
+// @gen:r,w,regserver
+type PlayServerboundKeepAlive struct {
+	KeepAliveID int64 `field:"Long"`
+}
+
+func (p PlayServerboundKeepAlive) ID() int32 {
+	return 0x18
+}
+
+// @gen:r,w,regclient
+type PlayCookieRequest struct {
+	Key string `field:"Identifier"`
+}
+
+func (p PlayCookieRequest) ID() int32 {
+	return 0x16
+}
+
+// @gen:r,w,regclient
+type PlayClientboundPluginMessage struct {
+	Channel string `field:"Identifier"`
+	Data    []byte `field:"ByteArray"`
+}
+
+func (p PlayClientboundPluginMessage) ID() int32 {
+	return 0x19
+}
+
+// @gen:r,w,regserver
+type PlayServerboundPluginMessage struct {
+	Channel string `field:"Identifier"`
+	Data    []byte `field:"ByteArray"`
+}
+
+func (p PlayServerboundPluginMessage) ID() int32 {
+	return 0x12
+}
+
+// @gen:r,w,regclient
+type PlayStoreCookie struct {
+	Key     string `field:"Identifier"`
+	Payload []byte `field:"Cookie"`
+}
+
+func (p PlayStoreCookie) ID() int32 {
+	return 0x6B
+}
+
+// @gen:r,w,regclient
+type PlayTransfer struct {
+	Host string `field:"String"`
+	Port int32  `field:"VarInt"`
+}
+
+func (p PlayTransfer) ID() int32 {
+	return 0x73
+}
+
+// @gen:r,w,regserver
+type PlayClientInformation struct {
+	Locale              string `field:"String"`
+	ViewDistance        byte   `field:"Byte"`
+	ChatMode            int32  `field:"VarInt"`
+	ChatColors          bool   `field:"Boolean"`
+	DisplayedSkinParts  byte   `field:"Byte"`
+	MainHand            int32  `field:"VarInt"`
+	EnableTextFiltering bool   `field:"Boolean"`
+	AllowServerListings bool   `field:"Boolean"`
+}
+
+func (p PlayClientInformation) ID() int32 {
+	return 0x0A
+}
+
+// @gen:r,w,regserver
+type PlayCookieResponse struct {
+	Key     string           `field:"Identifier"`
+	Payload Optional[[]byte] `field:"Optional" inner:"Cookie"`
+}
+
+func (p PlayCookieResponse) ID() int32 {
+	return 0x11
+}
+
+// @gen:r,w,regclient
+type PlayUpdateTags struct {
+	Registries []RegistryTags `field:"PrefixedArray" write:"WriteRegistryTags" read:"ReadRegistryTags"`
+}
+
+func (p PlayUpdateTags) ID() int32 {
+	return 0x78
+}
+
+// @gen:r,w,regclient
+type PlayDisconnect struct {
+	Reason any `field:"NBT"` // Text component
+}
+
+func (p PlayDisconnect) ID() int32 {
+	return 0x1D
+}
+
+// @gen:r,w,regclient
+type PlayRemoveResourcePack struct {
+	UUID Optional[uuid.UUID] `field:"Optional" write:"WriteUUID" read:"ReadUUID"` // All packs if absent
+}
+
+func (p PlayRemoveResourcePack) ID() int32 {
+	return 0x45
+}
+
+// @gen:r,w,regclient
+type PlayAddResourcePack struct {
+	UUID          uuid.UUID     `field:"UUID"`
+	URL           string        `field:"String"`
+	Hash          string        `field:"String"` // Hex SHA-1 of the pack
+	Forced        bool          `field:"Boolean"`
+	PromptMessage Optional[any] `field:"Optional" write:"WriteNBT" read:"ReadNBT"` // Text component
+}
+
+func (p PlayAddResourcePack) ID() int32 {
+	return 0x46
+}
+
+// @gen:r,w,regserver
+type PlayResourcePackResponse struct {
+	UUID   uuid.UUID `field:"UUID"`
+	Result int32     `field:"VarInt"`
+}
+
+func (p PlayResourcePackResponse) ID() int32 {
+	return 0x2B
+}
+
+// DeathLocation is the dimension and position where a player last died.
+type DeathLocation struct {
+	DimensionName string
+	Location      Position
+}
+
+func writeDeathLocation(w Writer, v DeathLocation) (err error) {
+	if err = WriteString(w, v.DimensionName); err != nil {
+		return
+	}
+	err = WritePosition(w, v.Location)
+	return
+}
+
+func readDeathLocation(r Reader) (v DeathLocation, err error) {
+	if v.DimensionName, err = ReadString(r); err != nil {
+		return
+	}
+	v.Location, err = ReadPosition(r)
+	return
+}
+
+// @gen:r,w,regclient
+type PlayLogin struct {
+	EntityID            int32                   `field:"Int"`
+	IsHardcore          bool                    `field:"Boolean"`
+	DimensionNames      []string                `field:"PrefixedArray" inner:"String"`
+	MaxPlayers          int32                   `field:"VarInt"`
+	ViewDistance        int32                   `field:"VarInt"`
+	SimulationDistance  int32                   `field:"VarInt"`
+	ReducedDebugInfo    bool                    `field:"Boolean"`
+	EnableRespawnScreen bool                    `field:"Boolean"`
+	DoLimitedCrafting   bool                    `field:"Boolean"`
+	DimensionType       int32                   `field:"VarInt"`
+	DimensionName       string                  `field:"String"`
+	HashedSeed          int64                   `field:"Long"`
+	GameMode            byte                    `field:"Byte"`
+	PreviousGameMode    byte                    `field:"Byte"` // 0xFF if none
+	IsDebug             bool                    `field:"Boolean"`
+	IsFlat              bool                    `field:"Boolean"`
+	DeathLocation       Optional[DeathLocation] `field:"Optional" write:"writeDeathLocation" read:"readDeathLocation"`
+	PortalCooldown      int32                   `field:"VarInt"`
+	EnforcesSecureChat  bool                    `field:"Boolean"`
+}
+
+func (p PlayLogin) ID() int32 {
+	return 0x2B
+}
+
+// BlockEntity is a block entity sent with a chunk, such as a sign.
+type BlockEntity struct {
+	XZ   byte // X<<4 | Z, within the chunk
+	Y    int16
+	Type int32 // minecraft:block_entity_type network ID
+	Data nbt.Compound
+}
+
+func WriteBlockEntity(w Writer, v BlockEntity) (err error) {
+	if err = w.WriteByte(v.XZ); err != nil {
+		return
+	}
+	if err = WriteUnsignedShort(w, uint16(v.Y)); err != nil {
+		return
+	}
+	if err = WriteVarInt(w, v.Type); err != nil {
+		return
+	}
+	return WriteNBTCompound(w, v.Data)
+}
+
+func ReadBlockEntity(r Reader) (v BlockEntity, err error) {
+	if v.XZ, err = r.ReadByte(); err != nil {
+		return
+	}
+	var y uint16
+	if y, err = ReadUnsignedShort(r); err != nil {
+		return
+	}
+	v.Y = int16(y)
+	if v.Type, err = ReadVarInt(r); err != nil {
+		return
+	}
+	v.Data, err = ReadNBTCompound(r)
+	return
+}
+
+// LightData holds the light of a chunk column. Bit i of the masks is the
+// section i from the one below the world to the one above it; each set
+// bit of SkyLightMask and BlockLightMask has a 2048-byte nibble array.
+type LightData struct {
+	SkyLightMask        []int64 // BitSet
+	BlockLightMask      []int64
+	EmptySkyLightMask   []int64
+	EmptyBlockLightMask []int64
+	SkyLight            [][]byte
+	BlockLight          [][]byte
+}
+
+func WriteLightData(w Writer, v LightData) (err error) {
+	for _, mask := range [][]int64{v.SkyLightMask, v.BlockLightMask, v.EmptySkyLightMask, v.EmptyBlockLightMask} {
+		if err = WritePrefixedArray(w, mask, WriteLong); err != nil {
+			return
+		}
+	}
+	if err = WritePrefixedArray(w, v.SkyLight, WritePrefixedByteArray); err != nil {
+		return
+	}
+	return WritePrefixedArray(w, v.BlockLight, WritePrefixedByteArray)
+}
+
+func ReadLightData(r Reader) (v LightData, err error) {
+	for _, mask := range []*[]int64{&v.SkyLightMask, &v.BlockLightMask, &v.EmptySkyLightMask, &v.EmptyBlockLightMask} {
+		if *mask, err = ReadPrefixedArray(r, ReadLong); err != nil {
+			return
+		}
+	}
+	if v.SkyLight, err = ReadPrefixedArray(r, ReadPrefixedByteArray); err != nil {
+		return
+	}
+	v.BlockLight, err = ReadPrefixedArray(r, ReadPrefixedByteArray)
+	return
+}
+
+// @gen:r,w,regclient
+type ChunkDataAndUpdateLight struct {
+	ChunkX        int32         `field:"Int"`
+	ChunkZ        int32         `field:"Int"`
+	Heightmaps    nbt.Compound  `field:"NBTCompound"`
+	Data          []byte        `field:"PrefixedByteArray"` // Sections, see package chunk
+	BlockEntities []BlockEntity `field:"PrefixedArray" write:"WriteBlockEntity" read:"ReadBlockEntity"`
+	Light         LightData     `field:"LightData"`
+}
+
+func (p ChunkDataAndUpdateLight) ID() int32 {
+	return 0x27
+}
+
+// @gen:r,w,regclient
+type UpdateLight struct {
+	ChunkX int32     `field:"VarInt"`
+	ChunkZ int32     `field:"VarInt"`
+	Light  LightData `field:"LightData"`
+}
+
+func (p UpdateLight) ID() int32 {
+	return 0x2A
+}
+
+// @gen:r,w,regclient
+type ChunkBatchStart struct{}
+
+func (p ChunkBatchStart) ID() int32 {
+	return 0x0D
+}
+
+// @gen:r,w,regclient
+type ChunkBatchFinished struct {
+	BatchSize int32 `field:"VarInt"` // Chunks sent since Chunk Batch Start
+}
+
+func (p ChunkBatchFinished) ID() int32 {
+	return 0x0C
+}
+
+// @gen:r,w,regserver
+type ChunkBatchReceived struct {
+	ChunksPerTick float32 `field:"Float"` // Rate the client wants chunks at
+}
+
+func (p ChunkBatchReceived) ID() int32 {
+	return 0x08
+}
+
+// @gen:r,w,regclient
+type UnloadChunk struct {
+	ChunkZ int32 `field:"Int"`
+	ChunkX int32 `field:"Int"`
+}
+
+func (p UnloadChunk) ID() int32 {
+	return 0x21
+}
+
+// @gen:r,w,regclient
+type SetCenterChunk struct {
+	ChunkX int32 `field:"VarInt"`
+	ChunkZ int32 `field:"VarInt"`
+}
+
+func (p SetCenterChunk) ID() int32 {
+	return 0x54
+}
+
+// @gen:r,w,regclient
+type SetRenderDistance struct {
+	ViewDistance int32 `field:"VarInt"`
+}
+
+func (p SetRenderDistance) ID() int32 {
+	return 0x55
+}
+
+// @gen:r,w,regserver
+type SetPlayerPosition struct {
+	X        float64 `field:"Double"`
+	FeetY    float64 `field:"Double"`
+	Z        float64 `field:"Double"`
+	OnGround bool    `field:"Boolean"`
+}
+
+func (p SetPlayerPosition) ID() int32 {
+	return 0x1A
+}
+
+// @gen:r,w,regserver
+type SetPlayerPositionAndRotation struct {
+	X        float64 `field:"Double"`
+	FeetY    float64 `field:"Double"`
+	Z        float64 `field:"Double"`
+	Yaw      float32 `field:"Float"`
+	Pitch    float32 `field:"Float"`
+	OnGround bool    `field:"Boolean"`
+}
+
+func (p SetPlayerPositionAndRotation) ID() int32 {
+	return 0x1B
+}
+
+// @gen:r,w,regserver
+type SetPlayerRotation struct {
+	Yaw      float32 `field:"Float"`
+	Pitch    float32 `field:"Float"`
+	OnGround bool    `field:"Boolean"`
+}
+
+func (p SetPlayerRotation) ID() int32 {
+	return 0x1C
+}
+
+// @gen:r,w,regserver
+type SetPlayerOnGround struct {
+	OnGround bool `field:"Boolean"`
+}
+
+func (p SetPlayerOnGround) ID() int32 {
+	return 0x1D
+}
+
+// Flags of Synchronize Player Position, marking the fields relative to
+// the current position and rotation of the player.
+const (
+	RelativeX byte = 1 << iota
+	RelativeY
+	RelativeZ
+	RelativeYaw
+	RelativePitch
+)
+
+// @gen:r,w,regclient
+type SynchronizePlayerPosition struct {
+	X          float64 `field:"Double"`
+	Y          float64 `field:"Double"`
+	Z          float64 `field:"Double"`
+	Yaw        float32 `field:"Float"`
+	Pitch      float32 `field:"Float"`
+	Flags      byte    `field:"Byte"`
+	TeleportID int32   `field:"VarInt"` // Echoed by Confirm Teleportation
+}
+
+func (p SynchronizePlayerPosition) ID() int32 {
+	return 0x40
+}
+
+// @gen:r,w,regserver
+type ConfirmTeleportation struct {
+	TeleportID int32 `field:"VarInt"`
+}
+
+func (p ConfirmTeleportation) ID() int32 {
+	return 0x00
+}
+
+// Events of Game Event.
+const (
+	GameEventChangeGameMode        byte = 3
+	GameEventStartWaitingForChunks byte = 13
+)
+
+// @gen:r,w,regclient
+type GameEvent struct {
+	Event byte    `field:"Byte"`
+	Value float32 `field:"Float"`
+}
+
+func (p GameEvent) ID() int32 {
+	return 0x22
+}
+
+// @gen:r,w,regclient
+type SetDefaultSpawnPosition struct {
+	Location Position `field:"Position"`
+	Angle    float32  `field:"Float"`
+}
+
+func (p SetDefaultSpawnPosition) ID() int32 {
+	return 0x56
+}
+
+// @gen:r,w,regclient
+type SystemChatMessage struct {
+	Content any  `field:"NBT"`     // Text component
+	Overlay bool `field:"Boolean"` // Shown above the hotbar rather than in chat
+}
+
+func (p SystemChatMessage) ID() int32 {
+	return 0x6C
+}
+
+// MessageSignatureLen is the length of chat message signatures.
+const MessageSignatureLen = 256
+
+var ErrSignatureLength = errors.New("message signature is not 256 bytes")
+
+func writeMessageSignature(w Writer, v []byte) (err error) {
+	if len(v) != MessageSignatureLen {
+		return ErrSignatureLength
+	}
+	_, err = w.Write(v)
+	return
+}
+
+func readMessageSignature(r Reader) (v []byte, err error) {
+	buf, err := readN(r, MessageSignatureLen)
+	v = bytes.Clone(buf)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return
+}
+
+// AcknowledgedMessages is a bit set of the last 20 chat messages seen by
+// the client.
+type AcknowledgedMessages [3]byte
+
+func WriteAcknowledgedMessages(w Writer, v AcknowledgedMessages) (err error) {
+	_, err = w.Write(v[:])
+	return
+}
+
+func ReadAcknowledgedMessages(r Reader) (v AcknowledgedMessages, err error) {
+	buf, err := readN(r, len(v))
+	copy(v[:], buf)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return
+}
+
+// @gen:r,w,regserver
+type ChatMessage struct {
+	Message      string               `field:"String"`
+	Timestamp    int64                `field:"Long"` // Milliseconds since the epoch
+	Salt         int64                `field:"Long"`
+	Signature    Optional[[]byte]     `field:"Optional" write:"writeMessageSignature" read:"readMessageSignature"`
+	MessageCount int32                `field:"VarInt"`
+	Acknowledged AcknowledgedMessages `field:"AcknowledgedMessages"`
+}
+
+func (p ChatMessage) ID() int32 {
+	return 0x06
+}
+
+// @gen:r,w,regserver
+type ChatCommand struct {
+	Command string `field:"String"` // Without the leading slash
+}
+
+func (p ChatCommand) ID() int32 {
+	return 0x04
+}
+
+// @gen:r,w,regclient
+type SetContainerContent struct {
+	WindowID    byte   `field:"Byte"` // 0 for the player inventory
+	StateID     int32  `field:"VarInt"`
+	Slots       []Slot `field:"PrefixedArray" write:"WriteSlot" read:"ReadSlot"`
+	CarriedItem Slot   `field:"Slot"`
+}
+
+func (p SetContainerContent) ID() int32 {
+	return 0x13
+}
+
+// @gen:r,w,regclient
+type SetContainerSlot struct {
+	WindowID byte  `field:"Byte"`
+	StateID  int32 `field:"VarInt"`
+	Slot     int16 `field:"Short"`
+	SlotData Slot  `field:"Slot"`
+}
+
+func (p SetContainerSlot) ID() int32 {
+	return 0x15
+}
+
+// @gen:r,w,regserver
+type SetCreativeModeSlot struct {
+	Slot        int16 `field:"Short"`
+	ClickedItem Slot  `field:"Slot"`
+}
+
+func (p SetCreativeModeSlot) ID() int32 {
+	return 0x32
+}
+
+// @gen:r,w,regclient
+type SetEntityMetadata struct {
+	EntityID int32          `field:"VarInt"`
+	Metadata EntityMetadata `field:"EntityMetadata"`
+}
+
+func (p SetEntityMetadata) ID() int32 {
+	return 0x58
+}
@@ -0,0 +1,1700 @@
+package packet
+
+import (
+	"errors"
+	"io"
+
+	"github.com/google/uuid"
+	"github.com/gstoney/mcproto/nbt"
+)
+
+var (
+	ErrUnknownComponent = errors.New("unknown data component type")
+	ErrSlotTooDeep      = errors.New("item stacks nested too deep")
+	ErrComponentNBT     = errors.New("unexpected NBT tag in data component")
+	ErrEffectTooDeep    = errors.New("hidden effects nested too deep")
+)
+
+// Data component types, the entries of the minecraft:data_component_type
+// registry in 1.21.1.
+const (
+	ComponentCustomData int32 = iota
+	ComponentMaxStackSize
+	ComponentMaxDamage
+	ComponentDamage
+	ComponentUnbreakable
+	ComponentCustomName
+	ComponentItemName
+	ComponentLore
+	ComponentRarity
+	ComponentEnchantments
+	ComponentCanPlaceOn
+	ComponentCanBreak
+	ComponentAttributeModifiers
+	ComponentCustomModelData
+	ComponentHideAdditionalTooltip
+	ComponentHideTooltip
+	ComponentRepairCost
+	ComponentCreativeSlotLock
+	ComponentEnchantmentGlintOverride
+	ComponentIntangibleProjectile
+	ComponentFood
+	ComponentFireResistant
+	ComponentTool
+	ComponentStoredEnchantments
+	ComponentDyedColor
+	ComponentMapColor
+	ComponentMapID
+	ComponentMapDecorations
+	ComponentMapPostProcessing
+	ComponentChargedProjectiles
+	ComponentBundleContents
+	ComponentPotionContents
+	ComponentSuspiciousStewEffects
+	ComponentWritableBookContent
+	ComponentWrittenBookContent
+	ComponentTrim
+	ComponentDebugStickState
+	ComponentEntityData
+	ComponentBucketEntityData
+	ComponentBlockEntityData
+	ComponentInstrument
+	ComponentOminousBottleAmplifier
+	ComponentJukeboxPlayable
+	ComponentRecipes
+	ComponentLodestoneTracker
+	ComponentFireworkExplosion
+	ComponentFireworks
+	ComponentProfile
+	ComponentNoteBlockSound
+	ComponentBannerPatterns
+	ComponentBaseColor
+	ComponentPotDecorations
+	ComponentContainer
+	ComponentBlockState
+	ComponentBees
+	ComponentLock
+	ComponentContainerLoot
+)
+
+// maxSlotDepth bounds the nesting of item stacks in components such as
+// container.
+const maxSlotDepth = 16
+
+// Slot is an item stack, empty if Count is 0.
+//
+// Add holds the components set on the stack, on top of the defaults of
+// the item, and Remove the types of default components taken off it.
+// An Opaque component in Add is written as is, but ReadSlot never
+// returns one: components it has no codec for fail the read.
+type Slot struct {
+	Count  int32
+	ItemID int32 // ID in the minecraft:item registry
+	Add    []Component
+	Remove []int32
+}
+
+// Component returns the added component of type typ, if any.
+func (s Slot) Component(typ int32) (Component, bool) {
+	for _, c := range s.Add {
+		if c.ComponentType() == typ {
+			return c, true
+		}
+	}
+	return nil, false
+}
+
+func WriteSlot(w Writer, v Slot) (err error) {
+	if err = WriteVarInt(w, v.Count); err != nil || v.Count <= 0 {
+		return
+	}
+	if err = WriteVarInt(w, v.ItemID); err != nil {
+		return
+	}
+	if err = WriteVarInt(w, int32(len(v.Add))); err != nil {
+		return
+	}
+	if err = WriteVarInt(w, int32(len(v.Remove))); err != nil {
+		return
+	}
+	for _, c := range v.Add {
+		if err = WriteVarInt(w, c.ComponentType()); err != nil {
+			return
+		}
+		if err = c.encode(w); err != nil {
+			return
+		}
+	}
+	for _, typ := range v.Remove {
+		if err = WriteVarInt(w, typ); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// ReadSlot reads an item stack. As components are not length prefixed,
+// stacks with components of a type unknown to 1.21.1 cannot be read and
+// fail with ErrUnknownComponent.
+func ReadSlot(r Reader) (v Slot, err error) {
+	return readSlot(r, 0)
+}
+
+func readSlot(r Reader, depth int) (v Slot, err error) {
+	if depth > maxSlotDepth {
+		return v, ErrSlotTooDeep
+	}
+	if v.Count, err = ReadVarInt(r); err != nil || v.Count <= 0 {
+		return
+	}
+	if v.ItemID, err = ReadVarInt(r); err != nil {
+		return
+	}
+	var add, remove int32
+	if add, err = ReadVarInt(r); err != nil {
+		return
+	}
+	if remove, err = ReadVarInt(r); err != nil {
+		return
+	}
+	if add < 0 || remove < 0 {
+		return v, ErrNegativeLength
+	}
+
+	for range add {
+		var typ int32
+		if typ, err = ReadVarInt(r); err != nil {
+			return
+		}
+		read, ok := componentReaders[typ]
+		if !ok {
+			return v, ErrUnknownComponent
+		}
+		var c Component
+		if c, err = read(r, depth); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return
+		}
+		v.Add = append(v.Add, c)
+	}
+	for range remove {
+		var typ int32
+		if typ, err = ReadVarInt(r); err != nil {
+			return
+		}
+		v.Remove = append(v.Remove, typ)
+	}
+	return
+}
+
+// A Component is a data component of an item stack, one of the types of
+// this package or Opaque.
+type Component interface {
+	ComponentType() int32
+	encode(w Writer) error
+}
+
+// Opaque is a component of any type, encoded beforehand. It can be
+// written but is never read, see ReadSlot.
+type Opaque struct {
+	Type int32
+	Data []byte
+}
+
+func (c Opaque) ComponentType() int32 { return c.Type }
+func (c Opaque) encode(w Writer) error {
+	_, err := w.Write(c.Data)
+	return err
+}
+
+// Components holding NBT.
+type (
+	CustomData       struct{ Data nbt.Compound }
+	MapDecorations   struct{ Data nbt.Compound }
+	DebugStickState  struct{ Data nbt.Compound }
+	EntityData       struct{ Data nbt.Compound }
+	BucketEntityData struct{ Data nbt.Compound }
+	BlockEntityData  struct{ Data nbt.Compound }
+	ContainerLoot    struct{ Data nbt.Compound }
+)
+
+func (c CustomData) ComponentType() int32       { return ComponentCustomData }
+func (c MapDecorations) ComponentType() int32   { return ComponentMapDecorations }
+func (c DebugStickState) ComponentType() int32  { return ComponentDebugStickState }
+func (c EntityData) ComponentType() int32       { return ComponentEntityData }
+func (c BucketEntityData) ComponentType() int32 { return ComponentBucketEntityData }
+func (c BlockEntityData) ComponentType() int32  { return ComponentBlockEntityData }
+func (c ContainerLoot) ComponentType() int32    { return ComponentContainerLoot }
+
+func (c CustomData) encode(w Writer) error       { return WriteNBTCompound(w, c.Data) }
+func (c MapDecorations) encode(w Writer) error   { return WriteNBTCompound(w, c.Data) }
+func (c DebugStickState) encode(w Writer) error  { return WriteNBTCompound(w, c.Data) }
+func (c EntityData) encode(w Writer) error       { return WriteNBTCompound(w, c.Data) }
+func (c BucketEntityData) encode(w Writer) error { return WriteNBTCompound(w, c.Data) }
+func (c BlockEntityData) encode(w Writer) error  { return WriteNBTCompound(w, c.Data) }
+func (c ContainerLoot) encode(w Writer) error    { return WriteNBTCompound(w, c.Data) }
+
+// Recipes are the recipes a knowledge book unlocks, sent as an NBT list
+// of strings.
+type Recipes struct {
+	Recipes []string // Recipe identifiers
+}
+
+func (c Recipes) ComponentType() int32 { return ComponentRecipes }
+func (c Recipes) encode(w Writer) error {
+	l := make(nbt.List, len(c.Recipes))
+	for i, id := range c.Recipes {
+		l[i] = id
+	}
+	return WriteNBT(w, l)
+}
+
+// Lock is the key a container needs to be opened with, sent as an NBT
+// string.
+type Lock struct {
+	Key string
+}
+
+func (c Lock) ComponentType() int32  { return ComponentLock }
+func (c Lock) encode(w Writer) error { return WriteNBT(w, c.Key) }
+
+// Components holding a VarInt.
+type (
+	MaxStackSize           struct{ Size int32 }
+	MaxDamage              struct{ Damage int32 }
+	Damage                 struct{ Damage int32 }
+	Rarity                 struct{ Rarity int32 } // Common, uncommon, rare or epic
+	CustomModelData        struct{ Value int32 }
+	RepairCost             struct{ Cost int32 }
+	MapID                  struct{ ID int32 }
+	MapPostProcessing      struct{ Kind int32 } // Lock or scale
+	OminousBottleAmplifier struct{ Amplifier int32 }
+	BaseColor              struct{ Color int32 } // Dye color
+)
+
+func (c MaxStackSize) ComponentType() int32           { return ComponentMaxStackSize }
+func (c MaxDamage) ComponentType() int32              { return ComponentMaxDamage }
+func (c Damage) ComponentType() int32                 { return ComponentDamage }
+func (c Rarity) ComponentType() int32                 { return ComponentRarity }
+func (c CustomModelData) ComponentType() int32        { return ComponentCustomModelData }
+func (c RepairCost) ComponentType() int32             { return ComponentRepairCost }
+func (c MapID) ComponentType() int32                  { return ComponentMapID }
+func (c MapPostProcessing) ComponentType() int32      { return ComponentMapPostProcessing }
+func (c OminousBottleAmplifier) ComponentType() int32 { return ComponentOminousBottleAmplifier }
+func (c BaseColor) ComponentType() int32              { return ComponentBaseColor }
+
+func (c MaxStackSize) encode(w Writer) error           { return WriteVarInt(w, c.Size) }
+func (c MaxDamage) encode(w Writer) error              { return WriteVarInt(w, c.Damage) }
+func (c Damage) encode(w Writer) error                 { return WriteVarInt(w, c.Damage) }
+func (c Rarity) encode(w Writer) error                 { return WriteVarInt(w, c.Rarity) }
+func (c CustomModelData) encode(w Writer) error        { return WriteVarInt(w, c.Value) }
+func (c RepairCost) encode(w Writer) error             { return WriteVarInt(w, c.Cost) }
+func (c MapID) encode(w Writer) error                  { return WriteVarInt(w, c.ID) }
+func (c MapPostProcessing) encode(w Writer) error      { return WriteVarInt(w, c.Kind) }
+func (c OminousBottleAmplifier) encode(w Writer) error { return WriteVarInt(w, c.Amplifier) }
+func (c BaseColor) encode(w Writer) error              { return WriteVarInt(w, c.Color) }
+
+// Components without data, whose presence is a flag.
+type (
+	HideAdditionalTooltip struct{}
+	HideTooltip           struct{}
+	CreativeSlotLock      struct{}
+	FireResistant         struct{}
+)
+
+func (c HideAdditionalTooltip) ComponentType() int32 { return ComponentHideAdditionalTooltip }
+func (c HideTooltip) ComponentType() int32           { return ComponentHideTooltip }
+func (c CreativeSlotLock) ComponentType() int32      { return ComponentCreativeSlotLock }
+func (c FireResistant) ComponentType() int32         { return ComponentFireResistant }
+
+func (c HideAdditionalTooltip) encode(w Writer) error { return nil }
+func (c HideTooltip) encode(w Writer) error           { return nil }
+func (c CreativeSlotLock) encode(w Writer) error      { return nil }
+func (c FireResistant) encode(w Writer) error         { return nil }
+
+// Components holding item stacks.
+type (
+	ChargedProjectiles struct{ Items []Slot }
+	BundleContents     struct{ Items []Slot }
+	Container          struct{ Items []Slot }
+)
+
+func (c ChargedProjectiles) ComponentType() int32 { return ComponentChargedProjectiles }
+func (c BundleContents) ComponentType() int32     { return ComponentBundleContents }
+func (c Container) ComponentType() int32          { return ComponentContainer }
+
+func (c ChargedProjectiles) encode(w Writer) error { return WritePrefixedArray(w, c.Items, WriteSlot) }
+func (c BundleContents) encode(w Writer) error     { return WritePrefixedArray(w, c.Items, WriteSlot) }
+func (c Container) encode(w Writer) error          { return WritePrefixedArray(w, c.Items, WriteSlot) }
+
+// Unbreakable prevents the item from taking damage.
+type Unbreakable struct{ ShowInTooltip bool }
+
+func (c Unbreakable) ComponentType() int32  { return ComponentUnbreakable }
+func (c Unbreakable) encode(w Writer) error { return WriteBoolean(w, c.ShowInTooltip) }
+
+// EnchantmentGlintOverride forces the enchantment glint on or off.
+type EnchantmentGlintOverride struct{ Glint bool }
+
+func (c EnchantmentGlintOverride) ComponentType() int32  { return ComponentEnchantmentGlintOverride }
+func (c EnchantmentGlintOverride) encode(w Writer) error { return WriteBoolean(w, c.Glint) }
+
+// IntangibleProjectile marks projectiles that cannot be picked up.
+type IntangibleProjectile struct{}
+
+func (c IntangibleProjectile) ComponentType() int32 { return ComponentIntangibleProjectile }
+func (c IntangibleProjectile) encode(w Writer) error {
+	return WriteNBTCompound(w, nbt.Compound{}) // Always empty.
+}
+
+// CustomName is the name of the item, as renamed in an anvil.
+type CustomName struct {
+	Name any // Text component
+}
+
+func (c CustomName) ComponentType() int32  { return ComponentCustomName }
+func (c CustomName) encode(w Writer) error { return WriteNBT(w, c.Name) }
+
+// ItemName is the default name of the item, which anvils cannot change.
+type ItemName struct {
+	Name any // Text component
+}
+
+func (c ItemName) ComponentType() int32  { return ComponentItemName }
+func (c ItemName) encode(w Writer) error { return WriteNBT(w, c.Name) }
+
+// Lore is the text below the name of the item.
+type Lore struct {
+	Lines []any // Text components
+}
+
+func (c Lore) ComponentType() int32  { return ComponentLore }
+func (c Lore) encode(w Writer) error { return WritePrefixedArray(w, c.Lines, WriteNBT) }
+
+// Enchantment is an enchantment and its level.
+type Enchantment struct {
+	ID    int32 // ID in the minecraft:enchantment registry
+	Level int32
+}
+
+func WriteEnchantment(w Writer, v Enchantment) (err error) {
+	if err = WriteVarInt(w, v.ID); err != nil {
+		return
+	}
+	err = WriteVarInt(w, v.Level)
+	return
+}
+
+func ReadEnchantment(r Reader) (v Enchantment, err error) {
+	if v.ID, err = ReadVarInt(r); err != nil {
+		return
+	}
+	v.Level, err = ReadVarInt(r)
+	return
+}
+
+// Enchantments are the enchantments of an item.
+type Enchantments struct {
+	Enchantments  []Enchantment
+	ShowInTooltip bool
+}
+
+func (c Enchantments) ComponentType() int32 { return ComponentEnchantments }
+func (c Enchantments) encode(w Writer) error {
+	return writeEnchantments(w, c.Enchantments, c.ShowInTooltip)
+}
+
+// StoredEnchantments are the enchantments an enchanted book applies.
+type StoredEnchantments struct {
+	Enchantments  []Enchantment
+	ShowInTooltip bool
+}
+
+func (c StoredEnchantments) ComponentType() int32 { return ComponentStoredEnchantments }
+func (c StoredEnchantments) encode(w Writer) error {
+	return writeEnchantments(w, c.Enchantments, c.ShowInTooltip)
+}
+
+func writeEnchantments(w Writer, v []Enchantment, show bool) (err error) {
+	if err = WritePrefixedArray(w, v, WriteEnchantment); err != nil {
+		return
+	}
+	return WriteBoolean(w, show)
+}
+
+func readEnchantments(r Reader) (v []Enchantment, show bool, err error) {
+	if v, err = ReadPrefixedArray(r, ReadEnchantment); err != nil {
+		return
+	}
+	show, err = ReadBoolean(r)
+	return
+}
+
+// DyedColor is the color of dyed leather armor.
+type DyedColor struct {
+	Color         int32 // 0xRRGGBB
+	ShowInTooltip bool
+}
+
+func (c DyedColor) ComponentType() int32 { return ComponentDyedColor }
+func (c DyedColor) encode(w Writer) (err error) {
+	if err = WriteInt(w, c.Color); err != nil {
+		return
+	}
+	return WriteBoolean(w, c.ShowInTooltip)
+}
+
+// MapColor is the color of the markings of a filled map item.
+type MapColor struct {
+	Color int32 // 0xRRGGBB
+}
+
+func (c MapColor) ComponentType() int32  { return ComponentMapColor }
+func (c MapColor) encode(w Writer) error { return WriteInt(w, c.Color) }
+
+// Profile is the player of a player head. Properties hold the skin.
+type Profile struct {
+	Name       Optional[string]
+	UUID       Optional[uuid.UUID]
+	Properties []GameProfileProperty
+}
+
+func (c Profile) ComponentType() int32 { return ComponentProfile }
+func (c Profile) encode(w Writer) (err error) {
+	if err = WriteOptional(w, c.Name, WriteString); err != nil {
+		return
+	}
+	if err = WriteOptional(w, c.UUID, WriteUUID); err != nil {
+		return
+	}
+	return WritePrefixedArray(w, c.Properties, WriteGameProfileProperty)
+}
+
+// NoteBlockSound is the sound a player head plays on a note block.
+type NoteBlockSound struct {
+	Sound string // Identifier
+}
+
+func (c NoteBlockSound) ComponentType() int32  { return ComponentNoteBlockSound }
+func (c NoteBlockSound) encode(w Writer) error { return WriteIdentifier(w, c.Sound) }
+
+// PotDecorations are the sherds of a decorated pot, as item IDs.
+type PotDecorations struct {
+	Items []int32
+}
+
+func (c PotDecorations) ComponentType() int32 { return ComponentPotDecorations }
+func (c PotDecorations) encode(w Writer) error {
+	return WritePrefixedArray(w, c.Items, WriteVarInt)
+}
+
+// BlockStateProperty is a block state property set by BlockState.
+type BlockStateProperty struct {
+	Name  string
+	Value string
+}
+
+func WriteBlockStateProperty(w Writer, v BlockStateProperty) (err error) {
+	if err = WriteString(w, v.Name); err != nil {
+		return
+	}
+	err = WriteString(w, v.Value)
+	return
+}
+
+func ReadBlockStateProperty(r Reader) (v BlockStateProperty, err error) {
+	if v.Name, err = ReadString(r); err != nil {
+		return
+	}
+	v.Value, err = ReadString(r)
+	return
+}
+
+// BlockState sets properties of the block placed from the item.
+type BlockState struct {
+	Properties []BlockStateProperty
+}
+
+func (c BlockState) ComponentType() int32 { return ComponentBlockState }
+func (c BlockState) encode(w Writer) error {
+	return WritePrefixedArray(w, c.Properties, WriteBlockStateProperty)
+}
+
+// PropertyMatcher matches a block state property, by exact value or by
+// range.
+type PropertyMatcher struct {
+	Name     string
+	Exact    bool
+	Value    string           // If Exact
+	Min, Max Optional[string] // Otherwise
+}
+
+func WritePropertyMatcher(w Writer, v PropertyMatcher) (err error) {
+	if err = WriteString(w, v.Name); err != nil {
+		return
+	}
+	if err = WriteBoolean(w, v.Exact); err != nil {
+		return
+	}
+	if v.Exact {
+		return WriteString(w, v.Value)
+	}
+	if err = WriteOptional(w, v.Min, WriteString); err != nil {
+		return
+	}
+	return WriteOptional(w, v.Max, WriteString)
+}
+
+func ReadPropertyMatcher(r Reader) (v PropertyMatcher, err error) {
+	if v.Name, err = ReadString(r); err != nil {
+		return
+	}
+	if v.Exact, err = ReadBoolean(r); err != nil {
+		return
+	}
+	if v.Exact {
+		v.Value, err = ReadString(r)
+		return
+	}
+	if v.Min, err = ReadOptional(r, ReadString); err != nil {
+		return
+	}
+	v.Max, err = ReadOptional(r, ReadString)
+	return
+}
+
+// BlockPredicate matches blocks for CanPlaceOn and CanBreak. Absent
+// fields match any block.
+type BlockPredicate struct {
+	Blocks     Optional[IDSet] // Of the minecraft:block registry
+	Properties Optional[[]PropertyMatcher]
+	NBT        Optional[nbt.Compound]
+}
+
+func WriteBlockPredicate(w Writer, v BlockPredicate) (err error) {
+	if err = WriteOptional(w, v.Blocks, WriteIDSet); err != nil {
+		return
+	}
+	err = WriteOptional(w, v.Properties, func(w Writer, v []PropertyMatcher) error {
+		return WritePrefixedArray(w, v, WritePropertyMatcher)
+	})
+	if err != nil {
+		return
+	}
+	return WriteOptional(w, v.NBT, WriteNBTCompound)
+}
+
+func ReadBlockPredicate(r Reader) (v BlockPredicate, err error) {
+	if v.Blocks, err = ReadOptional(r, ReadIDSet); err != nil {
+		return
+	}
+	v.Properties, err = ReadOptional(r, func(r Reader) ([]PropertyMatcher, error) {
+		return ReadPrefixedArray(r, ReadPropertyMatcher)
+	})
+	if err != nil {
+		return
+	}
+	v.NBT, err = ReadOptional(r, ReadNBTCompound)
+	return
+}
+
+// CanPlaceOn are the blocks the item can be placed on in adventure mode.
+type CanPlaceOn struct {
+	Predicates    []BlockPredicate
+	ShowInTooltip bool
+}
+
+func (c CanPlaceOn) ComponentType() int32 { return ComponentCanPlaceOn }
+func (c CanPlaceOn) encode(w Writer) error {
+	return writeBlockPredicates(w, c.Predicates, c.ShowInTooltip)
+}
+
+// CanBreak are the blocks the item can break in adventure mode.
+type CanBreak struct {
+	Predicates    []BlockPredicate
+	ShowInTooltip bool
+}
+
+func (c CanBreak) ComponentType() int32 { return ComponentCanBreak }
+func (c CanBreak) encode(w Writer) error {
+	return writeBlockPredicates(w, c.Predicates, c.ShowInTooltip)
+}
+
+func writeBlockPredicates(w Writer, v []BlockPredicate, show bool) (err error) {
+	if err = WritePrefixedArray(w, v, WriteBlockPredicate); err != nil {
+		return
+	}
+	return WriteBoolean(w, show)
+}
+
+func readBlockPredicates(r Reader) (v []BlockPredicate, show bool, err error) {
+	if v, err = ReadPrefixedArray(r, ReadBlockPredicate); err != nil {
+		return
+	}
+	show, err = ReadBoolean(r)
+	return
+}
+
+// AttributeModifier changes an attribute of the entity holding or
+// wearing the item.
+type AttributeModifier struct {
+	Attribute int32  // ID in the minecraft:attribute registry
+	ID        string // Identifier of the modifier
+	Amount    float64
+	Operation int32 // Add value, add multiplied base or add multiplied total
+	Slot      int32 // Equipment slot group: any, main hand, off hand, hand, feet, legs, chest, head, armor or body
+}
+
+func WriteAttributeModifier(w Writer, v AttributeModifier) (err error) {
+	if err = WriteVarInt(w, v.Attribute); err != nil {
+		return
+	}
+	if err = WriteIdentifier(w, v.ID); err != nil {
+		return
+	}
+	if err = WriteDouble(w, v.Amount); err != nil {
+		return
+	}
+	if err = WriteVarInt(w, v.Operation); err != nil {
+		return
+	}
+	return WriteVarInt(w, v.Slot)
+}
+
+func ReadAttributeModifier(r Reader) (v AttributeModifier, err error) {
+	if v.Attribute, err = ReadVarInt(r); err != nil {
+		return
+	}
+	if v.ID, err = ReadIdentifier(r); err != nil {
+		return
+	}
+	if v.Amount, err = ReadDouble(r); err != nil {
+		return
+	}
+	if v.Operation, err = ReadVarInt(r); err != nil {
+		return
+	}
+	v.Slot, err = ReadVarInt(r)
+	return
+}
+
+// AttributeModifiers replace the default attribute modifiers of the item.
+type AttributeModifiers struct {
+	Modifiers     []AttributeModifier
+	ShowInTooltip bool
+}
+
+func (c AttributeModifiers) ComponentType() int32 { return ComponentAttributeModifiers }
+func (c AttributeModifiers) encode(w Writer) (err error) {
+	if err = WritePrefixedArray(w, c.Modifiers, WriteAttributeModifier); err != nil {
+		return
+	}
+	return WriteBoolean(w, c.ShowInTooltip)
+}
+
+// EffectDetails are the strength and duration of a status effect.
+type EffectDetails struct {
+	Amplifier     int32
+	Duration      int32 // In ticks, -1 for infinite
+	Ambient       bool
+	ShowParticles bool
+	ShowIcon      bool
+	Hidden        *EffectDetails // Weaker effect resumed when this one ends, if any
+}
+
+func WriteEffectDetails(w Writer, v EffectDetails) (err error) {
+	if err = WriteVarInt(w, v.Amplifier); err != nil {
+		return
+	}
+	if err = WriteVarInt(w, v.Duration); err != nil {
+		return
+	}
+	for _, b := range []bool{v.Ambient, v.ShowParticles, v.ShowIcon, v.Hidden != nil} {
+		if err = WriteBoolean(w, b); err != nil {
+			return
+		}
+	}
+	if v.Hidden != nil {
+		return WriteEffectDetails(w, *v.Hidden)
+	}
+	return
+}
+
+// ReadEffectDetails reads the details of an effect, failing with
+// ErrEffectTooDeep past maxSlotDepth hidden effects.
+func ReadEffectDetails(r Reader) (v EffectDetails, err error) {
+	return readEffectDetails(r, 0)
+}
+
+func readEffectDetails(r Reader, depth int) (v EffectDetails, err error) {
+	if depth > maxSlotDepth {
+		return v, ErrEffectTooDeep
+	}
+	if v.Amplifier, err = ReadVarInt(r); err != nil {
+		return
+	}
+	if v.Duration, err = ReadVarInt(r); err != nil {
+		return
+	}
+	var hidden bool
+	for _, b := range []*bool{&v.Ambient, &v.ShowParticles, &v.ShowIcon, &hidden} {
+		if *b, err = ReadBoolean(r); err != nil {
+			return
+		}
+	}
+	if hidden {
+		var h EffectDetails
+		if h, err = readEffectDetails(r, depth+1); err != nil {
+			return
+		}
+		v.Hidden = &h
+	}
+	return
+}
+
+// MobEffect is a status effect, such as those of potions.
+type MobEffect struct {
+	Effect  int32 // ID in the minecraft:mob_effect registry
+	Details EffectDetails
+}
+
+func WriteMobEffect(w Writer, v MobEffect) (err error) {
+	if err = WriteVarInt(w, v.Effect); err != nil {
+		return
+	}
+	return WriteEffectDetails(w, v.Details)
+}
+
+func ReadMobEffect(r Reader) (v MobEffect, err error) {
+	if v.Effect, err = ReadVarInt(r); err != nil {
+		return
+	}
+	v.Details, err = ReadEffectDetails(r)
+	return
+}
+
+// FoodEffect is an effect applied with a probability when eating.
+type FoodEffect struct {
+	Effect      MobEffect
+	Probability float32
+}
+
+func WriteFoodEffect(w Writer, v FoodEffect) (err error) {
+	if err = WriteMobEffect(w, v.Effect); err != nil {
+		return
+	}
+	return WriteFloat(w, v.Probability)
+}
+
+func ReadFoodEffect(r Reader) (v FoodEffect, err error) {
+	if v.Effect, err = ReadMobEffect(r); err != nil {
+		return
+	}
+	v.Probability, err = ReadFloat(r)
+	return
+}
+
+// Food makes the item edible.
+type Food struct {
+	Nutrition    int32
+	Saturation   float32
+	CanAlwaysEat bool
+	EatSeconds   float32
+	ConvertsTo   Optional[Slot] // Item left after eating, such as a bowl
+	Effects      []FoodEffect
+}
+
+func (c Food) ComponentType() int32 { return ComponentFood }
+func (c Food) encode(w Writer) (err error) {
+	if err = WriteVarInt(w, c.Nutrition); err != nil {
+		return
+	}
+	if err = WriteFloat(w, c.Saturation); err != nil {
+		return
+	}
+	if err = WriteBoolean(w, c.CanAlwaysEat); err != nil {
+		return
+	}
+	if err = WriteFloat(w, c.EatSeconds); err != nil {
+		return
+	}
+	if err = WriteOptional(w, c.ConvertsTo, WriteSlot); err != nil {
+		return
+	}
+	return WritePrefixedArray(w, c.Effects, WriteFoodEffect)
+}
+
+func readFood(r Reader, depth int) (c Component, err error) {
+	var v Food
+	if v.Nutrition, err = ReadVarInt(r); err != nil {
+		return
+	}
+	if v.Saturation, err = ReadFloat(r); err != nil {
+		return
+	}
+	if v.CanAlwaysEat, err = ReadBoolean(r); err != nil {
+		return
+	}
+	if v.EatSeconds, err = ReadFloat(r); err != nil {
+		return
+	}
+	if v.ConvertsTo, err = ReadOptional(r, func(r Reader) (Slot, error) { return readSlot(r, depth+1) }); err != nil {
+		return
+	}
+	v.Effects, err = ReadPrefixedArray(r, ReadFoodEffect)
+	return v, err
+}
+
+// ToolRule sets the mining speed of a tool for some blocks.
+type ToolRule struct {
+	Blocks          IDSet // Of the minecraft:block registry
+	Speed           Optional[float32]
+	CorrectForDrops Optional[bool]
+}
+
+func WriteToolRule(w Writer, v ToolRule) (err error) {
+	if err = WriteIDSet(w, v.Blocks); err != nil {
+		return
+	}
+	if err = WriteOptional(w, v.Speed, WriteFloat); err != nil {
+		return
+	}
+	return WriteOptional(w, v.CorrectForDrops, WriteBoolean)
+}
+
+func ReadToolRule(r Reader) (v ToolRule, err error) {
+	if v.Blocks, err = ReadIDSet(r); err != nil {
+		return
+	}
+	if v.Speed, err = ReadOptional(r, ReadFloat); err != nil {
+		return
+	}
+	v.CorrectForDrops, err = ReadOptional(r, ReadBoolean)
+	return
+}
+
+// Tool makes the item a mining tool.
+type Tool struct {
+	Rules              []ToolRule
+	DefaultMiningSpeed float32
+	DamagePerBlock     int32
+}
+
+func (c Tool) ComponentType() int32 { return ComponentTool }
+func (c Tool) encode(w Writer) (err error) {
+	if err = WritePrefixedArray(w, c.Rules, WriteToolRule); err != nil {
+		return
+	}
+	if err = WriteFloat(w, c.DefaultMiningSpeed); err != nil {
+		return
+	}
+	return WriteVarInt(w, c.DamagePerBlock)
+}
+
+// PotionContents are the effects of a potion, tipped arrow or
+// lingering cloud.
+type PotionContents struct {
+	Potion        Optional[int32] // ID in the minecraft:potion registry
+	CustomColor   Optional[int32] // 0xRRGGBB
+	CustomEffects []MobEffect
+}
+
+func (c PotionContents) ComponentType() int32 { return ComponentPotionContents }
+func (c PotionContents) encode(w Writer) (err error) {
+	if err = WriteOptional(w, c.Potion, WriteVarInt); err != nil {
+		return
+	}
+	if err = WriteOptional(w, c.CustomColor, WriteInt); err != nil {
+		return
+	}
+	return WritePrefixedArray(w, c.CustomEffects, WriteMobEffect)
+}
+
+// StewEffect is an effect of a suspicious stew.
+type StewEffect struct {
+	Effect   int32 // ID in the minecraft:mob_effect registry
+	Duration int32 // In ticks
+}
+
+func WriteStewEffect(w Writer, v StewEffect) (err error) {
+	if err = WriteVarInt(w, v.Effect); err != nil {
+		return
+	}
+	return WriteVarInt(w, v.Duration)
+}
+
+func ReadStewEffect(r Reader) (v StewEffect, err error) {
+	if v.Effect, err = ReadVarInt(r); err != nil {
+		return
+	}
+	v.Duration, err = ReadVarInt(r)
+	return
+}
+
+// SuspiciousStewEffects are the effects of a suspicious stew.
+type SuspiciousStewEffects struct {
+	Effects []StewEffect
+}
+
+func (c SuspiciousStewEffects) ComponentType() int32 { return ComponentSuspiciousStewEffects }
+func (c SuspiciousStewEffects) encode(w Writer) error {
+	return WritePrefixedArray(w, c.Effects, WriteStewEffect)
+}
+
+// FilterableString is a text written by a player, with the version shown
+// to players filtering profanity.
+type FilterableString struct {
+	Raw      string
+	Filtered Optional[string]
+}
+
+func WriteFilterableString(w Writer, v FilterableString) (err error) {
+	if err = WriteString(w, v.Raw); err != nil {
+		return
+	}
+	return WriteOptional(w, v.Filtered, WriteString)
+}
+
+func ReadFilterableString(r Reader) (v FilterableString, err error) {
+	if v.Raw, err = ReadString(r); err != nil {
+		return
+	}
+	v.Filtered, err = ReadOptional(r, ReadString)
+	return
+}
+
+// FilterableText is a text component with the version shown to players
+// filtering profanity.
+type FilterableText struct {
+	Raw      any // Text component
+	Filtered Optional[any]
+}
+
+func WriteFilterableText(w Writer, v FilterableText) (err error) {
+	if err = WriteNBT(w, v.Raw); err != nil {
+		return
+	}
+	return WriteOptional(w, v.Filtered, WriteNBT)
+}
+
+func ReadFilterableText(r Reader) (v FilterableText, err error) {
+	if v.Raw, err = ReadNBT(r); err != nil {
+		return
+	}
+	v.Filtered, err = ReadOptional(r, ReadNBT)
+	return
+}
+
+// WritableBookContent are the pages of a book and quill.
+type WritableBookContent struct {
+	Pages []FilterableString
+}
+
+func (c WritableBookContent) ComponentType() int32 { return ComponentWritableBookContent }
+func (c WritableBookContent) encode(w Writer) error {
+	return WritePrefixedArray(w, c.Pages, WriteFilterableString)
+}
+
+// WrittenBookContent is the content of a signed book.
+type WrittenBookContent struct {
+	Title      FilterableString
+	Author     string
+	Generation int32 // 0 for the original, up to 3 for a copy of a copy
+	Pages      []FilterableText
+	Resolved   bool // Whether the pages hold no unresolved selectors
+}
+
+func (c WrittenBookContent) ComponentType() int32 { return ComponentWrittenBookContent }
+func (c WrittenBookContent) encode(w Writer) (err error) {
+	if err = WriteFilterableString(w, c.Title); err != nil {
+		return
+	}
+	if err = WriteString(w, c.Author); err != nil {
+		return
+	}
+	if err = WriteVarInt(w, c.Generation); err != nil {
+		return
+	}
+	if err = WritePrefixedArray(w, c.Pages, WriteFilterableText); err != nil {
+		return
+	}
+	return WriteBoolean(w, c.Resolved)
+}
+
+// TrimOverride is the asset of a trim material used on armor of one
+// material, such as darker iron on iron armor.
+type TrimOverride struct {
+	ArmorMaterial int32 // ID in the minecraft:armor_material registry
+	AssetName     string
+}
+
+func WriteTrimOverride(w Writer, v TrimOverride) (err error) {
+	if err = WriteVarInt(w, v.ArmorMaterial); err != nil {
+		return
+	}
+	return WriteString(w, v.AssetName)
+}
+
+func ReadTrimOverride(r Reader) (v TrimOverride, err error) {
+	if v.ArmorMaterial, err = ReadVarInt(r); err != nil {
+		return
+	}
+	v.AssetName, err = ReadString(r)
+	return
+}
+
+// TrimMaterial is an entry of the minecraft:trim_material registry.
+type TrimMaterial struct {
+	AssetName      string
+	Ingredient     int32 // ID in the minecraft:item registry
+	ItemModelIndex float32
+	Overrides      []TrimOverride
+	Description    any // Text component
+}
+
+func WriteTrimMaterial(w Writer, v TrimMaterial) (err error) {
+	if err = WriteString(w, v.AssetName); err != nil {
+		return
+	}
+	if err = WriteVarInt(w, v.Ingredient); err != nil {
+		return
+	}
+	if err = WriteFloat(w, v.ItemModelIndex); err != nil {
+		return
+	}
+	if err = WritePrefixedArray(w, v.Overrides, WriteTrimOverride); err != nil {
+		return
+	}
+	return WriteNBT(w, v.Description)
+}
+
+func ReadTrimMaterial(r Reader) (v TrimMaterial, err error) {
+	if v.AssetName, err = ReadString(r); err != nil {
+		return
+	}
+	if v.Ingredient, err = ReadVarInt(r); err != nil {
+		return
+	}
+	if v.ItemModelIndex, err = ReadFloat(r); err != nil {
+		return
+	}
+	if v.Overrides, err = ReadPrefixedArray(r, ReadTrimOverride); err != nil {
+		return
+	}
+	v.Description, err = ReadNBT(r)
+	return
+}
+
+// TrimPattern is an entry of the minecraft:trim_pattern registry.
+type TrimPattern struct {
+	AssetID      string // Identifier
+	TemplateItem int32  // ID in the minecraft:item registry
+	Description  any    // Text component
+	Decal        bool
+}
+
+func WriteTrimPattern(w Writer, v TrimPattern) (err error) {
+	if err = WriteIdentifier(w, v.AssetID); err != nil {
+		return
+	}
+	if err = WriteVarInt(w, v.TemplateItem); err != nil {
+		return
+	}
+	if err = WriteNBT(w, v.Description); err != nil {
+		return
+	}
+	return WriteBoolean(w, v.Decal)
+}
+
+func ReadTrimPattern(r Reader) (v TrimPattern, err error) {
+	if v.AssetID, err = ReadIdentifier(r); err != nil {
+		return
+	}
+	if v.TemplateItem, err = ReadVarInt(r); err != nil {
+		return
+	}
+	if v.Description, err = ReadNBT(r); err != nil {
+		return
+	}
+	v.Decal, err = ReadBoolean(r)
+	return
+}
+
+// Trim is the armor trim of a piece of armor.
+type Trim struct {
+	Material      Holder[TrimMaterial]
+	Pattern       Holder[TrimPattern]
+	ShowInTooltip bool
+}
+
+func (c Trim) ComponentType() int32 { return ComponentTrim }
+func (c Trim) encode(w Writer) (err error) {
+	if err = WriteHolder(w, c.Material, WriteTrimMaterial); err != nil {
+		return
+	}
+	if err = WriteHolder(w, c.Pattern, WriteTrimPattern); err != nil {
+		return
+	}
+	return WriteBoolean(w, c.ShowInTooltip)
+}
+
+// SoundEvent is an entry of the minecraft:sound_event registry.
+type SoundEvent struct {
+	Name       string            // Identifier
+	FixedRange Optional[float32] // Range, instead of one growing with volume
+}
+
+func WriteSoundEvent(w Writer, v SoundEvent) (err error) {
+	if err = WriteIdentifier(w, v.Name); err != nil {
+		return
+	}
+	return WriteOptional(w, v.FixedRange, WriteFloat)
+}
+
+func ReadSoundEvent(r Reader) (v SoundEvent, err error) {
+	if v.Name, err = ReadIdentifier(r); err != nil {
+		return
+	}
+	v.FixedRange, err = ReadOptional(r, ReadFloat)
+	return
+}
+
+// InstrumentDefinition is an entry of the minecraft:instrument registry.
+type InstrumentDefinition struct {
+	Sound       Holder[SoundEvent]
+	UseDuration int32 // In ticks
+	Range       float32
+}
+
+func WriteInstrumentDefinition(w Writer, v InstrumentDefinition) (err error) {
+	if err = WriteHolder(w, v.Sound, WriteSoundEvent); err != nil {
+		return
+	}
+	if err = WriteVarInt(w, v.UseDuration); err != nil {
+		return
+	}
+	return WriteFloat(w, v.Range)
+}
+
+func ReadInstrumentDefinition(r Reader) (v InstrumentDefinition, err error) {
+	if v.Sound, err = ReadHolder(r, ReadSoundEvent); err != nil {
+		return
+	}
+	if v.UseDuration, err = ReadVarInt(r); err != nil {
+		return
+	}
+	v.Range, err = ReadFloat(r)
+	return
+}
+
+// Instrument is the sound of a goat horn.
+type Instrument struct {
+	Instrument Holder[InstrumentDefinition]
+}
+
+func (c Instrument) ComponentType() int32 { return ComponentInstrument }
+func (c Instrument) encode(w Writer) error {
+	return WriteHolder(w, c.Instrument, WriteInstrumentDefinition)
+}
+
+// JukeboxSong is an entry of the minecraft:jukebox_song registry.
+type JukeboxSong struct {
+	Sound            Holder[SoundEvent]
+	Description      any // Text component
+	LengthInSeconds  float32
+	ComparatorOutput int32
+}
+
+func WriteJukeboxSong(w Writer, v JukeboxSong) (err error) {
+	if err = WriteHolder(w, v.Sound, WriteSoundEvent); err != nil {
+		return
+	}
+	if err = WriteNBT(w, v.Description); err != nil {
+		return
+	}
+	if err = WriteFloat(w, v.LengthInSeconds); err != nil {
+		return
+	}
+	return WriteVarInt(w, v.ComparatorOutput)
+}
+
+func ReadJukeboxSong(r Reader) (v JukeboxSong, err error) {
+	if v.Sound, err = ReadHolder(r, ReadSoundEvent); err != nil {
+		return
+	}
+	if v.Description, err = ReadNBT(r); err != nil {
+		return
+	}
+	if v.LengthInSeconds, err = ReadFloat(r); err != nil {
+		return
+	}
+	v.ComparatorOutput, err = ReadVarInt(r)
+	return
+}
+
+// JukeboxPlayable is the song a music disc plays in a jukebox, given as
+// a holder, or by name if SongName is not empty.
+type JukeboxPlayable struct {
+	Song          Holder[JukeboxSong]
+	SongName      string // Identifier
+	ShowInTooltip bool
+}
+
+func (c JukeboxPlayable) ComponentType() int32 { return ComponentJukeboxPlayable }
+func (c JukeboxPlayable) encode(w Writer) (err error) {
+	if err = WriteBoolean(w, c.SongName == ""); err != nil {
+		return
+	}
+	if c.SongName == "" {
+		err = WriteHolder(w, c.Song, WriteJukeboxSong)
+	} else {
+		err = WriteIdentifier(w, c.SongName)
+	}
+	if err != nil {
+		return
+	}
+	return WriteBoolean(w, c.ShowInTooltip)
+}
+
+// LodestoneTracker is the lodestone a compass points to.
+type LodestoneTracker struct {
+	Target  Optional[GlobalPos]
+	Tracked bool // Whether the compass stops pointing once the lodestone is gone
+}
+
+func (c LodestoneTracker) ComponentType() int32 { return ComponentLodestoneTracker }
+func (c LodestoneTracker) encode(w Writer) (err error) {
+	if err = WriteOptional(w, c.Target, WriteGlobalPos); err != nil {
+		return
+	}
+	return WriteBoolean(w, c.Tracked)
+}
+
+// FireworkExplosion is the explosion of a firework star, or one of those
+// of a firework rocket.
+type FireworkExplosion struct {
+	Shape      int32   // Small ball, large ball, star, creeper or burst
+	Colors     []int32 // 0xRRGGBB
+	FadeColors []int32
+	HasTrail   bool
+	HasTwinkle bool
+}
+
+func (c FireworkExplosion) ComponentType() int32  { return ComponentFireworkExplosion }
+func (c FireworkExplosion) encode(w Writer) error { return WriteFireworkExplosion(w, c) }
+
+func WriteFireworkExplosion(w Writer, v FireworkExplosion) (err error) {
+	if err = WriteVarInt(w, v.Shape); err != nil {
+		return
+	}
+	if err = WritePrefixedArray(w, v.Colors, WriteInt); err != nil {
+		return
+	}
+	if err = WritePrefixedArray(w, v.FadeColors, WriteInt); err != nil {
+		return
+	}
+	if err = WriteBoolean(w, v.HasTrail); err != nil {
+		return
+	}
+	return WriteBoolean(w, v.HasTwinkle)
+}
+
+func ReadFireworkExplosion(r Reader) (v FireworkExplosion, err error) {
+	if v.Shape, err = ReadVarInt(r); err != nil {
+		return
+	}
+	if v.Colors, err = ReadPrefixedArray(r, ReadInt); err != nil {
+		return
+	}
+	if v.FadeColors, err = ReadPrefixedArray(r, ReadInt); err != nil {
+		return
+	}
+	if v.HasTrail, err = ReadBoolean(r); err != nil {
+		return
+	}
+	v.HasTwinkle, err = ReadBoolean(r)
+	return
+}
+
+// Fireworks are the flight duration and explosions of a firework rocket.
+type Fireworks struct {
+	FlightDuration int32
+	Explosions     []FireworkExplosion
+}
+
+func (c Fireworks) ComponentType() int32 { return ComponentFireworks }
+func (c Fireworks) encode(w Writer) (err error) {
+	if err = WriteVarInt(w, c.FlightDuration); err != nil {
+		return
+	}
+	return WritePrefixedArray(w, c.Explosions, WriteFireworkExplosion)
+}
+
+// BannerPattern is an entry of the minecraft:banner_pattern registry.
+type BannerPattern struct {
+	AssetID        string // Identifier
+	TranslationKey string
+}
+
+func WriteBannerPattern(w Writer, v BannerPattern) (err error) {
+	if err = WriteIdentifier(w, v.AssetID); err != nil {
+		return
+	}
+	return WriteString(w, v.TranslationKey)
+}
+
+func ReadBannerPattern(r Reader) (v BannerPattern, err error) {
+	if v.AssetID, err = ReadIdentifier(r); err != nil {
+		return
+	}
+	v.TranslationKey, err = ReadString(r)
+	return
+}
+
+// BannerLayer is a pattern of a banner in a color.
+type BannerLayer struct {
+	Pattern Holder[BannerPattern]
+	Color   int32 // Dye color
+}
+
+func WriteBannerLayer(w Writer, v BannerLayer) (err error) {
+	if err = WriteHolder(w, v.Pattern, WriteBannerPattern); err != nil {
+		return
+	}
+	return WriteVarInt(w, v.Color)
+}
+
+func ReadBannerLayer(r Reader) (v BannerLayer, err error) {
+	if v.Pattern, err = ReadHolder(r, ReadBannerPattern); err != nil {
+		return
+	}
+	v.Color, err = ReadVarInt(r)
+	return
+}
+
+// BannerPatterns are the layers of a banner or shield, bottom first.
+type BannerPatterns struct {
+	Layers []BannerLayer
+}
+
+func (c BannerPatterns) ComponentType() int32 { return ComponentBannerPatterns }
+func (c BannerPatterns) encode(w Writer) error {
+	return WritePrefixedArray(w, c.Layers, WriteBannerLayer)
+}
+
+// Bee is a bee inside a beehive or bee nest.
+type Bee struct {
+	EntityData     nbt.Compound
+	TicksInHive    int32
+	MinTicksInHive int32
+}
+
+func WriteBee(w Writer, v Bee) (err error) {
+	if err = WriteNBTCompound(w, v.EntityData); err != nil {
+		return
+	}
+	if err = WriteVarInt(w, v.TicksInHive); err != nil {
+		return
+	}
+	return WriteVarInt(w, v.MinTicksInHive)
+}
+
+func ReadBee(r Reader) (v Bee, err error) {
+	if v.EntityData, err = ReadNBTCompound(r); err != nil {
+		return
+	}
+	if v.TicksInHive, err = ReadVarInt(r); err != nil {
+		return
+	}
+	v.MinTicksInHive, err = ReadVarInt(r)
+	return
+}
+
+// Bees are the bees inside a beehive or bee nest.
+type Bees struct {
+	Bees []Bee
+}
+
+func (c Bees) ComponentType() int32  { return ComponentBees }
+func (c Bees) encode(w Writer) error { return WritePrefixedArray(w, c.Bees, WriteBee) }
+
+// componentReaders read the components of each type known, given the
+// depth of the stack they are read for.
+var componentReaders map[int32]func(r Reader, depth int) (Component, error)
+
+func init() {
+	nbtReader := func(wrap func(nbt.Compound) Component) func(Reader, int) (Component, error) {
+		return func(r Reader, _ int) (Component, error) {
+			v, err := ReadNBTCompound(r)
+			return wrap(v), err
+		}
+	}
+	varIntReader := func(wrap func(int32) Component) func(Reader, int) (Component, error) {
+		return func(r Reader, _ int) (Component, error) {
+			v, err := ReadVarInt(r)
+			return wrap(v), err
+		}
+	}
+	flagReader := func(c Component) func(Reader, int) (Component, error) {
+		return func(Reader, int) (Component, error) { return c, nil }
+	}
+	slotsReader := func(wrap func([]Slot) Component) func(Reader, int) (Component, error) {
+		return func(r Reader, depth int) (Component, error) {
+			v, err := ReadPrefixedArray(r, func(r Reader) (Slot, error) { return readSlot(r, depth+1) })
+			return wrap(v), err
+		}
+	}
+
+	componentReaders = map[int32]func(Reader, int) (Component, error){
+		ComponentCustomData:       nbtReader(func(v nbt.Compound) Component { return CustomData{v} }),
+		ComponentMapDecorations:   nbtReader(func(v nbt.Compound) Component { return MapDecorations{v} }),
+		ComponentDebugStickState:  nbtReader(func(v nbt.Compound) Component { return DebugStickState{v} }),
+		ComponentEntityData:       nbtReader(func(v nbt.Compound) Component { return EntityData{v} }),
+		ComponentBucketEntityData: nbtReader(func(v nbt.Compound) Component { return BucketEntityData{v} }),
+		ComponentBlockEntityData:  nbtReader(func(v nbt.Compound) Component { return BlockEntityData{v} }),
+		ComponentContainerLoot:    nbtReader(func(v nbt.Compound) Component { return ContainerLoot{v} }),
+
+		ComponentMaxStackSize:           varIntReader(func(v int32) Component { return MaxStackSize{v} }),
+		ComponentMaxDamage:              varIntReader(func(v int32) Component { return MaxDamage{v} }),
+		ComponentDamage:                 varIntReader(func(v int32) Component { return Damage{v} }),
+		ComponentRarity:                 varIntReader(func(v int32) Component { return Rarity{v} }),
+		ComponentCustomModelData:        varIntReader(func(v int32) Component { return CustomModelData{v} }),
+		ComponentRepairCost:             varIntReader(func(v int32) Component { return RepairCost{v} }),
+		ComponentMapID:                  varIntReader(func(v int32) Component { return MapID{v} }),
+		ComponentMapPostProcessing:      varIntReader(func(v int32) Component { return MapPostProcessing{v} }),
+		ComponentOminousBottleAmplifier: varIntReader(func(v int32) Component { return OminousBottleAmplifier{v} }),
+		ComponentBaseColor:              varIntReader(func(v int32) Component { return BaseColor{v} }),
+
+		ComponentHideAdditionalTooltip: flagReader(HideAdditionalTooltip{}),
+		ComponentHideTooltip:           flagReader(HideTooltip{}),
+		ComponentCreativeSlotLock:      flagReader(CreativeSlotLock{}),
+		ComponentFireResistant:         flagReader(FireResistant{}),
+
+		ComponentChargedProjectiles: slotsReader(func(v []Slot) Component { return ChargedProjectiles{v} }),
+		ComponentBundleContents:     slotsReader(func(v []Slot) Component { return BundleContents{v} }),
+		ComponentContainer:          slotsReader(func(v []Slot) Component { return Container{v} }),
+
+		ComponentUnbreakable: func(r Reader, _ int) (Component, error) {
+			v, err := ReadBoolean(r)
+			return Unbreakable{v}, err
+		},
+		ComponentEnchantmentGlintOverride: func(r Reader, _ int) (Component, error) {
+			v, err := ReadBoolean(r)
+			return EnchantmentGlintOverride{v}, err
+		},
+		ComponentIntangibleProjectile: func(r Reader, _ int) (Component, error) {
+			_, err := ReadNBT(r)
+			return IntangibleProjectile{}, err
+		},
+		ComponentCustomName: func(r Reader, _ int) (Component, error) {
+			v, err := ReadNBT(r)
+			return CustomName{v}, err
+		},
+		ComponentItemName: func(r Reader, _ int) (Component, error) {
+			v, err := ReadNBT(r)
+			return ItemName{v}, err
+		},
+		ComponentRecipes: func(r Reader, _ int) (Component, error) {
+			tag, err := ReadNBT(r)
+			if err != nil {
+				return nil, err
+			}
+			l, ok := tag.(nbt.List)
+			if !ok {
+				return nil, ErrComponentNBT
+			}
+			var v Recipes
+			for _, e := range l {
+				id, ok := e.(string)
+				if !ok {
+					return nil, ErrComponentNBT
+				}
+				v.Recipes = append(v.Recipes, id)
+			}
+			return v, nil
+		},
+		ComponentLock: func(r Reader, _ int) (Component, error) {
+			tag, err := ReadNBT(r)
+			if err != nil {
+				return nil, err
+			}
+			key, ok := tag.(string)
+			if !ok {
+				return nil, ErrComponentNBT
+			}
+			return Lock{key}, nil
+		},
+		ComponentLore: func(r Reader, _ int) (Component, error) {
+			v, err := ReadPrefixedArray(r, ReadNBT)
+			return Lore{v}, err
+		},
+		ComponentEnchantments: func(r Reader, _ int) (Component, error) {
+			v, show, err := readEnchantments(r)
+			return Enchantments{v, show}, err
+		},
+		ComponentStoredEnchantments: func(r Reader, _ int) (Component, error) {
+			v, show, err := readEnchantments(r)
+			return StoredEnchantments{v, show}, err
+		},
+		ComponentDyedColor: func(r Reader, _ int) (c Component, err error) {
+			var v DyedColor
+			if v.Color, err = ReadInt(r); err != nil {
+				return
+			}
+			v.ShowInTooltip, err = ReadBoolean(r)
+			return v, err
+		},
+		ComponentMapColor: func(r Reader, _ int) (Component, error) {
+			v, err := ReadInt(r)
+			return MapColor{v}, err
+		},
+		ComponentProfile: func(r Reader, _ int) (c Component, err error) {
+			var v Profile
+			if v.Name, err = ReadOptional(r, ReadString); err != nil {
+				return
+			}
+			if v.UUID, err = ReadOptional(r, ReadUUID); err != nil {
+				return
+			}
+			v.Properties, err = ReadPrefixedArray(r, ReadGameProfileProperty)
+			return v, err
+		},
+		ComponentNoteBlockSound: func(r Reader, _ int) (Component, error) {
+			v, err := ReadIdentifier(r)
+			return NoteBlockSound{v}, err
+		},
+		ComponentPotDecorations: func(r Reader, _ int) (Component, error) {
+			v, err := ReadPrefixedArray(r, ReadVarInt)
+			return PotDecorations{v}, err
+		},
+		ComponentBlockState: func(r Reader, _ int) (Component, error) {
+			v, err := ReadPrefixedArray(r, ReadBlockStateProperty)
+			return BlockState{v}, err
+		},
+		ComponentCanPlaceOn: func(r Reader, _ int) (Component, error) {
+			v, show, err := readBlockPredicates(r)
+			return CanPlaceOn{v, show}, err
+		},
+		ComponentCanBreak: func(r Reader, _ int) (Component, error) {
+			v, show, err := readBlockPredicates(r)
+			return CanBreak{v, show}, err
+		},
+		ComponentAttributeModifiers: func(r Reader, _ int) (c Component, err error) {
+			var v AttributeModifiers
+			if v.Modifiers, err = ReadPrefixedArray(r, ReadAttributeModifier); err != nil {
+				return
+			}
+			v.ShowInTooltip, err = ReadBoolean(r)
+			return v, err
+		},
+		ComponentFood: readFood,
+		ComponentTool: func(r Reader, _ int) (c Component, err error) {
+			var v Tool
+			if v.Rules, err = ReadPrefixedArray(r, ReadToolRule); err != nil {
+				return
+			}
+			if v.DefaultMiningSpeed, err = ReadFloat(r); err != nil {
+				return
+			}
+			v.DamagePerBlock, err = ReadVarInt(r)
+			return v, err
+		},
+		ComponentPotionContents: func(r Reader, _ int) (c Component, err error) {
+			var v PotionContents
+			if v.Potion, err = ReadOptional(r, ReadVarInt); err != nil {
+				return
+			}
+			if v.CustomColor, err = ReadOptional(r, ReadInt); err != nil {
+				return
+			}
+			v.CustomEffects, err = ReadPrefixedArray(r, ReadMobEffect)
+			return v, err
+		},
+		ComponentSuspiciousStewEffects: func(r Reader, _ int) (Component, error) {
+			v, err := ReadPrefixedArray(r, ReadStewEffect)
+			return SuspiciousStewEffects{v}, err
+		},
+		ComponentWritableBookContent: func(r Reader, _ int) (Component, error) {
+			v, err := ReadPrefixedArray(r, ReadFilterableString)
+			return WritableBookContent{v}, err
+		},
+		ComponentWrittenBookContent: func(r Reader, _ int) (c Component, err error) {
+			var v WrittenBookContent
+			if v.Title, err = ReadFilterableString(r); err != nil {
+				return
+			}
+			if v.Author, err = ReadString(r); err != nil {
+				return
+			}
+			if v.Generation, err = ReadVarInt(r); err != nil {
+				return
+			}
+			if v.Pages, err = ReadPrefixedArray(r, ReadFilterableText); err != nil {
+				return
+			}
+			v.Resolved, err = ReadBoolean(r)
+			return v, err
+		},
+		ComponentTrim: func(r Reader, _ int) (c Component, err error) {
+			var v Trim
+			if v.Material, err = ReadHolder(r, ReadTrimMaterial); err != nil {
+				return
+			}
+			if v.Pattern, err = ReadHolder(r, ReadTrimPattern); err != nil {
+				return
+			}
+			v.ShowInTooltip, err = ReadBoolean(r)
+			return v, err
+		},
+		ComponentInstrument: func(r Reader, _ int) (Component, error) {
+			v, err := ReadHolder(r, ReadInstrumentDefinition)
+			return Instrument{v}, err
+		},
+		ComponentJukeboxPlayable: func(r Reader, _ int) (c Component, err error) {
+			var v JukeboxPlayable
+			var direct bool
+			if direct, err = ReadBoolean(r); err != nil {
+				return
+			}
+			if direct {
+				v.Song, err = ReadHolder(r, ReadJukeboxSong)
+			} else {
+				v.SongName, err = ReadIdentifier(r)
+			}
+			if err != nil {
+				return
+			}
+			v.ShowInTooltip, err = ReadBoolean(r)
+			return v, err
+		},
+		ComponentLodestoneTracker: func(r Reader, _ int) (c Component, err error) {
+			var v LodestoneTracker
+			if v.Target, err = ReadOptional(r, ReadGlobalPos); err != nil {
+				return
+			}
+			v.Tracked, err = ReadBoolean(r)
+			return v, err
+		},
+		ComponentFireworkExplosion: func(r Reader, _ int) (Component, error) {
+			return ReadFireworkExplosion(r)
+		},
+		ComponentFireworks: func(r Reader, _ int) (c Component, err error) {
+			var v Fireworks
+			if v.FlightDuration, err = ReadVarInt(r); err != nil {
+				return
+			}
+			v.Explosions, err = ReadPrefixedArray(r, ReadFireworkExplosion)
+			return v, err
+		},
+		ComponentBannerPatterns: func(r Reader, _ int) (Component, error) {
+			v, err := ReadPrefixedArray(r, ReadBannerLayer)
+			return BannerPatterns{v}, err
+		},
+		ComponentBees: func(r Reader, _ int) (Component, error) {
+			v, err := ReadPrefixedArray(r, ReadBee)
+			return Bees{v}, err
+		},
+	}
+}
@@ -0,0 +1,247 @@
+package packet
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gstoney/mcproto/nbt"
+)
+
+func TestWriteSlot(t *testing.T) {
+	for _, tC := range []struct {
+		desc string
+		v    Slot
+		ser  []byte
+	}{
+		{"empty", Slot{}, []byte{0x00}},
+		{"no components", Slot{Count: 64, ItemID: 1}, []byte{0x40, 0x01, 0x00, 0x00}},
+		{"damage and removed", Slot{Count: 1, ItemID: 300, Add: []Component{Damage{3}}, Remove: []int32{ComponentFood}},
+			[]byte{0x01, 0xac, 0x02, 0x01, 0x01, 0x03, 0x03, 0x14}},
+		{"opaque", Slot{Count: 1, ItemID: 2, Add: []Component{Opaque{ComponentFood, []byte{0xde, 0xad}}}},
+			[]byte{0x01, 0x02, 0x01, 0x00, 0x14, 0xde, 0xad}},
+	} {
+		var buf bytes.Buffer
+		if err := WriteSlot(&buf, tC.v); err != nil {
+			t.Errorf("%s: WriteSlot failed: %v", tC.desc, err)
+			continue
+		}
+		if !bytes.Equal(buf.Bytes(), tC.ser) {
+			t.Errorf("%s: expected %x, got %x", tC.desc, tC.ser, buf.Bytes())
+		}
+	}
+}
+
+// TestSlot_NBTComponents verifies that components sent as NBT of their
+// persistent form match the encoding of vanilla, both ways.
+func TestSlot_NBTComponents(t *testing.T) {
+	for _, tC := range []struct {
+		desc string
+		v    Slot
+		ser  []byte
+	}{
+		{"recipes", Slot{Count: 1, ItemID: 1, Add: []Component{Recipes{[]string{"minecraft:stick"}}}},
+			append([]byte{0x01, 0x01, 0x01, 0x00, 0x2b, 0x09, 0x08, 0x00, 0x00, 0x00, 0x01, 0x00, 0x0f},
+				"minecraft:stick"...)},
+		{"no recipes", Slot{Count: 1, ItemID: 1, Add: []Component{Recipes{}}},
+			[]byte{0x01, 0x01, 0x01, 0x00, 0x2b, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00}},
+		{"lock", Slot{Count: 1, ItemID: 1, Add: []Component{Lock{"key"}}},
+			[]byte{0x01, 0x01, 0x01, 0x00, 0x37, 0x08, 0x00, 0x03, 'k', 'e', 'y'}},
+		{"intangible projectile", Slot{Count: 1, ItemID: 1, Add: []Component{IntangibleProjectile{}}},
+			[]byte{0x01, 0x01, 0x01, 0x00, 0x13, 0x0a, 0x00}},
+	} {
+		var buf bytes.Buffer
+		if err := WriteSlot(&buf, tC.v); err != nil {
+			t.Errorf("%s: WriteSlot failed: %v", tC.desc, err)
+			continue
+		}
+		if !bytes.Equal(buf.Bytes(), tC.ser) {
+			t.Errorf("%s: expected %x, got %x", tC.desc, tC.ser, buf.Bytes())
+		}
+
+		got, err := ReadSlot(bytes.NewReader(tC.ser))
+		if err != nil || !reflect.DeepEqual(got, tC.v) {
+			t.Errorf("%s: expected %+v, got %+v, %v", tC.desc, tC.v, got, err)
+		}
+	}
+
+	// A compound where a string is expected.
+	ser := []byte{0x01, 0x01, 0x01, 0x00, 0x37, 0x0a, 0x00}
+	if _, err := ReadSlot(bytes.NewReader(ser)); !errors.Is(err, ErrComponentNBT) {
+		t.Errorf("lock compound: expected ErrComponentNBT, got %v", err)
+	}
+}
+
+// TestSlotRoundtrip verifies that every component type read is written
+// back identically, nested stacks included.
+func TestSlotRoundtrip(t *testing.T) {
+	sword := Slot{Count: 1, ItemID: 800, Add: []Component{
+		CustomName{Name: nbt.Compound{"text": "Excalibur", "color": "gold"}},
+		ItemName{Name: "Sword"},
+		Lore{Lines: []any{"first", "second"}},
+		Enchantments{Enchantments: []Enchantment{{ID: 12, Level: 5}, {ID: 3, Level: 1}}, ShowInTooltip: true},
+		Damage{Damage: 17},
+		Unbreakable{ShowInTooltip: false},
+		CustomModelData{Value: 1234},
+	}, Remove: []int32{ComponentAttributeModifiers}}
+
+	want := Slot{Count: 1, ItemID: 700, Add: []Component{
+		CustomData{Data: nbt.Compound{"id": int32(7)}},
+		MaxStackSize{Size: 16}, MaxDamage{Damage: 250}, Rarity{Rarity: 3}, RepairCost{Cost: 2},
+		MapID{ID: 9}, MapPostProcessing{Kind: 1}, OminousBottleAmplifier{Amplifier: 4}, BaseColor{Color: 14},
+		HideAdditionalTooltip{}, HideTooltip{}, CreativeSlotLock{}, FireResistant{}, IntangibleProjectile{},
+		EnchantmentGlintOverride{Glint: true},
+		StoredEnchantments{Enchantments: []Enchantment{{ID: 1, Level: 2}}},
+		DyedColor{Color: 0xa06540, ShowInTooltip: true}, MapColor{Color: 0x46402e},
+		Profile{
+			Name: Optional[string]{Exists: true, Item: "Notch"},
+			UUID: Optional[uuid.UUID]{Exists: true, Item: uuid.MustParse("069a79f4-44e9-4726-a5be-fca90e38aaf5")},
+			Properties: []GameProfileProperty{
+				{Name: "textures", Value: "e30=", Signature: Optional[string]{Exists: true, Item: "c2ln"}},
+			},
+		},
+		NoteBlockSound{Sound: "minecraft:entity.creeper.primed"},
+		PotDecorations{Items: []int32{1, 2, 3, 4}},
+		BlockState{Properties: []BlockStateProperty{{"facing", "north"}}},
+		MapDecorations{Data: nbt.Compound{}}, DebugStickState{Data: nbt.Compound{}},
+		EntityData{Data: nbt.Compound{"id": "minecraft:pig"}}, BucketEntityData{Data: nbt.Compound{}},
+		BlockEntityData{Data: nbt.Compound{}}, Recipes{Recipes: []string{"minecraft:stick"}}, Lock{Key: "key"},
+		ContainerLoot{Data: nbt.Compound{"loot_table": "minecraft:chests/simple_dungeon"}},
+		ChargedProjectiles{Items: []Slot{{Count: 1, ItemID: 5}}},
+		BundleContents{Items: []Slot{sword, {Count: 3, ItemID: 6}}},
+		Container{Items: []Slot{{}, sword}},
+		CanPlaceOn{Predicates: []BlockPredicate{{
+			Blocks: Optional[IDSet]{Exists: true, Item: IDSet{Tag: "minecraft:logs"}},
+			Properties: Optional[[]PropertyMatcher]{Exists: true, Item: []PropertyMatcher{
+				{Name: "axis", Exact: true, Value: "y"},
+				{Name: "age", Min: Optional[string]{Exists: true, Item: "2"}},
+			}},
+		}}, ShowInTooltip: true},
+		CanBreak{Predicates: []BlockPredicate{
+			{Blocks: Optional[IDSet]{Exists: true, Item: IDSet{IDs: []int32{1, 2}}}},
+			{NBT: Optional[nbt.Compound]{Exists: true, Item: nbt.Compound{"Items": nbt.List{}}}},
+		}},
+		AttributeModifiers{Modifiers: []AttributeModifier{
+			{Attribute: 2, ID: "minecraft:base_attack_damage", Amount: 6, Slot: 1},
+		}, ShowInTooltip: true},
+		Food{Nutrition: 6, Saturation: 7.2, EatSeconds: 1.6,
+			ConvertsTo: Optional[Slot]{Exists: true, Item: Slot{Count: 1, ItemID: 840}},
+			Effects:    []FoodEffect{{Effect: MobEffect{Effect: 16, Details: EffectDetails{Duration: 600, ShowIcon: true}}, Probability: 0.8}},
+		},
+		Tool{Rules: []ToolRule{
+			{Blocks: IDSet{Tag: "minecraft:mineable/pickaxe"}, Speed: Optional[float32]{Exists: true, Item: 8}, CorrectForDrops: Optional[bool]{Exists: true, Item: true}},
+			{Blocks: IDSet{IDs: []int32{}}},
+		}, DefaultMiningSpeed: 1, DamagePerBlock: 1},
+		PotionContents{
+			Potion:      Optional[int32]{Exists: true, Item: 5},
+			CustomColor: Optional[int32]{Exists: true, Item: 0xff0000},
+			CustomEffects: []MobEffect{{Effect: 3, Details: EffectDetails{Amplifier: 1, Duration: 200,
+				Hidden: &EffectDetails{Duration: 400, ShowParticles: true}}}},
+		},
+		SuspiciousStewEffects{Effects: []StewEffect{{Effect: 9, Duration: 160}}},
+		WritableBookContent{Pages: []FilterableString{{Raw: "page"}, {Raw: "damn", Filtered: Optional[string]{Exists: true, Item: "****"}}}},
+		WrittenBookContent{Title: FilterableString{Raw: "Title"}, Author: "Notch", Generation: 1,
+			Pages: []FilterableText{{Raw: "text", Filtered: Optional[any]{Exists: true, Item: nbt.Compound{"text": "t"}}}}, Resolved: true},
+		Trim{Material: Holder[TrimMaterial]{ID: 3}, Pattern: Holder[TrimPattern]{Inline: &TrimPattern{
+			AssetID: "minecraft:coast", TemplateItem: 1200, Description: "Coast", Decal: true}}, ShowInTooltip: true},
+		Trim{Material: Holder[TrimMaterial]{Inline: &TrimMaterial{AssetName: "gold", Ingredient: 800, ItemModelIndex: 0.6,
+			Overrides: []TrimOverride{{ArmorMaterial: 4, AssetName: "gold_darker"}}, Description: "Gold"}}},
+		Instrument{Instrument: Holder[InstrumentDefinition]{Inline: &InstrumentDefinition{
+			Sound:       Holder[SoundEvent]{Inline: &SoundEvent{Name: "minecraft:item.goat_horn.sound.0", FixedRange: Optional[float32]{Exists: true, Item: 256}}},
+			UseDuration: 140, Range: 256}}},
+		JukeboxPlayable{Song: Holder[JukeboxSong]{ID: 12}, ShowInTooltip: true},
+		JukeboxPlayable{Song: Holder[JukeboxSong]{Inline: &JukeboxSong{Sound: Holder[SoundEvent]{ID: 7},
+			Description: "Song", LengthInSeconds: 178, ComparatorOutput: 1}}},
+		JukeboxPlayable{SongName: "minecraft:pigstep"},
+		LodestoneTracker{Target: Optional[GlobalPos]{Exists: true, Item: GlobalPos{"minecraft:overworld", Position{X: 1, Y: 64, Z: -3}}}, Tracked: true},
+		FireworkExplosion{Shape: 4, Colors: []int32{0xff}, FadeColors: []int32{}, HasTrail: true},
+		Fireworks{FlightDuration: 2, Explosions: []FireworkExplosion{{Shape: 1, Colors: []int32{}, FadeColors: []int32{0xff00}, HasTwinkle: true}}},
+		BannerPatterns{Layers: []BannerLayer{
+			{Pattern: Holder[BannerPattern]{ID: 2}, Color: 14},
+			{Pattern: Holder[BannerPattern]{Inline: &BannerPattern{AssetID: "minecraft:globe", TranslationKey: "block.minecraft.banner.globe"}}},
+		}},
+		Bees{Bees: []Bee{{EntityData: nbt.Compound{"id": "minecraft:bee"}, TicksInHive: 100, MinTicksInHive: 600}}},
+	}}
+
+	var buf bytes.Buffer
+	if err := WriteSlot(&buf, want); err != nil {
+		t.Fatalf("WriteSlot failed: %v", err)
+	}
+	got, err := ReadSlot(&buf)
+	if err != nil {
+		t.Fatalf("ReadSlot failed: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("%d bytes left unread", buf.Len())
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+
+	c, ok := got.Component(ComponentProfile)
+	if p, _ := c.(Profile); !ok || p.Name.Item != "Notch" {
+		t.Errorf("Component(profile): got %+v %v", c, ok)
+	}
+	if _, ok := got.Component(ComponentDamage); ok {
+		t.Error("Component(damage): got a component not added")
+	}
+}
+
+func TestReadSlot_Errors(t *testing.T) {
+	var opaque bytes.Buffer
+	WriteSlot(&opaque, Slot{Count: 1, ItemID: 2, Add: []Component{Opaque{ComponentContainerLoot + 1, []byte{0x01}}}})
+
+	effect := EffectDetails{Amplifier: 1, Duration: 20}
+	for range maxSlotDepth + 1 {
+		hidden := effect
+		effect = EffectDetails{Amplifier: 1, Duration: 20, Hidden: &hidden}
+	}
+	var hidden bytes.Buffer
+	WriteSlot(&hidden, Slot{Count: 1, ItemID: 3, Add: []Component{
+		PotionContents{CustomEffects: []MobEffect{{Effect: 1, Details: effect}}},
+	}})
+
+	deep := Slot{Count: 1, ItemID: 1}
+	for range maxSlotDepth + 1 {
+		deep = Slot{Count: 1, ItemID: 1, Add: []Component{Container{Items: []Slot{deep}}}}
+	}
+	var nested bytes.Buffer
+	WriteSlot(&nested, deep)
+
+	for _, tC := range []struct {
+		desc      string
+		ser       []byte
+		expectErr error
+	}{
+		{"unknown component", opaque.Bytes(), ErrUnknownComponent},
+		{"nested too deep", nested.Bytes(), ErrSlotTooDeep},
+		{"hidden effects too deep", hidden.Bytes(), ErrEffectTooDeep},
+		{"negative count of components", []byte{0x01, 0x01, 0xff, 0xff, 0xff, 0xff, 0x0f, 0x00}, ErrNegativeLength},
+		{"truncated component", []byte{0x01, 0x01, 0x01, 0x00, 0x18}, nil},
+	} {
+		_, err := ReadSlot(bytes.NewReader(tC.ser))
+		if err == nil || tC.expectErr != nil && !errors.Is(err, tC.expectErr) {
+			t.Errorf("%s: expected %v, got %v", tC.desc, tC.expectErr, err)
+		}
+	}
+}
+
+func TestSetCreativeModeSlot(t *testing.T) {
+	want := SetCreativeModeSlot{Slot: -1, ClickedItem: Slot{Count: 2, ItemID: 27, Add: []Component{Damage{1}}}}
+	var buf bytes.Buffer
+	if err := want.Encode(&buf); err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	if id, err := ReadVarInt(&buf); err != nil || id != want.ID() {
+		t.Fatalf("expected ID %d, got %d, %v", want.ID(), id, err)
+	}
+	var got SetCreativeModeSlot
+	if err := got.Decode(&buf); err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
@@ -24,3 +24,12 @@ type StatusRespPacket struct {
 func (p StatusRespPacket) ID() int32 {
 	return 0
 }
+
+// @gen:r,w,regclient
+type PingRespPacket struct {
+	Timestamp int64 `field:"Long"`
+}
+
+func (p PingRespPacket) ID() int32 {
+	return 1
+}
@@ -2,17 +2,299 @@
 package packet
 
 
+// Source: config.go
+var ConfigServerboundRegistry = map[int32]func() Packet{
+	0x06: func() Packet { return &ConfigResourcePackResponse{} },
+	0x00: func() Packet { return &ConfigClientInformation{} },
+	0x01: func() Packet { return &ConfigCookieResponse{} },
+	0x02: func() Packet { return &ConfigServerboundPluginMessage{} },
+	0x03: func() Packet { return &FinishConfigurationAcknowledge{} },
+	0x04: func() Packet { return &ConfigServerboundKeepAlive{} },
+	0x07: func() Packet { return &ConfigServerboundKnownPacks{} },
+}
+var ConfigClientboundRegistry = map[int32]func() Packet{
+	0x00: func() Packet { return &ConfigCookieRequest{} },
+	0x01: func() Packet { return &ConfigClientboundPluginMessage{} },
+	0x02: func() Packet { return &ConfigDisconnect{} },
+	0x08: func() Packet { return &ConfigRemoveResourcePack{} },
+	0x09: func() Packet { return &ConfigAddResourcePack{} },
+	0x03: func() Packet { return &FinishConfiguration{} },
+	0x04: func() Packet { return &ConfigClientboundKeepAlive{} },
+	0x0A: func() Packet { return &ConfigStoreCookie{} },
+	0x0B: func() Packet { return &ConfigTransfer{} },
+	0x0C: func() Packet { return &FeatureFlags{} },
+	0x07: func() Packet { return &RegistryData{} },
+	0x0E: func() Packet { return &ConfigClientboundKnownPacks{} },
+	0x0D: func() Packet { return &ConfigUpdateTags{} },
+}
+
+func (p ConfigCookieRequest) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteIdentifier(w, p.Key); err != nil { return }
+	return
+}
+
+func (p *ConfigCookieRequest) Decode(r Reader) (err error) {
+	if p.Key, err = ReadIdentifier(r); err != nil { return }
+	return nil
+}
+
+func (p ConfigClientboundPluginMessage) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteIdentifier(w, p.Channel); err != nil { return }
+	if err = WriteByteArray(w, p.Data); err != nil { return }
+	return
+}
+
+func (p *ConfigClientboundPluginMessage) Decode(r Reader) (err error) {
+	if p.Channel, err = ReadIdentifier(r); err != nil { return }
+	if p.Data, err = ReadByteArray(r); err != nil { return }
+	return nil
+}
+
+func (p ConfigDisconnect) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteNBT(w, p.Reason); err != nil { return }
+	return
+}
+
+func (p *ConfigDisconnect) Decode(r Reader) (err error) {
+	if p.Reason, err = ReadNBT(r); err != nil { return }
+	return nil
+}
+
+func (p ConfigRemoveResourcePack) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteOptional(w, p.UUID, WriteUUID); err != nil { return }
+	return
+}
+
+func (p *ConfigRemoveResourcePack) Decode(r Reader) (err error) {
+	if p.UUID, err = ReadOptional(r, ReadUUID); err != nil { return }
+	return nil
+}
+
+func (p ConfigAddResourcePack) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteUUID(w, p.UUID); err != nil { return }
+	if err = WriteString(w, p.URL); err != nil { return }
+	if err = WriteString(w, p.Hash); err != nil { return }
+	if err = WriteBoolean(w, p.Forced); err != nil { return }
+	if err = WriteOptional(w, p.PromptMessage, WriteNBT); err != nil { return }
+	return
+}
+
+func (p *ConfigAddResourcePack) Decode(r Reader) (err error) {
+	if p.UUID, err = ReadUUID(r); err != nil { return }
+	if p.URL, err = ReadString(r); err != nil { return }
+	if p.Hash, err = ReadString(r); err != nil { return }
+	if p.Forced, err = ReadBoolean(r); err != nil { return }
+	if p.PromptMessage, err = ReadOptional(r, ReadNBT); err != nil { return }
+	return nil
+}
+
+func (p ConfigResourcePackResponse) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteUUID(w, p.UUID); err != nil { return }
+	if err = WriteVarInt(w, p.Result); err != nil { return }
+	return
+}
+
+func (p *ConfigResourcePackResponse) Decode(r Reader) (err error) {
+	if p.UUID, err = ReadUUID(r); err != nil { return }
+	if p.Result, err = ReadVarInt(r); err != nil { return }
+	return nil
+}
+
+func (p FinishConfiguration) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	return
+}
+
+func (p *FinishConfiguration) Decode(r Reader) (err error) {
+	return nil
+}
+
+func (p ConfigClientboundKeepAlive) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteLong(w, p.KeepAliveID); err != nil { return }
+	return
+}
+
+func (p *ConfigClientboundKeepAlive) Decode(r Reader) (err error) {
+	if p.KeepAliveID, err = ReadLong(r); err != nil { return }
+	return nil
+}
+
+func (p ConfigStoreCookie) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteIdentifier(w, p.Key); err != nil { return }
+	if err = WriteCookie(w, p.Payload); err != nil { return }
+	return
+}
+
+func (p *ConfigStoreCookie) Decode(r Reader) (err error) {
+	if p.Key, err = ReadIdentifier(r); err != nil { return }
+	if p.Payload, err = ReadCookie(r); err != nil { return }
+	return nil
+}
+
+func (p ConfigTransfer) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteString(w, p.Host); err != nil { return }
+	if err = WriteVarInt(w, p.Port); err != nil { return }
+	return
+}
+
+func (p *ConfigTransfer) Decode(r Reader) (err error) {
+	if p.Host, err = ReadString(r); err != nil { return }
+	if p.Port, err = ReadVarInt(r); err != nil { return }
+	return nil
+}
+
+func (p FeatureFlags) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WritePrefixedArray(w, p.Flags, WriteIdentifier); err != nil { return }
+	return
+}
+
+func (p *FeatureFlags) Decode(r Reader) (err error) {
+	if p.Flags, err = ReadPrefixedArray(r, ReadIdentifier); err != nil { return }
+	return nil
+}
+
+func (p ConfigClientInformation) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteString(w, p.Locale); err != nil { return }
+	if err = WriteByte(w, p.ViewDistance); err != nil { return }
+	if err = WriteVarInt(w, p.ChatMode); err != nil { return }
+	if err = WriteBoolean(w, p.ChatColors); err != nil { return }
+	if err = WriteByte(w, p.DisplayedSkinParts); err != nil { return }
+	if err = WriteVarInt(w, p.MainHand); err != nil { return }
+	if err = WriteBoolean(w, p.EnableTextFiltering); err != nil { return }
+	if err = WriteBoolean(w, p.AllowServerListings); err != nil { return }
+	return
+}
+
+func (p *ConfigClientInformation) Decode(r Reader) (err error) {
+	if p.Locale, err = ReadString(r); err != nil { return }
+	if p.ViewDistance, err = ReadByte(r); err != nil { return }
+	if p.ChatMode, err = ReadVarInt(r); err != nil { return }
+	if p.ChatColors, err = ReadBoolean(r); err != nil { return }
+	if p.DisplayedSkinParts, err = ReadByte(r); err != nil { return }
+	if p.MainHand, err = ReadVarInt(r); err != nil { return }
+	if p.EnableTextFiltering, err = ReadBoolean(r); err != nil { return }
+	if p.AllowServerListings, err = ReadBoolean(r); err != nil { return }
+	return nil
+}
+
+func (p ConfigCookieResponse) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteIdentifier(w, p.Key); err != nil { return }
+	if err = WriteOptional(w, p.Payload, WriteCookie); err != nil { return }
+	return
+}
+
+func (p *ConfigCookieResponse) Decode(r Reader) (err error) {
+	if p.Key, err = ReadIdentifier(r); err != nil { return }
+	if p.Payload, err = ReadOptional(r, ReadCookie); err != nil { return }
+	return nil
+}
+
+func (p ConfigServerboundPluginMessage) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteIdentifier(w, p.Channel); err != nil { return }
+	if err = WriteByteArray(w, p.Data); err != nil { return }
+	return
+}
+
+func (p *ConfigServerboundPluginMessage) Decode(r Reader) (err error) {
+	if p.Channel, err = ReadIdentifier(r); err != nil { return }
+	if p.Data, err = ReadByteArray(r); err != nil { return }
+	return nil
+}
+
+func (p FinishConfigurationAcknowledge) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	return
+}
+
+func (p *FinishConfigurationAcknowledge) Decode(r Reader) (err error) {
+	return nil
+}
+
+func (p ConfigServerboundKeepAlive) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteLong(w, p.KeepAliveID); err != nil { return }
+	return
+}
+
+func (p *ConfigServerboundKeepAlive) Decode(r Reader) (err error) {
+	if p.KeepAliveID, err = ReadLong(r); err != nil { return }
+	return nil
+}
+
+func (p RegistryData) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteIdentifier(w, p.Registry); err != nil { return }
+	if err = WritePrefixedArray(w, p.Entries, WriteRegistryEntry); err != nil { return }
+	return
+}
+
+func (p *RegistryData) Decode(r Reader) (err error) {
+	if p.Registry, err = ReadIdentifier(r); err != nil { return }
+	if p.Entries, err = ReadPrefixedArray(r, ReadRegistryEntry); err != nil { return }
+	return nil
+}
+
+func (p ConfigClientboundKnownPacks) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WritePrefixedArray(w, p.Packs, WriteKnownPack); err != nil { return }
+	return
+}
+
+func (p *ConfigClientboundKnownPacks) Decode(r Reader) (err error) {
+	if p.Packs, err = ReadPrefixedArray(r, ReadKnownPack); err != nil { return }
+	return nil
+}
+
+func (p ConfigServerboundKnownPacks) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WritePrefixedArray(w, p.Packs, WriteKnownPack); err != nil { return }
+	return
+}
+
+func (p *ConfigServerboundKnownPacks) Decode(r Reader) (err error) {
+	if p.Packs, err = ReadPrefixedArray(r, ReadKnownPack); err != nil { return }
+	return nil
+}
+
+func (p ConfigUpdateTags) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WritePrefixedArray(w, p.Registries, WriteRegistryTags); err != nil { return }
+	return
+}
+
+func (p *ConfigUpdateTags) Decode(r Reader) (err error) {
+	if p.Registries, err = ReadPrefixedArray(r, ReadRegistryTags); err != nil { return }
+	return nil
+}
+
 // Source: login.go
 var LoginServerboundRegistry = map[int32]func() Packet{
 	0: func() Packet { return &LoginStart{} },
 	1: func() Packet { return &EncryptionResponse{} },
+	2: func() Packet { return &LoginPluginResponse{} },
 	3: func() Packet { return &LoginAcknowledge{} },
+	4: func() Packet { return &LoginCookieResponse{} },
 }
 var LoginClientboundRegistry = map[int32]func() Packet{
 	0: func() Packet { return &LoginDisconnect{} },
 	1: func() Packet { return &EncryptionRequest{} },
 	2: func() Packet { return &LoginSuccess{} },
 	3: func() Packet { return &SetCompression{} },
+	4: func() Packet { return &LoginPluginRequest{} },
+	5: func() Packet { return &LoginCookieRequest{} },
 }
 
 func (p LoginStart) Encode(w Writer) (err error) {
@@ -41,6 +323,21 @@ func (p *EncryptionResponse) Decode(r Reader) (err error) {
 	return nil
 }
 
+func (p LoginPluginResponse) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteVarInt(w, p.MessageID); err != nil { return }
+	if err = WriteBoolean(w, p.Successful); err != nil { return }
+	if err = WriteByteArray(w, p.Data); err != nil { return }
+	return
+}
+
+func (p *LoginPluginResponse) Decode(r Reader) (err error) {
+	if p.MessageID, err = ReadVarInt(r); err != nil { return }
+	if p.Successful, err = ReadBoolean(r); err != nil { return }
+	if p.Data, err = ReadByteArray(r); err != nil { return }
+	return nil
+}
+
 func (p LoginAcknowledge) Encode(w Writer) (err error) {
 	if err = WriteVarInt(w, p.ID()); err != nil { return }
 	return
@@ -50,6 +347,19 @@ func (p *LoginAcknowledge) Decode(r Reader) (err error) {
 	return nil
 }
 
+func (p LoginCookieResponse) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteIdentifier(w, p.Key); err != nil { return }
+	if err = WriteOptional(w, p.Payload, WriteCookie); err != nil { return }
+	return
+}
+
+func (p *LoginCookieResponse) Decode(r Reader) (err error) {
+	if p.Key, err = ReadIdentifier(r); err != nil { return }
+	if p.Payload, err = ReadOptional(r, ReadCookie); err != nil { return }
+	return nil
+}
+
 func (p LoginDisconnect) Encode(w Writer) (err error) {
 	if err = WriteVarInt(w, p.ID()); err != nil { return }
 	if err = WriteString(w, p.Reason); err != nil { return }
@@ -82,7 +392,7 @@ func (p LoginSuccess) Encode(w Writer) (err error) {
 	if err = WriteVarInt(w, p.ID()); err != nil { return }
 	if err = WriteUUID(w, p.UUID); err != nil { return }
 	if err = WriteString(w, p.Username); err != nil { return }
-	if err = WritePrefixedArray(w, p.Properties, writeGameProfileProperty); err != nil { return }
+	if err = WritePrefixedArray(w, p.Properties, WriteGameProfileProperty); err != nil { return }
 	if err = WriteBoolean(w, p.StrictErrHandling); err != nil { return }
 	return
 }
@@ -90,7 +400,7 @@ func (p LoginSuccess) Encode(w Writer) (err error) {
 func (p *LoginSuccess) Decode(r Reader) (err error) {
 	if p.UUID, err = ReadUUID(r); err != nil { return }
 	if p.Username, err = ReadString(r); err != nil { return }
-	if p.Properties, err = ReadPrefixedArray(r, readGameProfileProperty); err != nil { return }
+	if p.Properties, err = ReadPrefixedArray(r, ReadGameProfileProperty); err != nil { return }
 	if p.StrictErrHandling, err = ReadBoolean(r); err != nil { return }
 	return nil
 }
@@ -106,6 +416,32 @@ func (p *SetCompression) Decode(r Reader) (err error) {
 	return nil
 }
 
+func (p LoginPluginRequest) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteVarInt(w, p.MessageID); err != nil { return }
+	if err = WriteIdentifier(w, p.Channel); err != nil { return }
+	if err = WriteByteArray(w, p.Data); err != nil { return }
+	return
+}
+
+func (p *LoginPluginRequest) Decode(r Reader) (err error) {
+	if p.MessageID, err = ReadVarInt(r); err != nil { return }
+	if p.Channel, err = ReadIdentifier(r); err != nil { return }
+	if p.Data, err = ReadByteArray(r); err != nil { return }
+	return nil
+}
+
+func (p LoginCookieRequest) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteIdentifier(w, p.Key); err != nil { return }
+	return
+}
+
+func (p *LoginCookieRequest) Decode(r Reader) (err error) {
+	if p.Key, err = ReadIdentifier(r); err != nil { return }
+	return nil
+}
+
 // Source: packet.go
 
 func (p HandshakePacket) Encode(w Writer) (err error) {
@@ -125,6 +461,638 @@ func (p *HandshakePacket) Decode(r Reader) (err error) {
 	return nil
 }
 
+// Source: play.go
+var PlayServerboundRegistry = map[int32]func() Packet{
+	0x0C: func() Packet { return &StartConfigurationAcknowledge{} },
+	0x18: func() Packet { return &PlayServerboundKeepAlive{} },
+	0x12: func() Packet { return &PlayServerboundPluginMessage{} },
+	0x0A: func() Packet { return &PlayClientInformation{} },
+	0x11: func() Packet { return &PlayCookieResponse{} },
+	0x2B: func() Packet { return &PlayResourcePackResponse{} },
+	0x08: func() Packet { return &ChunkBatchReceived{} },
+	0x1A: func() Packet { return &SetPlayerPosition{} },
+	0x1B: func() Packet { return &SetPlayerPositionAndRotation{} },
+	0x1C: func() Packet { return &SetPlayerRotation{} },
+	0x1D: func() Packet { return &SetPlayerOnGround{} },
+	0x00: func() Packet { return &ConfirmTeleportation{} },
+	0x06: func() Packet { return &ChatMessage{} },
+	0x04: func() Packet { return &ChatCommand{} },
+	0x32: func() Packet { return &SetCreativeModeSlot{} },
+}
+var PlayClientboundRegistry = map[int32]func() Packet{
+	0x26: func() Packet { return &PlayClientboundKeepAlive{} },
+	0x69: func() Packet { return &StartConfiguration{} },
+	0x16: func() Packet { return &PlayCookieRequest{} },
+	0x19: func() Packet { return &PlayClientboundPluginMessage{} },
+	0x6B: func() Packet { return &PlayStoreCookie{} },
+	0x73: func() Packet { return &PlayTransfer{} },
+	0x78: func() Packet { return &PlayUpdateTags{} },
+	0x1D: func() Packet { return &PlayDisconnect{} },
+	0x45: func() Packet { return &PlayRemoveResourcePack{} },
+	0x46: func() Packet { return &PlayAddResourcePack{} },
+	0x2B: func() Packet { return &PlayLogin{} },
+	0x27: func() Packet { return &ChunkDataAndUpdateLight{} },
+	0x2A: func() Packet { return &UpdateLight{} },
+	0x0D: func() Packet { return &ChunkBatchStart{} },
+	0x0C: func() Packet { return &ChunkBatchFinished{} },
+	0x21: func() Packet { return &UnloadChunk{} },
+	0x54: func() Packet { return &SetCenterChunk{} },
+	0x55: func() Packet { return &SetRenderDistance{} },
+	0x40: func() Packet { return &SynchronizePlayerPosition{} },
+	0x22: func() Packet { return &GameEvent{} },
+	0x56: func() Packet { return &SetDefaultSpawnPosition{} },
+	0x6C: func() Packet { return &SystemChatMessage{} },
+	0x13: func() Packet { return &SetContainerContent{} },
+	0x15: func() Packet { return &SetContainerSlot{} },
+	0x58: func() Packet { return &SetEntityMetadata{} },
+}
+
+func (p PlayClientboundKeepAlive) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteLong(w, p.KeepAliveID); err != nil { return }
+	return
+}
+
+func (p *PlayClientboundKeepAlive) Decode(r Reader) (err error) {
+	if p.KeepAliveID, err = ReadLong(r); err != nil { return }
+	return nil
+}
+
+func (p StartConfiguration) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	return
+}
+
+func (p *StartConfiguration) Decode(r Reader) (err error) {
+	return nil
+}
+
+func (p StartConfigurationAcknowledge) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	return
+}
+
+func (p *StartConfigurationAcknowledge) Decode(r Reader) (err error) {
+	return nil
+}
+
+func (p PlayServerboundKeepAlive) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteLong(w, p.KeepAliveID); err != nil { return }
+	return
+}
+
+func (p *PlayServerboundKeepAlive) Decode(r Reader) (err error) {
+	if p.KeepAliveID, err = ReadLong(r); err != nil { return }
+	return nil
+}
+
+func (p PlayCookieRequest) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteIdentifier(w, p.Key); err != nil { return }
+	return
+}
+
+func (p *PlayCookieRequest) Decode(r Reader) (err error) {
+	if p.Key, err = ReadIdentifier(r); err != nil { return }
+	return nil
+}
+
+func (p PlayClientboundPluginMessage) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteIdentifier(w, p.Channel); err != nil { return }
+	if err = WriteByteArray(w, p.Data); err != nil { return }
+	return
+}
+
+func (p *PlayClientboundPluginMessage) Decode(r Reader) (err error) {
+	if p.Channel, err = ReadIdentifier(r); err != nil { return }
+	if p.Data, err = ReadByteArray(r); err != nil { return }
+	return nil
+}
+
+func (p PlayServerboundPluginMessage) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteIdentifier(w, p.Channel); err != nil { return }
+	if err = WriteByteArray(w, p.Data); err != nil { return }
+	return
+}
+
+func (p *PlayServerboundPluginMessage) Decode(r Reader) (err error) {
+	if p.Channel, err = ReadIdentifier(r); err != nil { return }
+	if p.Data, err = ReadByteArray(r); err != nil { return }
+	return nil
+}
+
+func (p PlayStoreCookie) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteIdentifier(w, p.Key); err != nil { return }
+	if err = WriteCookie(w, p.Payload); err != nil { return }
+	return
+}
+
+func (p *PlayStoreCookie) Decode(r Reader) (err error) {
+	if p.Key, err = ReadIdentifier(r); err != nil { return }
+	if p.Payload, err = ReadCookie(r); err != nil { return }
+	return nil
+}
+
+func (p PlayTransfer) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteString(w, p.Host); err != nil { return }
+	if err = WriteVarInt(w, p.Port); err != nil { return }
+	return
+}
+
+func (p *PlayTransfer) Decode(r Reader) (err error) {
+	if p.Host, err = ReadString(r); err != nil { return }
+	if p.Port, err = ReadVarInt(r); err != nil { return }
+	return nil
+}
+
+func (p PlayClientInformation) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteString(w, p.Locale); err != nil { return }
+	if err = WriteByte(w, p.ViewDistance); err != nil { return }
+	if err = WriteVarInt(w, p.ChatMode); err != nil { return }
+	if err = WriteBoolean(w, p.ChatColors); err != nil { return }
+	if err = WriteByte(w, p.DisplayedSkinParts); err != nil { return }
+	if err = WriteVarInt(w, p.MainHand); err != nil { return }
+	if err = WriteBoolean(w, p.EnableTextFiltering); err != nil { return }
+	if err = WriteBoolean(w, p.AllowServerListings); err != nil { return }
+	return
+}
+
+func (p *PlayClientInformation) Decode(r Reader) (err error) {
+	if p.Locale, err = ReadString(r); err != nil { return }
+	if p.ViewDistance, err = ReadByte(r); err != nil { return }
+	if p.ChatMode, err = ReadVarInt(r); err != nil { return }
+	if p.ChatColors, err = ReadBoolean(r); err != nil { return }
+	if p.DisplayedSkinParts, err = ReadByte(r); err != nil { return }
+	if p.MainHand, err = ReadVarInt(r); err != nil { return }
+	if p.EnableTextFiltering, err = ReadBoolean(r); err != nil { return }
+	if p.AllowServerListings, err = ReadBoolean(r); err != nil { return }
+	return nil
+}
+
+func (p PlayCookieResponse) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteIdentifier(w, p.Key); err != nil { return }
+	if err = WriteOptional(w, p.Payload, WriteCookie); err != nil { return }
+	return
+}
+
+func (p *PlayCookieResponse) Decode(r Reader) (err error) {
+	if p.Key, err = ReadIdentifier(r); err != nil { return }
+	if p.Payload, err = ReadOptional(r, ReadCookie); err != nil { return }
+	return nil
+}
+
+func (p PlayUpdateTags) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WritePrefixedArray(w, p.Registries, WriteRegistryTags); err != nil { return }
+	return
+}
+
+func (p *PlayUpdateTags) Decode(r Reader) (err error) {
+	if p.Registries, err = ReadPrefixedArray(r, ReadRegistryTags); err != nil { return }
+	return nil
+}
+
+func (p PlayDisconnect) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteNBT(w, p.Reason); err != nil { return }
+	return
+}
+
+func (p *PlayDisconnect) Decode(r Reader) (err error) {
+	if p.Reason, err = ReadNBT(r); err != nil { return }
+	return nil
+}
+
+func (p PlayRemoveResourcePack) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteOptional(w, p.UUID, WriteUUID); err != nil { return }
+	return
+}
+
+func (p *PlayRemoveResourcePack) Decode(r Reader) (err error) {
+	if p.UUID, err = ReadOptional(r, ReadUUID); err != nil { return }
+	return nil
+}
+
+func (p PlayAddResourcePack) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteUUID(w, p.UUID); err != nil { return }
+	if err = WriteString(w, p.URL); err != nil { return }
+	if err = WriteString(w, p.Hash); err != nil { return }
+	if err = WriteBoolean(w, p.Forced); err != nil { return }
+	if err = WriteOptional(w, p.PromptMessage, WriteNBT); err != nil { return }
+	return
+}
+
+func (p *PlayAddResourcePack) Decode(r Reader) (err error) {
+	if p.UUID, err = ReadUUID(r); err != nil { return }
+	if p.URL, err = ReadString(r); err != nil { return }
+	if p.Hash, err = ReadString(r); err != nil { return }
+	if p.Forced, err = ReadBoolean(r); err != nil { return }
+	if p.PromptMessage, err = ReadOptional(r, ReadNBT); err != nil { return }
+	return nil
+}
+
+func (p PlayResourcePackResponse) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteUUID(w, p.UUID); err != nil { return }
+	if err = WriteVarInt(w, p.Result); err != nil { return }
+	return
+}
+
+func (p *PlayResourcePackResponse) Decode(r Reader) (err error) {
+	if p.UUID, err = ReadUUID(r); err != nil { return }
+	if p.Result, err = ReadVarInt(r); err != nil { return }
+	return nil
+}
+
+func (p PlayLogin) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteInt(w, p.EntityID); err != nil { return }
+	if err = WriteBoolean(w, p.IsHardcore); err != nil { return }
+	if err = WritePrefixedArray(w, p.DimensionNames, WriteString); err != nil { return }
+	if err = WriteVarInt(w, p.MaxPlayers); err != nil { return }
+	if err = WriteVarInt(w, p.ViewDistance); err != nil { return }
+	if err = WriteVarInt(w, p.SimulationDistance); err != nil { return }
+	if err = WriteBoolean(w, p.ReducedDebugInfo); err != nil { return }
+	if err = WriteBoolean(w, p.EnableRespawnScreen); err != nil { return }
+	if err = WriteBoolean(w, p.DoLimitedCrafting); err != nil { return }
+	if err = WriteVarInt(w, p.DimensionType); err != nil { return }
+	if err = WriteString(w, p.DimensionName); err != nil { return }
+	if err = WriteLong(w, p.HashedSeed); err != nil { return }
+	if err = WriteByte(w, p.GameMode); err != nil { return }
+	if err = WriteByte(w, p.PreviousGameMode); err != nil { return }
+	if err = WriteBoolean(w, p.IsDebug); err != nil { return }
+	if err = WriteBoolean(w, p.IsFlat); err != nil { return }
+	if err = WriteOptional(w, p.DeathLocation, writeDeathLocation); err != nil { return }
+	if err = WriteVarInt(w, p.PortalCooldown); err != nil { return }
+	if err = WriteBoolean(w, p.EnforcesSecureChat); err != nil { return }
+	return
+}
+
+func (p *PlayLogin) Decode(r Reader) (err error) {
+	if p.EntityID, err = ReadInt(r); err != nil { return }
+	if p.IsHardcore, err = ReadBoolean(r); err != nil { return }
+	if p.DimensionNames, err = ReadPrefixedArray(r, ReadString); err != nil { return }
+	if p.MaxPlayers, err = ReadVarInt(r); err != nil { return }
+	if p.ViewDistance, err = ReadVarInt(r); err != nil { return }
+	if p.SimulationDistance, err = ReadVarInt(r); err != nil { return }
+	if p.ReducedDebugInfo, err = ReadBoolean(r); err != nil { return }
+	if p.EnableRespawnScreen, err = ReadBoolean(r); err != nil { return }
+	if p.DoLimitedCrafting, err = ReadBoolean(r); err != nil { return }
+	if p.DimensionType, err = ReadVarInt(r); err != nil { return }
+	if p.DimensionName, err = ReadString(r); err != nil { return }
+	if p.HashedSeed, err = ReadLong(r); err != nil { return }
+	if p.GameMode, err = ReadByte(r); err != nil { return }
+	if p.PreviousGameMode, err = ReadByte(r); err != nil { return }
+	if p.IsDebug, err = ReadBoolean(r); err != nil { return }
+	if p.IsFlat, err = ReadBoolean(r); err != nil { return }
+	if p.DeathLocation, err = ReadOptional(r, readDeathLocation); err != nil { return }
+	if p.PortalCooldown, err = ReadVarInt(r); err != nil { return }
+	if p.EnforcesSecureChat, err = ReadBoolean(r); err != nil { return }
+	return nil
+}
+
+func (p ChunkDataAndUpdateLight) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteInt(w, p.ChunkX); err != nil { return }
+	if err = WriteInt(w, p.ChunkZ); err != nil { return }
+	if err = WriteNBTCompound(w, p.Heightmaps); err != nil { return }
+	if err = WritePrefixedByteArray(w, p.Data); err != nil { return }
+	if err = WritePrefixedArray(w, p.BlockEntities, WriteBlockEntity); err != nil { return }
+	if err = WriteLightData(w, p.Light); err != nil { return }
+	return
+}
+
+func (p *ChunkDataAndUpdateLight) Decode(r Reader) (err error) {
+	if p.ChunkX, err = ReadInt(r); err != nil { return }
+	if p.ChunkZ, err = ReadInt(r); err != nil { return }
+	if p.Heightmaps, err = ReadNBTCompound(r); err != nil { return }
+	if p.Data, err = ReadPrefixedByteArray(r); err != nil { return }
+	if p.BlockEntities, err = ReadPrefixedArray(r, ReadBlockEntity); err != nil { return }
+	if p.Light, err = ReadLightData(r); err != nil { return }
+	return nil
+}
+
+func (p UpdateLight) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteVarInt(w, p.ChunkX); err != nil { return }
+	if err = WriteVarInt(w, p.ChunkZ); err != nil { return }
+	if err = WriteLightData(w, p.Light); err != nil { return }
+	return
+}
+
+func (p *UpdateLight) Decode(r Reader) (err error) {
+	if p.ChunkX, err = ReadVarInt(r); err != nil { return }
+	if p.ChunkZ, err = ReadVarInt(r); err != nil { return }
+	if p.Light, err = ReadLightData(r); err != nil { return }
+	return nil
+}
+
+func (p ChunkBatchStart) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	return
+}
+
+func (p *ChunkBatchStart) Decode(r Reader) (err error) {
+	return nil
+}
+
+func (p ChunkBatchFinished) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteVarInt(w, p.BatchSize); err != nil { return }
+	return
+}
+
+func (p *ChunkBatchFinished) Decode(r Reader) (err error) {
+	if p.BatchSize, err = ReadVarInt(r); err != nil { return }
+	return nil
+}
+
+func (p ChunkBatchReceived) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteFloat(w, p.ChunksPerTick); err != nil { return }
+	return
+}
+
+func (p *ChunkBatchReceived) Decode(r Reader) (err error) {
+	if p.ChunksPerTick, err = ReadFloat(r); err != nil { return }
+	return nil
+}
+
+func (p UnloadChunk) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteInt(w, p.ChunkZ); err != nil { return }
+	if err = WriteInt(w, p.ChunkX); err != nil { return }
+	return
+}
+
+func (p *UnloadChunk) Decode(r Reader) (err error) {
+	if p.ChunkZ, err = ReadInt(r); err != nil { return }
+	if p.ChunkX, err = ReadInt(r); err != nil { return }
+	return nil
+}
+
+func (p SetCenterChunk) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteVarInt(w, p.ChunkX); err != nil { return }
+	if err = WriteVarInt(w, p.ChunkZ); err != nil { return }
+	return
+}
+
+func (p *SetCenterChunk) Decode(r Reader) (err error) {
+	if p.ChunkX, err = ReadVarInt(r); err != nil { return }
+	if p.ChunkZ, err = ReadVarInt(r); err != nil { return }
+	return nil
+}
+
+func (p SetRenderDistance) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteVarInt(w, p.ViewDistance); err != nil { return }
+	return
+}
+
+func (p *SetRenderDistance) Decode(r Reader) (err error) {
+	if p.ViewDistance, err = ReadVarInt(r); err != nil { return }
+	return nil
+}
+
+func (p SetPlayerPosition) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteDouble(w, p.X); err != nil { return }
+	if err = WriteDouble(w, p.FeetY); err != nil { return }
+	if err = WriteDouble(w, p.Z); err != nil { return }
+	if err = WriteBoolean(w, p.OnGround); err != nil { return }
+	return
+}
+
+func (p *SetPlayerPosition) Decode(r Reader) (err error) {
+	if p.X, err = ReadDouble(r); err != nil { return }
+	if p.FeetY, err = ReadDouble(r); err != nil { return }
+	if p.Z, err = ReadDouble(r); err != nil { return }
+	if p.OnGround, err = ReadBoolean(r); err != nil { return }
+	return nil
+}
+
+func (p SetPlayerPositionAndRotation) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteDouble(w, p.X); err != nil { return }
+	if err = WriteDouble(w, p.FeetY); err != nil { return }
+	if err = WriteDouble(w, p.Z); err != nil { return }
+	if err = WriteFloat(w, p.Yaw); err != nil { return }
+	if err = WriteFloat(w, p.Pitch); err != nil { return }
+	if err = WriteBoolean(w, p.OnGround); err != nil { return }
+	return
+}
+
+func (p *SetPlayerPositionAndRotation) Decode(r Reader) (err error) {
+	if p.X, err = ReadDouble(r); err != nil { return }
+	if p.FeetY, err = ReadDouble(r); err != nil { return }
+	if p.Z, err = ReadDouble(r); err != nil { return }
+	if p.Yaw, err = ReadFloat(r); err != nil { return }
+	if p.Pitch, err = ReadFloat(r); err != nil { return }
+	if p.OnGround, err = ReadBoolean(r); err != nil { return }
+	return nil
+}
+
+func (p SetPlayerRotation) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteFloat(w, p.Yaw); err != nil { return }
+	if err = WriteFloat(w, p.Pitch); err != nil { return }
+	if err = WriteBoolean(w, p.OnGround); err != nil { return }
+	return
+}
+
+func (p *SetPlayerRotation) Decode(r Reader) (err error) {
+	if p.Yaw, err = ReadFloat(r); err != nil { return }
+	if p.Pitch, err = ReadFloat(r); err != nil { return }
+	if p.OnGround, err = ReadBoolean(r); err != nil { return }
+	return nil
+}
+
+func (p SetPlayerOnGround) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteBoolean(w, p.OnGround); err != nil { return }
+	return
+}
+
+func (p *SetPlayerOnGround) Decode(r Reader) (err error) {
+	if p.OnGround, err = ReadBoolean(r); err != nil { return }
+	return nil
+}
+
+func (p SynchronizePlayerPosition) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteDouble(w, p.X); err != nil { return }
+	if err = WriteDouble(w, p.Y); err != nil { return }
+	if err = WriteDouble(w, p.Z); err != nil { return }
+	if err = WriteFloat(w, p.Yaw); err != nil { return }
+	if err = WriteFloat(w, p.Pitch); err != nil { return }
+	if err = WriteByte(w, p.Flags); err != nil { return }
+	if err = WriteVarInt(w, p.TeleportID); err != nil { return }
+	return
+}
+
+func (p *SynchronizePlayerPosition) Decode(r Reader) (err error) {
+	if p.X, err = ReadDouble(r); err != nil { return }
+	if p.Y, err = ReadDouble(r); err != nil { return }
+	if p.Z, err = ReadDouble(r); err != nil { return }
+	if p.Yaw, err = ReadFloat(r); err != nil { return }
+	if p.Pitch, err = ReadFloat(r); err != nil { return }
+	if p.Flags, err = ReadByte(r); err != nil { return }
+	if p.TeleportID, err = ReadVarInt(r); err != nil { return }
+	return nil
+}
+
+func (p ConfirmTeleportation) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteVarInt(w, p.TeleportID); err != nil { return }
+	return
+}
+
+func (p *ConfirmTeleportation) Decode(r Reader) (err error) {
+	if p.TeleportID, err = ReadVarInt(r); err != nil { return }
+	return nil
+}
+
+func (p GameEvent) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteByte(w, p.Event); err != nil { return }
+	if err = WriteFloat(w, p.Value); err != nil { return }
+	return
+}
+
+func (p *GameEvent) Decode(r Reader) (err error) {
+	if p.Event, err = ReadByte(r); err != nil { return }
+	if p.Value, err = ReadFloat(r); err != nil { return }
+	return nil
+}
+
+func (p SetDefaultSpawnPosition) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WritePosition(w, p.Location); err != nil { return }
+	if err = WriteFloat(w, p.Angle); err != nil { return }
+	return
+}
+
+func (p *SetDefaultSpawnPosition) Decode(r Reader) (err error) {
+	if p.Location, err = ReadPosition(r); err != nil { return }
+	if p.Angle, err = ReadFloat(r); err != nil { return }
+	return nil
+}
+
+func (p SystemChatMessage) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteNBT(w, p.Content); err != nil { return }
+	if err = WriteBoolean(w, p.Overlay); err != nil { return }
+	return
+}
+
+func (p *SystemChatMessage) Decode(r Reader) (err error) {
+	if p.Content, err = ReadNBT(r); err != nil { return }
+	if p.Overlay, err = ReadBoolean(r); err != nil { return }
+	return nil
+}
+
+func (p ChatMessage) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteString(w, p.Message); err != nil { return }
+	if err = WriteLong(w, p.Timestamp); err != nil { return }
+	if err = WriteLong(w, p.Salt); err != nil { return }
+	if err = WriteOptional(w, p.Signature, writeMessageSignature); err != nil { return }
+	if err = WriteVarInt(w, p.MessageCount); err != nil { return }
+	if err = WriteAcknowledgedMessages(w, p.Acknowledged); err != nil { return }
+	return
+}
+
+func (p *ChatMessage) Decode(r Reader) (err error) {
+	if p.Message, err = ReadString(r); err != nil { return }
+	if p.Timestamp, err = ReadLong(r); err != nil { return }
+	if p.Salt, err = ReadLong(r); err != nil { return }
+	if p.Signature, err = ReadOptional(r, readMessageSignature); err != nil { return }
+	if p.MessageCount, err = ReadVarInt(r); err != nil { return }
+	if p.Acknowledged, err = ReadAcknowledgedMessages(r); err != nil { return }
+	return nil
+}
+
+func (p ChatCommand) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteString(w, p.Command); err != nil { return }
+	return
+}
+
+func (p *ChatCommand) Decode(r Reader) (err error) {
+	if p.Command, err = ReadString(r); err != nil { return }
+	return nil
+}
+
+func (p SetContainerContent) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteByte(w, p.WindowID); err != nil { return }
+	if err = WriteVarInt(w, p.StateID); err != nil { return }
+	if err = WritePrefixedArray(w, p.Slots, WriteSlot); err != nil { return }
+	if err = WriteSlot(w, p.CarriedItem); err != nil { return }
+	return
+}
+
+func (p *SetContainerContent) Decode(r Reader) (err error) {
+	if p.WindowID, err = ReadByte(r); err != nil { return }
+	if p.StateID, err = ReadVarInt(r); err != nil { return }
+	if p.Slots, err = ReadPrefixedArray(r, ReadSlot); err != nil { return }
+	if p.CarriedItem, err = ReadSlot(r); err != nil { return }
+	return nil
+}
+
+func (p SetContainerSlot) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteByte(w, p.WindowID); err != nil { return }
+	if err = WriteVarInt(w, p.StateID); err != nil { return }
+	if err = WriteShort(w, p.Slot); err != nil { return }
+	if err = WriteSlot(w, p.SlotData); err != nil { return }
+	return
+}
+
+func (p *SetContainerSlot) Decode(r Reader) (err error) {
+	if p.WindowID, err = ReadByte(r); err != nil { return }
+	if p.StateID, err = ReadVarInt(r); err != nil { return }
+	if p.Slot, err = ReadShort(r); err != nil { return }
+	if p.SlotData, err = ReadSlot(r); err != nil { return }
+	return nil
+}
+
+func (p SetCreativeModeSlot) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteShort(w, p.Slot); err != nil { return }
+	if err = WriteSlot(w, p.ClickedItem); err != nil { return }
+	return
+}
+
+func (p *SetCreativeModeSlot) Decode(r Reader) (err error) {
+	if p.Slot, err = ReadShort(r); err != nil { return }
+	if p.ClickedItem, err = ReadSlot(r); err != nil { return }
+	return nil
+}
+
+func (p SetEntityMetadata) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteVarInt(w, p.EntityID); err != nil { return }
+	if err = WriteEntityMetadata(w, p.Metadata); err != nil { return }
+	return
+}
+
+func (p *SetEntityMetadata) Decode(r Reader) (err error) {
+	if p.EntityID, err = ReadVarInt(r); err != nil { return }
+	if p.Metadata, err = ReadEntityMetadata(r); err != nil { return }
+	return nil
+}
+
 // Source: status.go
 var StatusServerboundRegistry = map[int32]func() Packet{
 	0: func() Packet { return &StatusReqPacket{} },
@@ -132,6 +1100,7 @@ var StatusServerboundRegistry = map[int32]func() Packet{
 }
 var StatusClientboundRegistry = map[int32]func() Packet{
 	0: func() Packet { return &StatusRespPacket{} },
+	1: func() Packet { return &PingRespPacket{} },
 }
 
 func (p StatusReqPacket) Encode(w Writer) (err error) {
@@ -165,3 +1134,14 @@ func (p *StatusRespPacket) Decode(r Reader) (err error) {
 	return nil
 }
 
+func (p PingRespPacket) Encode(w Writer) (err error) {
+	if err = WriteVarInt(w, p.ID()); err != nil { return }
+	if err = WriteLong(w, p.Timestamp); err != nil { return }
+	return
+}
+
+func (p *PingRespPacket) Decode(r Reader) (err error) {
+	if p.Timestamp, err = ReadLong(r); err != nil { return }
+	return nil
+}
+
@@ -0,0 +1,282 @@
+// Package proxy implements a debugging proxy relaying Minecraft connections
+// between clients and a backend server, decoding packets on the way.
+//
+// Only offline mode backends are supported, as encrypted sessions cannot be
+// observed by a third party.
+package proxy
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"sync"
+
+	"github.com/gstoney/mcproto"
+	"github.com/gstoney/mcproto/packet"
+)
+
+var (
+	ErrOnlineMode        = errors.New("proxy: backend requested encryption; only offline mode is supported")
+	ErrUnexpectedPacket  = errors.New("proxy: unexpected packet")
+	errDisconnectedLogin = errors.New("proxy: backend disconnected during login")
+)
+
+// An Interceptor is called with every decoded packet before it is relayed.
+//
+// It returns the packet to relay in its place, which may be p itself,
+// modified or not, or nil to drop it. When an Interceptor is set, decoded
+// packets are re-encoded instead of relayed as received.
+type Interceptor func(mode mcproto.ConnectionMode, dir mcproto.Direction, p packet.Packet) packet.Packet
+
+// A Proxy relays client connections to a backend server.
+type Proxy struct {
+	// Backend is the address of the server to dial for each client.
+	Backend string
+	Config  mcproto.TransportConfig
+
+	// Interceptor, if set, may modify or drop decoded packets.
+	Interceptor Interceptor
+	// Logger, if set, receives a line for every relayed packet.
+	Logger *log.Logger
+
+	// Dial connects to Backend. net.Dial is used if nil.
+	Dial func(network, addr string) (net.Conn, error)
+}
+
+// Serve accepts incoming connections on the Listener l,
+// relaying each on a new goroutine.
+func (p *Proxy) Serve(l net.Listener) error {
+	for {
+		c, err := l.Accept()
+		if err != nil {
+			var ne net.Error
+			if errors.As(err, &ne) && ne.Timeout() {
+				continue
+			}
+			return err
+		}
+
+		go func() {
+			if err := p.Relay(c); err != nil {
+				p.logf("%s: %v", c.RemoteAddr(), err)
+			}
+		}()
+	}
+}
+
+// Relay dials the backend and relays packets between it and client until
+// either side closes. Both connections are closed on return.
+func (p *Proxy) Relay(client net.Conn) error {
+	defer client.Close()
+
+	dial := p.Dial
+	if dial == nil {
+		dial = net.Dial
+	}
+	backend, err := dial("tcp", p.Backend)
+	if err != nil {
+		return err
+	}
+	defer backend.Close()
+
+	r := relay{
+		p:       p,
+		client:  mcproto.NewTransport(client, client, p.Config),
+		backend: mcproto.NewTransport(backend, backend, p.Config),
+	}
+	r.serverbound = pipe{dir: mcproto.Serverbound, src: &r.client, dst: &r.backend}
+	r.clientbound = pipe{dir: mcproto.Clientbound, src: &r.backend, dst: &r.client}
+
+	if err = r.login(); err != nil {
+		return err
+	}
+	return r.run(client, backend)
+}
+
+func (p *Proxy) logf(format string, args ...any) {
+	if p.Logger != nil {
+		p.Logger.Printf(format, args...)
+	}
+}
+
+// relay holds the state of one proxied connection.
+type relay struct {
+	p *Proxy
+
+	client  mcproto.Transport
+	backend mcproto.Transport
+
+	serverbound pipe
+	clientbound pipe
+
+	mu   sync.Mutex
+	mode mcproto.ConnectionMode
+}
+
+// pipe relays frames of one direction.
+type pipe struct {
+	dir      mcproto.Direction
+	src, dst *mcproto.Transport
+
+	raw bytes.Buffer
+	out bytes.Buffer
+	rd  bytes.Reader
+}
+
+func (r *relay) getMode() mcproto.ConnectionMode {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.mode
+}
+
+// forward relays a single frame through pp. It returns the decoded packet,
+// or nil if the frame could not be decoded and was relayed as is.
+//
+// Mode transitions are applied before the frame is sent on, so the reply
+// is decoded in the new mode.
+func (r *relay) forward(pp *pipe) (p packet.Packet, err error) {
+	pr, err := pp.src.Recv()
+	if err != nil {
+		return nil, err
+	}
+	pp.raw.Reset()
+	if _, err = pp.raw.ReadFrom(pr); err != nil {
+		pr.Discard()
+		return nil, err
+	}
+	if err = pr.Close(); err != nil {
+		return nil, err
+	}
+
+	mode := r.getMode()
+	p = r.decode(pp, mode)
+	r.log(mode, pp.dir, p, pp.raw.Bytes())
+
+	out := pp.raw.Bytes()
+	if p != nil && r.p.Interceptor != nil {
+		ip := r.p.Interceptor(mode, pp.dir, p)
+		if ip == nil {
+			return p, nil
+		}
+		pp.out.Reset()
+		if err = ip.Encode(&pp.out); err != nil {
+			return p, err
+		}
+		out = pp.out.Bytes()
+		p = ip
+	}
+
+	if p != nil && pp.dir == mcproto.Serverbound {
+		if next := mcproto.NextMode(mode, p); next != mode {
+			r.mu.Lock()
+			r.mode = next
+			r.mu.Unlock()
+		}
+	}
+
+	return p, pp.dst.Send(out)
+}
+
+// decode decodes the frame held in pp.raw, returning nil for unknown
+// packets and payloads not consumed exactly.
+func (r *relay) decode(pp *pipe, mode mcproto.ConnectionMode) packet.Packet {
+	pp.rd.Reset(pp.raw.Bytes())
+	id, err := packet.ReadVarInt(&pp.rd)
+	if err != nil {
+		return nil
+	}
+	factory, ok := mcproto.Registry(mode, pp.dir)[id]
+	if !ok {
+		return nil
+	}
+	p := factory()
+	if err = p.Decode(&pp.rd); err != nil || pp.rd.Len() > 0 {
+		return nil
+	}
+	return p
+}
+
+func (r *relay) log(mode mcproto.ConnectionMode, dir mcproto.Direction, p packet.Packet, raw []byte) {
+	if r.p.Logger == nil {
+		return
+	}
+	if p == nil {
+		id, _ := packet.ReadVarInt(bytes.NewReader(raw))
+		r.p.logf("%-9s %s 0x%02X (%d bytes, not decoded)", mode, dir, id, len(raw))
+		return
+	}
+	r.p.logf("%-9s %s 0x%02X %s %+v", mode, dir, p.ID(), mcproto.PacketName(p), p)
+}
+
+// login relays the handshake and, for logins, the login sequence in lock
+// step, following compression changes of the backend.
+func (r *relay) login() error {
+	p, err := r.forward(&r.serverbound)
+	if err != nil {
+		return err
+	}
+	if _, ok := p.(*packet.HandshakePacket); !ok {
+		return ErrUnexpectedPacket
+	}
+	if mode := r.getMode(); mode != mcproto.Login && mode != mcproto.Transfer {
+		return nil
+	}
+
+	// Login Start
+	if _, err = r.forward(&r.serverbound); err != nil {
+		return err
+	}
+
+	for {
+		p, err := r.forward(&r.clientbound)
+		if err != nil {
+			return err
+		}
+
+		switch p := p.(type) {
+		case *packet.SetCompression:
+			r.client.CompressionThreshold = int(p.Threshold)
+			r.backend.CompressionThreshold = int(p.Threshold)
+		case *packet.LoginSuccess:
+			return nil
+		case *packet.EncryptionRequest:
+			return ErrOnlineMode
+		case *packet.LoginDisconnect:
+			return errDisconnectedLogin
+		case *packet.LoginPluginRequest, *packet.LoginCookieRequest:
+			// A request the client answers before the login goes on.
+			// Frames that could not be decoded were relayed as is and
+			// are not waited on.
+			if _, err = r.forward(&r.serverbound); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// run relays both directions concurrently until either side fails.
+func (r *relay) run(client, backend net.Conn) error {
+	errc := make(chan error, 2)
+	pump := func(pp *pipe) {
+		for {
+			if _, err := r.forward(pp); err != nil {
+				errc <- err
+				return
+			}
+		}
+	}
+	go pump(&r.serverbound)
+	go pump(&r.clientbound)
+
+	err := <-errc
+	client.Close()
+	backend.Close()
+	<-errc
+
+	if err == io.EOF || errors.Is(err, net.ErrClosed) {
+		err = nil
+	}
+	return err
+}
@@ -0,0 +1,240 @@
+package proxy
+
+import (
+	"errors"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gstoney/mcproto"
+	"github.com/gstoney/mcproto/internal/mctest"
+	"github.com/gstoney/mcproto/packet"
+)
+
+// TestProxy_Relay verifies that a login with compression and a
+// configuration to play transition are relayed, and that an interceptor
+// can rewrite packets on the way.
+func TestProxy_Relay(t *testing.T) {
+	backendL, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("listen: %v", err)
+	}
+	defer backendL.Close()
+	proxyL, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("listen: %v", err)
+	}
+	defer proxyL.Close()
+
+	p := &Proxy{
+		Backend: backendL.Addr().String(),
+		Config:  mctest.Config(),
+		Interceptor: func(mode mcproto.ConnectionMode, dir mcproto.Direction, p packet.Packet) packet.Packet {
+			if ka, ok := p.(*packet.PlayClientboundKeepAlive); ok {
+				ka.KeepAliveID++
+			}
+			return p
+		},
+	}
+	go p.Serve(proxyL)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c, err := backendL.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		server := mctest.NewPeer(t, c, mcproto.Clientbound)
+
+		server.Read() // Handshake
+		start := server.Read().(*packet.LoginStart)
+		server.Write(&packet.SetCompression{Threshold: 16})
+		server.Transport.CompressionThreshold = 16
+		server.Write(&packet.LoginSuccess{UUID: start.PlayerUUID, Username: start.Name})
+		server.Read() // Login Acknowledged
+		server.Write(&packet.FinishConfiguration{})
+		server.Read() // Acknowledge Finish Configuration
+		server.Write(&packet.PlayClientboundKeepAlive{KeepAliveID: 41})
+		ka := server.Read().(*packet.PlayServerboundKeepAlive)
+		if ka.KeepAliveID != 42 {
+			t.Errorf("backend: got keep alive %d, want 42", ka.KeepAliveID)
+		}
+	}()
+
+	c, err := net.Dial("tcp", proxyL.Addr().String())
+	if err != nil {
+		t.Fatalf("dial proxy: %v", err)
+	}
+	defer c.Close()
+	client := mctest.NewPeer(t, c, mcproto.Serverbound)
+
+	client.Write(&packet.HandshakePacket{ProtocolVersion: 767, ServerAddr: "localhost", ServerPort: 25565, RequestType: 2})
+	client.Write(&packet.LoginStart{Name: "Steve", PlayerUUID: uuid.New()})
+
+	if sc, ok := client.Read().(*packet.SetCompression); !ok || sc.Threshold != 16 {
+		t.Fatalf("client: expected SetCompression")
+	}
+	client.Transport.CompressionThreshold = 16
+	if _, ok := client.Read().(*packet.LoginSuccess); !ok {
+		t.Fatalf("client: expected LoginSuccess")
+	}
+	client.Write(&packet.LoginAcknowledge{})
+	if _, ok := client.Read().(*packet.FinishConfiguration); !ok {
+		t.Fatalf("client: expected FinishConfiguration")
+	}
+	client.Write(&packet.FinishConfigurationAcknowledge{})
+
+	ka, ok := client.Read().(*packet.PlayClientboundKeepAlive)
+	if !ok {
+		t.Fatalf("client: expected PlayClientboundKeepAlive")
+	}
+	if ka.KeepAliveID != 42 {
+		t.Errorf("client: got keep alive %d, want intercepted 42", ka.KeepAliveID)
+	}
+	client.Write(&packet.PlayServerboundKeepAlive{KeepAliveID: ka.KeepAliveID})
+
+	<-done
+}
+
+// TestProxy_LoginRequests verifies that requests of the backend during
+// login are relayed to the client and answered, as a backend expecting
+// forwarding does.
+func TestProxy_LoginRequests(t *testing.T) {
+	for _, tc := range []struct {
+		req  packet.Packet
+		resp packet.Packet
+	}{
+		{
+			&packet.LoginPluginRequest{MessageID: 7, Channel: "velocity:player_info", Data: []byte{4}},
+			&packet.LoginPluginResponse{MessageID: 7, Successful: true, Data: []byte{1, 2, 3}},
+		},
+		{
+			&packet.LoginCookieRequest{Key: "example:session"},
+			&packet.LoginCookieResponse{Key: "example:session", Payload: packet.Optional[[]byte]{Exists: true, Item: []byte("token")}},
+		},
+	} {
+		backendL, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Skipf("listen: %v", err)
+		}
+		defer backendL.Close()
+		proxyL, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Skipf("listen: %v", err)
+		}
+		defer proxyL.Close()
+
+		p := &Proxy{Backend: backendL.Addr().String(), Config: mctest.Config()}
+		go p.Serve(proxyL)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			c, err := backendL.Accept()
+			if err != nil {
+				return
+			}
+			defer c.Close()
+			server := mctest.NewPeer(t, c, mcproto.Clientbound)
+
+			server.Read() // Handshake
+			start := server.Read().(*packet.LoginStart)
+			server.Write(tc.req)
+			if got := server.Read(); !reflect.DeepEqual(got, tc.resp) {
+				t.Errorf("backend: got %+v, want %+v", got, tc.resp)
+			}
+			server.Write(&packet.LoginSuccess{UUID: start.PlayerUUID, Username: start.Name})
+		}()
+
+		c, err := net.Dial("tcp", proxyL.Addr().String())
+		if err != nil {
+			t.Fatalf("dial proxy: %v", err)
+		}
+		defer c.Close()
+		client := mctest.NewPeer(t, c, mcproto.Serverbound)
+
+		client.Write(&packet.HandshakePacket{ProtocolVersion: 767, ServerAddr: "localhost", ServerPort: 25565, RequestType: 2})
+		client.Write(&packet.LoginStart{Name: "Steve", PlayerUUID: uuid.New()})
+		if got := client.Read(); !reflect.DeepEqual(got, tc.req) {
+			t.Fatalf("client: got %+v, want %+v", got, tc.req)
+		}
+		client.Write(tc.resp)
+		if _, ok := client.Read().(*packet.LoginSuccess); !ok {
+			t.Fatalf("client: expected LoginSuccess")
+		}
+		<-done
+	}
+}
+
+// rawPacket is a packet unknown to the registries, written as is.
+type rawPacket struct {
+	id   int32
+	data []byte
+}
+
+func (p rawPacket) ID() int32 { return p.id }
+func (p rawPacket) Encode(w packet.Writer) (err error) {
+	if err = packet.WriteVarInt(w, p.id); err != nil {
+		return
+	}
+	_, err = w.Write(p.data)
+	return
+}
+func (p *rawPacket) Decode(r packet.Reader) error { return nil }
+
+// TestProxy_LoginUnknownFrame verifies that a login frame the proxy cannot
+// decode is relayed unchanged, without waiting for the client to answer it.
+func TestProxy_LoginUnknownFrame(t *testing.T) {
+	backendL, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("listen: %v", err)
+	}
+	defer backendL.Close()
+	proxyL, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("listen: %v", err)
+	}
+	defer proxyL.Close()
+
+	p := &Proxy{Backend: backendL.Addr().String(), Config: mctest.Config()}
+	go p.Serve(proxyL)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c, err := backendL.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		server := mctest.NewPeer(t, c, mcproto.Clientbound)
+
+		server.Read() // Handshake
+		start := server.Read().(*packet.LoginStart)
+		server.Write(&rawPacket{0x7f, []byte{1, 2, 3}})
+		server.Write(&packet.LoginSuccess{UUID: start.PlayerUUID, Username: start.Name})
+	}()
+
+	c, err := net.Dial("tcp", proxyL.Addr().String())
+	if err != nil {
+		t.Fatalf("dial proxy: %v", err)
+	}
+	defer c.Close()
+	c.SetDeadline(time.Now().Add(5 * time.Second))
+	client := mctest.NewPeer(t, c, mcproto.Serverbound)
+
+	client.Write(&packet.HandshakePacket{ProtocolVersion: 767, ServerAddr: "localhost", ServerPort: 25565, RequestType: 2})
+	client.Write(&packet.LoginStart{Name: "Steve", PlayerUUID: uuid.New()})
+	var upe *mcproto.UnknownPacketError
+	if _, err := client.Conn.ReadPacket(); !errors.As(err, &upe) || upe.ID != 0x7f {
+		t.Fatalf("client: expected unknown packet 0x7f, got %v", err)
+	}
+	if _, ok := client.Read().(*packet.LoginSuccess); !ok {
+		t.Fatalf("client: expected LoginSuccess")
+	}
+	<-done
+}
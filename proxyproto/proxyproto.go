@@ -0,0 +1,310 @@
+// Package proxyproto implements a net.Listener accepting connections
+// prefixed with a HAProxy PROXY protocol header, version 1 or 2.
+//
+// Wrap the listener given to mcproto.Server.Serve so that sessions see the
+// original client address in RemoteAddr:
+//
+//	l, _ := net.Listen("tcp", ":25565")
+//	pl, _ := proxyproto.NewListener(l, "10.0.0.0/8")
+//	srv.Serve(pl)
+package proxyproto
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrNoHeader      = errors.New("proxyproto: missing PROXY header")
+	ErrInvalidHeader = errors.New("proxyproto: malformed PROXY header")
+	ErrUntrusted     = errors.New("proxyproto: connection from untrusted source")
+)
+
+// v2Signature starts every version 2 header.
+var v2Signature = []byte("\r\n\r\n\x00\r\nQUIT\n")
+
+const (
+	v1MaxLen        = 107
+	v1Prefix        = "PROXY "
+	defaultDeadline = 5 * time.Second
+)
+
+// Listener parses PROXY headers of connections from trusted sources.
+//
+// Connections from trusted sources must start with a valid header, or
+// reading from them fails. Connections from other sources are passed
+// through untouched, or refused if RejectUntrusted is set.
+type Listener struct {
+	net.Listener
+
+	// Trusted lists networks of load balancers allowed to send headers.
+	// All sources are trusted if empty.
+	Trusted []*net.IPNet
+	// RejectUntrusted closes connections from sources not in Trusted.
+	RejectUntrusted bool
+	// HeaderTimeout bounds the time to receive the header. Defaults to
+	// 5 seconds.
+	HeaderTimeout time.Duration
+}
+
+// NewListener wraps l, trusting sources in the given CIDR networks.
+func NewListener(l net.Listener, trusted ...string) (*Listener, error) {
+	pl := &Listener{Listener: l}
+	for _, cidr := range trusted {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		pl.Trusted = append(pl.Trusted, n)
+	}
+	return pl, nil
+}
+
+func (l *Listener) trusted(addr net.Addr) bool {
+	if len(l.Trusted) == 0 {
+		return true
+	}
+	tcp, ok := addr.(*net.TCPAddr)
+	if !ok {
+		return false
+	}
+	for _, n := range l.Trusted {
+		if n.Contains(tcp.IP) {
+			return true
+		}
+	}
+	return false
+}
+
+// Accept waits for the next connection. The header of a trusted
+// connection is parsed on first use of Read, RemoteAddr or LocalAddr,
+// so a slow client does not hold up Accept.
+func (l *Listener) Accept() (net.Conn, error) {
+	for {
+		c, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+
+		if !l.trusted(c.RemoteAddr()) {
+			if l.RejectUntrusted {
+				c.Close()
+				continue
+			}
+			return c, nil
+		}
+
+		timeout := l.HeaderTimeout
+		if timeout == 0 {
+			timeout = defaultDeadline
+		}
+		return &Conn{Conn: c, r: bufio.NewReader(c), timeout: timeout}, nil
+	}
+}
+
+// Conn is a connection whose addresses are taken from its PROXY header.
+type Conn struct {
+	net.Conn
+	r       *bufio.Reader
+	timeout time.Duration
+
+	once       sync.Once
+	err        error
+	remoteAddr net.Addr
+	localAddr  net.Addr
+
+	mu           sync.Mutex
+	readDeadline time.Time // set by the caller, restored after the header
+}
+
+func (c *Conn) init() {
+	c.once.Do(func() {
+		c.mu.Lock()
+		deadline := time.Now().Add(c.timeout)
+		if d := c.readDeadline; !d.IsZero() && d.Before(deadline) {
+			deadline = d
+		}
+		c.Conn.SetReadDeadline(deadline)
+		c.mu.Unlock()
+
+		c.remoteAddr, c.localAddr, c.err = readHeader(c.r)
+
+		c.mu.Lock()
+		c.Conn.SetReadDeadline(c.readDeadline)
+		c.mu.Unlock()
+	})
+}
+
+// SetDeadline sets the read and write deadlines. The read deadline
+// applies to the header too, if earlier than its timeout.
+func (c *Conn) SetDeadline(t time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.readDeadline = t
+	return c.Conn.SetDeadline(t)
+}
+
+// SetReadDeadline sets the read deadline. It applies to the header too,
+// if earlier than its timeout.
+func (c *Conn) SetReadDeadline(t time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.readDeadline = t
+	return c.Conn.SetReadDeadline(t)
+}
+
+// Read reads data following the header. It fails if the header is
+// missing or malformed.
+func (c *Conn) Read(b []byte) (int, error) {
+	c.init()
+	if c.err != nil {
+		return 0, c.err
+	}
+	return c.r.Read(b)
+}
+
+// RemoteAddr returns the client address of the header, or the address
+// of the peer if the header carries none.
+func (c *Conn) RemoteAddr() net.Addr {
+	c.init()
+	if c.remoteAddr == nil {
+		return c.Conn.RemoteAddr()
+	}
+	return c.remoteAddr
+}
+
+// LocalAddr returns the destination address of the header, or the local
+// address if the header carries none.
+func (c *Conn) LocalAddr() net.Addr {
+	c.init()
+	if c.localAddr == nil {
+		return c.Conn.LocalAddr()
+	}
+	return c.localAddr
+}
+
+// HeaderErr returns the error parsing the header, if any.
+func (c *Conn) HeaderErr() error {
+	c.init()
+	return c.err
+}
+
+// readHeader reads a version 1 or 2 header from r. Addresses are nil for
+// headers not carrying TCP addresses (UNKNOWN, LOCAL or UNSPEC).
+func readHeader(r *bufio.Reader) (src, dst net.Addr, err error) {
+	sig, err := r.Peek(len(v2Signature))
+	if err != nil && len(sig) < len(v1Prefix) {
+		return nil, nil, ErrNoHeader
+	}
+
+	switch {
+	case bytes.HasPrefix(sig, []byte(v1Prefix)):
+		return readV1(r)
+	case bytes.Equal(sig, v2Signature):
+		return readV2(r)
+	}
+	return nil, nil, ErrNoHeader
+}
+
+func readV1(r *bufio.Reader) (src, dst net.Addr, err error) {
+	var line []byte
+	for len(line) < v1MaxLen {
+		b, err := r.ReadByte()
+		if err != nil {
+			return nil, nil, ErrInvalidHeader
+		}
+		line = append(line, b)
+		if bytes.HasSuffix(line, []byte("\r\n")) {
+			break
+		}
+	}
+	if !bytes.HasSuffix(line, []byte("\r\n")) {
+		return nil, nil, ErrInvalidHeader
+	}
+
+	fields := strings.Split(string(line[:len(line)-2]), " ")
+	if len(fields) >= 2 && fields[1] == "UNKNOWN" {
+		return nil, nil, nil
+	}
+	if len(fields) != 6 || (fields[1] != "TCP4" && fields[1] != "TCP6") {
+		return nil, nil, ErrInvalidHeader
+	}
+
+	srcIP, dstIP := net.ParseIP(fields[2]), net.ParseIP(fields[3])
+	if srcIP == nil || dstIP == nil || (fields[1] == "TCP4") != (srcIP.To4() != nil && dstIP.To4() != nil) {
+		return nil, nil, ErrInvalidHeader
+	}
+	srcPort, err1 := parsePort(fields[4])
+	dstPort, err2 := parsePort(fields[5])
+	if err1 != nil || err2 != nil {
+		return nil, nil, ErrInvalidHeader
+	}
+
+	return &net.TCPAddr{IP: srcIP, Port: srcPort}, &net.TCPAddr{IP: dstIP, Port: dstPort}, nil
+}
+
+func parsePort(s string) (int, error) {
+	// Ports must be decimal without leading zeroes.
+	if len(s) > 1 && s[0] == '0' {
+		return 0, ErrInvalidHeader
+	}
+	port, err := strconv.ParseUint(s, 10, 16)
+	return int(port), err
+}
+
+func readV2(r *bufio.Reader) (src, dst net.Addr, err error) {
+	var head [16]byte
+	if _, err = io.ReadFull(r, head[:]); err != nil {
+		return nil, nil, ErrInvalidHeader
+	}
+
+	verCmd, fam := head[12], head[13]
+	length := int(binary.BigEndian.Uint16(head[14:]))
+	if verCmd>>4 != 2 {
+		return nil, nil, ErrInvalidHeader
+	}
+
+	body := make([]byte, length)
+	if _, err = io.ReadFull(r, body); err != nil {
+		return nil, nil, ErrInvalidHeader
+	}
+
+	switch verCmd & 0xF {
+	case 0x0: // LOCAL: health checks of the proxy itself
+		return nil, nil, nil
+	case 0x1: // PROXY
+	default:
+		return nil, nil, ErrInvalidHeader
+	}
+
+	var ipLen int
+	switch fam {
+	case 0x11: // TCP over IPv4
+		ipLen = net.IPv4len
+	case 0x21: // TCP over IPv6
+		ipLen = net.IPv6len
+	case 0x00: // UNSPEC
+		return nil, nil, nil
+	default:
+		return nil, nil, ErrInvalidHeader
+	}
+
+	// Address block, followed by TLVs which are ignored.
+	if length < 2*ipLen+4 {
+		return nil, nil, ErrInvalidHeader
+	}
+	srcIP := net.IP(body[:ipLen])
+	dstIP := net.IP(body[ipLen : 2*ipLen])
+	srcPort := binary.BigEndian.Uint16(body[2*ipLen:])
+	dstPort := binary.BigEndian.Uint16(body[2*ipLen+2:])
+
+	return &net.TCPAddr{IP: srcIP, Port: int(srcPort)}, &net.TCPAddr{IP: dstIP, Port: int(dstPort)}, nil
+}
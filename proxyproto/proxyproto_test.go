@@ -0,0 +1,209 @@
+package proxyproto
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func v2Header(cmd, fam byte, addrs []byte) []byte {
+	h := append([]byte{}, v2Signature...)
+	h = append(h, 0x20|cmd, fam, byte(len(addrs)>>8), byte(len(addrs)))
+	return append(h, addrs...)
+}
+
+var headerTc = []struct {
+	desc      string
+	header    []byte
+	expectErr error
+	src       string
+}{
+	{
+		desc:   "v1 TCP4",
+		header: []byte("PROXY TCP4 203.0.113.7 10.0.0.1 51234 25565\r\n"),
+		src:    "203.0.113.7:51234",
+	},
+	{
+		desc:   "v1 TCP6",
+		header: []byte("PROXY TCP6 2001:db8::7 2001:db8::1 51234 25565\r\n"),
+		src:    "[2001:db8::7]:51234",
+	},
+	{
+		desc:   "v1 UNKNOWN",
+		header: []byte("PROXY UNKNOWN\r\n"),
+	},
+	{
+		desc: "v2 TCP4",
+		header: v2Header(0x1, 0x11, []byte{
+			203, 0, 113, 7, 10, 0, 0, 1, 0xc8, 0x22, 0x63, 0xdd,
+			0x04, 0x00, 0x01, 0x00, // Ignored TLV
+		}),
+		src: "203.0.113.7:51234",
+	},
+	{
+		desc:   "v2 LOCAL",
+		header: v2Header(0x0, 0x00, nil),
+	},
+	{
+		desc:      "v1 address family mismatch",
+		header:    []byte("PROXY TCP4 2001:db8::7 10.0.0.1 51234 25565\r\n"),
+		expectErr: ErrInvalidHeader,
+	},
+	{
+		desc:      "v1 unterminated",
+		header:    []byte("PROXY TCP4 203.0.113.7 10.0.0.1 51234 25565" + strings.Repeat(" ", 100)),
+		expectErr: ErrInvalidHeader,
+	},
+	{
+		desc:      "v2 truncated addresses",
+		header:    v2Header(0x1, 0x11, []byte{203, 0, 113, 7}),
+		expectErr: ErrInvalidHeader,
+	},
+	{
+		desc:      "v2 bad version",
+		header:    append(append([]byte{}, v2Signature...), 0x11, 0x11, 0, 0),
+		expectErr: ErrInvalidHeader,
+	},
+	{
+		desc:      "Missing header",
+		header:    []byte{0x10, 0x00, 0xf7, 0x05, 0x09, 'l', 'o', 'c', 'a', 'l', 'h', 'o', 's', 't'},
+		expectErr: ErrNoHeader,
+	},
+}
+
+func TestReadHeader(t *testing.T) {
+	for _, tC := range headerTc {
+		t.Run(tC.desc, func(t *testing.T) {
+			payload := []byte("handshake")
+			r := bufio.NewReader(bytes.NewReader(append(append([]byte{}, tC.header...), payload...)))
+
+			src, _, err := readHeader(r)
+			if err != tC.expectErr {
+				t.Fatalf("readHeader: got error %v, want %v", err, tC.expectErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if tC.src == "" && src != nil {
+				t.Errorf("got source %s, want none", src)
+			} else if tC.src != "" && (src == nil || src.String() != tC.src) {
+				t.Errorf("got source %v, want %s", src, tC.src)
+			}
+
+			if rest, _ := io.ReadAll(r); !bytes.Equal(rest, payload) {
+				t.Errorf("got remaining %q, want %q", rest, payload)
+			}
+		})
+	}
+}
+
+// TestListener verifies that accepted connections report the client
+// address of the header and read the data following it.
+func TestListener(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("listen: %v", err)
+	}
+	defer l.Close()
+
+	pl, err := NewListener(l, "127.0.0.0/8")
+	if err != nil {
+		t.Fatalf("NewListener: %v", err)
+	}
+
+	go func() {
+		c, err := net.Dial("tcp", l.Addr().String())
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		c.Write([]byte("PROXY TCP4 203.0.113.7 10.0.0.1 51234 25565\r\nhello"))
+	}()
+
+	c, err := pl.Accept()
+	if err != nil {
+		t.Fatalf("Accept: %v", err)
+	}
+	defer c.Close()
+
+	if got := c.RemoteAddr().String(); got != "203.0.113.7:51234" {
+		t.Errorf("got RemoteAddr %s", got)
+	}
+	if got, _ := io.ReadAll(c); string(got) != "hello" {
+		t.Errorf("got data %q, want hello", got)
+	}
+}
+
+// TestConn_ReadDeadline verifies that a read deadline set before the
+// header is parsed is kept for the data following it.
+func TestConn_ReadDeadline(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("listen: %v", err)
+	}
+	defer l.Close()
+
+	pl, _ := NewListener(l, "127.0.0.0/8")
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		c, err := net.Dial("tcp", l.Addr().String())
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		c.Write([]byte("PROXY TCP4 203.0.113.7 10.0.0.1 51234 25565\r\n"))
+		<-done
+	}()
+
+	c, err := pl.Accept()
+	if err != nil {
+		t.Fatalf("Accept: %v", err)
+	}
+	defer c.Close()
+
+	c.SetReadDeadline(time.Now().Add(50 * time.Millisecond))
+	if _, err := c.Read(make([]byte, 1)); !errors.Is(err, os.ErrDeadlineExceeded) {
+		t.Errorf("got %v, want deadline exceeded", err)
+	}
+}
+
+// TestListener_Untrusted verifies that connections from untrusted
+// sources are passed through without parsing a header.
+func TestListener_Untrusted(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("listen: %v", err)
+	}
+	defer l.Close()
+
+	pl, _ := NewListener(l, "10.0.0.0/8")
+	go func() {
+		c, err := net.Dial("tcp", l.Addr().String())
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		c.Write([]byte("PROXY TCP4 203.0.113.7 10.0.0.1 51234 25565\r\n"))
+	}()
+
+	c, err := pl.Accept()
+	if err != nil {
+		t.Fatalf("Accept: %v", err)
+	}
+	defer c.Close()
+
+	if _, ok := c.(*Conn); ok {
+		t.Errorf("untrusted connection was wrapped")
+	}
+	if ip := c.RemoteAddr().(*net.TCPAddr).IP; !ip.IsLoopback() {
+		t.Errorf("got RemoteAddr %s, want peer address", c.RemoteAddr())
+	}
+}
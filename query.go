@@ -0,0 +1,143 @@
+package mcproto
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/gstoney/mcproto/packet"
+)
+
+var (
+	ErrQueryTimeout = errors.New("login query timed out")
+	ErrNoDeadline   = errors.New("login query timeout needs Session.Conn to set a deadline on")
+)
+
+// LoginQuery sends Login Plugin Requests and Cookie Requests to a client
+// during login, and awaits the responses.
+//
+// Responses are matched by message ID or cookie key, so several requests
+// may be outstanding at once. Responses arriving while awaiting another
+// are kept until awaited.
+//
+// Any other packet fails an await with ErrUnexpectedPacket. A timeout
+// leaves the connection unusable, and the login should be aborted.
+// Timeouts are enforced with read deadlines on Session.Conn; without it,
+// an await with a non-zero timeout fails with ErrNoDeadline.
+type LoginQuery struct {
+	conn   *Conn
+	nextID int32
+
+	plugin  map[int32]*packet.LoginPluginResponse
+	cookies map[string]*packet.LoginCookieResponse
+}
+
+// NewLoginQuery creates a LoginQuery on conn, which must be in Login mode.
+func NewLoginQuery(conn *Conn) *LoginQuery {
+	return &LoginQuery{
+		conn:    conn,
+		plugin:  make(map[int32]*packet.LoginPluginResponse),
+		cookies: make(map[string]*packet.LoginCookieResponse),
+	}
+}
+
+// SendPluginRequest sends data on channel, returning the message ID the
+// response will carry.
+func (q *LoginQuery) SendPluginRequest(channel string, data []byte) (id int32, err error) {
+	id = q.nextID
+	q.nextID++
+	err = q.conn.WritePacket(&packet.LoginPluginRequest{
+		MessageID: id,
+		Channel:   channel,
+		Data:      data,
+	})
+	return
+}
+
+// AwaitPluginResponse waits for the response to message id. ok reports
+// whether the client understood the channel. A zero timeout waits
+// indefinitely.
+func (q *LoginQuery) AwaitPluginResponse(id int32, timeout time.Duration) (data []byte, ok bool, err error) {
+	err = q.await(timeout, func() bool {
+		resp, found := q.plugin[id]
+		if found {
+			delete(q.plugin, id)
+			data, ok = resp.Data, resp.Successful
+		}
+		return found
+	})
+	return
+}
+
+// QueryPlugin sends data on channel and waits for the response.
+func (q *LoginQuery) QueryPlugin(channel string, data []byte, timeout time.Duration) (resp []byte, ok bool, err error) {
+	id, err := q.SendPluginRequest(channel, data)
+	if err != nil {
+		return
+	}
+	return q.AwaitPluginResponse(id, timeout)
+}
+
+// RequestCookie asks the client for the cookie stored under key and waits
+// for the response. ok reports whether the client had the cookie.
+func (q *LoginQuery) RequestCookie(key string, timeout time.Duration) (payload []byte, ok bool, err error) {
+	if err = q.conn.RequestCookie(key); err != nil {
+		return
+	}
+
+	var resp *packet.LoginCookieResponse
+	err = q.await(timeout, func() bool {
+		var found bool
+		if resp, found = q.cookies[key]; found {
+			delete(q.cookies, key)
+		}
+		return found
+	})
+	if err != nil {
+		return
+	}
+
+	if _, payload, err = ParseCookieResponse(resp); err != nil {
+		return
+	}
+	return payload, payload != nil, nil
+}
+
+// await reads responses until done reports the awaited one arrived.
+func (q *LoginQuery) await(timeout time.Duration, done func() bool) error {
+	if done() {
+		return nil
+	}
+
+	if timeout > 0 {
+		c := q.conn.Session.Conn
+		if c == nil {
+			return ErrNoDeadline
+		}
+		c.SetReadDeadline(time.Now().Add(timeout))
+		defer c.SetReadDeadline(time.Time{})
+	}
+
+	for {
+		p, err := q.conn.ReadPacket()
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			return ErrQueryTimeout
+		}
+		if err != nil {
+			return err
+		}
+
+		switch p := p.(type) {
+		case *packet.LoginPluginResponse:
+			q.plugin[p.MessageID] = p
+		case *packet.LoginCookieResponse:
+			q.cookies[p.Key] = p
+		default:
+			return ErrUnexpectedPacket
+		}
+
+		if done() {
+			return nil
+		}
+	}
+}
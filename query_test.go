@@ -0,0 +1,104 @@
+package mcproto
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gstoney/mcproto/packet"
+)
+
+// loginPipe returns server and client Conns in Login mode over net.Pipe.
+func loginPipe(t *testing.T) (server, client *Conn) {
+	t.Helper()
+
+	sc, cc := net.Pipe()
+	t.Cleanup(func() { sc.Close(); cc.Close() })
+
+	ss := &Session{Conn: sc, Mode: Login}
+	st := NewTransport(sc, sc, defaultConfig())
+	cs := &Session{Conn: cc, Mode: Login}
+	ct := NewTransport(cc, cc, defaultConfig())
+	return NewConn(ss, &st, Clientbound), NewConn(cs, &ct, Serverbound)
+}
+
+// TestLoginQuery verifies that responses answered out of order are
+// matched to their requests.
+func TestLoginQuery(t *testing.T) {
+	server, client := loginPipe(t)
+
+	go func() {
+		var reqs []*packet.LoginPluginRequest
+		for range 3 {
+			p, err := client.ReadPacket()
+			if err != nil {
+				return
+			}
+			switch p := p.(type) {
+			case *packet.LoginPluginRequest:
+				reqs = append(reqs, p)
+			case *packet.LoginCookieRequest:
+				client.WritePacket(&packet.LoginCookieResponse{
+					Key:     p.Key,
+					Payload: packet.Optional[[]byte]{Exists: true, Item: []byte("session")},
+				})
+			}
+		}
+		// Answer plugin requests in reverse.
+		for i := len(reqs) - 1; i >= 0; i-- {
+			resp := &packet.LoginPluginResponse{MessageID: reqs[i].MessageID}
+			if reqs[i].Channel == "test:echo" {
+				resp.Successful = true
+				resp.Data = reqs[i].Data
+			}
+			client.WritePacket(resp)
+		}
+	}()
+
+	q := NewLoginQuery(server)
+	echo, err := q.SendPluginRequest("test:echo", []byte("ping"))
+	if err != nil {
+		t.Fatalf("SendPluginRequest: %v", err)
+	}
+	unknown, _ := q.SendPluginRequest("test:unknown", nil)
+
+	cookie, ok, err := q.RequestCookie("test:session", time.Second)
+	if err != nil || !ok || string(cookie) != "session" {
+		t.Errorf("RequestCookie: got %q %v %v", cookie, ok, err)
+	}
+
+	data, ok, err := q.AwaitPluginResponse(echo, time.Second)
+	if err != nil || !ok || string(data) != "ping" {
+		t.Errorf("echo: got %q %v %v", data, ok, err)
+	}
+	if _, ok, err = q.AwaitPluginResponse(unknown, time.Second); err != nil || ok {
+		t.Errorf("unknown: got %v %v, want unsuccessful", ok, err)
+	}
+}
+
+// TestLoginQuery_Timeout verifies that an unanswered request fails with
+// ErrQueryTimeout.
+func TestLoginQuery_Timeout(t *testing.T) {
+	server, client := loginPipe(t)
+	go client.ReadPacket()
+
+	q := NewLoginQuery(server)
+	_, _, err := q.QueryPlugin("test:silent", nil, 10*time.Millisecond)
+	if err != ErrQueryTimeout {
+		t.Errorf("got %v, want ErrQueryTimeout", err)
+	}
+}
+
+// TestLoginQuery_NoDeadline verifies that a timeout is refused without a
+// Session.Conn to enforce it, rather than waiting indefinitely.
+func TestLoginQuery_NoDeadline(t *testing.T) {
+	server, client := loginPipe(t)
+	server.Session.Conn = nil
+	go client.ReadPacket()
+
+	q := NewLoginQuery(server)
+	_, _, err := q.QueryPlugin("test:silent", nil, 10*time.Millisecond)
+	if err != ErrNoDeadline {
+		t.Errorf("got %v, want ErrNoDeadline", err)
+	}
+}
@@ -0,0 +1,78 @@
+package mcproto
+
+import (
+	"strings"
+
+	"github.com/gstoney/mcproto/packet"
+	"github.com/gstoney/mcproto/registry"
+	"github.com/gstoney/mcproto/tags"
+)
+
+// SendRegistries offers the packs of s to the client with Known Packs, and
+// sends the registries of s once the client answers which packs it knows.
+// The Session must be in Config mode.
+//
+// Other packets read while awaiting the answer, such as Client Information,
+// are returned in deferred for the caller to handle. Clients not knowing
+// packs whose entries s lacks data for are disconnected.
+func SendRegistries(c *Conn, s *registry.Set) (deferred []packet.Packet, err error) {
+	if c.Session.Mode != Config {
+		return nil, ErrInvalidMode
+	}
+	if err = c.WritePacket(s.KnownPacks()); err != nil {
+		return
+	}
+
+	var known []packet.KnownPack
+	for {
+		p, err := c.ReadPacket()
+		if err != nil {
+			return deferred, err
+		}
+		if kp, ok := p.(*packet.ConfigServerboundKnownPacks); ok {
+			known = kp.Packs
+			break
+		}
+		deferred = append(deferred, p)
+	}
+
+	return deferred, sendRegistryData(c, s, known)
+}
+
+// sendRegistryData sends the registries of s to a client knowing the packs
+// in known. A client lacking packs s needs is disconnected, failing with
+// registry.ErrUnknownPack.
+func sendRegistryData(c *Conn, s *registry.Set, known []packet.KnownPack) error {
+	ps, err := s.RegistryData(known)
+	if err != nil {
+		names := make([]string, len(s.Packs))
+		for i, p := range s.Packs {
+			names[i] = p.Namespace + ":" + p.ID + " " + p.Version
+		}
+		c.Disconnect("This server requires the data packs " + strings.Join(names, ", "))
+		return err
+	}
+	for _, p := range ps {
+		if err = c.WritePacket(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SendTags sends the tags of s with Update Tags of the current mode,
+// Config or Play, mapping entries to network IDs with ids.
+func SendTags(c *Conn, s *tags.Set, ids tags.IDs) error {
+	rts, err := s.RegistryTags(ids)
+	if err != nil {
+		return err
+	}
+
+	switch c.Session.Mode {
+	case Config:
+		return c.WritePacket(&packet.ConfigUpdateTags{Registries: rts})
+	case Play:
+		return c.WritePacket(&packet.PlayUpdateTags{Registries: rts})
+	}
+	return ErrInvalidMode
+}
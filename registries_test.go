@@ -0,0 +1,124 @@
+package mcproto
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gstoney/mcproto/packet"
+	"github.com/gstoney/mcproto/registry"
+	"github.com/gstoney/mcproto/tags"
+	"github.com/gstoney/mcproto/vanilla"
+)
+
+// TestSendRegistries verifies the Known Packs exchange, and that packets
+// read before the answer are deferred.
+func TestSendRegistries(t *testing.T) {
+	server, client := loginPipe(t)
+	server.Session.Mode = Config
+	client.Session.Mode = Config
+
+	type result struct {
+		deferred []packet.Packet
+		err      error
+	}
+	done := make(chan result, 1)
+	go func() {
+		deferred, err := SendRegistries(server, registry.Vanilla())
+		done <- result{deferred, err}
+	}()
+
+	p, err := client.ReadPacket()
+	kp, ok := p.(*packet.ConfigClientboundKnownPacks)
+	if !ok {
+		t.Fatalf("got %v %v, want Known Packs", p, err)
+	}
+	client.SendBrand("vanilla")
+	client.WritePacket(&packet.ConfigServerboundKnownPacks{Packs: kp.Packs})
+
+	for i := range registry.Vanilla().Registries {
+		p, err := client.ReadPacket()
+		rd, ok := p.(*packet.RegistryData)
+		if !ok {
+			t.Fatalf("registry %d: got %v %v, want Registry Data", i, p, err)
+		}
+		for _, e := range rd.Entries {
+			if e.Data.Exists {
+				t.Errorf("%s %s: data sent for known pack", rd.Registry, e.ID)
+			}
+		}
+	}
+
+	r := <-done
+	if r.err != nil {
+		t.Fatalf("SendRegistries: %v", r.err)
+	}
+	if len(r.deferred) != 1 {
+		t.Fatalf("got %d deferred packets, want 1", len(r.deferred))
+	}
+	if ch, _, _ := PluginMessage(r.deferred[0]); ch != BrandChannel {
+		t.Errorf("got deferred %s, want brand message", PacketName(r.deferred[0]))
+	}
+}
+
+// TestSendRegistries_MatchesVanilla verifies that painting variants are
+// sent byte for byte as the captured server does, but for dennis, which
+// that server, of 1.21.10, has from a later version than 1.21.1.
+func TestSendRegistries_MatchesVanilla(t *testing.T) {
+	r := bytes.NewReader(payload_compressed_reg)
+	packet.ReadVarInt(r)
+	var captured packet.RegistryData
+	if err := captured.Decode(r); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	want := captured
+	want.Entries = nil
+	for _, e := range captured.Entries {
+		if e.ID != "minecraft:dennis" {
+			want.Entries = append(want.Entries, e)
+		}
+	}
+	var wantBuf bytes.Buffer
+	want.Encode(&wantBuf)
+
+	s := registry.Vanilla()
+	ps, err := s.RegistryData(s.Packs)
+	if err != nil {
+		t.Fatalf("RegistryData: %v", err)
+	}
+	for _, p := range ps {
+		if p.Registry != "minecraft:painting_variant" {
+			continue
+		}
+		var buf bytes.Buffer
+		if err := p.Encode(&buf); err != nil {
+			t.Fatalf("Encode: %v", err)
+		}
+		if !bytes.Equal(buf.Bytes(), wantBuf.Bytes()) {
+			t.Errorf("got %q, want %q", buf.Bytes(), wantBuf.Bytes())
+		}
+		return
+	}
+	t.Fatal("painting_variant not sent")
+}
+
+func TestSendTags(t *testing.T) {
+	server, client := loginPipe(t)
+	server.Session.Mode = Config
+	client.Session.Mode = Config
+
+	go SendTags(server, tags.Vanilla(), vanilla.Resolver{}.EntryID)
+
+	p, err := client.ReadPacket()
+	ut, ok := p.(*packet.ConfigUpdateTags)
+	if !ok {
+		t.Fatalf("got %v %v, want Update Tags", p, err)
+	}
+	if len(ut.Registries) != 5 {
+		t.Errorf("got %d registries, want 5", len(ut.Registries))
+	}
+
+	server.Session.Mode = Login
+	if err := SendTags(server, tags.Vanilla(), vanilla.Resolver{}.EntryID); err != ErrInvalidMode {
+		t.Errorf("got %v, want ErrInvalidMode", err)
+	}
+}
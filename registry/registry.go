@@ -0,0 +1,119 @@
+// Package registry holds the data driven registries servers send to
+// clients during configuration, such as biomes and dimension types.
+package registry
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gstoney/mcproto/nbt"
+	"github.com/gstoney/mcproto/packet"
+)
+
+// ErrUnknownPack is returned for clients that do not know the pack of an
+// entry without data, as its network ID could not be kept.
+var ErrUnknownPack = errors.New("registry: client does not know the pack of an entry without data")
+
+// CorePack is the built-in data pack of Minecraft 1.21.1.
+var CorePack = packet.KnownPack{Namespace: "minecraft", ID: "core", Version: "1.21.1"}
+
+// An Entry is an element of a registry.
+type Entry struct {
+	ID string
+	// Data is the element, sent to clients not knowing Pack. It may be nil
+	// for entries only sent to clients knowing Pack.
+	Data nbt.Compound
+	// Pack is the data pack the entry comes from, nil for custom entries.
+	Pack *packet.KnownPack
+}
+
+// A Registry is an ordered collection of entries. The index of an entry
+// is its network ID, such as the biome ID in chunk data.
+type Registry struct {
+	ID      string
+	Entries []Entry
+}
+
+// Index returns the network ID of the entry id, or -1 if absent.
+func (r *Registry) Index(id string) int {
+	for i, e := range r.Entries {
+		if e.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// Put adds e, replacing an entry of the same ID in place.
+func (r *Registry) Put(e Entry) {
+	if i := r.Index(e.ID); i >= 0 {
+		r.Entries[i] = e
+		return
+	}
+	r.Entries = append(r.Entries, e)
+}
+
+// A Set is the registries sent to clients, and the packs their entries
+// come from.
+type Set struct {
+	Packs      []packet.KnownPack
+	Registries []*Registry
+}
+
+// Registry returns the registry id, or nil if absent.
+func (s *Set) Registry(id string) *Registry {
+	for _, r := range s.Registries {
+		if r.ID == id {
+			return r
+		}
+	}
+	return nil
+}
+
+// KnownPacks returns the packet offering s.Packs to the client.
+func (s *Set) KnownPacks() *packet.ConfigClientboundKnownPacks {
+	return &packet.ConfigClientboundKnownPacks{Packs: s.Packs}
+}
+
+// RegistryData returns the packets sending s to a client knowing the
+// packs in known, as answered to KnownPacks.
+//
+// Data of entries from known packs is omitted. An entry without data from
+// a pack the client does not know fails with ErrUnknownPack, as leaving it
+// out would shift the network IDs of entries following it.
+func (s *Set) RegistryData(known []packet.KnownPack) ([]*packet.RegistryData, error) {
+	isKnown := func(p *packet.KnownPack) bool {
+		if p == nil {
+			return false
+		}
+		for _, k := range known {
+			if k == *p {
+				return true
+			}
+		}
+		return false
+	}
+
+	ps := make([]*packet.RegistryData, 0, len(s.Registries))
+	for _, r := range s.Registries {
+		p := &packet.RegistryData{Registry: r.ID}
+		for _, e := range r.Entries {
+			switch {
+			case isKnown(e.Pack):
+				p.Entries = append(p.Entries, packet.RegistryEntry{ID: e.ID})
+			case e.Data != nil:
+				p.Entries = append(p.Entries, packet.RegistryEntry{
+					ID:   e.ID,
+					Data: packet.Optional[nbt.Compound]{Exists: true, Item: e.Data},
+				})
+			case e.Pack != nil:
+				return nil, fmt.Errorf("%w: %s of %s needs %s:%s %s", ErrUnknownPack,
+					e.ID, r.ID, e.Pack.Namespace, e.Pack.ID, e.Pack.Version)
+			default:
+				return nil, fmt.Errorf("%w: %s of %s has no pack", ErrUnknownPack, e.ID, r.ID)
+			}
+		}
+		ps = append(ps, p)
+	}
+	return ps, nil
+}
@@ -0,0 +1,213 @@
+package registry
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gstoney/mcproto/nbt"
+	"github.com/gstoney/mcproto/packet"
+)
+
+// TestVanilla verifies entry counts of 1.21.1 registries, and that every
+// entry has data that encodes.
+func TestVanilla(t *testing.T) {
+	want := map[string]int{
+		"minecraft:worldgen/biome":   64,
+		"minecraft:chat_type":        7,
+		"minecraft:trim_pattern":     18,
+		"minecraft:trim_material":    10,
+		"minecraft:wolf_variant":     9,
+		"minecraft:painting_variant": 50,
+		"minecraft:dimension_type":   4,
+		"minecraft:damage_type":      47,
+		"minecraft:banner_pattern":   43,
+		"minecraft:enchantment":      42,
+		"minecraft:jukebox_song":     19,
+	}
+
+	s := Vanilla()
+	if len(s.Registries) != len(want) {
+		t.Errorf("got %d registries, want %d", len(s.Registries), len(want))
+	}
+	for id, n := range want {
+		r := s.Registry(id)
+		if r == nil {
+			t.Errorf("missing %s", id)
+			continue
+		}
+		if len(r.Entries) != n {
+			t.Errorf("%s: got %d entries, want %d", id, len(r.Entries), n)
+		}
+	}
+
+	ps, err := s.RegistryData(s.Packs)
+	if err != nil {
+		t.Fatalf("RegistryData: %v", err)
+	}
+	var buf bytes.Buffer
+	for _, p := range ps {
+		if err := p.Encode(&buf); err != nil {
+			t.Errorf("encode %s: %v", p.Registry, err)
+		}
+	}
+	for _, r := range s.Registries {
+		for _, e := range r.Entries {
+			if e.Data == nil {
+				continue
+			}
+			p := &packet.RegistryData{Registry: r.ID, Entries: []packet.RegistryEntry{
+				{ID: e.ID, Data: packet.Optional[nbt.Compound]{Exists: true, Item: e.Data}},
+			}}
+			if err := p.Encode(&buf); err != nil {
+				t.Errorf("encode %s of %s: %v", e.ID, r.ID, err)
+			}
+		}
+	}
+	ps, err = s.RegistryData(nil)
+	if err != nil {
+		t.Fatalf("RegistryData without CorePack: %v", err)
+	}
+	for _, p := range ps {
+		for _, e := range p.Entries {
+			if !e.Data.Exists {
+				t.Errorf("without CorePack: %s of %s has no data", e.ID, p.Registry)
+			}
+		}
+	}
+
+	if i := s.Registry("minecraft:worldgen/biome").Index("minecraft:plains"); i != 39 {
+		t.Errorf("got plains ID %d, want 39", i)
+	}
+}
+
+// TestVanilla_Entries verifies that the entries of each registry, and
+// their network IDs, are those of 1.21.1 as listed in
+// testdata/registries.json, in the format of the vanilla reports.
+func TestVanilla_Entries(t *testing.T) {
+	b, err := os.ReadFile(filepath.Join("testdata", "registries.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var report map[string]struct {
+		Entries map[string]struct {
+			ProtocolID int `json:"protocol_id"`
+		} `json:"entries"`
+	}
+	if err = json.Unmarshal(b, &report); err != nil {
+		t.Fatal(err)
+	}
+
+	s := Vanilla()
+	for id, r := range report {
+		reg := s.Registry(id)
+		if reg == nil {
+			t.Errorf("missing %s", id)
+			continue
+		}
+		for name, e := range r.Entries {
+			if i := reg.Index(name); i != e.ProtocolID {
+				t.Errorf("%s: %s has ID %d, want %d", id, name, i, e.ProtocolID)
+			}
+		}
+		for _, e := range reg.Entries {
+			if _, ok := r.Entries[e.ID]; !ok {
+				t.Errorf("%s: %s is not in 1.21.1", id, e.ID)
+			}
+		}
+	}
+	if len(report) != len(s.Registries) {
+		t.Errorf("got %d registries, report has %d", len(s.Registries), len(report))
+	}
+}
+
+// TestSet_RegistryData verifies that data is omitted for known packs, and
+// that clients not knowing the pack of an entry without data are refused.
+func TestSet_RegistryData(t *testing.T) {
+	pack := &packet.KnownPack{Namespace: "test", ID: "pack", Version: "1"}
+	newSet := func(entries ...Entry) *Set {
+		return &Set{
+			Packs:      []packet.KnownPack{*pack},
+			Registries: []*Registry{{ID: "test:registry", Entries: entries}},
+		}
+	}
+	known := Entry{ID: "test:known", Pack: pack}
+	knownData := Entry{ID: "test:known_data", Data: map[string]any{"a": int32(1)}, Pack: pack}
+	custom := Entry{ID: "test:custom", Data: map[string]any{}}
+	unknown := []packet.KnownPack{{Namespace: "test", ID: "pack", Version: "2"}}
+
+	tcs := []struct {
+		desc  string
+		s     *Set
+		known []packet.KnownPack
+		ids   []string
+		data  []bool
+		err   error
+	}{
+		{
+			desc:  "Pack known",
+			s:     newSet(known, knownData, custom),
+			known: []packet.KnownPack{*pack},
+			ids:   []string{"test:known", "test:known_data", "test:custom"},
+			data:  []bool{false, false, true},
+		},
+		{
+			desc:  "Pack unknown",
+			s:     newSet(knownData, custom),
+			known: unknown,
+			ids:   []string{"test:known_data", "test:custom"},
+			data:  []bool{true, true},
+		},
+		{
+			desc:  "Pack unknown, entry without data",
+			s:     newSet(known, knownData, custom),
+			known: unknown,
+			err:   ErrUnknownPack,
+		},
+		{
+			desc: "Custom entry without data",
+			s:    newSet(Entry{ID: "test:empty"}),
+			err:  ErrUnknownPack,
+		},
+	}
+	for _, tC := range tcs {
+		t.Run(tC.desc, func(t *testing.T) {
+			ps, err := tC.s.RegistryData(tC.known)
+			if tC.err != nil || err != nil {
+				if !errors.Is(err, tC.err) {
+					t.Errorf("got error %v, want %v", err, tC.err)
+				}
+				return
+			}
+			if len(ps) != 1 {
+				t.Fatalf("got %d packets, want 1", len(ps))
+			}
+			entries := ps[0].Entries
+			if len(entries) != len(tC.ids) {
+				t.Fatalf("got %d entries, want %d", len(entries), len(tC.ids))
+			}
+			for i, e := range entries {
+				if e.ID != tC.ids[i] || e.Data.Exists != tC.data[i] {
+					t.Errorf("entry[%d]: got %s with data %v, want %s with data %v", i, e.ID, e.Data.Exists, tC.ids[i], tC.data[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRegistry_Put(t *testing.T) {
+	r := &Registry{ID: "test:registry"}
+	r.Put(Entry{ID: "test:a"})
+	r.Put(Entry{ID: "test:b"})
+	r.Put(Entry{ID: "test:a", Data: map[string]any{}})
+
+	if len(r.Entries) != 2 || r.Index("test:a") != 0 || r.Entries[0].Data == nil {
+		t.Errorf("got entries %+v", r.Entries)
+	}
+	if r.Index("test:c") != -1 {
+		t.Errorf("got index of missing entry")
+	}
+}
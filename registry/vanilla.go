@@ -0,0 +1,42 @@
+//go:generate go run ../codegen/gen_vanilla_registries.go -- ../testdata/data .
+
+package registry
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/gstoney/mcproto/nbt"
+	"github.com/gstoney/mcproto/packet"
+)
+
+// vanillaRegistry is a registry of zz_generated_registries.go, with the
+// data of its entries by name.
+type vanillaRegistry struct {
+	id      string
+	entries map[string]nbt.Compound
+}
+
+// Vanilla returns the registries of a vanilla 1.21.1 server, all from
+// CorePack. Each call returns a new Set the caller may modify.
+//
+// Entries are in the order vanilla assigns network IDs, and all have
+// data, so the Set can be sent to clients not knowing CorePack. The data
+// is generated from that of the vanilla data generator in testdata/data,
+// see codegen/gen_vanilla_registries.go.
+func Vanilla() *Set {
+	s := &Set{Packs: []packet.KnownPack{CorePack}}
+	for _, r := range vanillaRegistries() {
+		// Vanilla loads entries from pack files, sorted by name.
+		reg := &Registry{ID: r.id}
+		for _, name := range slices.Sorted(maps.Keys(r.entries)) {
+			reg.Entries = append(reg.Entries, Entry{
+				ID:   "minecraft:" + name,
+				Data: r.entries[name],
+				Pack: &CorePack,
+			})
+		}
+		s.Registries = append(s.Registries, reg)
+	}
+	return s
+}
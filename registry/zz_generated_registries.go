@@ -0,0 +1,345 @@
+// Code generated by gen_vanilla_registries.go; DO NOT EDIT.
+
+package registry
+
+import "github.com/gstoney/mcproto/nbt"
+
+func vanillaRegistries() []vanillaRegistry {
+	return []vanillaRegistry{
+		{"minecraft:worldgen/biome", map[string]nbt.Compound{
+			"badlands":                 {"downfall": float32(0), "effects": nbt.Compound{"fog_color": int32(12638463), "foliage_color": int32(10387789), "grass_color": int32(9470285), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.badlands"}, "sky_color": int32(7254527), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": false, "temperature": float32(2)},
+			"bamboo_jungle":            {"downfall": float32(0.9), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.bamboo_jungle"}, "sky_color": int32(7842047), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.95)},
+			"basalt_deltas":            {"downfall": float32(0), "effects": nbt.Compound{"additions_sound": nbt.Compound{"sound": "minecraft:ambient.basalt_deltas.additions", "tick_chance": float32(0.0111)}, "ambient_sound": "minecraft:ambient.basalt_deltas.loop", "fog_color": int32(6840176), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.basalt_deltas.mood", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.nether.basalt_deltas"}, "particle": nbt.Compound{"options": nbt.Compound{"type": "minecraft:white_ash"}, "probability": float32(0.118093334)}, "sky_color": int32(7254527), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": false, "temperature": float32(2)},
+			"beach":                    {"downfall": float32(0.4), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(7907327), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.8)},
+			"birch_forest":             {"downfall": float32(0.6), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.forest"}, "sky_color": int32(8037887), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.6)},
+			"cherry_grove":             {"downfall": float32(0.8), "effects": nbt.Compound{"fog_color": int32(12638463), "foliage_color": int32(11983713), "grass_color": int32(11983713), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.cherry_grove"}, "sky_color": int32(8103167), "water_color": int32(6141935), "water_fog_color": int32(6141935)}, "has_precipitation": true, "temperature": float32(0.5)},
+			"cold_ocean":               {"downfall": float32(0.5), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(8103167), "water_color": int32(4020182), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.5)},
+			"crimson_forest":           {"downfall": float32(0), "effects": nbt.Compound{"additions_sound": nbt.Compound{"sound": "minecraft:ambient.crimson_forest.additions", "tick_chance": float32(0.0111)}, "ambient_sound": "minecraft:ambient.crimson_forest.loop", "fog_color": int32(3343107), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.crimson_forest.mood", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.nether.crimson_forest"}, "particle": nbt.Compound{"options": nbt.Compound{"type": "minecraft:crimson_spore"}, "probability": float32(0.025)}, "sky_color": int32(7254527), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": false, "temperature": float32(2)},
+			"dark_forest":              {"downfall": float32(0.8), "effects": nbt.Compound{"fog_color": int32(12638463), "grass_color_modifier": "dark_forest", "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.forest"}, "sky_color": int32(7972607), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.7)},
+			"deep_cold_ocean":          {"downfall": float32(0.5), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(8103167), "water_color": int32(4020182), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.5)},
+			"deep_dark":                {"downfall": float32(0.4), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.deep_dark"}, "sky_color": int32(7907327), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.8)},
+			"deep_frozen_ocean":        {"downfall": float32(0.5), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(8103167), "water_color": int32(3750089), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.5), "temperature_modifier": "frozen"},
+			"deep_lukewarm_ocean":      {"downfall": float32(0.5), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(8103167), "water_color": int32(4566514), "water_fog_color": int32(267827)}, "has_precipitation": true, "temperature": float32(0.5)},
+			"deep_ocean":               {"downfall": float32(0.5), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(8103167), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.5)},
+			"desert":                   {"downfall": float32(0), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.desert"}, "sky_color": int32(7254527), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": false, "temperature": float32(2)},
+			"dripstone_caves":          {"downfall": float32(0.4), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.dripstone_caves"}, "sky_color": int32(7907327), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.8)},
+			"end_barrens":              {"downfall": float32(0.5), "effects": nbt.Compound{"fog_color": int32(10518688), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(0), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": false, "temperature": float32(0.5)},
+			"end_highlands":            {"downfall": float32(0.5), "effects": nbt.Compound{"fog_color": int32(10518688), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(0), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": false, "temperature": float32(0.5)},
+			"end_midlands":             {"downfall": float32(0.5), "effects": nbt.Compound{"fog_color": int32(10518688), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(0), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": false, "temperature": float32(0.5)},
+			"eroded_badlands":          {"downfall": float32(0), "effects": nbt.Compound{"fog_color": int32(12638463), "foliage_color": int32(10387789), "grass_color": int32(9470285), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.badlands"}, "sky_color": int32(7254527), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": false, "temperature": float32(2)},
+			"flower_forest":            {"downfall": float32(0.8), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.flower_forest"}, "sky_color": int32(7972607), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.7)},
+			"forest":                   {"downfall": float32(0.8), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.forest"}, "sky_color": int32(7972607), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.7)},
+			"frozen_ocean":             {"downfall": float32(0.5), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(8364543), "water_color": int32(3750089), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0), "temperature_modifier": "frozen"},
+			"frozen_peaks":             {"downfall": float32(0.9), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.frozen_peaks"}, "sky_color": int32(8756735), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(-0.7)},
+			"frozen_river":             {"downfall": float32(0.5), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(8364543), "water_color": int32(3750089), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0)},
+			"grove":                    {"downfall": float32(0.8), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.grove"}, "sky_color": int32(8495359), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(-0.2)},
+			"ice_spikes":               {"downfall": float32(0.5), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(8364543), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0)},
+			"jagged_peaks":             {"downfall": float32(0.9), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.jagged_peaks"}, "sky_color": int32(8756735), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(-0.7)},
+			"jungle":                   {"downfall": float32(0.9), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.jungle"}, "sky_color": int32(7842047), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.95)},
+			"lukewarm_ocean":           {"downfall": float32(0.5), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(8103167), "water_color": int32(4566514), "water_fog_color": int32(267827)}, "has_precipitation": true, "temperature": float32(0.5)},
+			"lush_caves":               {"downfall": float32(0.5), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.lush_caves"}, "sky_color": int32(8103167), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.5)},
+			"mangrove_swamp":           {"downfall": float32(0.9), "effects": nbt.Compound{"fog_color": int32(12638463), "foliage_color": int32(9285927), "grass_color_modifier": "swamp", "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.swamp"}, "sky_color": int32(7907327), "water_color": int32(3832426), "water_fog_color": int32(5077600)}, "has_precipitation": true, "temperature": float32(0.8)},
+			"meadow":                   {"downfall": float32(0.8), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.meadow"}, "sky_color": int32(8103167), "water_color": int32(937679), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.5)},
+			"mushroom_fields":          {"downfall": float32(1), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(7842047), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.9)},
+			"nether_wastes":            {"downfall": float32(0), "effects": nbt.Compound{"additions_sound": nbt.Compound{"sound": "minecraft:ambient.nether_wastes.additions", "tick_chance": float32(0.0111)}, "ambient_sound": "minecraft:ambient.nether_wastes.loop", "fog_color": int32(3344392), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.nether_wastes.mood", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.nether.nether_wastes"}, "sky_color": int32(7254527), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": false, "temperature": float32(2)},
+			"ocean":                    {"downfall": float32(0.5), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(8103167), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.5)},
+			"old_growth_birch_forest":  {"downfall": float32(0.6), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.forest"}, "sky_color": int32(8037887), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.6)},
+			"old_growth_pine_taiga":    {"downfall": float32(0.8), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.old_growth_taiga"}, "sky_color": int32(8168447), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.3)},
+			"old_growth_spruce_taiga":  {"downfall": float32(0.8), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.old_growth_taiga"}, "sky_color": int32(8233983), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.25)},
+			"plains":                   {"downfall": float32(0.4), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(7907327), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.8)},
+			"river":                    {"downfall": float32(0.5), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(8103167), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.5)},
+			"savanna":                  {"downfall": float32(0), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(7254527), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": false, "temperature": float32(2)},
+			"savanna_plateau":          {"downfall": float32(0), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(7254527), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": false, "temperature": float32(2)},
+			"small_end_islands":        {"downfall": float32(0.5), "effects": nbt.Compound{"fog_color": int32(10518688), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(0), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": false, "temperature": float32(0.5)},
+			"snowy_beach":              {"downfall": float32(0.3), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(8364543), "water_color": int32(4020182), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.05)},
+			"snowy_plains":             {"downfall": float32(0.5), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(8364543), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0)},
+			"snowy_slopes":             {"downfall": float32(0.9), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.snowy_slopes"}, "sky_color": int32(8560639), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(-0.3)},
+			"snowy_taiga":              {"downfall": float32(0.4), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(8625919), "water_color": int32(4020182), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(-0.5)},
+			"soul_sand_valley":         {"downfall": float32(0), "effects": nbt.Compound{"additions_sound": nbt.Compound{"sound": "minecraft:ambient.soul_sand_valley.additions", "tick_chance": float32(0.0111)}, "ambient_sound": "minecraft:ambient.soul_sand_valley.loop", "fog_color": int32(1787717), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.soul_sand_valley.mood", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.nether.soul_sand_valley"}, "particle": nbt.Compound{"options": nbt.Compound{"type": "minecraft:ash"}, "probability": float32(0.00625)}, "sky_color": int32(7254527), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": false, "temperature": float32(2)},
+			"sparse_jungle":            {"downfall": float32(0.8), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.sparse_jungle"}, "sky_color": int32(7842047), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.95)},
+			"stony_peaks":              {"downfall": float32(0.3), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.stony_peaks"}, "sky_color": int32(7776511), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(1)},
+			"stony_shore":              {"downfall": float32(0.3), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(8233727), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.2)},
+			"sunflower_plains":         {"downfall": float32(0.4), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(7907327), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.8)},
+			"swamp":                    {"downfall": float32(0.9), "effects": nbt.Compound{"fog_color": int32(12638463), "foliage_color": int32(6975545), "grass_color_modifier": "swamp", "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.swamp"}, "sky_color": int32(7907327), "water_color": int32(6388580), "water_fog_color": int32(2302743)}, "has_precipitation": true, "temperature": float32(0.8)},
+			"taiga":                    {"downfall": float32(0.8), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(8233983), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.25)},
+			"the_end":                  {"downfall": float32(0.5), "effects": nbt.Compound{"fog_color": int32(10518688), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(0), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": false, "temperature": float32(0.5)},
+			"the_void":                 {"downfall": float32(0.5), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(8103167), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": false, "temperature": float32(0.5)},
+			"warm_ocean":               {"downfall": float32(0.5), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(8103167), "water_color": int32(4445678), "water_fog_color": int32(270131)}, "has_precipitation": true, "temperature": float32(0.5)},
+			"warped_forest":            {"downfall": float32(0), "effects": nbt.Compound{"additions_sound": nbt.Compound{"sound": "minecraft:ambient.warped_forest.additions", "tick_chance": float32(0.0111)}, "ambient_sound": "minecraft:ambient.warped_forest.loop", "fog_color": int32(1705242), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.warped_forest.mood", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.nether.warped_forest"}, "particle": nbt.Compound{"options": nbt.Compound{"type": "minecraft:warped_spore"}, "probability": float32(0.01428)}, "sky_color": int32(7254527), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": false, "temperature": float32(2)},
+			"windswept_forest":         {"downfall": float32(0.3), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(8233727), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.2)},
+			"windswept_gravelly_hills": {"downfall": float32(0.3), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(8233727), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.2)},
+			"windswept_hills":          {"downfall": float32(0.3), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(8233727), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": true, "temperature": float32(0.2)},
+			"windswept_savanna":        {"downfall": float32(0), "effects": nbt.Compound{"fog_color": int32(12638463), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "sky_color": int32(7254527), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": false, "temperature": float32(2)},
+			"wooded_badlands":          {"downfall": float32(0), "effects": nbt.Compound{"fog_color": int32(12638463), "foliage_color": int32(10387789), "grass_color": int32(9470285), "mood_sound": nbt.Compound{"block_search_extent": int32(8), "offset": float64(2), "sound": "minecraft:ambient.cave", "tick_delay": int32(6000)}, "music": nbt.Compound{"max_delay": int32(24000), "min_delay": int32(12000), "replace_current_music": false, "sound": "minecraft:music.overworld.badlands"}, "sky_color": int32(7254527), "water_color": int32(4159204), "water_fog_color": int32(329011)}, "has_precipitation": false, "temperature": float32(2)},
+		}},
+		{"minecraft:chat_type", map[string]nbt.Compound{
+			"chat":                      {"chat": nbt.Compound{"parameters": nbt.List{"sender", "content"}, "translation_key": "chat.type.text"}, "narration": nbt.Compound{"parameters": nbt.List{"sender", "content"}, "translation_key": "chat.type.text.narrate"}},
+			"emote_command":             {"chat": nbt.Compound{"parameters": nbt.List{"sender", "content"}, "translation_key": "chat.type.emote"}, "narration": nbt.Compound{"parameters": nbt.List{"sender", "content"}, "translation_key": "chat.type.emote"}},
+			"msg_command_incoming":      {"chat": nbt.Compound{"parameters": nbt.List{"sender", "content"}, "style": nbt.Compound{"color": "gray", "italic": true}, "translation_key": "commands.message.display.incoming"}, "narration": nbt.Compound{"parameters": nbt.List{"sender", "content"}, "translation_key": "chat.type.text.narrate"}},
+			"msg_command_outgoing":      {"chat": nbt.Compound{"parameters": nbt.List{"target", "content"}, "style": nbt.Compound{"color": "gray", "italic": true}, "translation_key": "commands.message.display.outgoing"}, "narration": nbt.Compound{"parameters": nbt.List{"sender", "content"}, "translation_key": "chat.type.text.narrate"}},
+			"say_command":               {"chat": nbt.Compound{"parameters": nbt.List{"sender", "content"}, "translation_key": "chat.type.announcement"}, "narration": nbt.Compound{"parameters": nbt.List{"sender", "content"}, "translation_key": "chat.type.text.narrate"}},
+			"team_msg_command_incoming": {"chat": nbt.Compound{"parameters": nbt.List{"target", "sender", "content"}, "translation_key": "chat.type.team.text"}, "narration": nbt.Compound{"parameters": nbt.List{"sender", "content"}, "translation_key": "chat.type.text.narrate"}},
+			"team_msg_command_outgoing": {"chat": nbt.Compound{"parameters": nbt.List{"target", "sender", "content"}, "translation_key": "chat.type.team.sent"}, "narration": nbt.Compound{"parameters": nbt.List{"sender", "content"}, "translation_key": "chat.type.text.narrate"}},
+		}},
+		{"minecraft:trim_pattern", map[string]nbt.Compound{
+			"bolt":      {"asset_id": "minecraft:bolt", "decal": false, "description": nbt.Compound{"translate": "trim_pattern.minecraft.bolt"}, "template_item": "minecraft:bolt_armor_trim_smithing_template"},
+			"coast":     {"asset_id": "minecraft:coast", "decal": false, "description": nbt.Compound{"translate": "trim_pattern.minecraft.coast"}, "template_item": "minecraft:coast_armor_trim_smithing_template"},
+			"dune":      {"asset_id": "minecraft:dune", "decal": false, "description": nbt.Compound{"translate": "trim_pattern.minecraft.dune"}, "template_item": "minecraft:dune_armor_trim_smithing_template"},
+			"eye":       {"asset_id": "minecraft:eye", "decal": false, "description": nbt.Compound{"translate": "trim_pattern.minecraft.eye"}, "template_item": "minecraft:eye_armor_trim_smithing_template"},
+			"flow":      {"asset_id": "minecraft:flow", "decal": false, "description": nbt.Compound{"translate": "trim_pattern.minecraft.flow"}, "template_item": "minecraft:flow_armor_trim_smithing_template"},
+			"host":      {"asset_id": "minecraft:host", "decal": false, "description": nbt.Compound{"translate": "trim_pattern.minecraft.host"}, "template_item": "minecraft:host_armor_trim_smithing_template"},
+			"raiser":    {"asset_id": "minecraft:raiser", "decal": false, "description": nbt.Compound{"translate": "trim_pattern.minecraft.raiser"}, "template_item": "minecraft:raiser_armor_trim_smithing_template"},
+			"rib":       {"asset_id": "minecraft:rib", "decal": false, "description": nbt.Compound{"translate": "trim_pattern.minecraft.rib"}, "template_item": "minecraft:rib_armor_trim_smithing_template"},
+			"sentry":    {"asset_id": "minecraft:sentry", "decal": false, "description": nbt.Compound{"translate": "trim_pattern.minecraft.sentry"}, "template_item": "minecraft:sentry_armor_trim_smithing_template"},
+			"shaper":    {"asset_id": "minecraft:shaper", "decal": false, "description": nbt.Compound{"translate": "trim_pattern.minecraft.shaper"}, "template_item": "minecraft:shaper_armor_trim_smithing_template"},
+			"silence":   {"asset_id": "minecraft:silence", "decal": false, "description": nbt.Compound{"translate": "trim_pattern.minecraft.silence"}, "template_item": "minecraft:silence_armor_trim_smithing_template"},
+			"snout":     {"asset_id": "minecraft:snout", "decal": false, "description": nbt.Compound{"translate": "trim_pattern.minecraft.snout"}, "template_item": "minecraft:snout_armor_trim_smithing_template"},
+			"spire":     {"asset_id": "minecraft:spire", "decal": false, "description": nbt.Compound{"translate": "trim_pattern.minecraft.spire"}, "template_item": "minecraft:spire_armor_trim_smithing_template"},
+			"tide":      {"asset_id": "minecraft:tide", "decal": false, "description": nbt.Compound{"translate": "trim_pattern.minecraft.tide"}, "template_item": "minecraft:tide_armor_trim_smithing_template"},
+			"vex":       {"asset_id": "minecraft:vex", "decal": false, "description": nbt.Compound{"translate": "trim_pattern.minecraft.vex"}, "template_item": "minecraft:vex_armor_trim_smithing_template"},
+			"ward":      {"asset_id": "minecraft:ward", "decal": false, "description": nbt.Compound{"translate": "trim_pattern.minecraft.ward"}, "template_item": "minecraft:ward_armor_trim_smithing_template"},
+			"wayfinder": {"asset_id": "minecraft:wayfinder", "decal": false, "description": nbt.Compound{"translate": "trim_pattern.minecraft.wayfinder"}, "template_item": "minecraft:wayfinder_armor_trim_smithing_template"},
+			"wild":      {"asset_id": "minecraft:wild", "decal": false, "description": nbt.Compound{"translate": "trim_pattern.minecraft.wild"}, "template_item": "minecraft:wild_armor_trim_smithing_template"},
+		}},
+		{"minecraft:trim_material", map[string]nbt.Compound{
+			"amethyst":  {"asset_name": "amethyst", "description": nbt.Compound{"color": "#9A5CC6", "translate": "trim_material.minecraft.amethyst"}, "ingredient": "minecraft:amethyst_shard", "item_model_index": float32(0.8)},
+			"copper":    {"asset_name": "copper", "description": nbt.Compound{"color": "#B4684D", "translate": "trim_material.minecraft.copper"}, "ingredient": "minecraft:copper_ingot", "item_model_index": float32(0.5)},
+			"diamond":   {"asset_name": "diamond", "description": nbt.Compound{"color": "#6EECD2", "translate": "trim_material.minecraft.diamond"}, "ingredient": "minecraft:diamond", "item_model_index": float32(0.8), "override_armor_materials": nbt.Compound{"minecraft:diamond": "diamond_darker"}},
+			"emerald":   {"asset_name": "emerald", "description": nbt.Compound{"color": "#11A036", "translate": "trim_material.minecraft.emerald"}, "ingredient": "minecraft:emerald", "item_model_index": float32(0.7)},
+			"gold":      {"asset_name": "gold", "description": nbt.Compound{"color": "#DEB12D", "translate": "trim_material.minecraft.gold"}, "ingredient": "minecraft:gold_ingot", "item_model_index": float32(0.6), "override_armor_materials": nbt.Compound{"minecraft:gold": "gold_darker"}},
+			"iron":      {"asset_name": "iron", "description": nbt.Compound{"color": "#ECECEC", "translate": "trim_material.minecraft.iron"}, "ingredient": "minecraft:iron_ingot", "item_model_index": float32(0.2), "override_armor_materials": nbt.Compound{"minecraft:iron": "iron_darker"}},
+			"lapis":     {"asset_name": "lapis", "description": nbt.Compound{"color": "#416E97", "translate": "trim_material.minecraft.lapis"}, "ingredient": "minecraft:lapis_lazuli", "item_model_index": float32(0.9)},
+			"netherite": {"asset_name": "netherite", "description": nbt.Compound{"color": "#625859", "translate": "trim_material.minecraft.netherite"}, "ingredient": "minecraft:netherite_ingot", "item_model_index": float32(0.3), "override_armor_materials": nbt.Compound{"minecraft:netherite": "netherite_darker"}},
+			"quartz":    {"asset_name": "quartz", "description": nbt.Compound{"color": "#E3D4C4", "translate": "trim_material.minecraft.quartz"}, "ingredient": "minecraft:quartz", "item_model_index": float32(0.1)},
+			"redstone":  {"asset_name": "redstone", "description": nbt.Compound{"color": "#971607", "translate": "trim_material.minecraft.redstone"}, "ingredient": "minecraft:redstone", "item_model_index": float32(0.4)},
+		}},
+		{"minecraft:wolf_variant", map[string]nbt.Compound{
+			"ashen":    {"angry_texture": "minecraft:entity/wolf/wolf_ashen_angry", "biomes": "minecraft:snowy_taiga", "tame_texture": "minecraft:entity/wolf/wolf_ashen_tame", "wild_texture": "minecraft:entity/wolf/wolf_ashen"},
+			"black":    {"angry_texture": "minecraft:entity/wolf/wolf_black_angry", "biomes": "minecraft:old_growth_pine_taiga", "tame_texture": "minecraft:entity/wolf/wolf_black_tame", "wild_texture": "minecraft:entity/wolf/wolf_black"},
+			"chestnut": {"angry_texture": "minecraft:entity/wolf/wolf_chestnut_angry", "biomes": "minecraft:old_growth_spruce_taiga", "tame_texture": "minecraft:entity/wolf/wolf_chestnut_tame", "wild_texture": "minecraft:entity/wolf/wolf_chestnut"},
+			"pale":     {"angry_texture": "minecraft:entity/wolf/wolf_angry", "biomes": "minecraft:taiga", "tame_texture": "minecraft:entity/wolf/wolf_tame", "wild_texture": "minecraft:entity/wolf/wolf"},
+			"rusty":    {"angry_texture": "minecraft:entity/wolf/wolf_rusty_angry", "biomes": "#minecraft:is_jungle", "tame_texture": "minecraft:entity/wolf/wolf_rusty_tame", "wild_texture": "minecraft:entity/wolf/wolf_rusty"},
+			"snowy":    {"angry_texture": "minecraft:entity/wolf/wolf_snowy_angry", "biomes": "minecraft:grove", "tame_texture": "minecraft:entity/wolf/wolf_snowy_tame", "wild_texture": "minecraft:entity/wolf/wolf_snowy"},
+			"spotted":  {"angry_texture": "minecraft:entity/wolf/wolf_spotted_angry", "biomes": "#minecraft:is_savanna", "tame_texture": "minecraft:entity/wolf/wolf_spotted_tame", "wild_texture": "minecraft:entity/wolf/wolf_spotted"},
+			"striped":  {"angry_texture": "minecraft:entity/wolf/wolf_striped_angry", "biomes": "#minecraft:is_badlands", "tame_texture": "minecraft:entity/wolf/wolf_striped_tame", "wild_texture": "minecraft:entity/wolf/wolf_striped"},
+			"woods":    {"angry_texture": "minecraft:entity/wolf/wolf_woods_angry", "biomes": "minecraft:forest", "tame_texture": "minecraft:entity/wolf/wolf_woods_tame", "wild_texture": "minecraft:entity/wolf/wolf_woods"},
+		}},
+		{"minecraft:painting_variant", map[string]nbt.Compound{
+			"alban":           {"asset_id": "minecraft:alban", "height": int32(1), "width": int32(1)},
+			"aztec":           {"asset_id": "minecraft:aztec", "height": int32(1), "width": int32(1)},
+			"aztec2":          {"asset_id": "minecraft:aztec2", "height": int32(1), "width": int32(1)},
+			"backyard":        {"asset_id": "minecraft:backyard", "height": int32(4), "width": int32(3)},
+			"baroque":         {"asset_id": "minecraft:baroque", "height": int32(2), "width": int32(2)},
+			"bomb":            {"asset_id": "minecraft:bomb", "height": int32(1), "width": int32(1)},
+			"bouquet":         {"asset_id": "minecraft:bouquet", "height": int32(3), "width": int32(3)},
+			"burning_skull":   {"asset_id": "minecraft:burning_skull", "height": int32(4), "width": int32(4)},
+			"bust":            {"asset_id": "minecraft:bust", "height": int32(2), "width": int32(2)},
+			"cavebird":        {"asset_id": "minecraft:cavebird", "height": int32(3), "width": int32(3)},
+			"changing":        {"asset_id": "minecraft:changing", "height": int32(2), "width": int32(4)},
+			"cotan":           {"asset_id": "minecraft:cotan", "height": int32(3), "width": int32(3)},
+			"courbet":         {"asset_id": "minecraft:courbet", "height": int32(1), "width": int32(2)},
+			"creebet":         {"asset_id": "minecraft:creebet", "height": int32(1), "width": int32(2)},
+			"donkey_kong":     {"asset_id": "minecraft:donkey_kong", "height": int32(3), "width": int32(4)},
+			"earth":           {"asset_id": "minecraft:earth", "height": int32(2), "width": int32(2)},
+			"endboss":         {"asset_id": "minecraft:endboss", "height": int32(3), "width": int32(3)},
+			"fern":            {"asset_id": "minecraft:fern", "height": int32(3), "width": int32(3)},
+			"fighters":        {"asset_id": "minecraft:fighters", "height": int32(2), "width": int32(4)},
+			"finding":         {"asset_id": "minecraft:finding", "height": int32(2), "width": int32(4)},
+			"fire":            {"asset_id": "minecraft:fire", "height": int32(2), "width": int32(2)},
+			"graham":          {"asset_id": "minecraft:graham", "height": int32(2), "width": int32(1)},
+			"humble":          {"asset_id": "minecraft:humble", "height": int32(2), "width": int32(2)},
+			"kebab":           {"asset_id": "minecraft:kebab", "height": int32(1), "width": int32(1)},
+			"lowmist":         {"asset_id": "minecraft:lowmist", "height": int32(2), "width": int32(4)},
+			"match":           {"asset_id": "minecraft:match", "height": int32(2), "width": int32(2)},
+			"meditative":      {"asset_id": "minecraft:meditative", "height": int32(1), "width": int32(1)},
+			"orb":             {"asset_id": "minecraft:orb", "height": int32(4), "width": int32(4)},
+			"owlemons":        {"asset_id": "minecraft:owlemons", "height": int32(3), "width": int32(3)},
+			"passage":         {"asset_id": "minecraft:passage", "height": int32(2), "width": int32(4)},
+			"pigscene":        {"asset_id": "minecraft:pigscene", "height": int32(4), "width": int32(4)},
+			"plant":           {"asset_id": "minecraft:plant", "height": int32(1), "width": int32(1)},
+			"pointer":         {"asset_id": "minecraft:pointer", "height": int32(4), "width": int32(4)},
+			"pond":            {"asset_id": "minecraft:pond", "height": int32(4), "width": int32(3)},
+			"pool":            {"asset_id": "minecraft:pool", "height": int32(1), "width": int32(2)},
+			"prairie_ride":    {"asset_id": "minecraft:prairie_ride", "height": int32(2), "width": int32(1)},
+			"sea":             {"asset_id": "minecraft:sea", "height": int32(1), "width": int32(2)},
+			"skeleton":        {"asset_id": "minecraft:skeleton", "height": int32(3), "width": int32(4)},
+			"skull_and_roses": {"asset_id": "minecraft:skull_and_roses", "height": int32(2), "width": int32(2)},
+			"stage":           {"asset_id": "minecraft:stage", "height": int32(2), "width": int32(2)},
+			"sunflowers":      {"asset_id": "minecraft:sunflowers", "height": int32(3), "width": int32(3)},
+			"sunset":          {"asset_id": "minecraft:sunset", "height": int32(1), "width": int32(2)},
+			"tides":           {"asset_id": "minecraft:tides", "height": int32(3), "width": int32(3)},
+			"unpacked":        {"asset_id": "minecraft:unpacked", "height": int32(4), "width": int32(4)},
+			"void":            {"asset_id": "minecraft:void", "height": int32(2), "width": int32(2)},
+			"wanderer":        {"asset_id": "minecraft:wanderer", "height": int32(2), "width": int32(1)},
+			"wasteland":       {"asset_id": "minecraft:wasteland", "height": int32(1), "width": int32(1)},
+			"water":           {"asset_id": "minecraft:water", "height": int32(2), "width": int32(2)},
+			"wind":            {"asset_id": "minecraft:wind", "height": int32(2), "width": int32(2)},
+			"wither":          {"asset_id": "minecraft:wither", "height": int32(2), "width": int32(2)},
+		}},
+		{"minecraft:dimension_type", map[string]nbt.Compound{
+			"overworld":       {"ambient_light": float32(0), "bed_works": true, "coordinate_scale": float64(1), "effects": "minecraft:overworld", "has_ceiling": false, "has_raids": true, "has_skylight": true, "height": int32(384), "infiniburn": "#minecraft:infiniburn_overworld", "logical_height": int32(384), "min_y": int32(-64), "monster_spawn_block_light_limit": int32(0), "monster_spawn_light_level": nbt.Compound{"max_inclusive": int32(7), "min_inclusive": int32(0), "type": "minecraft:uniform"}, "natural": true, "piglin_safe": false, "respawn_anchor_works": false, "ultrawarm": false},
+			"overworld_caves": {"ambient_light": float32(0), "bed_works": true, "coordinate_scale": float64(1), "effects": "minecraft:overworld", "has_ceiling": true, "has_raids": true, "has_skylight": true, "height": int32(384), "infiniburn": "#minecraft:infiniburn_overworld", "logical_height": int32(384), "min_y": int32(-64), "monster_spawn_block_light_limit": int32(0), "monster_spawn_light_level": nbt.Compound{"max_inclusive": int32(7), "min_inclusive": int32(0), "type": "minecraft:uniform"}, "natural": true, "piglin_safe": false, "respawn_anchor_works": false, "ultrawarm": false},
+			"the_end":         {"ambient_light": float32(0), "bed_works": false, "coordinate_scale": float64(1), "effects": "minecraft:the_end", "fixed_time": int64(6000), "has_ceiling": false, "has_raids": true, "has_skylight": false, "height": int32(256), "infiniburn": "#minecraft:infiniburn_end", "logical_height": int32(256), "min_y": int32(0), "monster_spawn_block_light_limit": int32(0), "monster_spawn_light_level": nbt.Compound{"max_inclusive": int32(7), "min_inclusive": int32(0), "type": "minecraft:uniform"}, "natural": false, "piglin_safe": false, "respawn_anchor_works": false, "ultrawarm": false},
+			"the_nether":      {"ambient_light": float32(0.1), "bed_works": false, "coordinate_scale": float64(8), "effects": "minecraft:the_nether", "fixed_time": int64(18000), "has_ceiling": true, "has_raids": false, "has_skylight": false, "height": int32(256), "infiniburn": "#minecraft:infiniburn_nether", "logical_height": int32(128), "min_y": int32(0), "monster_spawn_block_light_limit": int32(15), "monster_spawn_light_level": int32(7), "natural": false, "piglin_safe": true, "respawn_anchor_works": true, "ultrawarm": true},
+		}},
+		{"minecraft:damage_type", map[string]nbt.Compound{
+			"arrow":                 {"exhaustion": float32(0.1), "message_id": "arrow", "scaling": "when_caused_by_living_non_player"},
+			"bad_respawn_point":     {"death_message_type": "intentional_game_design", "exhaustion": float32(0.1), "message_id": "badRespawnPoint", "scaling": "always"},
+			"cactus":                {"exhaustion": float32(0.1), "message_id": "cactus", "scaling": "when_caused_by_living_non_player"},
+			"cramming":              {"exhaustion": float32(0), "message_id": "cramming", "scaling": "when_caused_by_living_non_player"},
+			"dragon_breath":         {"exhaustion": float32(0), "message_id": "dragonBreath", "scaling": "when_caused_by_living_non_player"},
+			"drown":                 {"effects": "drowning", "exhaustion": float32(0), "message_id": "drown", "scaling": "when_caused_by_living_non_player"},
+			"dry_out":               {"exhaustion": float32(0.1), "message_id": "dryout", "scaling": "when_caused_by_living_non_player"},
+			"explosion":             {"exhaustion": float32(0.1), "message_id": "explosion", "scaling": "always"},
+			"fall":                  {"death_message_type": "fall_variants", "exhaustion": float32(0), "message_id": "fall", "scaling": "when_caused_by_living_non_player"},
+			"falling_anvil":         {"exhaustion": float32(0.1), "message_id": "anvil", "scaling": "when_caused_by_living_non_player"},
+			"falling_block":         {"exhaustion": float32(0.1), "message_id": "fallingBlock", "scaling": "when_caused_by_living_non_player"},
+			"falling_stalactite":    {"exhaustion": float32(0.1), "message_id": "fallingStalactite", "scaling": "when_caused_by_living_non_player"},
+			"fireball":              {"effects": "burning", "exhaustion": float32(0.1), "message_id": "fireball", "scaling": "when_caused_by_living_non_player"},
+			"fireworks":             {"exhaustion": float32(0.1), "message_id": "fireworks", "scaling": "when_caused_by_living_non_player"},
+			"fly_into_wall":         {"exhaustion": float32(0), "message_id": "flyIntoWall", "scaling": "when_caused_by_living_non_player"},
+			"freeze":                {"effects": "freezing", "exhaustion": float32(0), "message_id": "freeze", "scaling": "when_caused_by_living_non_player"},
+			"generic":               {"exhaustion": float32(0), "message_id": "generic", "scaling": "when_caused_by_living_non_player"},
+			"generic_kill":          {"exhaustion": float32(0), "message_id": "genericKill", "scaling": "when_caused_by_living_non_player"},
+			"hot_floor":             {"effects": "burning", "exhaustion": float32(0.1), "message_id": "hotFloor", "scaling": "when_caused_by_living_non_player"},
+			"in_fire":               {"effects": "burning", "exhaustion": float32(0.1), "message_id": "inFire", "scaling": "when_caused_by_living_non_player"},
+			"in_wall":               {"exhaustion": float32(0), "message_id": "inWall", "scaling": "when_caused_by_living_non_player"},
+			"indirect_magic":        {"exhaustion": float32(0), "message_id": "indirectMagic", "scaling": "when_caused_by_living_non_player"},
+			"lava":                  {"effects": "burning", "exhaustion": float32(0.1), "message_id": "lava", "scaling": "when_caused_by_living_non_player"},
+			"lightning_bolt":        {"exhaustion": float32(0.1), "message_id": "lightningBolt", "scaling": "when_caused_by_living_non_player"},
+			"mace_smash":            {"exhaustion": float32(0.1), "message_id": "mace_smash", "scaling": "when_caused_by_living_non_player"},
+			"magic":                 {"exhaustion": float32(0), "message_id": "magic", "scaling": "when_caused_by_living_non_player"},
+			"mob_attack":            {"exhaustion": float32(0.1), "message_id": "mob", "scaling": "when_caused_by_living_non_player"},
+			"mob_attack_no_aggro":   {"exhaustion": float32(0.1), "message_id": "mob", "scaling": "when_caused_by_living_non_player"},
+			"mob_projectile":        {"exhaustion": float32(0.1), "message_id": "mob", "scaling": "when_caused_by_living_non_player"},
+			"on_fire":               {"effects": "burning", "exhaustion": float32(0), "message_id": "onFire", "scaling": "when_caused_by_living_non_player"},
+			"out_of_world":          {"exhaustion": float32(0), "message_id": "outOfWorld", "scaling": "when_caused_by_living_non_player"},
+			"outside_border":        {"exhaustion": float32(0), "message_id": "outsideBorder", "scaling": "when_caused_by_living_non_player"},
+			"player_attack":         {"exhaustion": float32(0.1), "message_id": "player", "scaling": "when_caused_by_living_non_player"},
+			"player_explosion":      {"exhaustion": float32(0.1), "message_id": "explosion.player", "scaling": "always"},
+			"sonic_boom":            {"exhaustion": float32(0), "message_id": "sonic_boom", "scaling": "always"},
+			"spit":                  {"exhaustion": float32(0.1), "message_id": "mob", "scaling": "when_caused_by_living_non_player"},
+			"stalagmite":            {"exhaustion": float32(0), "message_id": "stalagmite", "scaling": "when_caused_by_living_non_player"},
+			"starve":                {"exhaustion": float32(0), "message_id": "starve", "scaling": "when_caused_by_living_non_player"},
+			"sting":                 {"exhaustion": float32(0.1), "message_id": "sting", "scaling": "when_caused_by_living_non_player"},
+			"sweet_berry_bush":      {"effects": "poking", "exhaustion": float32(0.1), "message_id": "sweetBerryBush", "scaling": "when_caused_by_living_non_player"},
+			"thorns":                {"effects": "thorns", "exhaustion": float32(0.1), "message_id": "thorns", "scaling": "when_caused_by_living_non_player"},
+			"thrown":                {"exhaustion": float32(0.1), "message_id": "thrown", "scaling": "when_caused_by_living_non_player"},
+			"trident":               {"exhaustion": float32(0.1), "message_id": "trident", "scaling": "when_caused_by_living_non_player"},
+			"unattributed_fireball": {"effects": "burning", "exhaustion": float32(0.1), "message_id": "onFire", "scaling": "when_caused_by_living_non_player"},
+			"wind_charge":           {"exhaustion": float32(0.1), "message_id": "mob", "scaling": "when_caused_by_living_non_player"},
+			"wither":                {"exhaustion": float32(0), "message_id": "wither", "scaling": "when_caused_by_living_non_player"},
+			"wither_skull":          {"exhaustion": float32(0.1), "message_id": "witherSkull", "scaling": "when_caused_by_living_non_player"},
+		}},
+		{"minecraft:banner_pattern", map[string]nbt.Compound{
+			"base":                   {"asset_id": "minecraft:base", "translation_key": "block.minecraft.banner.base"},
+			"border":                 {"asset_id": "minecraft:border", "translation_key": "block.minecraft.banner.border"},
+			"bricks":                 {"asset_id": "minecraft:bricks", "translation_key": "block.minecraft.banner.bricks"},
+			"circle":                 {"asset_id": "minecraft:circle", "translation_key": "block.minecraft.banner.circle"},
+			"creeper":                {"asset_id": "minecraft:creeper", "translation_key": "block.minecraft.banner.creeper"},
+			"cross":                  {"asset_id": "minecraft:cross", "translation_key": "block.minecraft.banner.cross"},
+			"curly_border":           {"asset_id": "minecraft:curly_border", "translation_key": "block.minecraft.banner.curly_border"},
+			"diagonal_left":          {"asset_id": "minecraft:diagonal_left", "translation_key": "block.minecraft.banner.diagonal_left"},
+			"diagonal_right":         {"asset_id": "minecraft:diagonal_right", "translation_key": "block.minecraft.banner.diagonal_right"},
+			"diagonal_up_left":       {"asset_id": "minecraft:diagonal_up_left", "translation_key": "block.minecraft.banner.diagonal_up_left"},
+			"diagonal_up_right":      {"asset_id": "minecraft:diagonal_up_right", "translation_key": "block.minecraft.banner.diagonal_up_right"},
+			"flow":                   {"asset_id": "minecraft:flow", "translation_key": "block.minecraft.banner.flow"},
+			"flower":                 {"asset_id": "minecraft:flower", "translation_key": "block.minecraft.banner.flower"},
+			"globe":                  {"asset_id": "minecraft:globe", "translation_key": "block.minecraft.banner.globe"},
+			"gradient":               {"asset_id": "minecraft:gradient", "translation_key": "block.minecraft.banner.gradient"},
+			"gradient_up":            {"asset_id": "minecraft:gradient_up", "translation_key": "block.minecraft.banner.gradient_up"},
+			"guster":                 {"asset_id": "minecraft:guster", "translation_key": "block.minecraft.banner.guster"},
+			"half_horizontal":        {"asset_id": "minecraft:half_horizontal", "translation_key": "block.minecraft.banner.half_horizontal"},
+			"half_horizontal_bottom": {"asset_id": "minecraft:half_horizontal_bottom", "translation_key": "block.minecraft.banner.half_horizontal_bottom"},
+			"half_vertical":          {"asset_id": "minecraft:half_vertical", "translation_key": "block.minecraft.banner.half_vertical"},
+			"half_vertical_right":    {"asset_id": "minecraft:half_vertical_right", "translation_key": "block.minecraft.banner.half_vertical_right"},
+			"mojang":                 {"asset_id": "minecraft:mojang", "translation_key": "block.minecraft.banner.mojang"},
+			"piglin":                 {"asset_id": "minecraft:piglin", "translation_key": "block.minecraft.banner.piglin"},
+			"rhombus":                {"asset_id": "minecraft:rhombus", "translation_key": "block.minecraft.banner.rhombus"},
+			"skull":                  {"asset_id": "minecraft:skull", "translation_key": "block.minecraft.banner.skull"},
+			"small_stripes":          {"asset_id": "minecraft:small_stripes", "translation_key": "block.minecraft.banner.small_stripes"},
+			"square_bottom_left":     {"asset_id": "minecraft:square_bottom_left", "translation_key": "block.minecraft.banner.square_bottom_left"},
+			"square_bottom_right":    {"asset_id": "minecraft:square_bottom_right", "translation_key": "block.minecraft.banner.square_bottom_right"},
+			"square_top_left":        {"asset_id": "minecraft:square_top_left", "translation_key": "block.minecraft.banner.square_top_left"},
+			"square_top_right":       {"asset_id": "minecraft:square_top_right", "translation_key": "block.minecraft.banner.square_top_right"},
+			"straight_cross":         {"asset_id": "minecraft:straight_cross", "translation_key": "block.minecraft.banner.straight_cross"},
+			"stripe_bottom":          {"asset_id": "minecraft:stripe_bottom", "translation_key": "block.minecraft.banner.stripe_bottom"},
+			"stripe_center":          {"asset_id": "minecraft:stripe_center", "translation_key": "block.minecraft.banner.stripe_center"},
+			"stripe_downleft":        {"asset_id": "minecraft:stripe_downleft", "translation_key": "block.minecraft.banner.stripe_downleft"},
+			"stripe_downright":       {"asset_id": "minecraft:stripe_downright", "translation_key": "block.minecraft.banner.stripe_downright"},
+			"stripe_left":            {"asset_id": "minecraft:stripe_left", "translation_key": "block.minecraft.banner.stripe_left"},
+			"stripe_middle":          {"asset_id": "minecraft:stripe_middle", "translation_key": "block.minecraft.banner.stripe_middle"},
+			"stripe_right":           {"asset_id": "minecraft:stripe_right", "translation_key": "block.minecraft.banner.stripe_right"},
+			"stripe_top":             {"asset_id": "minecraft:stripe_top", "translation_key": "block.minecraft.banner.stripe_top"},
+			"triangle_bottom":        {"asset_id": "minecraft:triangle_bottom", "translation_key": "block.minecraft.banner.triangle_bottom"},
+			"triangle_top":           {"asset_id": "minecraft:triangle_top", "translation_key": "block.minecraft.banner.triangle_top"},
+			"triangles_bottom":       {"asset_id": "minecraft:triangles_bottom", "translation_key": "block.minecraft.banner.triangles_bottom"},
+			"triangles_top":          {"asset_id": "minecraft:triangles_top", "translation_key": "block.minecraft.banner.triangles_top"},
+		}},
+		{"minecraft:enchantment", map[string]nbt.Compound{
+			"aqua_affinity":         {"anvil_cost": int32(4), "description": nbt.Compound{"translate": "enchantment.minecraft.aqua_affinity"}, "effects": nbt.Compound{"minecraft:attributes": nbt.List{nbt.Compound{"amount": float32(4), "attribute": "minecraft:player.submerged_mining_speed", "id": "minecraft:enchantment.aqua_affinity", "operation": "add_multiplied_total"}}}, "max_cost": nbt.Compound{"base": int32(41), "per_level_above_first": int32(0)}, "max_level": int32(1), "min_cost": nbt.Compound{"base": int32(1), "per_level_above_first": int32(0)}, "slots": nbt.List{"head"}, "supported_items": "#minecraft:enchantable/head_armor", "weight": int32(2)},
+			"bane_of_arthropods":    {"anvil_cost": int32(2), "description": nbt.Compound{"translate": "enchantment.minecraft.bane_of_arthropods"}, "effects": nbt.Compound{"minecraft:damage": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:add", "value": nbt.Compound{"base": float32(2.5), "per_level_above_first": float32(2.5), "type": "minecraft:linear"}}, "requirements": nbt.Compound{"condition": "minecraft:entity_properties", "entity": "this", "predicate": nbt.Compound{"type": "#minecraft:sensitive_to_bane_of_arthropods"}}}}, "minecraft:post_attack": nbt.List{nbt.Compound{"affected": "victim", "effect": nbt.Compound{"max_amplifier": float32(3), "max_duration": nbt.Compound{"base": float32(1.5), "per_level_above_first": float32(0.5), "type": "minecraft:linear"}, "min_amplifier": float32(3), "min_duration": float32(1.5), "to_apply": "minecraft:slowness", "type": "minecraft:apply_mob_effect"}, "enchanted": "attacker", "requirements": nbt.Compound{"condition": "minecraft:all_of", "terms": nbt.List{nbt.Compound{"condition": "minecraft:entity_properties", "entity": "this", "predicate": nbt.Compound{"type": "#minecraft:sensitive_to_bane_of_arthropods"}}, nbt.Compound{"condition": "minecraft:damage_source_properties", "predicate": nbt.Compound{"is_direct": true}}}}}}}, "exclusive_set": "#minecraft:exclusive_set/damage", "max_cost": nbt.Compound{"base": int32(25), "per_level_above_first": int32(8)}, "max_level": int32(5), "min_cost": nbt.Compound{"base": int32(5), "per_level_above_first": int32(8)}, "primary_items": "#minecraft:enchantable/sword", "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/weapon", "weight": int32(5)},
+			"binding_curse":         {"anvil_cost": int32(8), "description": nbt.Compound{"translate": "enchantment.minecraft.binding_curse"}, "effects": nbt.Compound{"minecraft:prevent_armor_change": nbt.Compound{}}, "max_cost": nbt.Compound{"base": int32(50), "per_level_above_first": int32(0)}, "max_level": int32(1), "min_cost": nbt.Compound{"base": int32(25), "per_level_above_first": int32(0)}, "slots": nbt.List{"armor"}, "supported_items": "#minecraft:enchantable/equippable", "weight": int32(1)},
+			"blast_protection":      {"anvil_cost": int32(4), "description": nbt.Compound{"translate": "enchantment.minecraft.blast_protection"}, "effects": nbt.Compound{"minecraft:attributes": nbt.List{nbt.Compound{"amount": nbt.Compound{"base": float32(0.15), "per_level_above_first": float32(0.15), "type": "minecraft:linear"}, "attribute": "minecraft:generic.explosion_knockback_resistance", "id": "minecraft:enchantment.blast_protection", "operation": "add_value"}}, "minecraft:damage_protection": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:add", "value": nbt.Compound{"base": float32(2), "per_level_above_first": float32(2), "type": "minecraft:linear"}}, "requirements": nbt.Compound{"condition": "minecraft:damage_source_properties", "predicate": nbt.Compound{"tags": nbt.List{nbt.Compound{"expected": true, "id": "minecraft:is_explosion"}, nbt.Compound{"expected": false, "id": "minecraft:bypasses_invulnerability"}}}}}}}, "exclusive_set": "#minecraft:exclusive_set/armor", "max_cost": nbt.Compound{"base": int32(13), "per_level_above_first": int32(8)}, "max_level": int32(4), "min_cost": nbt.Compound{"base": int32(5), "per_level_above_first": int32(8)}, "slots": nbt.List{"armor"}, "supported_items": "#minecraft:enchantable/armor", "weight": int32(2)},
+			"breach":                {"anvil_cost": int32(4), "description": nbt.Compound{"translate": "enchantment.minecraft.breach"}, "effects": nbt.Compound{"minecraft:armor_effectiveness": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:add", "value": nbt.Compound{"base": float32(-0.15), "per_level_above_first": float32(-0.15), "type": "minecraft:linear"}}}}}, "exclusive_set": "#minecraft:exclusive_set/damage", "max_cost": nbt.Compound{"base": int32(65), "per_level_above_first": int32(9)}, "max_level": int32(4), "min_cost": nbt.Compound{"base": int32(15), "per_level_above_first": int32(9)}, "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/mace", "weight": int32(2)},
+			"channeling":            {"anvil_cost": int32(8), "description": nbt.Compound{"translate": "enchantment.minecraft.channeling"}, "effects": nbt.Compound{"minecraft:hit_block": nbt.List{nbt.Compound{"effect": nbt.Compound{"effects": nbt.List{nbt.Compound{"entity": "minecraft:lightning_bolt", "type": "minecraft:summon_entity"}, nbt.Compound{"pitch": float32(1), "sound": "minecraft:item.trident.thunder", "type": "minecraft:play_sound", "volume": float32(5)}}, "type": "minecraft:all_of"}, "requirements": nbt.Compound{"condition": "minecraft:all_of", "terms": nbt.List{nbt.Compound{"condition": "minecraft:weather_check", "thundering": true}, nbt.Compound{"condition": "minecraft:entity_properties", "entity": "this", "predicate": nbt.Compound{"type": "minecraft:trident"}}, nbt.Compound{"condition": "minecraft:location_check", "predicate": nbt.Compound{"can_see_sky": true}}, nbt.Compound{"block": "minecraft:lightning_rod", "condition": "minecraft:block_state_property"}}}}}, "minecraft:post_attack": nbt.List{nbt.Compound{"affected": "victim", "effect": nbt.Compound{"effects": nbt.List{nbt.Compound{"entity": "minecraft:lightning_bolt", "type": "minecraft:summon_entity"}, nbt.Compound{"pitch": float32(1), "sound": "minecraft:item.trident.thunder", "type": "minecraft:play_sound", "volume": float32(5)}}, "type": "minecraft:all_of"}, "enchanted": "attacker", "requirements": nbt.Compound{"condition": "minecraft:all_of", "terms": nbt.List{nbt.Compound{"condition": "minecraft:weather_check", "thundering": true}, nbt.Compound{"condition": "minecraft:entity_properties", "entity": "this", "predicate": nbt.Compound{"location": nbt.Compound{"can_see_sky": true}}}, nbt.Compound{"condition": "minecraft:entity_properties", "entity": "direct_attacker", "predicate": nbt.Compound{"type": "minecraft:trident"}}}}}}}, "max_cost": nbt.Compound{"base": int32(50), "per_level_above_first": int32(0)}, "max_level": int32(1), "min_cost": nbt.Compound{"base": int32(25), "per_level_above_first": int32(0)}, "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/trident", "weight": int32(1)},
+			"density":               {"anvil_cost": int32(2), "description": nbt.Compound{"translate": "enchantment.minecraft.density"}, "effects": nbt.Compound{"minecraft:smash_damage_per_fallen_block": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:add", "value": nbt.Compound{"base": float32(0.5), "per_level_above_first": float32(0.5), "type": "minecraft:linear"}}}}}, "exclusive_set": "#minecraft:exclusive_set/damage", "max_cost": nbt.Compound{"base": int32(25), "per_level_above_first": int32(8)}, "max_level": int32(5), "min_cost": nbt.Compound{"base": int32(5), "per_level_above_first": int32(8)}, "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/mace", "weight": int32(5)},
+			"depth_strider":         {"anvil_cost": int32(4), "description": nbt.Compound{"translate": "enchantment.minecraft.depth_strider"}, "effects": nbt.Compound{"minecraft:attributes": nbt.List{nbt.Compound{"amount": nbt.Compound{"base": float32(0.33333334), "per_level_above_first": float32(0.33333334), "type": "minecraft:linear"}, "attribute": "minecraft:generic.water_movement_efficiency", "id": "minecraft:enchantment.depth_strider", "operation": "add_value"}}}, "exclusive_set": "#minecraft:exclusive_set/boots", "max_cost": nbt.Compound{"base": int32(25), "per_level_above_first": int32(10)}, "max_level": int32(3), "min_cost": nbt.Compound{"base": int32(10), "per_level_above_first": int32(10)}, "slots": nbt.List{"feet"}, "supported_items": "#minecraft:enchantable/foot_armor", "weight": int32(2)},
+			"efficiency":            {"anvil_cost": int32(1), "description": nbt.Compound{"translate": "enchantment.minecraft.efficiency"}, "effects": nbt.Compound{"minecraft:attributes": nbt.List{nbt.Compound{"amount": nbt.Compound{"added": float32(1), "type": "minecraft:levels_squared"}, "attribute": "minecraft:player.mining_efficiency", "id": "minecraft:enchantment.efficiency", "operation": "add_value"}}}, "max_cost": nbt.Compound{"base": int32(51), "per_level_above_first": int32(10)}, "max_level": int32(5), "min_cost": nbt.Compound{"base": int32(1), "per_level_above_first": int32(10)}, "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/mining", "weight": int32(10)},
+			"feather_falling":       {"anvil_cost": int32(2), "description": nbt.Compound{"translate": "enchantment.minecraft.feather_falling"}, "effects": nbt.Compound{"minecraft:damage_protection": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:add", "value": nbt.Compound{"base": float32(3), "per_level_above_first": float32(3), "type": "minecraft:linear"}}, "requirements": nbt.Compound{"condition": "minecraft:damage_source_properties", "predicate": nbt.Compound{"tags": nbt.List{nbt.Compound{"expected": true, "id": "minecraft:is_fall"}, nbt.Compound{"expected": false, "id": "minecraft:bypasses_invulnerability"}}}}}}}, "max_cost": nbt.Compound{"base": int32(11), "per_level_above_first": int32(6)}, "max_level": int32(4), "min_cost": nbt.Compound{"base": int32(5), "per_level_above_first": int32(6)}, "slots": nbt.List{"armor"}, "supported_items": "#minecraft:enchantable/foot_armor", "weight": int32(5)},
+			"fire_aspect":           {"anvil_cost": int32(4), "description": nbt.Compound{"translate": "enchantment.minecraft.fire_aspect"}, "effects": nbt.Compound{"minecraft:post_attack": nbt.List{nbt.Compound{"affected": "victim", "effect": nbt.Compound{"duration": nbt.Compound{"base": float32(4), "per_level_above_first": float32(4), "type": "minecraft:linear"}, "type": "minecraft:ignite"}, "enchanted": "attacker", "requirements": nbt.Compound{"condition": "minecraft:damage_source_properties", "predicate": nbt.Compound{"is_direct": true}}}}}, "max_cost": nbt.Compound{"base": int32(60), "per_level_above_first": int32(20)}, "max_level": int32(2), "min_cost": nbt.Compound{"base": int32(10), "per_level_above_first": int32(20)}, "primary_items": "#minecraft:enchantable/sword", "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/fire_aspect", "weight": int32(2)},
+			"fire_protection":       {"anvil_cost": int32(2), "description": nbt.Compound{"translate": "enchantment.minecraft.fire_protection"}, "effects": nbt.Compound{"minecraft:attributes": nbt.List{nbt.Compound{"amount": nbt.Compound{"base": float32(-0.15), "per_level_above_first": float32(-0.15), "type": "minecraft:linear"}, "attribute": "minecraft:generic.burning_time", "id": "minecraft:enchantment.fire_protection", "operation": "add_multiplied_base"}}, "minecraft:damage_protection": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:add", "value": nbt.Compound{"base": float32(2), "per_level_above_first": float32(2), "type": "minecraft:linear"}}, "requirements": nbt.Compound{"condition": "minecraft:damage_source_properties", "predicate": nbt.Compound{"tags": nbt.List{nbt.Compound{"expected": true, "id": "minecraft:is_fire"}, nbt.Compound{"expected": false, "id": "minecraft:bypasses_invulnerability"}}}}}}}, "exclusive_set": "#minecraft:exclusive_set/armor", "max_cost": nbt.Compound{"base": int32(18), "per_level_above_first": int32(8)}, "max_level": int32(4), "min_cost": nbt.Compound{"base": int32(10), "per_level_above_first": int32(8)}, "slots": nbt.List{"armor"}, "supported_items": "#minecraft:enchantable/armor", "weight": int32(5)},
+			"flame":                 {"anvil_cost": int32(4), "description": nbt.Compound{"translate": "enchantment.minecraft.flame"}, "effects": nbt.Compound{"minecraft:projectile_spawned": nbt.List{nbt.Compound{"effect": nbt.Compound{"duration": float32(100), "type": "minecraft:ignite"}}}}, "max_cost": nbt.Compound{"base": int32(50), "per_level_above_first": int32(0)}, "max_level": int32(1), "min_cost": nbt.Compound{"base": int32(20), "per_level_above_first": int32(0)}, "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/bow", "weight": int32(2)},
+			"fortune":               {"anvil_cost": int32(4), "description": nbt.Compound{"translate": "enchantment.minecraft.fortune"}, "exclusive_set": "#minecraft:exclusive_set/mining", "max_cost": nbt.Compound{"base": int32(65), "per_level_above_first": int32(9)}, "max_level": int32(3), "min_cost": nbt.Compound{"base": int32(15), "per_level_above_first": int32(9)}, "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/mining_loot", "weight": int32(2)},
+			"frost_walker":          {"anvil_cost": int32(4), "description": nbt.Compound{"translate": "enchantment.minecraft.frost_walker"}, "effects": nbt.Compound{"minecraft:damage_immunity": nbt.List{nbt.Compound{"effect": nbt.Compound{}, "requirements": nbt.Compound{"condition": "minecraft:damage_source_properties", "predicate": nbt.Compound{"tags": nbt.List{nbt.Compound{"expected": true, "id": "minecraft:burn_from_stepping"}, nbt.Compound{"expected": false, "id": "minecraft:bypasses_invulnerability"}}}}}}, "minecraft:location_changed": nbt.List{nbt.Compound{"effect": nbt.Compound{"block_state": nbt.Compound{"state": nbt.Compound{"Name": "minecraft:frosted_ice", "Properties": nbt.Compound{"age": "0"}}, "type": "minecraft:simple_state_provider"}, "height": float32(1), "offset": nbt.List{int32(0), int32(-1), int32(0)}, "predicate": nbt.Compound{"predicates": nbt.List{nbt.Compound{"blocks": "minecraft:air", "offset": nbt.List{int32(0), int32(1), int32(0)}, "type": "minecraft:matching_blocks"}, nbt.Compound{"blocks": "minecraft:water", "type": "minecraft:matching_blocks"}, nbt.Compound{"fluids": "minecraft:water", "type": "minecraft:matching_fluids"}, nbt.Compound{"type": "minecraft:unobstructed"}}, "type": "minecraft:all_of"}, "radius": nbt.Compound{"max": float32(16), "min": float32(0), "type": "minecraft:clamped", "value": nbt.Compound{"base": float32(3), "per_level_above_first": float32(1), "type": "minecraft:linear"}}, "trigger_game_event": "minecraft:block_place", "type": "minecraft:replace_disk"}, "requirements": nbt.Compound{"condition": "minecraft:all_of", "terms": nbt.List{nbt.Compound{"condition": "minecraft:entity_properties", "entity": "this", "predicate": nbt.Compound{"flags": nbt.Compound{"is_on_ground": true}}}, nbt.Compound{"condition": "minecraft:inverted", "term": nbt.Compound{"condition": "minecraft:entity_properties", "entity": "this", "predicate": nbt.Compound{"vehicle": nbt.Compound{}}}}}}}}}, "exclusive_set": "#minecraft:exclusive_set/boots", "max_cost": nbt.Compound{"base": int32(25), "per_level_above_first": int32(10)}, "max_level": int32(2), "min_cost": nbt.Compound{"base": int32(10), "per_level_above_first": int32(10)}, "slots": nbt.List{"feet"}, "supported_items": "#minecraft:enchantable/foot_armor", "weight": int32(2)},
+			"impaling":              {"anvil_cost": int32(4), "description": nbt.Compound{"translate": "enchantment.minecraft.impaling"}, "effects": nbt.Compound{"minecraft:damage": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:add", "value": nbt.Compound{"base": float32(2.5), "per_level_above_first": float32(2.5), "type": "minecraft:linear"}}, "requirements": nbt.Compound{"condition": "minecraft:entity_properties", "entity": "this", "predicate": nbt.Compound{"type": "#minecraft:sensitive_to_impaling"}}}}}, "exclusive_set": "#minecraft:exclusive_set/damage", "max_cost": nbt.Compound{"base": int32(21), "per_level_above_first": int32(8)}, "max_level": int32(5), "min_cost": nbt.Compound{"base": int32(1), "per_level_above_first": int32(8)}, "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/trident", "weight": int32(2)},
+			"infinity":              {"anvil_cost": int32(8), "description": nbt.Compound{"translate": "enchantment.minecraft.infinity"}, "effects": nbt.Compound{"minecraft:ammo_use": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:set", "value": float32(0)}, "requirements": nbt.Compound{"condition": "minecraft:match_tool", "predicate": nbt.Compound{"items": "minecraft:arrow"}}}}}, "exclusive_set": "#minecraft:exclusive_set/bow", "max_cost": nbt.Compound{"base": int32(50), "per_level_above_first": int32(0)}, "max_level": int32(1), "min_cost": nbt.Compound{"base": int32(20), "per_level_above_first": int32(0)}, "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/bow", "weight": int32(1)},
+			"knockback":             {"anvil_cost": int32(2), "description": nbt.Compound{"translate": "enchantment.minecraft.knockback"}, "effects": nbt.Compound{"minecraft:knockback": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:add", "value": nbt.Compound{"base": float32(1), "per_level_above_first": float32(1), "type": "minecraft:linear"}}}}}, "max_cost": nbt.Compound{"base": int32(55), "per_level_above_first": int32(20)}, "max_level": int32(2), "min_cost": nbt.Compound{"base": int32(5), "per_level_above_first": int32(20)}, "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/sword", "weight": int32(5)},
+			"looting":               {"anvil_cost": int32(4), "description": nbt.Compound{"translate": "enchantment.minecraft.looting"}, "effects": nbt.Compound{"minecraft:equipment_drops": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:add", "value": nbt.Compound{"base": float32(0.01), "per_level_above_first": float32(0.01), "type": "minecraft:linear"}}, "enchanted": "attacker", "requirements": nbt.Compound{"condition": "minecraft:entity_properties", "entity": "attacker", "predicate": nbt.Compound{"type": "minecraft:player"}}}}}, "max_cost": nbt.Compound{"base": int32(65), "per_level_above_first": int32(9)}, "max_level": int32(3), "min_cost": nbt.Compound{"base": int32(15), "per_level_above_first": int32(9)}, "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/sword", "weight": int32(2)},
+			"loyalty":               {"anvil_cost": int32(2), "description": nbt.Compound{"translate": "enchantment.minecraft.loyalty"}, "effects": nbt.Compound{"minecraft:trident_return_acceleration": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:add", "value": nbt.Compound{"base": float32(1), "per_level_above_first": float32(1), "type": "minecraft:linear"}}}}}, "max_cost": nbt.Compound{"base": int32(50), "per_level_above_first": int32(0)}, "max_level": int32(3), "min_cost": nbt.Compound{"base": int32(12), "per_level_above_first": int32(7)}, "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/trident", "weight": int32(5)},
+			"luck_of_the_sea":       {"anvil_cost": int32(4), "description": nbt.Compound{"translate": "enchantment.minecraft.luck_of_the_sea"}, "effects": nbt.Compound{"minecraft:fishing_luck_bonus": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:add", "value": nbt.Compound{"base": float32(1), "per_level_above_first": float32(1), "type": "minecraft:linear"}}}}}, "max_cost": nbt.Compound{"base": int32(65), "per_level_above_first": int32(9)}, "max_level": int32(3), "min_cost": nbt.Compound{"base": int32(15), "per_level_above_first": int32(9)}, "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/fishing", "weight": int32(2)},
+			"lure":                  {"anvil_cost": int32(4), "description": nbt.Compound{"translate": "enchantment.minecraft.lure"}, "effects": nbt.Compound{"minecraft:fishing_time_reduction": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:add", "value": nbt.Compound{"base": float32(5), "per_level_above_first": float32(5), "type": "minecraft:linear"}}}}}, "max_cost": nbt.Compound{"base": int32(65), "per_level_above_first": int32(9)}, "max_level": int32(3), "min_cost": nbt.Compound{"base": int32(15), "per_level_above_first": int32(9)}, "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/fishing", "weight": int32(2)},
+			"mending":               {"anvil_cost": int32(4), "description": nbt.Compound{"translate": "enchantment.minecraft.mending"}, "effects": nbt.Compound{"minecraft:repair_with_xp": nbt.List{nbt.Compound{"effect": nbt.Compound{"factor": float32(2), "type": "minecraft:multiply"}}}}, "max_cost": nbt.Compound{"base": int32(50), "per_level_above_first": int32(25)}, "max_level": int32(1), "min_cost": nbt.Compound{"base": int32(0), "per_level_above_first": int32(25)}, "slots": nbt.List{"any"}, "supported_items": "#minecraft:enchantable/durability", "weight": int32(2)},
+			"multishot":             {"anvil_cost": int32(4), "description": nbt.Compound{"translate": "enchantment.minecraft.multishot"}, "effects": nbt.Compound{"minecraft:projectile_count": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:add", "value": float32(2)}}}, "minecraft:projectile_spread": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:add", "value": float32(10)}}}}, "exclusive_set": "#minecraft:exclusive_set/crossbow", "max_cost": nbt.Compound{"base": int32(50), "per_level_above_first": int32(0)}, "max_level": int32(1), "min_cost": nbt.Compound{"base": int32(20), "per_level_above_first": int32(0)}, "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/crossbow", "weight": int32(2)},
+			"piercing":              {"anvil_cost": int32(1), "description": nbt.Compound{"translate": "enchantment.minecraft.piercing"}, "effects": nbt.Compound{"minecraft:projectile_piercing": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:add", "value": nbt.Compound{"base": float32(1), "per_level_above_first": float32(1), "type": "minecraft:linear"}}}}}, "exclusive_set": "#minecraft:exclusive_set/crossbow", "max_cost": nbt.Compound{"base": int32(50), "per_level_above_first": int32(0)}, "max_level": int32(4), "min_cost": nbt.Compound{"base": int32(1), "per_level_above_first": int32(10)}, "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/crossbow", "weight": int32(10)},
+			"power":                 {"anvil_cost": int32(1), "description": nbt.Compound{"translate": "enchantment.minecraft.power"}, "effects": nbt.Compound{"minecraft:damage": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:add", "value": nbt.Compound{"base": float32(0.5), "per_level_above_first": float32(0.5), "type": "minecraft:linear"}}, "requirements": nbt.Compound{"condition": "minecraft:entity_properties", "entity": "direct_attacker", "predicate": nbt.Compound{"type": "#minecraft:arrows"}}}}}, "max_cost": nbt.Compound{"base": int32(16), "per_level_above_first": int32(10)}, "max_level": int32(5), "min_cost": nbt.Compound{"base": int32(1), "per_level_above_first": int32(10)}, "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/bow", "weight": int32(10)},
+			"projectile_protection": {"anvil_cost": int32(2), "description": nbt.Compound{"translate": "enchantment.minecraft.projectile_protection"}, "effects": nbt.Compound{"minecraft:damage_protection": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:add", "value": nbt.Compound{"base": float32(2), "per_level_above_first": float32(2), "type": "minecraft:linear"}}, "requirements": nbt.Compound{"condition": "minecraft:damage_source_properties", "predicate": nbt.Compound{"tags": nbt.List{nbt.Compound{"expected": true, "id": "minecraft:is_projectile"}, nbt.Compound{"expected": false, "id": "minecraft:bypasses_invulnerability"}}}}}}}, "exclusive_set": "#minecraft:exclusive_set/armor", "max_cost": nbt.Compound{"base": int32(9), "per_level_above_first": int32(6)}, "max_level": int32(4), "min_cost": nbt.Compound{"base": int32(3), "per_level_above_first": int32(6)}, "slots": nbt.List{"armor"}, "supported_items": "#minecraft:enchantable/armor", "weight": int32(5)},
+			"protection":            {"anvil_cost": int32(1), "description": nbt.Compound{"translate": "enchantment.minecraft.protection"}, "effects": nbt.Compound{"minecraft:damage_protection": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:add", "value": nbt.Compound{"base": float32(1), "per_level_above_first": float32(1), "type": "minecraft:linear"}}, "requirements": nbt.Compound{"condition": "minecraft:damage_source_properties", "predicate": nbt.Compound{"tags": nbt.List{nbt.Compound{"expected": false, "id": "minecraft:bypasses_invulnerability"}}}}}}}, "exclusive_set": "#minecraft:exclusive_set/armor", "max_cost": nbt.Compound{"base": int32(12), "per_level_above_first": int32(11)}, "max_level": int32(4), "min_cost": nbt.Compound{"base": int32(1), "per_level_above_first": int32(11)}, "slots": nbt.List{"armor"}, "supported_items": "#minecraft:enchantable/armor", "weight": int32(10)},
+			"punch":                 {"anvil_cost": int32(4), "description": nbt.Compound{"translate": "enchantment.minecraft.punch"}, "effects": nbt.Compound{"minecraft:knockback": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:add", "value": nbt.Compound{"base": float32(1), "per_level_above_first": float32(1), "type": "minecraft:linear"}}, "requirements": nbt.Compound{"condition": "minecraft:entity_properties", "entity": "direct_attacker", "predicate": nbt.Compound{"type": "#minecraft:arrows"}}}}}, "max_cost": nbt.Compound{"base": int32(37), "per_level_above_first": int32(20)}, "max_level": int32(2), "min_cost": nbt.Compound{"base": int32(12), "per_level_above_first": int32(20)}, "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/bow", "weight": int32(2)},
+			"quick_charge":          {"anvil_cost": int32(2), "description": nbt.Compound{"translate": "enchantment.minecraft.quick_charge"}, "effects": nbt.Compound{"minecraft:crossbow_charge_time": nbt.Compound{"type": "minecraft:add", "value": nbt.Compound{"base": float32(-0.25), "per_level_above_first": float32(-0.25), "type": "minecraft:linear"}}, "minecraft:crossbow_charging_sounds": nbt.List{nbt.Compound{"end": "minecraft:item.crossbow.loading_end", "start": "minecraft:item.crossbow.quick_charge_1"}, nbt.Compound{"end": "minecraft:item.crossbow.loading_end", "start": "minecraft:item.crossbow.quick_charge_2"}, nbt.Compound{"end": "minecraft:item.crossbow.loading_end", "start": "minecraft:item.crossbow.quick_charge_3"}}}, "max_cost": nbt.Compound{"base": int32(50), "per_level_above_first": int32(0)}, "max_level": int32(3), "min_cost": nbt.Compound{"base": int32(12), "per_level_above_first": int32(20)}, "slots": nbt.List{"mainhand", "offhand"}, "supported_items": "#minecraft:enchantable/crossbow", "weight": int32(5)},
+			"respiration":           {"anvil_cost": int32(4), "description": nbt.Compound{"translate": "enchantment.minecraft.respiration"}, "effects": nbt.Compound{"minecraft:attributes": nbt.List{nbt.Compound{"amount": nbt.Compound{"base": float32(1), "per_level_above_first": float32(1), "type": "minecraft:linear"}, "attribute": "minecraft:generic.oxygen_bonus", "id": "minecraft:enchantment.respiration", "operation": "add_value"}}}, "max_cost": nbt.Compound{"base": int32(40), "per_level_above_first": int32(10)}, "max_level": int32(3), "min_cost": nbt.Compound{"base": int32(10), "per_level_above_first": int32(10)}, "slots": nbt.List{"head"}, "supported_items": "#minecraft:enchantable/head_armor", "weight": int32(2)},
+			"riptide":               {"anvil_cost": int32(4), "description": nbt.Compound{"translate": "enchantment.minecraft.riptide"}, "effects": nbt.Compound{"minecraft:trident_sound": nbt.List{"minecraft:item.trident.riptide_1", "minecraft:item.trident.riptide_2", "minecraft:item.trident.riptide_3"}, "minecraft:trident_spin_attack_strength": nbt.Compound{"type": "minecraft:add", "value": nbt.Compound{"base": float32(1.5), "per_level_above_first": float32(0.75), "type": "minecraft:linear"}}}, "exclusive_set": "#minecraft:exclusive_set/riptide", "max_cost": nbt.Compound{"base": int32(50), "per_level_above_first": int32(0)}, "max_level": int32(3), "min_cost": nbt.Compound{"base": int32(17), "per_level_above_first": int32(7)}, "slots": nbt.List{"hand"}, "supported_items": "#minecraft:enchantable/trident", "weight": int32(2)},
+			"sharpness":             {"anvil_cost": int32(1), "description": nbt.Compound{"translate": "enchantment.minecraft.sharpness"}, "effects": nbt.Compound{"minecraft:damage": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:add", "value": nbt.Compound{"base": float32(1), "per_level_above_first": float32(0.5), "type": "minecraft:linear"}}}}}, "exclusive_set": "#minecraft:exclusive_set/damage", "max_cost": nbt.Compound{"base": int32(21), "per_level_above_first": int32(11)}, "max_level": int32(5), "min_cost": nbt.Compound{"base": int32(1), "per_level_above_first": int32(11)}, "primary_items": "#minecraft:enchantable/sword", "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/sharp_weapon", "weight": int32(10)},
+			"silk_touch":            {"anvil_cost": int32(8), "description": nbt.Compound{"translate": "enchantment.minecraft.silk_touch"}, "effects": nbt.Compound{"minecraft:block_experience": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:set", "value": float32(0)}}}}, "exclusive_set": "#minecraft:exclusive_set/mining", "max_cost": nbt.Compound{"base": int32(65), "per_level_above_first": int32(0)}, "max_level": int32(1), "min_cost": nbt.Compound{"base": int32(15), "per_level_above_first": int32(0)}, "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/mining_loot", "weight": int32(1)},
+			"smite":                 {"anvil_cost": int32(2), "description": nbt.Compound{"translate": "enchantment.minecraft.smite"}, "effects": nbt.Compound{"minecraft:damage": nbt.List{nbt.Compound{"effect": nbt.Compound{"type": "minecraft:add", "value": nbt.Compound{"base": float32(2.5), "per_level_above_first": float32(2.5), "type": "minecraft:linear"}}, "requirements": nbt.Compound{"condition": "minecraft:entity_properties", "entity": "this", "predicate": nbt.Compound{"type": "#minecraft:sensitive_to_smite"}}}}}, "exclusive_set": "#minecraft:exclusive_set/damage", "max_cost": nbt.Compound{"base": int32(25), "per_level_above_first": int32(8)}, "max_level": int32(5), "min_cost": nbt.Compound{"base": int32(5), "per_level_above_first": int32(8)}, "primary_items": "#minecraft:enchantable/sword", "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/weapon", "weight": int32(5)},
+			"soul_speed":            {"anvil_cost": int32(8), "description": nbt.Compound{"translate": "enchantment.minecraft.soul_speed"}, "effects": nbt.Compound{"minecraft:location_changed": nbt.List{nbt.Compound{"effect": nbt.Compound{"amount": nbt.Compound{"base": float32(0.0405), "per_level_above_first": float32(0.0105), "type": "minecraft:linear"}, "attribute": "minecraft:generic.movement_speed", "id": "minecraft:enchantment.soul_speed", "operation": "add_value", "type": "minecraft:attribute"}, "requirements": nbt.Compound{"condition": "minecraft:all_of", "terms": nbt.List{nbt.Compound{"condition": "minecraft:inverted", "term": nbt.Compound{"condition": "minecraft:entity_properties", "entity": "this", "predicate": nbt.Compound{"vehicle": nbt.Compound{}}}}, nbt.Compound{"condition": "minecraft:entity_properties", "entity": "this", "predicate": nbt.Compound{"movement_affected_by": nbt.Compound{"block": nbt.Compound{"blocks": "#minecraft:soul_speed_blocks"}}}}}}}, nbt.Compound{"effect": nbt.Compound{"amount": float32(1), "type": "minecraft:change_item_damage"}, "requirements": nbt.Compound{"condition": "minecraft:all_of", "terms": nbt.List{nbt.Compound{"chance": float32(0.04), "condition": "minecraft:random_chance"}, nbt.Compound{"condition": "minecraft:entity_properties", "entity": "this", "predicate": nbt.Compound{"flags": nbt.Compound{"is_on_ground": true}, "movement_affected_by": nbt.Compound{"block": nbt.Compound{"blocks": "#minecraft:soul_speed_blocks"}}}}}}}}}, "max_cost": nbt.Compound{"base": int32(25), "per_level_above_first": int32(10)}, "max_level": int32(3), "min_cost": nbt.Compound{"base": int32(10), "per_level_above_first": int32(10)}, "slots": nbt.List{"feet"}, "supported_items": "#minecraft:enchantable/foot_armor", "weight": int32(1)},
+			"sweeping_edge":         {"anvil_cost": int32(4), "description": nbt.Compound{"translate": "enchantment.minecraft.sweeping_edge"}, "effects": nbt.Compound{"minecraft:attributes": nbt.List{nbt.Compound{"amount": nbt.Compound{"denominator": nbt.Compound{"base": float32(2), "per_level_above_first": float32(1), "type": "minecraft:linear"}, "numerator": nbt.Compound{"base": float32(1), "per_level_above_first": float32(1), "type": "minecraft:linear"}, "type": "minecraft:fraction"}, "attribute": "minecraft:player.sweeping_damage_ratio", "id": "minecraft:enchantment.sweeping_edge", "operation": "add_value"}}}, "max_cost": nbt.Compound{"base": int32(20), "per_level_above_first": int32(9)}, "max_level": int32(3), "min_cost": nbt.Compound{"base": int32(5), "per_level_above_first": int32(9)}, "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/sword", "weight": int32(2)},
+			"swift_sneak":           {"anvil_cost": int32(8), "description": nbt.Compound{"translate": "enchantment.minecraft.swift_sneak"}, "effects": nbt.Compound{"minecraft:attributes": nbt.List{nbt.Compound{"amount": nbt.Compound{"base": float32(0.15), "per_level_above_first": float32(0.15), "type": "minecraft:linear"}, "attribute": "minecraft:player.sneaking_speed", "id": "minecraft:enchantment.swift_sneak", "operation": "add_value"}}}, "max_cost": nbt.Compound{"base": int32(75), "per_level_above_first": int32(25)}, "max_level": int32(3), "min_cost": nbt.Compound{"base": int32(25), "per_level_above_first": int32(25)}, "slots": nbt.List{"legs"}, "supported_items": "#minecraft:enchantable/leg_armor", "weight": int32(1)},
+			"thorns":                {"anvil_cost": int32(8), "description": nbt.Compound{"translate": "enchantment.minecraft.thorns"}, "effects": nbt.Compound{"minecraft:post_attack": nbt.List{nbt.Compound{"affected": "attacker", "effect": nbt.Compound{"effects": nbt.List{nbt.Compound{"damage_type": "minecraft:thorns", "max_damage": float32(5), "min_damage": float32(1), "type": "minecraft:damage_entity"}, nbt.Compound{"amount": float32(2), "type": "minecraft:damage_item"}}, "type": "minecraft:all_of"}, "enchanted": "victim", "requirements": nbt.Compound{"chance": nbt.Compound{"amount": nbt.Compound{"base": float32(0.15), "per_level_above_first": float32(0.15), "type": "minecraft:linear"}, "type": "minecraft:enchantment_level"}, "condition": "minecraft:random_chance"}}}}, "max_cost": nbt.Compound{"base": int32(60), "per_level_above_first": int32(20)}, "max_level": int32(3), "min_cost": nbt.Compound{"base": int32(10), "per_level_above_first": int32(20)}, "primary_items": "#minecraft:enchantable/chest_armor", "slots": nbt.List{"any"}, "supported_items": "#minecraft:enchantable/armor", "weight": int32(1)},
+			"unbreaking":            {"anvil_cost": int32(2), "description": nbt.Compound{"translate": "enchantment.minecraft.unbreaking"}, "effects": nbt.Compound{"minecraft:item_damage": nbt.List{nbt.Compound{"effect": nbt.Compound{"chance": nbt.Compound{"denominator": nbt.Compound{"base": float32(10), "per_level_above_first": float32(5), "type": "minecraft:linear"}, "numerator": nbt.Compound{"base": float32(2), "per_level_above_first": float32(2), "type": "minecraft:linear"}, "type": "minecraft:fraction"}, "type": "minecraft:remove_binomial"}, "requirements": nbt.Compound{"condition": "minecraft:match_tool", "predicate": nbt.Compound{"items": "#minecraft:enchantable/armor"}}}, nbt.Compound{"effect": nbt.Compound{"chance": nbt.Compound{"denominator": nbt.Compound{"base": float32(2), "per_level_above_first": float32(1), "type": "minecraft:linear"}, "numerator": nbt.Compound{"base": float32(1), "per_level_above_first": float32(1), "type": "minecraft:linear"}, "type": "minecraft:fraction"}, "type": "minecraft:remove_binomial"}, "requirements": nbt.Compound{"condition": "minecraft:inverted", "term": nbt.Compound{"condition": "minecraft:match_tool", "predicate": nbt.Compound{"items": "#minecraft:enchantable/armor"}}}}}}, "max_cost": nbt.Compound{"base": int32(55), "per_level_above_first": int32(8)}, "max_level": int32(3), "min_cost": nbt.Compound{"base": int32(5), "per_level_above_first": int32(8)}, "slots": nbt.List{"any"}, "supported_items": "#minecraft:enchantable/durability", "weight": int32(5)},
+			"vanishing_curse":       {"anvil_cost": int32(8), "description": nbt.Compound{"translate": "enchantment.minecraft.vanishing_curse"}, "effects": nbt.Compound{"minecraft:prevent_equipment_drop": nbt.Compound{}}, "max_cost": nbt.Compound{"base": int32(50), "per_level_above_first": int32(0)}, "max_level": int32(1), "min_cost": nbt.Compound{"base": int32(25), "per_level_above_first": int32(0)}, "slots": nbt.List{"any"}, "supported_items": "#minecraft:enchantable/vanishing", "weight": int32(1)},
+			"wind_burst":            {"anvil_cost": int32(4), "description": nbt.Compound{"translate": "enchantment.minecraft.wind_burst"}, "effects": nbt.Compound{"minecraft:post_attack": nbt.List{nbt.Compound{"affected": "attacker", "effect": nbt.Compound{"block_interaction": "trigger", "immune_blocks": "#minecraft:blocks_wind_charge_explosions", "knockback_multiplier": nbt.Compound{"fallback": nbt.Compound{"base": float32(1.5), "per_level_above_first": float32(0.35), "type": "minecraft:linear"}, "type": "minecraft:lookup", "values": nbt.List{float32(1.2), float32(1.75), float32(2.2)}}, "large_particle": nbt.Compound{"type": "minecraft:gust_emitter_large"}, "radius": float32(3.5), "small_particle": nbt.Compound{"type": "minecraft:gust_emitter_small"}, "sound": "minecraft:entity.wind_charge.wind_burst", "type": "minecraft:explode"}, "enchanted": "attacker", "requirements": nbt.Compound{"condition": "minecraft:entity_properties", "entity": "direct_attacker", "predicate": nbt.Compound{"flags": nbt.Compound{"is_flying": false}, "movement": nbt.Compound{"fall_distance": nbt.Compound{"min": float32(1.5)}}}}}}}, "max_cost": nbt.Compound{"base": int32(65), "per_level_above_first": int32(9)}, "max_level": int32(3), "min_cost": nbt.Compound{"base": int32(15), "per_level_above_first": int32(9)}, "slots": nbt.List{"mainhand"}, "supported_items": "#minecraft:enchantable/mace", "weight": int32(2)},
+		}},
+		{"minecraft:jukebox_song", map[string]nbt.Compound{
+			"11":                {"comparator_output": int32(11), "description": nbt.Compound{"translate": "jukebox_song.minecraft.11"}, "length_in_seconds": float32(71), "sound_event": "minecraft:music_disc.11"},
+			"13":                {"comparator_output": int32(1), "description": nbt.Compound{"translate": "jukebox_song.minecraft.13"}, "length_in_seconds": float32(178), "sound_event": "minecraft:music_disc.13"},
+			"5":                 {"comparator_output": int32(15), "description": nbt.Compound{"translate": "jukebox_song.minecraft.5"}, "length_in_seconds": float32(178), "sound_event": "minecraft:music_disc.5"},
+			"blocks":            {"comparator_output": int32(3), "description": nbt.Compound{"translate": "jukebox_song.minecraft.blocks"}, "length_in_seconds": float32(345), "sound_event": "minecraft:music_disc.blocks"},
+			"cat":               {"comparator_output": int32(2), "description": nbt.Compound{"translate": "jukebox_song.minecraft.cat"}, "length_in_seconds": float32(185), "sound_event": "minecraft:music_disc.cat"},
+			"chirp":             {"comparator_output": int32(4), "description": nbt.Compound{"translate": "jukebox_song.minecraft.chirp"}, "length_in_seconds": float32(185), "sound_event": "minecraft:music_disc.chirp"},
+			"creator":           {"comparator_output": int32(12), "description": nbt.Compound{"translate": "jukebox_song.minecraft.creator"}, "length_in_seconds": float32(176), "sound_event": "minecraft:music_disc.creator"},
+			"creator_music_box": {"comparator_output": int32(11), "description": nbt.Compound{"translate": "jukebox_song.minecraft.creator_music_box"}, "length_in_seconds": float32(73), "sound_event": "minecraft:music_disc.creator_music_box"},
+			"far":               {"comparator_output": int32(5), "description": nbt.Compound{"translate": "jukebox_song.minecraft.far"}, "length_in_seconds": float32(174), "sound_event": "minecraft:music_disc.far"},
+			"mall":              {"comparator_output": int32(6), "description": nbt.Compound{"translate": "jukebox_song.minecraft.mall"}, "length_in_seconds": float32(197), "sound_event": "minecraft:music_disc.mall"},
+			"mellohi":           {"comparator_output": int32(7), "description": nbt.Compound{"translate": "jukebox_song.minecraft.mellohi"}, "length_in_seconds": float32(96), "sound_event": "minecraft:music_disc.mellohi"},
+			"otherside":         {"comparator_output": int32(14), "description": nbt.Compound{"translate": "jukebox_song.minecraft.otherside"}, "length_in_seconds": float32(195), "sound_event": "minecraft:music_disc.otherside"},
+			"pigstep":           {"comparator_output": int32(13), "description": nbt.Compound{"translate": "jukebox_song.minecraft.pigstep"}, "length_in_seconds": float32(149), "sound_event": "minecraft:music_disc.pigstep"},
+			"precipice":         {"comparator_output": int32(13), "description": nbt.Compound{"translate": "jukebox_song.minecraft.precipice"}, "length_in_seconds": float32(299), "sound_event": "minecraft:music_disc.precipice"},
+			"relic":             {"comparator_output": int32(14), "description": nbt.Compound{"translate": "jukebox_song.minecraft.relic"}, "length_in_seconds": float32(218), "sound_event": "minecraft:music_disc.relic"},
+			"stal":              {"comparator_output": int32(8), "description": nbt.Compound{"translate": "jukebox_song.minecraft.stal"}, "length_in_seconds": float32(150), "sound_event": "minecraft:music_disc.stal"},
+			"strad":             {"comparator_output": int32(9), "description": nbt.Compound{"translate": "jukebox_song.minecraft.strad"}, "length_in_seconds": float32(188), "sound_event": "minecraft:music_disc.strad"},
+			"wait":              {"comparator_output": int32(12), "description": nbt.Compound{"translate": "jukebox_song.minecraft.wait"}, "length_in_seconds": float32(238), "sound_event": "minecraft:music_disc.wait"},
+			"ward":              {"comparator_output": int32(10), "description": nbt.Compound{"translate": "jukebox_song.minecraft.ward"}, "length_in_seconds": float32(251), "sound_event": "minecraft:music_disc.ward"},
+		}},
+	}
+}
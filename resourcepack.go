@@ -0,0 +1,220 @@
+package mcproto
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/gstoney/mcproto/nbt"
+	"github.com/gstoney/mcproto/packet"
+)
+
+var ErrResourcePackDeclined = errors.New("required resource pack declined")
+
+// ResourcePackStatus is a result of Resource Pack Response.
+type ResourcePackStatus int32
+
+const (
+	PackLoaded ResourcePackStatus = iota
+	PackDeclined
+	PackFailedDownload
+	PackAccepted
+	PackDownloaded
+	PackInvalidURL
+	PackFailedReload
+	PackDiscarded
+)
+
+func (s ResourcePackStatus) String() string {
+	switch s {
+	case PackLoaded:
+		return "loaded"
+	case PackDeclined:
+		return "declined"
+	case PackFailedDownload:
+		return "failed download"
+	case PackAccepted:
+		return "accepted"
+	case PackDownloaded:
+		return "downloaded"
+	case PackInvalidURL:
+		return "invalid URL"
+	case PackFailedReload:
+		return "failed reload"
+	case PackDiscarded:
+		return "discarded"
+	}
+	return "unknown"
+}
+
+// Final reports whether s ends the status sequence of a pack.
+func (s ResourcePackStatus) Final() bool {
+	return s != PackAccepted && s != PackDownloaded
+}
+
+// ResourcePack describes a pack for clients to download.
+type ResourcePack struct {
+	UUID   uuid.UUID
+	URL    string
+	Hash   string // Hex SHA-1 of the pack, checked by the client if set.
+	Forced bool   // Disconnect the client if it declines or fails to load.
+	Prompt any    // Text component shown with the prompt, nil for none.
+}
+
+// PackFuture tracks the statuses a client reports for a pushed pack.
+type PackFuture struct {
+	Pack ResourcePack
+
+	mu       sync.Mutex
+	statuses []ResourcePackStatus
+	done     chan struct{}
+}
+
+// Done is closed once a final status is received.
+func (f *PackFuture) Done() <-chan struct{} {
+	return f.done
+}
+
+// Statuses returns the statuses received so far, in order.
+func (f *PackFuture) Statuses() []ResourcePackStatus {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]ResourcePackStatus(nil), f.statuses...)
+}
+
+// Result returns the final status. It blocks until Done is closed, which
+// requires the connection to be read meanwhile.
+func (f *PackFuture) Result() ResourcePackStatus {
+	<-f.done
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.statuses[len(f.statuses)-1]
+}
+
+// add records s, reporting whether it completes f.
+func (f *PackFuture) add(s ResourcePackStatus) (final bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	select {
+	case <-f.done:
+		return false
+	default:
+	}
+	f.statuses = append(f.statuses, s)
+	if s.Final() {
+		close(f.done)
+	}
+	return s.Final()
+}
+
+// resourcePacks holds the futures of packs pushed on a Conn.
+type resourcePacks struct {
+	mu      sync.Mutex
+	futures map[uuid.UUID]*PackFuture
+}
+
+// update resolves futures with p if it is a Resource Pack Response, and
+// disconnects the client if it refused a forced pack.
+func (r *resourcePacks) update(c *Conn, p packet.Packet) error {
+	var id uuid.UUID
+	var status ResourcePackStatus
+	switch p := p.(type) {
+	case *packet.ConfigResourcePackResponse:
+		id, status = p.UUID, ResourcePackStatus(p.Result)
+	case *packet.PlayResourcePackResponse:
+		id, status = p.UUID, ResourcePackStatus(p.Result)
+	default:
+		return nil
+	}
+
+	r.mu.Lock()
+	f := r.futures[id]
+	r.mu.Unlock()
+	if f == nil || !f.add(status) {
+		return nil
+	}
+
+	r.mu.Lock()
+	delete(r.futures, id)
+	r.mu.Unlock()
+
+	if f.Pack.Forced && status != PackLoaded {
+		c.disconnect(nbt.Compound{"translate": "multiplayer.requiredTexturePrompt.disconnect"})
+		return ErrResourcePackDeclined
+	}
+	return nil
+}
+
+// PushResourcePack sends pack to the client in Config or Play mode. The
+// returned future resolves as ReadPacket reads the client's responses.
+func (c *Conn) PushResourcePack(pack ResourcePack) (*PackFuture, error) {
+	var prompt packet.Optional[any]
+	if pack.Prompt != nil {
+		prompt = packet.Optional[any]{Exists: true, Item: pack.Prompt}
+	}
+
+	var p packet.Packet
+	switch c.Session.Mode {
+	case Config:
+		p = &packet.ConfigAddResourcePack{UUID: pack.UUID, URL: pack.URL, Hash: pack.Hash, Forced: pack.Forced, PromptMessage: prompt}
+	case Play:
+		p = &packet.PlayAddResourcePack{UUID: pack.UUID, URL: pack.URL, Hash: pack.Hash, Forced: pack.Forced, PromptMessage: prompt}
+	default:
+		return nil, ErrInvalidMode
+	}
+
+	f := &PackFuture{Pack: pack, done: make(chan struct{})}
+	c.packs.mu.Lock()
+	if c.packs.futures == nil {
+		c.packs.futures = make(map[uuid.UUID]*PackFuture)
+	}
+	c.packs.futures[pack.UUID] = f
+	c.packs.mu.Unlock()
+
+	if err := c.WritePacket(p); err != nil {
+		c.packs.mu.Lock()
+		delete(c.packs.futures, pack.UUID)
+		c.packs.mu.Unlock()
+		return nil, err
+	}
+	return f, nil
+}
+
+// RemoveResourcePack asks the client to unload the pack id, or all packs
+// if id is uuid.Nil.
+func (c *Conn) RemoveResourcePack(id uuid.UUID) error {
+	var opt packet.Optional[uuid.UUID]
+	if id != uuid.Nil {
+		opt = packet.Optional[uuid.UUID]{Exists: true, Item: id}
+	}
+
+	switch c.Session.Mode {
+	case Config:
+		return c.WritePacket(&packet.ConfigRemoveResourcePack{UUID: opt})
+	case Play:
+		return c.WritePacket(&packet.PlayRemoveResourcePack{UUID: opt})
+	}
+	return ErrInvalidMode
+}
+
+// Disconnect sends a disconnect packet of the current mode with a plain
+// text reason.
+func (c *Conn) Disconnect(reason string) error {
+	if c.Session.Mode == Login || c.Session.Mode == Transfer {
+		return disconnect(c, reason)
+	}
+	return c.disconnect(reason)
+}
+
+// disconnect sends a disconnect packet of Config or Play mode with reason,
+// a text component.
+func (c *Conn) disconnect(reason any) error {
+	switch c.Session.Mode {
+	case Config:
+		return c.WritePacket(&packet.ConfigDisconnect{Reason: reason})
+	case Play:
+		return c.WritePacket(&packet.PlayDisconnect{Reason: reason})
+	}
+	return ErrInvalidMode
+}
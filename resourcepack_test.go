@@ -0,0 +1,120 @@
+package mcproto
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/gstoney/mcproto/nbt"
+	"github.com/gstoney/mcproto/packet"
+)
+
+// TestPushResourcePack verifies that the future records the statuses of
+// its pack, ignoring responses for other packs.
+func TestPushResourcePack(t *testing.T) {
+	server, client := loginPipe(t)
+	server.Session.Mode = Play
+	client.Session.Mode = Play
+
+	pack := ResourcePack{
+		UUID:   uuid.New(),
+		URL:    "https://example.com/pack.zip",
+		Hash:   "2fd4e1c67a2d28fced849ee1bb76e7391b93eb12",
+		Prompt: "Please",
+	}
+	go func() {
+		p, _ := client.ReadPacket()
+		add := p.(*packet.PlayAddResourcePack)
+		if add.URL != pack.URL || add.PromptMessage.Item != "Please" {
+			t.Errorf("got %+v", add)
+		}
+		for _, s := range []ResourcePackStatus{PackAccepted, PackDownloaded, PackLoaded} {
+			client.WritePacket(&packet.PlayResourcePackResponse{UUID: uuid.Nil, Result: int32(PackDeclined)})
+			client.WritePacket(&packet.PlayResourcePackResponse{UUID: add.UUID, Result: int32(s)})
+		}
+	}()
+
+	f, err := server.PushResourcePack(pack)
+	if err != nil {
+		t.Fatalf("PushResourcePack: %v", err)
+	}
+	for range 6 {
+		if _, err := server.ReadPacket(); err != nil {
+			t.Fatalf("ReadPacket: %v", err)
+		}
+	}
+
+	if f.Result() != PackLoaded {
+		t.Errorf("got result %v, want loaded", f.Result())
+	}
+	want := []ResourcePackStatus{PackAccepted, PackDownloaded, PackLoaded}
+	if got := f.Statuses(); !slices.Equal(got, want) {
+		t.Errorf("got statuses %v, want %v", got, want)
+	}
+}
+
+// TestPushResourcePack_ForcedDeclined verifies that declining a forced
+// pack disconnects the client.
+func TestPushResourcePack_ForcedDeclined(t *testing.T) {
+	server, client := loginPipe(t)
+	server.Session.Mode = Config
+	client.Session.Mode = Config
+
+	result := make(chan packet.Packet, 1)
+	go func() {
+		p, _ := client.ReadPacket()
+		add := p.(*packet.ConfigAddResourcePack)
+		client.WritePacket(&packet.ConfigResourcePackResponse{UUID: add.UUID, Result: int32(PackDeclined)})
+		p, _ = client.ReadPacket()
+		result <- p
+	}()
+
+	f, err := server.PushResourcePack(ResourcePack{UUID: uuid.New(), URL: "https://example.com/pack.zip", Forced: true})
+	if err != nil {
+		t.Fatalf("PushResourcePack: %v", err)
+	}
+	if _, err := server.ReadPacket(); err != ErrResourcePackDeclined {
+		t.Fatalf("ReadPacket: got %v, want ErrResourcePackDeclined", err)
+	}
+	if f.Result() != PackDeclined {
+		t.Errorf("got result %v, want declined", f.Result())
+	}
+
+	d, ok := (<-result).(*packet.ConfigDisconnect)
+	if !ok {
+		t.Fatalf("client did not receive Disconnect")
+	}
+	if c, ok := d.Reason.(nbt.Compound); !ok || c["translate"] != "multiplayer.requiredTexturePrompt.disconnect" {
+		t.Errorf("got reason %v", d.Reason)
+	}
+}
+
+// TestResourcePackStep verifies that configuration waits for packs.
+func TestResourcePackStep(t *testing.T) {
+	server, client := loginPipe(t)
+	server.Session.Mode = Config
+	client.Session.Mode = Config
+
+	pack := ResourcePack{UUID: uuid.New(), URL: "https://example.com/pack.zip"}
+	cfg := NewConfigurator(server, ResourcePackStep(pack))
+	done := make(chan error, 1)
+	go func() { done <- cfg.Run() }()
+
+	p, _ := client.ReadPacket()
+	if _, ok := p.(*packet.ConfigAddResourcePack); !ok {
+		t.Fatalf("got %v, want Add Resource Pack", p)
+	}
+	go func() {
+		client.WritePacket(&packet.ConfigResourcePackResponse{UUID: pack.UUID, Result: int32(PackAccepted)})
+		client.WritePacket(&packet.ConfigResourcePackResponse{UUID: pack.UUID, Result: int32(PackLoaded)})
+	}()
+
+	p, _ = client.ReadPacket()
+	if _, ok := p.(*packet.FinishConfiguration); !ok {
+		t.Fatalf("got %v, want Finish Configuration", p)
+	}
+	client.WritePacket(&packet.FinishConfigurationAcknowledge{})
+	if err := <-done; err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+}
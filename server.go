@@ -2,8 +2,10 @@ package mcproto
 
 import (
 	"net"
+	"strconv"
 
 	"github.com/google/uuid"
+	"github.com/gstoney/mcproto/packet"
 )
 
 // A Server defines parameters for running a Minecraft server.
@@ -11,6 +13,15 @@ type Server struct {
 	Addr               string
 	SessionEstablisher SessionEstablisher
 	SessionHandler     SessionHandler
+
+	// TrackedEstablisher, if set, is used in place of SessionEstablisher,
+	// and is given the stats Metrics tracks for the connection.
+	TrackedEstablisher TrackedEstablisher
+
+	// Metrics, if set, aggregates traffic counters of accepted connections,
+	// including those that fail to establish a session. Traffic is only
+	// counted for sessions set up by TrackedEstablisher.
+	Metrics *Metrics
 }
 
 // SessionEstablisher is given a new accepted connection to handle login process,
@@ -20,6 +31,11 @@ type Server struct {
 // Session and Transport.
 type SessionEstablisher func(c net.Conn) (Session, Transport, error)
 
+// TrackedEstablisher is a SessionEstablisher also given stats, the counters
+// the Server tracks for c, or nil without Metrics. The Transport set up on
+// c counts into them when given as TransportConfig.Stats.
+type TrackedEstablisher func(c net.Conn, stats *TransportStats) (Session, Transport, error)
+
 type SessionHandler func(s *Session, t *Transport) error
 
 // Serve accepts incoming connections on the Listener l,
@@ -37,7 +53,21 @@ func (s *Server) Serve(l net.Listener) error {
 		go func(s *Server, c net.Conn) {
 			defer c.Close()
 
-			session, transport, err := s.SessionEstablisher(c)
+			var stats *TransportStats
+			if s.Metrics != nil {
+				stats = &TransportStats{}
+				s.Metrics.Track(stats)
+				defer s.Metrics.Release(stats)
+			}
+
+			var session Session
+			var transport Transport
+			var err error
+			if s.TrackedEstablisher != nil {
+				session, transport, err = s.TrackedEstablisher(c, stats)
+			} else {
+				session, transport, err = s.SessionEstablisher(c)
+			}
 			if err != nil {
 				// LOG establish error
 				return
@@ -51,7 +81,7 @@ func (s *Server) Serve(l net.Listener) error {
 type ConnectionMode byte
 
 const (
-	_ ConnectionMode = iota
+	Handshake ConnectionMode = iota
 	Status
 	Login
 	Transfer
@@ -59,11 +89,34 @@ const (
 	Play
 )
 
+func (m ConnectionMode) String() string {
+	switch m {
+	case Handshake:
+		return "Handshake"
+	case Status:
+		return "Status"
+	case Login:
+		return "Login"
+	case Transfer:
+		return "Transfer"
+	case Config:
+		return "Config"
+	case Play:
+		return "Play"
+	}
+	return "ConnectionMode(" + strconv.Itoa(int(m)) + ")"
+}
+
 // A Session stores connection and states of a client.
 type Session struct {
+	// Conn is the client connection, set by Establisher.
+	Conn net.Conn
+
 	LocalAddr  net.Addr
 	RemoteAddr net.Addr
 
+	// Mode is the connection mode. While packets may be written from
+	// other goroutines, it is changed with Conn.SetMode.
 	Mode ConnectionMode
 
 	ProtocolVersion int
@@ -72,4 +125,15 @@ type Session struct {
 	Intent          int
 	Name            string
 	PlayerUUID      uuid.UUID
+	Properties      []packet.GameProfileProperty
+
+	// Brand is the client brand, such as "vanilla", once received.
+	Brand string
+
+	// ClientInfo holds the client settings, nil until received.
+	// Conn.ReadPacket keeps it up to date.
+	ClientInfo *ClientInfo
+	// OnClientInfo, if set, is called after ClientInfo changes, with the
+	// previous settings.
+	OnClientInfo func(s *Session, prev *ClientInfo)
 }
@@ -0,0 +1,140 @@
+package mcproto
+
+import (
+	"io"
+	"sync/atomic"
+)
+
+// TransportStats holds traffic counters of a Transport.
+//
+// Counters are updated atomically and can be read from other goroutines
+// while the Transport is in use.
+type TransportStats struct {
+	FramesIn  atomic.Uint64
+	FramesOut atomic.Uint64
+	BytesIn   atomic.Uint64 // Wire bytes, including frame length prefixes.
+	BytesOut  atomic.Uint64 // Wire bytes, including frame length prefixes.
+
+	CompressedIn    atomic.Uint64
+	CompressedOut   atomic.Uint64
+	UncompressedIn  atomic.Uint64
+	UncompressedOut atomic.Uint64
+
+	// Sizes of compressed frames, before (Raw) and after (Wire) compression.
+	CompressedRawBytesIn   atomic.Uint64
+	CompressedWireBytesIn  atomic.Uint64
+	CompressedRawBytesOut  atomic.Uint64
+	CompressedWireBytesOut atomic.Uint64
+
+	ErrPacketTooBig        atomic.Uint64
+	ErrZlibPayloadOverrun  atomic.Uint64
+	ErrZlibPayloadUnderrun atomic.Uint64
+	ErrZlibTrailingData    atomic.Uint64
+	ErrOther               atomic.Uint64
+}
+
+// StatsSnapshot is a point-in-time copy of TransportStats.
+type StatsSnapshot struct {
+	FramesIn, FramesOut                           uint64
+	BytesIn, BytesOut                             uint64
+	CompressedIn, CompressedOut                   uint64
+	UncompressedIn, UncompressedOut               uint64
+	CompressedRawBytesIn, CompressedWireBytesIn   uint64
+	CompressedRawBytesOut, CompressedWireBytesOut uint64
+
+	ErrPacketTooBig        uint64
+	ErrZlibPayloadOverrun  uint64
+	ErrZlibPayloadUnderrun uint64
+	ErrZlibTrailingData    uint64
+	ErrOther               uint64
+}
+
+// Snapshot copies current counter values.
+func (s *TransportStats) Snapshot() (v StatsSnapshot) {
+	v.FramesIn = s.FramesIn.Load()
+	v.FramesOut = s.FramesOut.Load()
+	v.BytesIn = s.BytesIn.Load()
+	v.BytesOut = s.BytesOut.Load()
+	v.CompressedIn = s.CompressedIn.Load()
+	v.CompressedOut = s.CompressedOut.Load()
+	v.UncompressedIn = s.UncompressedIn.Load()
+	v.UncompressedOut = s.UncompressedOut.Load()
+	v.CompressedRawBytesIn = s.CompressedRawBytesIn.Load()
+	v.CompressedWireBytesIn = s.CompressedWireBytesIn.Load()
+	v.CompressedRawBytesOut = s.CompressedRawBytesOut.Load()
+	v.CompressedWireBytesOut = s.CompressedWireBytesOut.Load()
+	v.ErrPacketTooBig = s.ErrPacketTooBig.Load()
+	v.ErrZlibPayloadOverrun = s.ErrZlibPayloadOverrun.Load()
+	v.ErrZlibPayloadUnderrun = s.ErrZlibPayloadUnderrun.Load()
+	v.ErrZlibTrailingData = s.ErrZlibTrailingData.Load()
+	v.ErrOther = s.ErrOther.Load()
+	return
+}
+
+// CompressionRatioIn reports wire bytes per decompressed byte of compressed
+// inbound frames. It returns 0 when no compressed frame was received.
+func (v StatsSnapshot) CompressionRatioIn() float64 {
+	if v.CompressedRawBytesIn == 0 {
+		return 0
+	}
+	return float64(v.CompressedWireBytesIn) / float64(v.CompressedRawBytesIn)
+}
+
+// CompressionRatioOut reports wire bytes per uncompressed byte of compressed
+// outbound frames. It returns 0 when no compressed frame was sent.
+func (v StatsSnapshot) CompressionRatioOut() float64 {
+	if v.CompressedRawBytesOut == 0 {
+		return 0
+	}
+	return float64(v.CompressedWireBytesOut) / float64(v.CompressedRawBytesOut)
+}
+
+func (v *StatsSnapshot) add(o StatsSnapshot) {
+	v.FramesIn += o.FramesIn
+	v.FramesOut += o.FramesOut
+	v.BytesIn += o.BytesIn
+	v.BytesOut += o.BytesOut
+	v.CompressedIn += o.CompressedIn
+	v.CompressedOut += o.CompressedOut
+	v.UncompressedIn += o.UncompressedIn
+	v.UncompressedOut += o.UncompressedOut
+	v.CompressedRawBytesIn += o.CompressedRawBytesIn
+	v.CompressedWireBytesIn += o.CompressedWireBytesIn
+	v.CompressedRawBytesOut += o.CompressedRawBytesOut
+	v.CompressedWireBytesOut += o.CompressedWireBytesOut
+	v.ErrPacketTooBig += o.ErrPacketTooBig
+	v.ErrZlibPayloadOverrun += o.ErrZlibPayloadOverrun
+	v.ErrZlibPayloadUnderrun += o.ErrZlibPayloadUnderrun
+	v.ErrZlibTrailingData += o.ErrZlibTrailingData
+	v.ErrOther += o.ErrOther
+}
+
+// countErr increments the counter matching err's kind.
+// A clean io.EOF is not a decode error, and ErrNotExhausted reflects
+// the caller's decoding, so neither is counted.
+func (s *TransportStats) countErr(err error) {
+	switch err {
+	case nil, io.EOF, ErrNotExhausted:
+	case ErrPacketTooBig:
+		s.ErrPacketTooBig.Add(1)
+	case ErrZlibPayloadOverrun:
+		s.ErrZlibPayloadOverrun.Add(1)
+	case ErrZlibPayloadUnderrun:
+		s.ErrZlibPayloadUnderrun.Add(1)
+	case ErrZlibTrailingData:
+		s.ErrZlibTrailingData.Add(1)
+	default:
+		s.ErrOther.Add(1)
+	}
+}
+
+// varIntLen returns the serialized size of v as a VarInt.
+func varIntLen(v int32) int {
+	uv := uint32(v)
+	n := 1
+	for uv >= 0x80 {
+		uv >>= 7
+		n++
+	}
+	return n
+}
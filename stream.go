@@ -0,0 +1,228 @@
+package mcproto
+
+import (
+	"math"
+
+	"github.com/gstoney/mcproto/packet"
+)
+
+// Chunk batch rates, in chunks per tick, as vanilla uses them.
+const (
+	initialChunksPerTick = 9
+	minChunksPerTick     = 0.01
+	maxChunksPerTick     = 64
+	maxUnackedBatches    = 10
+)
+
+// A ChunkLoader returns the Chunk Data of chunk x, z, or nil if the chunk
+// does not exist and is not to be sent.
+type ChunkLoader func(x, z int32) (*packet.ChunkDataAndUpdateLight, error)
+
+// ChunkStreamer sends a player the chunks within view distance of them,
+// and unloads those left behind.
+//
+// Chunks are sent from the center outwards in batches, at most one per
+// Tick and at the rate the client requests in Chunk Batch Received. Calls
+// are made from the goroutine handling the player; a ChunkStreamer is not
+// safe for concurrent use.
+type ChunkStreamer struct {
+	Conn *Conn
+	Load ChunkLoader
+
+	// MaxViewDistance bounds the view distance of the client, see
+	// Session.ViewDistance.
+	MaxViewDistance int
+
+	centered     bool
+	center       [2]int32
+	viewDistance int
+	sent         map[[2]int32]bool
+	pending      [][2]int32
+
+	unacked       int
+	maxUnacked    int
+	chunksPerTick float32
+	quota         float32
+}
+
+// NewChunkStreamer creates a ChunkStreamer for c, loading chunks with load.
+func NewChunkStreamer(c *Conn, maxViewDistance int, load ChunkLoader) *ChunkStreamer {
+	return &ChunkStreamer{
+		Conn:            c,
+		Load:            load,
+		MaxViewDistance: maxViewDistance,
+		sent:            make(map[[2]int32]bool),
+		maxUnacked:      1,
+		chunksPerTick:   initialChunksPerTick,
+	}
+}
+
+// Handle handles Set Player Position, Set Player Position and Rotation
+// and Chunk Batch Received, reporting whether p was one of them.
+func (cs *ChunkStreamer) Handle(p packet.Packet) (handled bool, err error) {
+	switch p := p.(type) {
+	case *packet.SetPlayerPosition:
+		return true, cs.MoveTo(p.X, p.Z)
+	case *packet.SetPlayerPositionAndRotation:
+		return true, cs.MoveTo(p.X, p.Z)
+	case *packet.ChunkBatchReceived:
+		cs.acknowledge(p.ChunksPerTick)
+		return true, nil
+	}
+	return false, nil
+}
+
+// MoveTo centers the view on the chunk holding block coordinates x, z.
+func (cs *ChunkStreamer) MoveTo(x, z float64) error {
+	return cs.SetCenter(int32(math.Floor(x))>>4, int32(math.Floor(z))>>4)
+}
+
+// SetCenter centers the view on chunk x, z, sending Set Center Chunk if
+// it moved.
+func (cs *ChunkStreamer) SetCenter(x, z int32) error {
+	center := [2]int32{x, z}
+	if cs.centered && cs.center == center {
+		return nil
+	}
+	cs.centered, cs.center = true, center
+
+	err := cs.Conn.WritePacket(&packet.SetCenterChunk{ChunkX: x, ChunkZ: z})
+	if err != nil {
+		return err
+	}
+	return cs.Refresh()
+}
+
+// Refresh recomputes the chunks in view, such as after the client changed
+// its view distance. Chunks out of view are unloaded, and those in view
+// queued to be sent. Chunks the loader found absent are queued again while
+// in view, so those created since are sent.
+func (cs *ChunkStreamer) Refresh() error {
+	if !cs.centered {
+		return nil
+	}
+	cs.viewDistance = cs.Conn.Session.ViewDistance(cs.MaxViewDistance)
+
+	for pos := range cs.sent {
+		if cs.inView(pos) {
+			continue
+		}
+		delete(cs.sent, pos)
+		err := cs.Conn.WritePacket(&packet.UnloadChunk{ChunkX: pos[0], ChunkZ: pos[1]})
+		if err != nil {
+			return err
+		}
+	}
+
+	cs.pending = cs.pending[:0]
+	for _, d := range spiral(cs.viewDistance) {
+		pos := [2]int32{cs.center[0] + d[0], cs.center[1] + d[1]}
+		if cs.inView(pos) && !cs.sent[pos] {
+			cs.pending = append(cs.pending, pos)
+		}
+	}
+	return nil
+}
+
+// inView reports whether pos is within view distance of the center, in
+// the cylindrical shape vanilla uses.
+func (cs *ChunkStreamer) inView(pos [2]int32) bool {
+	dx := max(0, abs(pos[0]-cs.center[0])-1)
+	dz := max(0, abs(pos[1]-cs.center[1])-1)
+	return int(dx*dx+dz*dz) < cs.viewDistance*cs.viewDistance
+}
+
+func abs(v int32) int32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// spiral returns the offsets of a square of chunks of radius r, ring by
+// ring from the center.
+func spiral(r int) [][2]int32 {
+	offsets := [][2]int32{{0, 0}}
+	for k := int32(1); k <= int32(r); k++ {
+		for x := -k; x < k; x++ {
+			offsets = append(offsets, [2]int32{x, -k})
+		}
+		for z := -k; z < k; z++ {
+			offsets = append(offsets, [2]int32{k, z})
+		}
+		for x := k; x > -k; x-- {
+			offsets = append(offsets, [2]int32{x, k})
+		}
+		for z := k; z > -k; z-- {
+			offsets = append(offsets, [2]int32{-k, z})
+		}
+	}
+	return offsets
+}
+
+// Tick sends the next batch of chunks, if the client has acknowledged
+// enough of the previous ones. Call it once per game tick.
+//
+// Every chunk loaded counts against the rate, absent ones included, so
+// the loader is not called more often than chunks would be sent.
+func (cs *ChunkStreamer) Tick() error {
+	if cs.unacked >= cs.maxUnacked || len(cs.pending) == 0 {
+		return nil
+	}
+
+	cs.quota = min(cs.quota+cs.chunksPerTick, max(1, cs.chunksPerTick))
+	if cs.quota < 1 {
+		return nil
+	}
+
+	var batch []*packet.ChunkDataAndUpdateLight
+	for ; len(cs.pending) > 0 && cs.quota >= 1; cs.quota-- {
+		pos := cs.pending[0]
+		cs.pending = cs.pending[1:]
+		p, err := cs.Load(pos[0], pos[1])
+		if err != nil {
+			return err
+		}
+		if p != nil {
+			cs.sent[pos] = true
+			batch = append(batch, p)
+		}
+	}
+	if len(batch) == 0 {
+		return nil
+	}
+
+	cs.unacked++
+	if err := cs.Conn.WritePacket(&packet.ChunkBatchStart{}); err != nil {
+		return err
+	}
+	for _, p := range batch {
+		if err := cs.Conn.WritePacket(p); err != nil {
+			return err
+		}
+	}
+	return cs.Conn.WritePacket(&packet.ChunkBatchFinished{BatchSize: int32(len(batch))})
+}
+
+func (cs *ChunkStreamer) acknowledge(chunksPerTick float32) {
+	cs.unacked = max(0, cs.unacked-1)
+	if math.IsNaN(float64(chunksPerTick)) {
+		chunksPerTick = minChunksPerTick
+	}
+	cs.chunksPerTick = min(max(chunksPerTick, minChunksPerTick), maxChunksPerTick)
+	if cs.unacked == 0 {
+		cs.quota = 1
+	}
+	cs.maxUnacked = maxUnackedBatches
+}
+
+// Loaded reports whether chunk x, z was sent and not unloaded since.
+// Chunks skipped as absent are not loaded.
+func (cs *ChunkStreamer) Loaded(x, z int32) bool {
+	return cs.sent[[2]int32{x, z}]
+}
+
+// Pending returns the number of chunks in view not sent yet.
+func (cs *ChunkStreamer) Pending() int {
+	return len(cs.pending)
+}
@@ -0,0 +1,232 @@
+package mcproto
+
+import (
+	"testing"
+
+	"github.com/gstoney/mcproto/nbt"
+	"github.com/gstoney/mcproto/packet"
+)
+
+// streamPipe returns a ChunkStreamer of view distance 2 and the packets
+// its client receives.
+func streamPipe(t *testing.T) (*ChunkStreamer, <-chan packet.Packet) {
+	t.Helper()
+	server, client := loginPipe(t)
+	server.Session.Mode = Play
+	client.Session.Mode = Play
+
+	received := make(chan packet.Packet, 256)
+	go func() {
+		for {
+			p, err := client.ReadPacket()
+			if err != nil {
+				return
+			}
+			received <- p
+		}
+	}()
+
+	load := func(x, z int32) (*packet.ChunkDataAndUpdateLight, error) {
+		if x == 100 {
+			return nil, nil
+		}
+		return &packet.ChunkDataAndUpdateLight{ChunkX: x, ChunkZ: z, Heightmaps: nbt.Compound{}}, nil
+	}
+	return NewChunkStreamer(server, 2, load), received
+}
+
+// expectBatch reads a batch of n chunks, returning their positions.
+func expectBatch(t *testing.T, received <-chan packet.Packet, n int) (chunks [][2]int32) {
+	t.Helper()
+	if p := <-received; !isType[*packet.ChunkBatchStart](p) {
+		t.Fatalf("got %T, want Chunk Batch Start", p)
+	}
+	for range n {
+		p, ok := (<-received).(*packet.ChunkDataAndUpdateLight)
+		if !ok {
+			t.Fatalf("got %T, want Chunk Data", p)
+		}
+		chunks = append(chunks, [2]int32{p.ChunkX, p.ChunkZ})
+	}
+	p, ok := (<-received).(*packet.ChunkBatchFinished)
+	if !ok || p.BatchSize != int32(n) {
+		t.Fatalf("got %+v, want Chunk Batch Finished of %d", p, n)
+	}
+	return
+}
+
+func isType[T packet.Packet](p packet.Packet) bool {
+	_, ok := p.(T)
+	return ok
+}
+
+// TestChunkStreamer verifies that chunks are sent from the center in
+// batches paced by acknowledgements, and unloaded when out of view.
+func TestChunkStreamer(t *testing.T) {
+	cs, received := streamPipe(t)
+
+	if _, err := cs.Handle(&packet.SetPlayerPosition{X: 8, FeetY: 64, Z: -1}); err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if p, ok := (<-received).(*packet.SetCenterChunk); !ok || p.ChunkX != 0 || p.ChunkZ != -1 {
+		t.Fatalf("got %+v, want Set Center Chunk 0, -1", p)
+	}
+	if got := cs.Pending(); got != 25 {
+		t.Fatalf("got %d pending chunks, want 25", got)
+	}
+
+	// The first batch holds the initial 9 chunks per tick: the center and
+	// the ring around it.
+	if err := cs.Tick(); err != nil {
+		t.Fatalf("Tick: %v", err)
+	}
+	first := expectBatch(t, received, 9)
+	if first[0] != [2]int32{0, -1} {
+		t.Errorf("got first chunk %v, want the center", first[0])
+	}
+	for _, pos := range first {
+		if abs(pos[0]) > 1 || abs(pos[1]+1) > 1 {
+			t.Errorf("chunk %v sent before nearer ones", pos)
+		}
+	}
+
+	// Nothing more is sent until the batch is acknowledged.
+	cs.Tick()
+	if _, err := cs.Handle(&packet.ChunkBatchReceived{ChunksPerTick: 5}); err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	cs.Tick()
+	expectBatch(t, received, 5)
+	cs.Tick()
+	expectBatch(t, received, 5)
+	if got := cs.Pending(); got != 6 {
+		t.Errorf("got %d pending chunks, want 6", got)
+	}
+
+	// Moving away unloads every chunk sent, and skips absent chunks.
+	if err := cs.MoveTo(100*16+3, -16); err != nil {
+		t.Fatalf("MoveTo: %v", err)
+	}
+	if p := <-received; !isType[*packet.SetCenterChunk](p) {
+		t.Fatalf("got %T, want Set Center Chunk", p)
+	}
+	for range 19 {
+		p, ok := (<-received).(*packet.UnloadChunk)
+		if !ok {
+			t.Fatalf("got %T, want Unload Chunk", p)
+		}
+		if cs.Loaded(p.ChunkX, p.ChunkZ) {
+			t.Errorf("chunk %d, %d still loaded", p.ChunkX, p.ChunkZ)
+		}
+	}
+
+	cs.Handle(&packet.ChunkBatchReceived{ChunksPerTick: 64})
+	cs.Handle(&packet.ChunkBatchReceived{ChunksPerTick: 64})
+	cs.Tick()
+	chunks := expectBatch(t, received, 20)
+	for _, pos := range chunks {
+		if pos[0] == 100 {
+			t.Errorf("absent chunk %v sent", pos)
+		}
+	}
+	if cs.Loaded(100, -1) || cs.Pending() != 0 {
+		t.Errorf("got absent chunk loaded %v, pending %d", cs.Loaded(100, -1), cs.Pending())
+	}
+}
+
+// TestChunkStreamer_Absent verifies that chunks the loader returns nil for
+// are not unloaded when left behind, and are sent once they exist.
+func TestChunkStreamer_Absent(t *testing.T) {
+	cs, received := streamPipe(t)
+	exists := false
+	cs.Load = func(x, z int32) (*packet.ChunkDataAndUpdateLight, error) {
+		if x == 0 && z == 0 && !exists {
+			return nil, nil
+		}
+		return &packet.ChunkDataAndUpdateLight{ChunkX: x, ChunkZ: z, Heightmaps: nbt.Compound{}}, nil
+	}
+	// Send every chunk in view in a single batch.
+	cs.Handle(&packet.ChunkBatchReceived{ChunksPerTick: 64})
+
+	moveTo := func(x int32, unloads int) {
+		t.Helper()
+		cs.SetCenter(x, 0)
+		if p := <-received; !isType[*packet.SetCenterChunk](p) {
+			t.Fatalf("got %T, want Set Center Chunk", p)
+		}
+		for range unloads {
+			p, ok := (<-received).(*packet.UnloadChunk)
+			if !ok {
+				t.Fatalf("got %T, want Unload Chunk", p)
+			}
+			if p.ChunkX == 0 && p.ChunkZ == 0 {
+				t.Errorf("absent chunk unloaded")
+			}
+		}
+		cs.Tick()
+	}
+
+	moveTo(0, 0)
+	expectBatch(t, received, 24)
+	if cs.Loaded(0, 0) || cs.Pending() != 0 {
+		t.Fatalf("got absent chunk loaded %v, pending %d", cs.Loaded(0, 0), cs.Pending())
+	}
+
+	// Leaving unloads the chunks sent only.
+	cs.Handle(&packet.ChunkBatchReceived{ChunksPerTick: 64})
+	moveTo(100, 24)
+	expectBatch(t, received, 25)
+
+	// Back in view, the chunk is loaded again and sent now it exists.
+	exists = true
+	cs.Handle(&packet.ChunkBatchReceived{ChunksPerTick: 64})
+	moveTo(0, 25)
+	chunks := expectBatch(t, received, 25)
+	if chunks[0] != [2]int32{0, 0} || !cs.Loaded(0, 0) {
+		t.Errorf("got first chunk %v, loaded %v, want 0, 0 loaded", chunks[0], cs.Loaded(0, 0))
+	}
+}
+
+// TestChunkStreamer_AbsentQuota verifies that absent chunks count against
+// the chunks per tick, bounding the loader calls of a Tick.
+func TestChunkStreamer_AbsentQuota(t *testing.T) {
+	cs, received := streamPipe(t)
+	loads := 0
+	cs.Load = func(x, z int32) (*packet.ChunkDataAndUpdateLight, error) {
+		loads++
+		return nil, nil
+	}
+
+	cs.SetCenter(0, 0)
+	<-received
+	// Only the initial 9 chunks per tick are loaded.
+	cs.Tick()
+	if loads != 9 || cs.Pending() != 16 {
+		t.Errorf("got %d loads, %d pending chunks, want 9 and 16", loads, cs.Pending())
+	}
+	cs.Tick()
+	if loads != 18 {
+		t.Errorf("got %d loads after the second tick, want 18", loads)
+	}
+}
+
+// TestChunkStreamer_ViewDistance verifies that the client's view distance
+// is honored.
+func TestChunkStreamer_ViewDistance(t *testing.T) {
+	cs, received := streamPipe(t)
+	cs.Conn.Session.ClientInfo = &ClientInfo{ViewDistance: 10}
+	cs.MaxViewDistance = 4
+
+	cs.SetCenter(0, 0)
+	<-received
+	// A square of radius 4 less the corners beyond the cylinder.
+	if got := cs.Pending(); got != 77 {
+		t.Errorf("got %d pending chunks, want 77", got)
+	}
+
+	cs.Conn.Session.ClientInfo = &ClientInfo{ViewDistance: 2}
+	cs.Refresh()
+	if got := cs.Pending(); got != 25 {
+		t.Errorf("got %d pending chunks, want 25", got)
+	}
+}
@@ -0,0 +1,146 @@
+// Package tags resolves tags, named sets of registry entries such as
+// minecraft:logs, and encodes them for Update Tags.
+//
+// A tag lists entry identifiers and references to other tags of the same
+// registry, prefixed with '#':
+//
+//	s.Add("minecraft:block", "minecraft:logs", "#minecraft:oak_logs", "minecraft:crimson_stem")
+//
+// Values suffixed with '?' are optional, as in vanilla data packs: an
+// unknown optional entry or tag is left out instead of failing.
+package tags
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/gstoney/mcproto/packet"
+)
+
+var (
+	ErrCycle        = errors.New("tags: reference cycle")
+	ErrUnknownTag   = errors.New("tags: unknown tag")
+	ErrUnknownEntry = errors.New("tags: unknown entry")
+)
+
+// IDs returns the network ID of entry in registry, reporting whether it
+// is known.
+type IDs func(registry, entry string) (id int32, ok bool)
+
+// Set holds the tags of several registries.
+type Set struct {
+	registries []string
+	tags       map[string]map[string][]string
+}
+
+// AddRegistry adds registry without tags, so that it is sent and clears
+// tags the client had for it.
+func (s *Set) AddRegistry(registry string) {
+	if s.tags == nil {
+		s.tags = make(map[string]map[string][]string)
+	}
+	if _, ok := s.tags[registry]; !ok {
+		s.tags[registry] = make(map[string][]string)
+		s.registries = append(s.registries, registry)
+	}
+}
+
+// Add appends values to tag of registry, creating the tag if needed.
+// Values are entry identifiers, or tag references starting with '#'.
+func (s *Set) Add(registry, tag string, values ...string) {
+	s.AddRegistry(registry)
+	s.tags[registry][tag] = append(s.tags[registry][tag], values...)
+}
+
+// Registries returns the registries having tags, in order of addition.
+func (s *Set) Registries() []string {
+	return slices.Clone(s.registries)
+}
+
+// Tags returns the names of the tags of registry, sorted.
+func (s *Set) Tags(registry string) []string {
+	var names []string
+	for name := range s.tags[registry] {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Resolve returns the entries of tag, expanding references. Entries are
+// sorted and without duplicates, optional ones included without their
+// suffix.
+func (s *Set) Resolve(registry, tag string) ([]string, error) {
+	required := make(map[string]bool)
+	if err := s.resolve(registry, tag, nil, required); err != nil {
+		return nil, err
+	}
+
+	return slices.Sorted(maps.Keys(required)), nil
+}
+
+// resolve adds the entries of tag to required, reporting whether each is
+// required by any value. path holds the tags being resolved, to detect
+// cycles.
+func (s *Set) resolve(registry, tag string, path []string, required map[string]bool) error {
+	if i := slices.Index(path, tag); i >= 0 {
+		cycle := append(slices.Clone(path[i:]), tag)
+		return fmt.Errorf("%w: %s", ErrCycle, strings.Join(cycle, " -> "))
+	}
+
+	values, ok := s.tags[registry][tag]
+	if !ok {
+		return fmt.Errorf("%w %s in %s", ErrUnknownTag, tag, registry)
+	}
+
+	path = append(path, tag)
+	for _, v := range values {
+		v, optional := strings.CutSuffix(v, "?")
+		if ref, ok := strings.CutPrefix(v, "#"); ok {
+			if _, ok := s.tags[registry][ref]; !ok && optional {
+				continue
+			}
+			if err := s.resolve(registry, ref, path, required); err != nil {
+				return err
+			}
+			continue
+		}
+		required[v] = required[v] || !optional
+	}
+	return nil
+}
+
+// RegistryTags resolves every tag, mapping entries to network IDs with
+// ids. Optional entries unknown to ids are left out; other unknown entries
+// fail with ErrUnknownEntry.
+func (s *Set) RegistryTags(ids IDs) ([]packet.RegistryTags, error) {
+	rts := make([]packet.RegistryTags, 0, len(s.registries))
+	for _, registry := range s.registries {
+		rt := packet.RegistryTags{Registry: registry}
+		for _, name := range s.Tags(registry) {
+			required := make(map[string]bool)
+			if err := s.resolve(registry, name, nil, required); err != nil {
+				return nil, err
+			}
+
+			tag := packet.Tag{Name: name, Entries: []int32{}}
+			for _, e := range slices.Sorted(maps.Keys(required)) {
+				id, ok := ids(registry, e)
+				if !ok {
+					if required[e] {
+						return nil, fmt.Errorf("%w %s in tag %s of %s", ErrUnknownEntry, e, name, registry)
+					}
+					continue
+				}
+				tag.Entries = append(tag.Entries, id)
+			}
+			slices.Sort(tag.Entries)
+			rt.Tags = append(rt.Tags, tag)
+		}
+		rts = append(rts, rt)
+	}
+	return rts, nil
+}
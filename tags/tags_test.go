@@ -0,0 +1,85 @@
+package tags
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestSet_Resolve(t *testing.T) {
+	s := &Set{}
+	s.Add(Block, "test:a", "test:x", "#test:b")
+	s.Add(Block, "test:b", "test:y", "#test:c", "test:x")
+	s.Add(Block, "test:c", "test:z")
+	s.Add(Block, "test:loop1", "#test:loop2")
+	s.Add(Block, "test:loop2", "test:x", "#test:loop1")
+	s.Add(Block, "test:self", "#test:self")
+	s.Add(Block, "test:dangling", "#test:missing")
+
+	tcs := []struct {
+		tag       string
+		entries   []string
+		expectErr error
+	}{
+		{tag: "test:a", entries: []string{"test:x", "test:y", "test:z"}},
+		{tag: "test:c", entries: []string{"test:z"}},
+		{tag: "test:loop1", expectErr: ErrCycle},
+		{tag: "test:self", expectErr: ErrCycle},
+		{tag: "test:dangling", expectErr: ErrUnknownTag},
+		{tag: "test:missing", expectErr: ErrUnknownTag},
+	}
+	for _, tC := range tcs {
+		t.Run(tC.tag, func(t *testing.T) {
+			entries, err := s.Resolve(Block, tC.tag)
+			if !errors.Is(err, tC.expectErr) {
+				t.Fatalf("got error %v, want %v", err, tC.expectErr)
+			}
+			if !slices.Equal(entries, tC.entries) {
+				t.Errorf("got %v, want %v", entries, tC.entries)
+			}
+		})
+	}
+}
+
+func TestSet_RegistryTags(t *testing.T) {
+	ids := func(registry, entry string) (int32, bool) {
+		id := slices.Index([]string{"test:x", "test:y", "test:z"}, entry)
+		return int32(id), registry == Block && id >= 0
+	}
+
+	s := &Set{}
+	s.Add(Block, "test:a", "test:z", "#test:b", "test:gone?", "#test:missing?")
+	s.Add(Block, "test:b", "test:x", "test:y?")
+	s.AddRegistry(Item)
+	rts, err := s.RegistryTags(ids)
+	if err != nil {
+		t.Fatalf("RegistryTags: %v", err)
+	}
+	if len(rts) != 2 || rts[0].Registry != Block || rts[1].Registry != Item || len(rts[1].Tags) != 0 {
+		t.Fatalf("got %+v", rts)
+	}
+	for _, tag := range rts[0].Tags {
+		want := map[string][]int32{"test:a": {0, 1, 2}, "test:b": {0, 1}}[tag.Name]
+		if !slices.Equal(tag.Entries, want) {
+			t.Errorf("%s: got %v, want %v", tag.Name, tag.Entries, want)
+		}
+	}
+
+	// An entry both optional and required is required.
+	s.Add(Block, "test:c", "test:gone?", "#test:d")
+	s.Add(Block, "test:d", "test:gone")
+	if _, err := s.RegistryTags(ids); !errors.Is(err, ErrUnknownEntry) {
+		t.Errorf("got %v, want ErrUnknownEntry", err)
+	}
+}
+
+func TestVanilla_Logs(t *testing.T) {
+	entries, err := Vanilla().Resolve(Item, "minecraft:logs")
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+	// 8 woods and 2 stems, 4 variants each.
+	if len(entries) != 40 || !slices.Contains(entries, "minecraft:stripped_cherry_wood") {
+		t.Errorf("got %d entries: %v", len(entries), entries)
+	}
+}
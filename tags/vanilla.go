@@ -0,0 +1,31 @@
+//go:generate go run ../codegen/gen_vanilla_tags.go -- ../testdata/data .
+
+package tags
+
+// Registries sent in Update Tags.
+const (
+	Block      = "minecraft:block"
+	Item       = "minecraft:item"
+	Fluid      = "minecraft:fluid"
+	EntityType = "minecraft:entity_type"
+	GameEvent  = "minecraft:game_event"
+)
+
+// vanillaTag is a tag of the vanilla data pack.
+type vanillaTag struct {
+	registry, name string
+	values         []string
+}
+
+// Vanilla returns the tags of vanilla 1.21.1 for the block, item, fluid,
+// entity type and game event registries, generated from the data of the
+// vanilla data generator in testdata/data, see
+// codegen/gen_vanilla_tags.go. Each call returns a new Set the caller may
+// modify. Resolve it with vanilla.Resolver.EntryID.
+func Vanilla() *Set {
+	s := &Set{}
+	for _, t := range vanillaTags {
+		s.Add(t.registry, t.name, t.values...)
+	}
+	return s
+}
@@ -0,0 +1,377 @@
+// Code generated by gen_vanilla_tags.go; DO NOT EDIT.
+
+package tags
+
+var vanillaTags = []vanillaTag{
+	{Block, "minecraft:acacia_logs", []string{"minecraft:acacia_log", "minecraft:acacia_wood", "minecraft:stripped_acacia_log", "minecraft:stripped_acacia_wood"}},
+	{Block, "minecraft:air", []string{"minecraft:air", "minecraft:void_air", "minecraft:cave_air"}},
+	{Block, "minecraft:all_hanging_signs", []string{"#minecraft:ceiling_hanging_signs", "#minecraft:wall_hanging_signs"}},
+	{Block, "minecraft:all_signs", []string{"#minecraft:signs", "#minecraft:all_hanging_signs"}},
+	{Block, "minecraft:ancient_city_replaceable", []string{"minecraft:deepslate", "minecraft:deepslate_bricks", "minecraft:deepslate_tiles", "minecraft:deepslate_brick_slab", "minecraft:deepslate_tile_slab", "minecraft:deepslate_brick_stairs", "minecraft:deepslate_tile_wall", "minecraft:deepslate_brick_wall", "minecraft:cobbled_deepslate", "minecraft:cracked_deepslate_bricks", "minecraft:cracked_deepslate_tiles", "minecraft:gray_wool"}},
+	{Block, "minecraft:animals_spawnable_on", []string{"minecraft:grass_block"}},
+	{Block, "minecraft:anvil", []string{"minecraft:anvil", "minecraft:chipped_anvil", "minecraft:damaged_anvil"}},
+	{Block, "minecraft:armadillo_spawnable_on", []string{"#minecraft:animals_spawnable_on", "#minecraft:badlands_terracotta", "minecraft:red_sand", "minecraft:coarse_dirt"}},
+	{Block, "minecraft:axolotls_spawnable_on", []string{"minecraft:clay"}},
+	{Block, "minecraft:azalea_grows_on", []string{"#minecraft:dirt", "#minecraft:sand", "#minecraft:terracotta", "minecraft:snow_block", "minecraft:powder_snow"}},
+	{Block, "minecraft:azalea_root_replaceable", []string{"#minecraft:base_stone_overworld", "#minecraft:dirt", "#minecraft:terracotta", "minecraft:red_sand", "minecraft:clay", "minecraft:gravel", "minecraft:sand", "minecraft:snow_block", "minecraft:powder_snow"}},
+	{Block, "minecraft:badlands_terracotta", []string{"minecraft:terracotta", "minecraft:white_terracotta", "minecraft:yellow_terracotta", "minecraft:orange_terracotta", "minecraft:red_terracotta", "minecraft:brown_terracotta", "minecraft:light_gray_terracotta"}},
+	{Block, "minecraft:bamboo_blocks", []string{"minecraft:bamboo_block", "minecraft:stripped_bamboo_block"}},
+	{Block, "minecraft:bamboo_plantable_on", []string{"#minecraft:sand", "#minecraft:dirt", "minecraft:bamboo", "minecraft:bamboo_sapling", "minecraft:gravel", "minecraft:suspicious_gravel"}},
+	{Block, "minecraft:banners", []string{"minecraft:white_banner", "minecraft:orange_banner", "minecraft:magenta_banner", "minecraft:light_blue_banner", "minecraft:yellow_banner", "minecraft:lime_banner", "minecraft:pink_banner", "minecraft:gray_banner", "minecraft:light_gray_banner", "minecraft:cyan_banner", "minecraft:purple_banner", "minecraft:blue_banner", "minecraft:brown_banner", "minecraft:green_banner", "minecraft:red_banner", "minecraft:black_banner", "minecraft:white_wall_banner", "minecraft:orange_wall_banner", "minecraft:magenta_wall_banner", "minecraft:light_blue_wall_banner", "minecraft:yellow_wall_banner", "minecraft:lime_wall_banner", "minecraft:pink_wall_banner", "minecraft:gray_wall_banner", "minecraft:light_gray_wall_banner", "minecraft:cyan_wall_banner", "minecraft:purple_wall_banner", "minecraft:blue_wall_banner", "minecraft:brown_wall_banner", "minecraft:green_wall_banner", "minecraft:red_wall_banner", "minecraft:black_wall_banner"}},
+	{Block, "minecraft:base_stone_nether", []string{"minecraft:netherrack", "minecraft:basalt", "minecraft:blackstone"}},
+	{Block, "minecraft:base_stone_overworld", []string{"minecraft:stone", "minecraft:granite", "minecraft:diorite", "minecraft:andesite", "minecraft:tuff", "minecraft:deepslate"}},
+	{Block, "minecraft:beacon_base_blocks", []string{"minecraft:netherite_block", "minecraft:emerald_block", "minecraft:diamond_block", "minecraft:gold_block", "minecraft:iron_block"}},
+	{Block, "minecraft:beds", []string{"minecraft:white_bed", "minecraft:orange_bed", "minecraft:magenta_bed", "minecraft:light_blue_bed", "minecraft:yellow_bed", "minecraft:lime_bed", "minecraft:pink_bed", "minecraft:gray_bed", "minecraft:light_gray_bed", "minecraft:cyan_bed", "minecraft:purple_bed", "minecraft:blue_bed", "minecraft:brown_bed", "minecraft:green_bed", "minecraft:red_bed", "minecraft:black_bed"}},
+	{Block, "minecraft:bee_growables", []string{"#minecraft:crops", "minecraft:sweet_berry_bush", "minecraft:cave_vines", "minecraft:cave_vines_plant"}},
+	{Block, "minecraft:beehives", []string{"minecraft:bee_nest", "minecraft:beehive"}},
+	{Block, "minecraft:big_dripleaf_placeable", []string{"#minecraft:small_dripleaf_placeable", "#minecraft:dirt", "minecraft:farmland"}},
+	{Block, "minecraft:birch_logs", []string{"minecraft:birch_log", "minecraft:birch_wood", "minecraft:stripped_birch_log", "minecraft:stripped_birch_wood"}},
+	{Block, "minecraft:blocks_wind_charge_explosions", []string{"minecraft:barrier", "minecraft:bedrock"}},
+	{Block, "minecraft:buttons", []string{"#minecraft:wooden_buttons", "#minecraft:stone_buttons"}},
+	{Block, "minecraft:camel_sand_step_sound_blocks", []string{"#minecraft:sand", "#minecraft:concrete_powder"}},
+	{Block, "minecraft:campfires", []string{"minecraft:campfire", "minecraft:soul_campfire"}},
+	{Block, "minecraft:candle_cakes", []string{"minecraft:candle_cake", "minecraft:white_candle_cake", "minecraft:orange_candle_cake", "minecraft:magenta_candle_cake", "minecraft:light_blue_candle_cake", "minecraft:yellow_candle_cake", "minecraft:lime_candle_cake", "minecraft:pink_candle_cake", "minecraft:gray_candle_cake", "minecraft:light_gray_candle_cake", "minecraft:cyan_candle_cake", "minecraft:purple_candle_cake", "minecraft:blue_candle_cake", "minecraft:brown_candle_cake", "minecraft:green_candle_cake", "minecraft:red_candle_cake", "minecraft:black_candle_cake"}},
+	{Block, "minecraft:candles", []string{"minecraft:candle", "minecraft:white_candle", "minecraft:orange_candle", "minecraft:magenta_candle", "minecraft:light_blue_candle", "minecraft:yellow_candle", "minecraft:lime_candle", "minecraft:pink_candle", "minecraft:gray_candle", "minecraft:light_gray_candle", "minecraft:cyan_candle", "minecraft:purple_candle", "minecraft:blue_candle", "minecraft:brown_candle", "minecraft:green_candle", "minecraft:red_candle", "minecraft:black_candle"}},
+	{Block, "minecraft:cauldrons", []string{"minecraft:cauldron", "minecraft:water_cauldron", "minecraft:lava_cauldron", "minecraft:powder_snow_cauldron"}},
+	{Block, "minecraft:cave_vines", []string{"minecraft:cave_vines_plant", "minecraft:cave_vines"}},
+	{Block, "minecraft:ceiling_hanging_signs", []string{"minecraft:oak_hanging_sign", "minecraft:spruce_hanging_sign", "minecraft:birch_hanging_sign", "minecraft:jungle_hanging_sign", "minecraft:acacia_hanging_sign", "minecraft:dark_oak_hanging_sign", "minecraft:crimson_hanging_sign", "minecraft:warped_hanging_sign", "minecraft:mangrove_hanging_sign", "minecraft:bamboo_hanging_sign", "minecraft:cherry_hanging_sign"}},
+	{Block, "minecraft:cherry_logs", []string{"minecraft:cherry_log", "minecraft:cherry_wood", "minecraft:stripped_cherry_log", "minecraft:stripped_cherry_wood"}},
+	{Block, "minecraft:climbable", []string{"minecraft:ladder", "minecraft:vine", "minecraft:scaffolding", "minecraft:weeping_vines", "minecraft:weeping_vines_plant", "minecraft:twisting_vines", "minecraft:twisting_vines_plant", "minecraft:cave_vines", "minecraft:cave_vines_plant"}},
+	{Block, "minecraft:coal_ores", []string{"minecraft:coal_ore", "minecraft:deepslate_coal_ore"}},
+	{Block, "minecraft:combination_step_sound_blocks", []string{"#minecraft:wool_carpets", "minecraft:moss_carpet", "minecraft:snow", "minecraft:nether_sprouts", "minecraft:warped_roots", "minecraft:crimson_roots"}},
+	{Block, "minecraft:completes_find_tree_tutorial", []string{"#minecraft:logs", "#minecraft:leaves", "#minecraft:wart_blocks"}},
+	{Block, "minecraft:concrete_powder", []string{"minecraft:white_concrete_powder", "minecraft:orange_concrete_powder", "minecraft:magenta_concrete_powder", "minecraft:light_blue_concrete_powder", "minecraft:yellow_concrete_powder", "minecraft:lime_concrete_powder", "minecraft:pink_concrete_powder", "minecraft:gray_concrete_powder", "minecraft:light_gray_concrete_powder", "minecraft:cyan_concrete_powder", "minecraft:purple_concrete_powder", "minecraft:blue_concrete_powder", "minecraft:brown_concrete_powder", "minecraft:green_concrete_powder", "minecraft:red_concrete_powder", "minecraft:black_concrete_powder"}},
+	{Block, "minecraft:convertable_to_mud", []string{"minecraft:dirt", "minecraft:coarse_dirt", "minecraft:rooted_dirt"}},
+	{Block, "minecraft:copper_ores", []string{"minecraft:copper_ore", "minecraft:deepslate_copper_ore"}},
+	{Block, "minecraft:coral_blocks", []string{"minecraft:tube_coral_block", "minecraft:brain_coral_block", "minecraft:bubble_coral_block", "minecraft:fire_coral_block", "minecraft:horn_coral_block"}},
+	{Block, "minecraft:coral_plants", []string{"minecraft:tube_coral", "minecraft:brain_coral", "minecraft:bubble_coral", "minecraft:fire_coral", "minecraft:horn_coral"}},
+	{Block, "minecraft:corals", []string{"#minecraft:coral_plants", "minecraft:tube_coral_fan", "minecraft:brain_coral_fan", "minecraft:bubble_coral_fan", "minecraft:fire_coral_fan", "minecraft:horn_coral_fan"}},
+	{Block, "minecraft:crimson_stems", []string{"minecraft:crimson_stem", "minecraft:stripped_crimson_stem", "minecraft:crimson_hyphae", "minecraft:stripped_crimson_hyphae"}},
+	{Block, "minecraft:crops", []string{"minecraft:beetroots", "minecraft:carrots", "minecraft:potatoes", "minecraft:wheat", "minecraft:melon_stem", "minecraft:pumpkin_stem", "minecraft:torchflower_crop", "minecraft:pitcher_crop"}},
+	{Block, "minecraft:crystal_sound_blocks", []string{"minecraft:amethyst_block", "minecraft:budding_amethyst"}},
+	{Block, "minecraft:dampens_vibrations", []string{"#minecraft:wool", "#minecraft:wool_carpets"}},
+	{Block, "minecraft:dark_oak_logs", []string{"minecraft:dark_oak_log", "minecraft:dark_oak_wood", "minecraft:stripped_dark_oak_log", "minecraft:stripped_dark_oak_wood"}},
+	{Block, "minecraft:dead_bush_may_place_on", []string{"#minecraft:sand", "#minecraft:terracotta", "#minecraft:dirt"}},
+	{Block, "minecraft:deepslate_ore_replaceables", []string{"minecraft:deepslate", "minecraft:tuff"}},
+	{Block, "minecraft:diamond_ores", []string{"minecraft:diamond_ore", "minecraft:deepslate_diamond_ore"}},
+	{Block, "minecraft:dirt", []string{"minecraft:dirt", "minecraft:grass_block", "minecraft:podzol", "minecraft:coarse_dirt", "minecraft:mycelium", "minecraft:rooted_dirt", "minecraft:moss_block", "minecraft:mud", "minecraft:muddy_mangrove_roots"}},
+	{Block, "minecraft:does_not_block_hoppers", []string{"#minecraft:beehives"}},
+	{Block, "minecraft:doors", []string{"#minecraft:wooden_doors", "minecraft:iron_door", "minecraft:copper_door", "minecraft:exposed_copper_door", "minecraft:weathered_copper_door", "minecraft:oxidized_copper_door", "minecraft:waxed_copper_door", "minecraft:waxed_exposed_copper_door", "minecraft:waxed_weathered_copper_door", "minecraft:waxed_oxidized_copper_door"}},
+	{Block, "minecraft:dragon_immune", []string{"minecraft:barrier", "minecraft:bedrock", "minecraft:end_portal", "minecraft:end_portal_frame", "minecraft:end_gateway", "minecraft:command_block", "minecraft:repeating_command_block", "minecraft:chain_command_block", "minecraft:structure_block", "minecraft:jigsaw", "minecraft:moving_piston", "minecraft:obsidian", "minecraft:crying_obsidian", "minecraft:end_stone", "minecraft:iron_bars", "minecraft:respawn_anchor", "minecraft:reinforced_deepslate"}},
+	{Block, "minecraft:dragon_transparent", []string{"minecraft:light", "#minecraft:fire"}},
+	{Block, "minecraft:dripstone_replaceable_blocks", []string{"#minecraft:base_stone_overworld"}},
+	{Block, "minecraft:emerald_ores", []string{"minecraft:emerald_ore", "minecraft:deepslate_emerald_ore"}},
+	{Block, "minecraft:enchantment_power_provider", []string{"minecraft:bookshelf"}},
+	{Block, "minecraft:enchantment_power_transmitter", []string{"#minecraft:replaceable"}},
+	{Block, "minecraft:enderman_holdable", []string{"#minecraft:small_flowers", "#minecraft:dirt", "minecraft:sand", "minecraft:red_sand", "minecraft:gravel", "minecraft:brown_mushroom", "minecraft:red_mushroom", "minecraft:tnt", "minecraft:cactus", "minecraft:clay", "minecraft:pumpkin", "minecraft:carved_pumpkin", "minecraft:melon", "minecraft:crimson_fungus", "minecraft:crimson_nylium", "minecraft:crimson_roots", "minecraft:warped_fungus", "minecraft:warped_nylium", "minecraft:warped_roots"}},
+	{Block, "minecraft:fall_damage_resetting", []string{"#minecraft:climbable", "minecraft:sweet_berry_bush", "minecraft:cobweb"}},
+	{Block, "minecraft:features_cannot_replace", []string{"minecraft:bedrock", "minecraft:spawner", "minecraft:chest", "minecraft:end_portal_frame", "minecraft:reinforced_deepslate", "minecraft:trial_spawner", "minecraft:vault"}},
+	{Block, "minecraft:fence_gates", []string{"minecraft:oak_fence_gate", "minecraft:spruce_fence_gate", "minecraft:birch_fence_gate", "minecraft:jungle_fence_gate", "minecraft:acacia_fence_gate", "minecraft:dark_oak_fence_gate", "minecraft:crimson_fence_gate", "minecraft:warped_fence_gate", "minecraft:mangrove_fence_gate", "minecraft:bamboo_fence_gate", "minecraft:cherry_fence_gate"}},
+	{Block, "minecraft:fences", []string{"#minecraft:wooden_fences", "minecraft:nether_brick_fence"}},
+	{Block, "minecraft:fire", []string{"minecraft:fire", "minecraft:soul_fire"}},
+	{Block, "minecraft:flower_pots", []string{"minecraft:flower_pot", "minecraft:potted_acacia_sapling", "minecraft:potted_allium", "minecraft:potted_azalea_bush", "minecraft:potted_azure_bluet", "minecraft:potted_bamboo", "minecraft:potted_birch_sapling", "minecraft:potted_blue_orchid", "minecraft:potted_brown_mushroom", "minecraft:potted_cactus", "minecraft:potted_cherry_sapling", "minecraft:potted_cornflower", "minecraft:potted_crimson_fungus", "minecraft:potted_crimson_roots", "minecraft:potted_dandelion", "minecraft:potted_dark_oak_sapling", "minecraft:potted_dead_bush", "minecraft:potted_fern", "minecraft:potted_flowering_azalea_bush", "minecraft:potted_jungle_sapling", "minecraft:potted_lily_of_the_valley", "minecraft:potted_mangrove_propagule", "minecraft:potted_oak_sapling", "minecraft:potted_orange_tulip", "minecraft:potted_oxeye_daisy", "minecraft:potted_pink_tulip", "minecraft:potted_poppy", "minecraft:potted_red_mushroom", "minecraft:potted_red_tulip", "minecraft:potted_spruce_sapling", "minecraft:potted_torchflower", "minecraft:potted_warped_fungus", "minecraft:potted_warped_roots", "minecraft:potted_white_tulip", "minecraft:potted_wither_rose"}},
+	{Block, "minecraft:flowers", []string{"#minecraft:small_flowers", "#minecraft:tall_flowers", "minecraft:flowering_azalea_leaves", "minecraft:flowering_azalea", "minecraft:mangrove_propagule", "minecraft:cherry_leaves", "minecraft:pink_petals", "minecraft:chorus_flower", "minecraft:spore_blossom"}},
+	{Block, "minecraft:foxes_spawnable_on", []string{"minecraft:grass_block", "minecraft:snow", "minecraft:snow_block", "minecraft:podzol", "minecraft:coarse_dirt"}},
+	{Block, "minecraft:frog_prefer_jump_to", []string{"minecraft:lily_pad", "minecraft:big_dripleaf"}},
+	{Block, "minecraft:frogs_spawnable_on", []string{"minecraft:grass_block", "minecraft:mud", "minecraft:mangrove_roots", "minecraft:muddy_mangrove_roots"}},
+	{Block, "minecraft:geode_invalid_blocks", []string{"minecraft:bedrock", "minecraft:water", "minecraft:lava", "minecraft:ice", "minecraft:packed_ice", "minecraft:blue_ice"}},
+	{Block, "minecraft:goats_spawnable_on", []string{"#minecraft:animals_spawnable_on", "minecraft:stone", "minecraft:snow", "minecraft:snow_block", "minecraft:packed_ice", "minecraft:gravel"}},
+	{Block, "minecraft:gold_ores", []string{"minecraft:gold_ore", "minecraft:nether_gold_ore", "minecraft:deepslate_gold_ore"}},
+	{Block, "minecraft:guarded_by_piglins", []string{"minecraft:gold_block", "minecraft:barrel", "minecraft:chest", "minecraft:ender_chest", "minecraft:gilded_blackstone", "minecraft:trapped_chest", "minecraft:raw_gold_block", "#minecraft:shulker_boxes", "#minecraft:gold_ores"}},
+	{Block, "minecraft:hoglin_repellents", []string{"minecraft:warped_fungus", "minecraft:potted_warped_fungus", "minecraft:nether_portal", "minecraft:respawn_anchor"}},
+	{Block, "minecraft:ice", []string{"minecraft:ice", "minecraft:packed_ice", "minecraft:blue_ice", "minecraft:frosted_ice"}},
+	{Block, "minecraft:impermeable", []string{"minecraft:glass", "minecraft:white_stained_glass", "minecraft:orange_stained_glass", "minecraft:magenta_stained_glass", "minecraft:light_blue_stained_glass", "minecraft:yellow_stained_glass", "minecraft:lime_stained_glass", "minecraft:pink_stained_glass", "minecraft:gray_stained_glass", "minecraft:light_gray_stained_glass", "minecraft:cyan_stained_glass", "minecraft:purple_stained_glass", "minecraft:blue_stained_glass", "minecraft:brown_stained_glass", "minecraft:green_stained_glass", "minecraft:red_stained_glass", "minecraft:black_stained_glass", "minecraft:tinted_glass"}},
+	{Block, "minecraft:incorrect_for_diamond_tool", []string{}},
+	{Block, "minecraft:incorrect_for_gold_tool", []string{"#minecraft:needs_diamond_tool", "#minecraft:needs_iron_tool", "#minecraft:needs_stone_tool"}},
+	{Block, "minecraft:incorrect_for_iron_tool", []string{"#minecraft:needs_diamond_tool"}},
+	{Block, "minecraft:incorrect_for_netherite_tool", []string{}},
+	{Block, "minecraft:incorrect_for_stone_tool", []string{"#minecraft:needs_diamond_tool", "#minecraft:needs_iron_tool"}},
+	{Block, "minecraft:incorrect_for_wooden_tool", []string{"#minecraft:needs_diamond_tool", "#minecraft:needs_iron_tool", "#minecraft:needs_stone_tool"}},
+	{Block, "minecraft:infiniburn_end", []string{"#minecraft:infiniburn_overworld", "minecraft:bedrock"}},
+	{Block, "minecraft:infiniburn_nether", []string{"#minecraft:infiniburn_overworld"}},
+	{Block, "minecraft:infiniburn_overworld", []string{"minecraft:netherrack", "minecraft:magma_block"}},
+	{Block, "minecraft:inside_step_sound_blocks", []string{"minecraft:powder_snow", "minecraft:sculk_vein", "minecraft:glow_lichen", "minecraft:lily_pad", "minecraft:small_amethyst_bud", "minecraft:pink_petals"}},
+	{Block, "minecraft:invalid_spawn_inside", []string{"minecraft:end_portal", "minecraft:end_gateway"}},
+	{Block, "minecraft:iron_ores", []string{"minecraft:iron_ore", "minecraft:deepslate_iron_ore"}},
+	{Block, "minecraft:jungle_logs", []string{"minecraft:jungle_log", "minecraft:jungle_wood", "minecraft:stripped_jungle_log", "minecraft:stripped_jungle_wood"}},
+	{Block, "minecraft:lapis_ores", []string{"minecraft:lapis_ore", "minecraft:deepslate_lapis_ore"}},
+	{Block, "minecraft:lava_pool_stone_cannot_replace", []string{"#minecraft:features_cannot_replace", "#minecraft:leaves", "#minecraft:logs"}},
+	{Block, "minecraft:leaves", []string{"minecraft:jungle_leaves", "minecraft:oak_leaves", "minecraft:spruce_leaves", "minecraft:dark_oak_leaves", "minecraft:acacia_leaves", "minecraft:birch_leaves", "minecraft:azalea_leaves", "minecraft:flowering_azalea_leaves", "minecraft:mangrove_leaves", "minecraft:cherry_leaves"}},
+	{Block, "minecraft:logs", []string{"#minecraft:logs_that_burn", "#minecraft:crimson_stems", "#minecraft:warped_stems"}},
+	{Block, "minecraft:logs_that_burn", []string{"#minecraft:oak_logs", "#minecraft:spruce_logs", "#minecraft:birch_logs", "#minecraft:jungle_logs", "#minecraft:acacia_logs", "#minecraft:dark_oak_logs", "#minecraft:mangrove_logs", "#minecraft:cherry_logs"}},
+	{Block, "minecraft:lush_ground_replaceable", []string{"#minecraft:moss_replaceable", "minecraft:clay", "minecraft:gravel", "minecraft:sand"}},
+	{Block, "minecraft:maintains_farmland", []string{"minecraft:pumpkin_stem", "minecraft:attached_pumpkin_stem", "minecraft:melon_stem", "minecraft:attached_melon_stem", "minecraft:beetroots", "minecraft:carrots", "minecraft:potatoes", "minecraft:torchflower_crop", "minecraft:torchflower", "minecraft:pitcher_crop", "minecraft:wheat"}},
+	{Block, "minecraft:mangrove_logs", []string{"minecraft:mangrove_log", "minecraft:mangrove_wood", "minecraft:stripped_mangrove_log", "minecraft:stripped_mangrove_wood"}},
+	{Block, "minecraft:mangrove_logs_can_grow_through", []string{"minecraft:mud", "minecraft:muddy_mangrove_roots", "minecraft:mangrove_roots", "minecraft:mangrove_leaves", "minecraft:mangrove_log", "minecraft:mangrove_propagule", "minecraft:moss_carpet", "minecraft:vine"}},
+	{Block, "minecraft:mangrove_roots_can_grow_through", []string{"minecraft:mud", "minecraft:muddy_mangrove_roots", "minecraft:mangrove_roots", "minecraft:moss_carpet", "minecraft:vine", "minecraft:mangrove_propagule", "minecraft:snow"}},
+	{Block, "minecraft:mineable/axe", []string{"#minecraft:logs", "#minecraft:planks", "#minecraft:wooden_buttons", "#minecraft:wooden_doors", "#minecraft:wooden_fences", "#minecraft:wooden_pressure_plates", "#minecraft:wooden_slabs", "#minecraft:wooden_stairs", "#minecraft:wooden_trapdoors", "#minecraft:fence_gates", "#minecraft:banners", "#minecraft:all_signs", "#minecraft:bamboo_blocks", "minecraft:bamboo", "minecraft:bamboo_mosaic", "minecraft:bamboo_mosaic_slab", "minecraft:bamboo_mosaic_stairs", "minecraft:barrel", "minecraft:bee_nest", "minecraft:beehive", "minecraft:big_dripleaf", "minecraft:big_dripleaf_stem", "minecraft:bookshelf", "minecraft:chiseled_bookshelf", "minecraft:brown_mushroom_block", "minecraft:campfire", "minecraft:soul_campfire", "minecraft:cartography_table", "minecraft:carved_pumpkin", "minecraft:chest", "minecraft:chorus_flower", "minecraft:chorus_plant", "minecraft:cocoa", "minecraft:composter", "minecraft:crafting_table", "minecraft:daylight_detector", "minecraft:fletching_table", "minecraft:glow_lichen", "minecraft:jack_o_lantern", "minecraft:jukebox", "minecraft:ladder", "minecraft:lectern", "minecraft:loom", "minecraft:mangrove_roots", "minecraft:melon", "minecraft:mushroom_stem", "minecraft:note_block", "minecraft:pumpkin", "minecraft:red_mushroom_block", "minecraft:small_dripleaf", "minecraft:smithing_table", "minecraft:trapped_chest", "minecraft:vine"}},
+	{Block, "minecraft:mineable/hoe", []string{"minecraft:nether_wart_block", "minecraft:warped_wart_block", "minecraft:hay_block", "minecraft:dried_kelp_block", "minecraft:target", "minecraft:shroomlight", "minecraft:sponge", "minecraft:wet_sponge", "minecraft:jungle_leaves", "minecraft:oak_leaves", "minecraft:spruce_leaves", "minecraft:dark_oak_leaves", "minecraft:acacia_leaves", "minecraft:birch_leaves", "minecraft:azalea_leaves", "minecraft:flowering_azalea_leaves", "minecraft:mangrove_leaves", "minecraft:sculk_sensor", "minecraft:calibrated_sculk_sensor", "minecraft:sculk", "minecraft:sculk_catalyst", "minecraft:sculk_vein", "minecraft:sculk_shrieker", "minecraft:pink_petals", "minecraft:cherry_leaves", "minecraft:moss_carpet", "minecraft:moss_block"}},
+	{Block, "minecraft:mineable/pickaxe", []string{"minecraft:stone", "minecraft:granite", "minecraft:polished_granite", "minecraft:diorite", "minecraft:polished_diorite", "minecraft:andesite", "minecraft:polished_andesite", "minecraft:cobblestone", "minecraft:mossy_cobblestone", "minecraft:stone_bricks", "minecraft:mossy_stone_bricks", "minecraft:cracked_stone_bricks", "minecraft:chiseled_stone_bricks", "minecraft:smooth_stone", "minecraft:bricks", "minecraft:sandstone", "minecraft:chiseled_sandstone", "minecraft:cut_sandstone", "minecraft:smooth_sandstone", "minecraft:red_sandstone", "minecraft:chiseled_red_sandstone", "minecraft:cut_red_sandstone", "minecraft:smooth_red_sandstone", "minecraft:gold_block", "minecraft:iron_block", "minecraft:diamond_block", "minecraft:emerald_block", "minecraft:lapis_block", "minecraft:redstone_block", "minecraft:coal_block", "minecraft:netherite_block", "minecraft:raw_iron_block", "minecraft:raw_gold_block", "minecraft:raw_copper_block", "minecraft:obsidian", "minecraft:crying_obsidian", "minecraft:respawn_anchor", "minecraft:ancient_debris", "minecraft:lodestone", "minecraft:bell", "minecraft:ice", "minecraft:packed_ice", "minecraft:blue_ice", "minecraft:prismarine", "minecraft:prismarine_bricks", "minecraft:dark_prismarine", "minecraft:purpur_block", "minecraft:purpur_pillar", "minecraft:end_stone", "minecraft:end_stone_bricks", "minecraft:quartz_block", "minecraft:chiseled_quartz_block", "minecraft:quartz_pillar", "minecraft:quartz_bricks", "minecraft:smooth_quartz", "minecraft:nether_quartz_ore", "minecraft:netherrack", "minecraft:nether_bricks", "minecraft:cracked_nether_bricks", "minecraft:chiseled_nether_bricks", "minecraft:red_nether_bricks", "minecraft:nether_brick_fence", "minecraft:basalt", "minecraft:polished_basalt", "minecraft:smooth_basalt", "minecraft:blackstone", "minecraft:polished_blackstone", "minecraft:polished_blackstone_bricks", "minecraft:cracked_polished_blackstone_bricks", "minecraft:chiseled_polished_blackstone", "minecraft:gilded_blackstone", "minecraft:crimson_nylium", "minecraft:warped_nylium", "minecraft:magma_block", "minecraft:deepslate", "minecraft:cobbled_deepslate", "minecraft:polished_deepslate", "minecraft:deepslate_bricks", "minecraft:cracked_deepslate_bricks", "minecraft:deepslate_tiles", "minecraft:cracked_deepslate_tiles", "minecraft:chiseled_deepslate", "minecraft:reinforced_deepslate", "minecraft:tuff", "minecraft:polished_tuff", "minecraft:chiseled_tuff", "minecraft:tuff_bricks", "minecraft:chiseled_tuff_bricks", "minecraft:calcite", "minecraft:dripstone_block", "minecraft:pointed_dripstone", "minecraft:amethyst_block", "minecraft:budding_amethyst", "minecraft:small_amethyst_bud", "minecraft:medium_amethyst_bud", "minecraft:large_amethyst_bud", "minecraft:amethyst_cluster", "minecraft:mud_bricks", "minecraft:packed_mud", "minecraft:furnace", "minecraft:blast_furnace", "minecraft:smoker", "minecraft:dispenser", "minecraft:dropper", "minecraft:observer", "minecraft:hopper", "minecraft:brewing_stand", "minecraft:cauldron", "minecraft:water_cauldron", "minecraft:lava_cauldron", "minecraft:powder_snow_cauldron", "minecraft:enchanting_table", "minecraft:ender_chest", "minecraft:spawner", "minecraft:trial_spawner", "minecraft:vault", "minecraft:crafter", "minecraft:heavy_core", "minecraft:conduit", "minecraft:lantern", "minecraft:soul_lantern", "minecraft:chain", "minecraft:iron_bars", "minecraft:iron_door", "minecraft:iron_trapdoor", "minecraft:lightning_rod", "minecraft:anvil", "minecraft:chipped_anvil", "minecraft:damaged_anvil", "minecraft:grindstone", "minecraft:stonecutter", "minecraft:rail", "minecraft:powered_rail", "minecraft:detector_rail", "minecraft:activator_rail", "minecraft:piston", "minecraft:sticky_piston", "minecraft:piston_head", "minecraft:stone_button", "minecraft:polished_blackstone_button", "minecraft:stone_pressure_plate", "minecraft:polished_blackstone_pressure_plate", "minecraft:light_weighted_pressure_plate", "minecraft:heavy_weighted_pressure_plate", "minecraft:shulker_box", "minecraft:coal_ore", "minecraft:copper_ore", "minecraft:deepslate_coal_ore", "minecraft:deepslate_copper_ore", "minecraft:deepslate_diamond_ore", "minecraft:deepslate_emerald_ore", "minecraft:deepslate_gold_ore", "minecraft:deepslate_iron_ore", "minecraft:deepslate_lapis_ore", "minecraft:deepslate_redstone_ore", "minecraft:diamond_ore", "minecraft:emerald_ore", "minecraft:gold_ore", "minecraft:iron_ore", "minecraft:lapis_ore", "minecraft:nether_gold_ore", "minecraft:redstone_ore", "minecraft:andesite_slab", "minecraft:blackstone_slab", "minecraft:brick_slab", "minecraft:cobbled_deepslate_slab", "minecraft:cobblestone_slab", "minecraft:cut_copper_slab", "minecraft:cut_red_sandstone_slab", "minecraft:cut_sandstone_slab", "minecraft:dark_prismarine_slab", "minecraft:deepslate_brick_slab", "minecraft:deepslate_tile_slab", "minecraft:diorite_slab", "minecraft:end_stone_brick_slab", "minecraft:exposed_cut_copper_slab", "minecraft:granite_slab", "minecraft:mossy_cobblestone_slab", "minecraft:mossy_stone_brick_slab", "minecraft:mud_brick_slab", "minecraft:nether_brick_slab", "minecraft:oxidized_cut_copper_slab", "minecraft:petrified_oak_slab", "minecraft:polished_andesite_slab", "minecraft:polished_blackstone_brick_slab", "minecraft:polished_blackstone_slab", "minecraft:polished_deepslate_slab", "minecraft:polished_diorite_slab", "minecraft:polished_granite_slab", "minecraft:polished_tuff_slab", "minecraft:prismarine_brick_slab", "minecraft:prismarine_slab", "minecraft:purpur_slab", "minecraft:quartz_slab", "minecraft:red_nether_brick_slab", "minecraft:red_sandstone_slab", "minecraft:sandstone_slab", "minecraft:smooth_quartz_slab", "minecraft:smooth_red_sandstone_slab", "minecraft:smooth_sandstone_slab", "minecraft:smooth_stone_slab", "minecraft:stone_brick_slab", "minecraft:stone_slab", "minecraft:tuff_brick_slab", "minecraft:tuff_slab", "minecraft:waxed_cut_copper_slab", "minecraft:waxed_exposed_cut_copper_slab", "minecraft:waxed_oxidized_cut_copper_slab", "minecraft:waxed_weathered_cut_copper_slab", "minecraft:weathered_cut_copper_slab", "minecraft:andesite_stairs", "minecraft:blackstone_stairs", "minecraft:brick_stairs", "minecraft:cobbled_deepslate_stairs", "minecraft:cobblestone_stairs", "minecraft:cut_copper_stairs", "minecraft:dark_prismarine_stairs", "minecraft:deepslate_brick_stairs", "minecraft:deepslate_tile_stairs", "minecraft:diorite_stairs", "minecraft:end_stone_brick_stairs", "minecraft:exposed_cut_copper_stairs", "minecraft:granite_stairs", "minecraft:mossy_cobblestone_stairs", "minecraft:mossy_stone_brick_stairs", "minecraft:mud_brick_stairs", "minecraft:nether_brick_stairs", "minecraft:oxidized_cut_copper_stairs", "minecraft:polished_andesite_stairs", "minecraft:polished_blackstone_brick_stairs", "minecraft:polished_blackstone_stairs", "minecraft:polished_deepslate_stairs", "minecraft:polished_diorite_stairs", "minecraft:polished_granite_stairs", "minecraft:polished_tuff_stairs", "minecraft:prismarine_brick_stairs", "minecraft:prismarine_stairs", "minecraft:purpur_stairs", "minecraft:quartz_stairs", "minecraft:red_nether_brick_stairs", "minecraft:red_sandstone_stairs", "minecraft:sandstone_stairs", "minecraft:smooth_quartz_stairs", "minecraft:smooth_red_sandstone_stairs", "minecraft:smooth_sandstone_stairs", "minecraft:stone_brick_stairs", "minecraft:stone_stairs", "minecraft:tuff_brick_stairs", "minecraft:tuff_stairs", "minecraft:waxed_cut_copper_stairs", "minecraft:waxed_exposed_cut_copper_stairs", "minecraft:waxed_oxidized_cut_copper_stairs", "minecraft:waxed_weathered_cut_copper_stairs", "minecraft:weathered_cut_copper_stairs", "minecraft:andesite_wall", "minecraft:blackstone_wall", "minecraft:brick_wall", "minecraft:cobbled_deepslate_wall", "minecraft:cobblestone_wall", "minecraft:deepslate_brick_wall", "minecraft:deepslate_tile_wall", "minecraft:diorite_wall", "minecraft:end_stone_brick_wall", "minecraft:granite_wall", "minecraft:mossy_cobblestone_wall", "minecraft:mossy_stone_brick_wall", "minecraft:mud_brick_wall", "minecraft:nether_brick_wall", "minecraft:polished_blackstone_brick_wall", "minecraft:polished_blackstone_wall", "minecraft:polished_deepslate_wall", "minecraft:polished_tuff_wall", "minecraft:prismarine_wall", "minecraft:red_nether_brick_wall", "minecraft:red_sandstone_wall", "minecraft:sandstone_wall", "minecraft:stone_brick_wall", "minecraft:tuff_brick_wall", "minecraft:tuff_wall", "minecraft:white_concrete", "minecraft:orange_concrete", "minecraft:magenta_concrete", "minecraft:light_blue_concrete", "minecraft:yellow_concrete", "minecraft:lime_concrete", "minecraft:pink_concrete", "minecraft:gray_concrete", "minecraft:light_gray_concrete", "minecraft:cyan_concrete", "minecraft:purple_concrete", "minecraft:blue_concrete", "minecraft:brown_concrete", "minecraft:green_concrete", "minecraft:red_concrete", "minecraft:black_concrete", "minecraft:terracotta", "minecraft:white_terracotta", "minecraft:orange_terracotta", "minecraft:magenta_terracotta", "minecraft:light_blue_terracotta", "minecraft:yellow_terracotta", "minecraft:lime_terracotta", "minecraft:pink_terracotta", "minecraft:gray_terracotta", "minecraft:light_gray_terracotta", "minecraft:cyan_terracotta", "minecraft:purple_terracotta", "minecraft:blue_terracotta", "minecraft:brown_terracotta", "minecraft:green_terracotta", "minecraft:red_terracotta", "minecraft:black_terracotta", "minecraft:white_glazed_terracotta", "minecraft:orange_glazed_terracotta", "minecraft:magenta_glazed_terracotta", "minecraft:light_blue_glazed_terracotta", "minecraft:yellow_glazed_terracotta", "minecraft:lime_glazed_terracotta", "minecraft:pink_glazed_terracotta", "minecraft:gray_glazed_terracotta", "minecraft:light_gray_glazed_terracotta", "minecraft:cyan_glazed_terracotta", "minecraft:purple_glazed_terracotta", "minecraft:blue_glazed_terracotta", "minecraft:brown_glazed_terracotta", "minecraft:green_glazed_terracotta", "minecraft:red_glazed_terracotta", "minecraft:black_glazed_terracotta", "minecraft:white_shulker_box", "minecraft:orange_shulker_box", "minecraft:magenta_shulker_box", "minecraft:light_blue_shulker_box", "minecraft:yellow_shulker_box", "minecraft:lime_shulker_box", "minecraft:pink_shulker_box", "minecraft:gray_shulker_box", "minecraft:light_gray_shulker_box", "minecraft:cyan_shulker_box", "minecraft:purple_shulker_box", "minecraft:blue_shulker_box", "minecraft:brown_shulker_box", "minecraft:green_shulker_box", "minecraft:red_shulker_box", "minecraft:black_shulker_box", "minecraft:chiseled_copper", "minecraft:cut_copper", "minecraft:copper_grate", "minecraft:copper_bulb", "minecraft:copper_door", "minecraft:copper_trapdoor", "minecraft:copper_block", "minecraft:exposed_chiseled_copper", "minecraft:exposed_cut_copper", "minecraft:exposed_copper_grate", "minecraft:exposed_copper_bulb", "minecraft:exposed_copper_door", "minecraft:exposed_copper_trapdoor", "minecraft:exposed_copper", "minecraft:weathered_chiseled_copper", "minecraft:weathered_cut_copper", "minecraft:weathered_copper_grate", "minecraft:weathered_copper_bulb", "minecraft:weathered_copper_door", "minecraft:weathered_copper_trapdoor", "minecraft:weathered_copper", "minecraft:oxidized_chiseled_copper", "minecraft:oxidized_cut_copper", "minecraft:oxidized_copper_grate", "minecraft:oxidized_copper_bulb", "minecraft:oxidized_copper_door", "minecraft:oxidized_copper_trapdoor", "minecraft:oxidized_copper", "minecraft:waxed_chiseled_copper", "minecraft:waxed_cut_copper", "minecraft:waxed_copper_grate", "minecraft:waxed_copper_bulb", "minecraft:waxed_copper_door", "minecraft:waxed_copper_trapdoor", "minecraft:waxed_copper_block", "minecraft:waxed_exposed_chiseled_copper", "minecraft:waxed_exposed_cut_copper", "minecraft:waxed_exposed_copper_grate", "minecraft:waxed_exposed_copper_bulb", "minecraft:waxed_exposed_copper_door", "minecraft:waxed_exposed_copper_trapdoor", "minecraft:waxed_exposed_copper", "minecraft:waxed_weathered_chiseled_copper", "minecraft:waxed_weathered_cut_copper", "minecraft:waxed_weathered_copper_grate", "minecraft:waxed_weathered_copper_bulb", "minecraft:waxed_weathered_copper_door", "minecraft:waxed_weathered_copper_trapdoor", "minecraft:waxed_weathered_copper", "minecraft:waxed_oxidized_chiseled_copper", "minecraft:waxed_oxidized_cut_copper", "minecraft:waxed_oxidized_copper_grate", "minecraft:waxed_oxidized_copper_bulb", "minecraft:waxed_oxidized_copper_door", "minecraft:waxed_oxidized_copper_trapdoor", "minecraft:waxed_oxidized_copper", "minecraft:tube_coral_block", "minecraft:brain_coral_block", "minecraft:bubble_coral_block", "minecraft:fire_coral_block", "minecraft:horn_coral_block", "minecraft:dead_tube_coral_block", "minecraft:dead_brain_coral_block", "minecraft:dead_bubble_coral_block", "minecraft:dead_fire_coral_block", "minecraft:dead_horn_coral_block", "minecraft:dead_tube_coral", "minecraft:dead_brain_coral", "minecraft:dead_bubble_coral", "minecraft:dead_fire_coral", "minecraft:dead_horn_coral", "minecraft:dead_tube_coral_fan", "minecraft:dead_brain_coral_fan", "minecraft:dead_bubble_coral_fan", "minecraft:dead_fire_coral_fan", "minecraft:dead_horn_coral_fan", "minecraft:dead_tube_coral_wall_fan", "minecraft:dead_brain_coral_wall_fan", "minecraft:dead_bubble_coral_wall_fan", "minecraft:dead_fire_coral_wall_fan", "minecraft:dead_horn_coral_wall_fan"}},
+	{Block, "minecraft:mineable/shovel", []string{"minecraft:clay", "minecraft:dirt", "minecraft:coarse_dirt", "minecraft:podzol", "minecraft:farmland", "minecraft:grass_block", "minecraft:gravel", "minecraft:mycelium", "minecraft:sand", "minecraft:red_sand", "minecraft:snow_block", "minecraft:snow", "minecraft:soul_sand", "minecraft:dirt_path", "minecraft:white_concrete_powder", "minecraft:orange_concrete_powder", "minecraft:magenta_concrete_powder", "minecraft:light_blue_concrete_powder", "minecraft:yellow_concrete_powder", "minecraft:lime_concrete_powder", "minecraft:pink_concrete_powder", "minecraft:gray_concrete_powder", "minecraft:light_gray_concrete_powder", "minecraft:cyan_concrete_powder", "minecraft:purple_concrete_powder", "minecraft:blue_concrete_powder", "minecraft:brown_concrete_powder", "minecraft:green_concrete_powder", "minecraft:red_concrete_powder", "minecraft:black_concrete_powder", "minecraft:soul_soil", "minecraft:rooted_dirt", "minecraft:muddy_mangrove_roots", "minecraft:mud", "minecraft:suspicious_sand", "minecraft:suspicious_gravel"}},
+	{Block, "minecraft:mob_interactable_doors", []string{"#minecraft:wooden_doors", "minecraft:copper_door", "minecraft:exposed_copper_door", "minecraft:weathered_copper_door", "minecraft:oxidized_copper_door", "minecraft:waxed_copper_door", "minecraft:waxed_exposed_copper_door", "minecraft:waxed_weathered_copper_door", "minecraft:waxed_oxidized_copper_door"}},
+	{Block, "minecraft:mooshrooms_spawnable_on", []string{"minecraft:mycelium"}},
+	{Block, "minecraft:moss_replaceable", []string{"#minecraft:base_stone_overworld", "#minecraft:cave_vines", "#minecraft:dirt"}},
+	{Block, "minecraft:mushroom_grow_block", []string{"minecraft:mycelium", "minecraft:podzol", "minecraft:crimson_nylium", "minecraft:warped_nylium"}},
+	{Block, "minecraft:needs_diamond_tool", []string{"minecraft:obsidian", "minecraft:crying_obsidian", "minecraft:netherite_block", "minecraft:respawn_anchor", "minecraft:ancient_debris"}},
+	{Block, "minecraft:needs_iron_tool", []string{"minecraft:diamond_block", "minecraft:diamond_ore", "minecraft:deepslate_diamond_ore", "minecraft:emerald_ore", "minecraft:deepslate_emerald_ore", "minecraft:emerald_block", "minecraft:gold_block", "minecraft:raw_gold_block", "minecraft:gold_ore", "minecraft:deepslate_gold_ore", "minecraft:redstone_ore", "minecraft:deepslate_redstone_ore"}},
+	{Block, "minecraft:needs_stone_tool", []string{"minecraft:iron_block", "minecraft:raw_iron_block", "minecraft:iron_ore", "minecraft:deepslate_iron_ore", "minecraft:lapis_block", "minecraft:lapis_ore", "minecraft:deepslate_lapis_ore", "minecraft:raw_copper_block", "minecraft:copper_ore", "minecraft:deepslate_copper_ore", "minecraft:lightning_rod", "minecraft:crafter", "minecraft:chiseled_copper", "minecraft:cut_copper", "minecraft:cut_copper_slab", "minecraft:cut_copper_stairs", "minecraft:copper_grate", "minecraft:copper_bulb", "minecraft:copper_block", "minecraft:exposed_chiseled_copper", "minecraft:exposed_cut_copper", "minecraft:exposed_cut_copper_slab", "minecraft:exposed_cut_copper_stairs", "minecraft:exposed_copper_grate", "minecraft:exposed_copper_bulb", "minecraft:exposed_copper", "minecraft:weathered_chiseled_copper", "minecraft:weathered_cut_copper", "minecraft:weathered_cut_copper_slab", "minecraft:weathered_cut_copper_stairs", "minecraft:weathered_copper_grate", "minecraft:weathered_copper_bulb", "minecraft:weathered_copper", "minecraft:oxidized_chiseled_copper", "minecraft:oxidized_cut_copper", "minecraft:oxidized_cut_copper_slab", "minecraft:oxidized_cut_copper_stairs", "minecraft:oxidized_copper_grate", "minecraft:oxidized_copper_bulb", "minecraft:oxidized_copper", "minecraft:waxed_chiseled_copper", "minecraft:waxed_cut_copper", "minecraft:waxed_cut_copper_slab", "minecraft:waxed_cut_copper_stairs", "minecraft:waxed_copper_grate", "minecraft:waxed_copper_bulb", "minecraft:waxed_copper_block", "minecraft:waxed_exposed_chiseled_copper", "minecraft:waxed_exposed_cut_copper", "minecraft:waxed_exposed_cut_copper_slab", "minecraft:waxed_exposed_cut_copper_stairs", "minecraft:waxed_exposed_copper_grate", "minecraft:waxed_exposed_copper_bulb", "minecraft:waxed_exposed_copper", "minecraft:waxed_weathered_chiseled_copper", "minecraft:waxed_weathered_cut_copper", "minecraft:waxed_weathered_cut_copper_slab", "minecraft:waxed_weathered_cut_copper_stairs", "minecraft:waxed_weathered_copper_grate", "minecraft:waxed_weathered_copper_bulb", "minecraft:waxed_weathered_copper", "minecraft:waxed_oxidized_chiseled_copper", "minecraft:waxed_oxidized_cut_copper", "minecraft:waxed_oxidized_cut_copper_slab", "minecraft:waxed_oxidized_cut_copper_stairs", "minecraft:waxed_oxidized_copper_grate", "minecraft:waxed_oxidized_copper_bulb", "minecraft:waxed_oxidized_copper"}},
+	{Block, "minecraft:nether_carver_replaceables", []string{"#minecraft:base_stone_overworld", "#minecraft:base_stone_nether", "#minecraft:dirt", "#minecraft:nylium", "#minecraft:wart_blocks", "minecraft:soul_sand", "minecraft:soul_soil"}},
+	{Block, "minecraft:nylium", []string{"minecraft:crimson_nylium", "minecraft:warped_nylium"}},
+	{Block, "minecraft:oak_logs", []string{"minecraft:oak_log", "minecraft:oak_wood", "minecraft:stripped_oak_log", "minecraft:stripped_oak_wood"}},
+	{Block, "minecraft:occludes_vibration_signals", []string{"#minecraft:wool"}},
+	{Block, "minecraft:overworld_carver_replaceables", []string{"#minecraft:base_stone_overworld", "#minecraft:dirt", "#minecraft:sand", "#minecraft:terracotta", "#minecraft:iron_ores", "#minecraft:copper_ores", "#minecraft:snow", "minecraft:water", "minecraft:gravel", "minecraft:suspicious_gravel", "minecraft:sandstone", "minecraft:red_sandstone", "minecraft:calcite", "minecraft:packed_ice", "minecraft:raw_iron_block", "minecraft:raw_copper_block"}},
+	{Block, "minecraft:overworld_natural_logs", []string{"minecraft:oak_log", "minecraft:spruce_log", "minecraft:birch_log", "minecraft:jungle_log", "minecraft:acacia_log", "minecraft:dark_oak_log", "minecraft:mangrove_log", "minecraft:cherry_log"}},
+	{Block, "minecraft:parrots_spawnable_on", []string{"minecraft:grass_block", "minecraft:air", "#minecraft:leaves", "#minecraft:logs"}},
+	{Block, "minecraft:piglin_repellents", []string{"minecraft:soul_fire", "minecraft:soul_torch", "minecraft:soul_lantern", "minecraft:soul_wall_torch", "minecraft:soul_campfire"}},
+	{Block, "minecraft:planks", []string{"minecraft:oak_planks", "minecraft:spruce_planks", "minecraft:birch_planks", "minecraft:jungle_planks", "minecraft:acacia_planks", "minecraft:dark_oak_planks", "minecraft:crimson_planks", "minecraft:warped_planks", "minecraft:mangrove_planks", "minecraft:bamboo_planks", "minecraft:cherry_planks"}},
+	{Block, "minecraft:polar_bears_spawnable_on_alternate", []string{"minecraft:ice"}},
+	{Block, "minecraft:portals", []string{"minecraft:nether_portal", "minecraft:end_portal", "minecraft:end_gateway"}},
+	{Block, "minecraft:pressure_plates", []string{"minecraft:light_weighted_pressure_plate", "minecraft:heavy_weighted_pressure_plate", "#minecraft:wooden_pressure_plates", "#minecraft:stone_pressure_plates"}},
+	{Block, "minecraft:prevent_mob_spawning_inside", []string{"#minecraft:rails"}},
+	{Block, "minecraft:rabbits_spawnable_on", []string{"minecraft:grass_block", "minecraft:snow", "minecraft:snow_block", "minecraft:sand"}},
+	{Block, "minecraft:rails", []string{"minecraft:rail", "minecraft:powered_rail", "minecraft:detector_rail", "minecraft:activator_rail"}},
+	{Block, "minecraft:redstone_ores", []string{"minecraft:redstone_ore", "minecraft:deepslate_redstone_ore"}},
+	{Block, "minecraft:replaceable", []string{"minecraft:air", "minecraft:water", "minecraft:lava", "minecraft:short_grass", "minecraft:fern", "minecraft:dead_bush", "minecraft:seagrass", "minecraft:tall_seagrass", "minecraft:fire", "minecraft:soul_fire", "minecraft:snow", "minecraft:vine", "minecraft:glow_lichen", "minecraft:light", "minecraft:tall_grass", "minecraft:large_fern", "minecraft:structure_void", "minecraft:void_air", "minecraft:cave_air", "minecraft:bubble_column", "minecraft:warped_roots", "minecraft:nether_sprouts", "minecraft:crimson_roots", "minecraft:hanging_roots"}},
+	{Block, "minecraft:replaceable_by_trees", []string{"#minecraft:leaves", "minecraft:short_grass", "minecraft:fern", "minecraft:dead_bush", "minecraft:vine", "minecraft:glow_lichen", "minecraft:sunflower", "minecraft:lilac", "minecraft:rose_bush", "minecraft:peony", "minecraft:tall_grass", "minecraft:large_fern", "minecraft:hanging_roots", "minecraft:pitcher_plant", "minecraft:water", "minecraft:seagrass", "minecraft:tall_seagrass", "minecraft:warped_roots", "minecraft:nether_sprouts", "minecraft:crimson_roots"}},
+	{Block, "minecraft:sand", []string{"minecraft:sand", "minecraft:red_sand", "minecraft:suspicious_sand"}},
+	{Block, "minecraft:saplings", []string{"minecraft:oak_sapling", "minecraft:spruce_sapling", "minecraft:birch_sapling", "minecraft:jungle_sapling", "minecraft:acacia_sapling", "minecraft:dark_oak_sapling", "minecraft:azalea", "minecraft:flowering_azalea", "minecraft:mangrove_propagule", "minecraft:cherry_sapling"}},
+	{Block, "minecraft:sculk_replaceable", []string{"#minecraft:base_stone_overworld", "#minecraft:dirt", "#minecraft:terracotta", "#minecraft:nylium", "#minecraft:base_stone_nether", "minecraft:sand", "minecraft:red_sand", "minecraft:gravel", "minecraft:soul_sand", "minecraft:soul_soil", "minecraft:calcite", "minecraft:smooth_basalt", "minecraft:clay", "minecraft:dripstone_block", "minecraft:end_stone", "minecraft:red_sandstone", "minecraft:sandstone"}},
+	{Block, "minecraft:sculk_replaceable_world_gen", []string{"#minecraft:sculk_replaceable", "minecraft:deepslate_bricks", "minecraft:deepslate_tiles", "minecraft:cobbled_deepslate", "minecraft:cracked_deepslate_bricks", "minecraft:cracked_deepslate_tiles", "minecraft:polished_deepslate"}},
+	{Block, "minecraft:shulker_boxes", []string{"minecraft:shulker_box", "minecraft:white_shulker_box", "minecraft:orange_shulker_box", "minecraft:magenta_shulker_box", "minecraft:light_blue_shulker_box", "minecraft:yellow_shulker_box", "minecraft:lime_shulker_box", "minecraft:pink_shulker_box", "minecraft:gray_shulker_box", "minecraft:light_gray_shulker_box", "minecraft:cyan_shulker_box", "minecraft:purple_shulker_box", "minecraft:blue_shulker_box", "minecraft:brown_shulker_box", "minecraft:green_shulker_box", "minecraft:red_shulker_box", "minecraft:black_shulker_box"}},
+	{Block, "minecraft:signs", []string{"#minecraft:standing_signs", "#minecraft:wall_signs"}},
+	{Block, "minecraft:slabs", []string{"#minecraft:wooden_slabs", "minecraft:andesite_slab", "minecraft:bamboo_mosaic_slab", "minecraft:blackstone_slab", "minecraft:brick_slab", "minecraft:cobbled_deepslate_slab", "minecraft:cobblestone_slab", "minecraft:cut_copper_slab", "minecraft:cut_red_sandstone_slab", "minecraft:cut_sandstone_slab", "minecraft:dark_prismarine_slab", "minecraft:deepslate_brick_slab", "minecraft:deepslate_tile_slab", "minecraft:diorite_slab", "minecraft:end_stone_brick_slab", "minecraft:exposed_cut_copper_slab", "minecraft:granite_slab", "minecraft:mossy_cobblestone_slab", "minecraft:mossy_stone_brick_slab", "minecraft:mud_brick_slab", "minecraft:nether_brick_slab", "minecraft:oxidized_cut_copper_slab", "minecraft:petrified_oak_slab", "minecraft:polished_andesite_slab", "minecraft:polished_blackstone_brick_slab", "minecraft:polished_blackstone_slab", "minecraft:polished_deepslate_slab", "minecraft:polished_diorite_slab", "minecraft:polished_granite_slab", "minecraft:polished_tuff_slab", "minecraft:prismarine_brick_slab", "minecraft:prismarine_slab", "minecraft:purpur_slab", "minecraft:quartz_slab", "minecraft:red_nether_brick_slab", "minecraft:red_sandstone_slab", "minecraft:sandstone_slab", "minecraft:smooth_quartz_slab", "minecraft:smooth_red_sandstone_slab", "minecraft:smooth_sandstone_slab", "minecraft:smooth_stone_slab", "minecraft:stone_brick_slab", "minecraft:stone_slab", "minecraft:tuff_brick_slab", "minecraft:tuff_slab", "minecraft:waxed_cut_copper_slab", "minecraft:waxed_exposed_cut_copper_slab", "minecraft:waxed_oxidized_cut_copper_slab", "minecraft:waxed_weathered_cut_copper_slab", "minecraft:weathered_cut_copper_slab"}},
+	{Block, "minecraft:small_dripleaf_placeable", []string{"minecraft:clay", "minecraft:moss_block"}},
+	{Block, "minecraft:small_flowers", []string{"minecraft:dandelion", "minecraft:poppy", "minecraft:blue_orchid", "minecraft:allium", "minecraft:azure_bluet", "minecraft:red_tulip", "minecraft:orange_tulip", "minecraft:white_tulip", "minecraft:pink_tulip", "minecraft:oxeye_daisy", "minecraft:cornflower", "minecraft:lily_of_the_valley", "minecraft:wither_rose", "minecraft:torchflower"}},
+	{Block, "minecraft:smelts_to_glass", []string{"minecraft:sand", "minecraft:red_sand"}},
+	{Block, "minecraft:snaps_goat_horn", []string{"#minecraft:overworld_natural_logs", "minecraft:stone", "minecraft:packed_ice", "minecraft:iron_ore", "minecraft:coal_ore", "minecraft:copper_ore", "minecraft:emerald_ore"}},
+	{Block, "minecraft:sniffer_diggable_block", []string{"minecraft:dirt", "minecraft:grass_block", "minecraft:podzol", "minecraft:coarse_dirt", "minecraft:rooted_dirt", "minecraft:moss_block", "minecraft:mud", "minecraft:muddy_mangrove_roots"}},
+	{Block, "minecraft:sniffer_egg_hatch_boost", []string{"minecraft:moss_block"}},
+	{Block, "minecraft:snow", []string{"minecraft:snow", "minecraft:snow_block", "minecraft:powder_snow"}},
+	{Block, "minecraft:snow_layer_can_survive_on", []string{"minecraft:honey_block", "minecraft:soul_sand", "minecraft:mud"}},
+	{Block, "minecraft:snow_layer_cannot_survive_on", []string{"minecraft:ice", "minecraft:packed_ice", "minecraft:barrier"}},
+	{Block, "minecraft:soul_fire_base_blocks", []string{"minecraft:soul_sand", "minecraft:soul_soil"}},
+	{Block, "minecraft:soul_speed_blocks", []string{"minecraft:soul_sand", "minecraft:soul_soil"}},
+	{Block, "minecraft:spruce_logs", []string{"minecraft:spruce_log", "minecraft:spruce_wood", "minecraft:stripped_spruce_log", "minecraft:stripped_spruce_wood"}},
+	{Block, "minecraft:stairs", []string{"#minecraft:wooden_stairs", "minecraft:andesite_stairs", "minecraft:bamboo_mosaic_stairs", "minecraft:blackstone_stairs", "minecraft:brick_stairs", "minecraft:cobbled_deepslate_stairs", "minecraft:cobblestone_stairs", "minecraft:cut_copper_stairs", "minecraft:dark_prismarine_stairs", "minecraft:deepslate_brick_stairs", "minecraft:deepslate_tile_stairs", "minecraft:diorite_stairs", "minecraft:end_stone_brick_stairs", "minecraft:exposed_cut_copper_stairs", "minecraft:granite_stairs", "minecraft:mossy_cobblestone_stairs", "minecraft:mossy_stone_brick_stairs", "minecraft:mud_brick_stairs", "minecraft:nether_brick_stairs", "minecraft:oxidized_cut_copper_stairs", "minecraft:polished_andesite_stairs", "minecraft:polished_blackstone_brick_stairs", "minecraft:polished_blackstone_stairs", "minecraft:polished_deepslate_stairs", "minecraft:polished_diorite_stairs", "minecraft:polished_granite_stairs", "minecraft:polished_tuff_stairs", "minecraft:prismarine_brick_stairs", "minecraft:prismarine_stairs", "minecraft:purpur_stairs", "minecraft:quartz_stairs", "minecraft:red_nether_brick_stairs", "minecraft:red_sandstone_stairs", "minecraft:sandstone_stairs", "minecraft:smooth_quartz_stairs", "minecraft:smooth_red_sandstone_stairs", "minecraft:smooth_sandstone_stairs", "minecraft:stone_brick_stairs", "minecraft:stone_stairs", "minecraft:tuff_brick_stairs", "minecraft:tuff_stairs", "minecraft:waxed_cut_copper_stairs", "minecraft:waxed_exposed_cut_copper_stairs", "minecraft:waxed_oxidized_cut_copper_stairs", "minecraft:waxed_weathered_cut_copper_stairs", "minecraft:weathered_cut_copper_stairs"}},
+	{Block, "minecraft:standing_signs", []string{"minecraft:oak_sign", "minecraft:spruce_sign", "minecraft:birch_sign", "minecraft:jungle_sign", "minecraft:acacia_sign", "minecraft:dark_oak_sign", "minecraft:crimson_sign", "minecraft:warped_sign", "minecraft:mangrove_sign", "minecraft:bamboo_sign", "minecraft:cherry_sign"}},
+	{Block, "minecraft:stone_bricks", []string{"minecraft:stone_bricks", "minecraft:mossy_stone_bricks", "minecraft:cracked_stone_bricks", "minecraft:chiseled_stone_bricks"}},
+	{Block, "minecraft:stone_buttons", []string{"minecraft:stone_button", "minecraft:polished_blackstone_button"}},
+	{Block, "minecraft:stone_ore_replaceables", []string{"minecraft:stone", "minecraft:granite", "minecraft:diorite", "minecraft:andesite"}},
+	{Block, "minecraft:stone_pressure_plates", []string{"minecraft:stone_pressure_plate", "minecraft:polished_blackstone_pressure_plate"}},
+	{Block, "minecraft:strider_warm_blocks", []string{"minecraft:lava"}},
+	{Block, "minecraft:sword_efficient", []string{"#minecraft:leaves", "#minecraft:saplings", "#minecraft:small_flowers", "#minecraft:crops", "minecraft:short_grass", "minecraft:fern", "minecraft:dead_bush", "minecraft:vine", "minecraft:glow_lichen", "minecraft:sunflower", "minecraft:lilac", "minecraft:rose_bush", "minecraft:peony", "minecraft:tall_grass", "minecraft:large_fern", "minecraft:hanging_roots", "minecraft:pitcher_plant", "minecraft:brown_mushroom", "minecraft:red_mushroom", "minecraft:sugar_cane", "minecraft:pumpkin", "minecraft:carved_pumpkin", "minecraft:jack_o_lantern", "minecraft:melon", "minecraft:attached_pumpkin_stem", "minecraft:attached_melon_stem", "minecraft:lily_pad", "minecraft:cocoa", "minecraft:pitcher_crop", "minecraft:sweet_berry_bush", "minecraft:cave_vines", "minecraft:cave_vines_plant", "minecraft:spore_blossom", "minecraft:moss_carpet", "minecraft:pink_petals", "minecraft:big_dripleaf", "minecraft:big_dripleaf_stem", "minecraft:small_dripleaf", "minecraft:nether_wart", "minecraft:warped_fungus", "minecraft:warped_roots", "minecraft:nether_sprouts", "minecraft:crimson_fungus", "minecraft:weeping_vines", "minecraft:weeping_vines_plant", "minecraft:twisting_vines", "minecraft:twisting_vines_plant", "minecraft:crimson_roots", "minecraft:chorus_plant", "minecraft:chorus_flower"}},
+	{Block, "minecraft:tall_flowers", []string{"minecraft:sunflower", "minecraft:lilac", "minecraft:peony", "minecraft:rose_bush", "minecraft:pitcher_plant"}},
+	{Block, "minecraft:terracotta", []string{"minecraft:terracotta", "minecraft:white_terracotta", "minecraft:orange_terracotta", "minecraft:magenta_terracotta", "minecraft:light_blue_terracotta", "minecraft:yellow_terracotta", "minecraft:lime_terracotta", "minecraft:pink_terracotta", "minecraft:gray_terracotta", "minecraft:light_gray_terracotta", "minecraft:cyan_terracotta", "minecraft:purple_terracotta", "minecraft:blue_terracotta", "minecraft:brown_terracotta", "minecraft:green_terracotta", "minecraft:red_terracotta", "minecraft:black_terracotta"}},
+	{Block, "minecraft:trail_ruins_replaceable", []string{"minecraft:gravel"}},
+	{Block, "minecraft:trapdoors", []string{"#minecraft:wooden_trapdoors", "minecraft:iron_trapdoor", "minecraft:copper_trapdoor", "minecraft:exposed_copper_trapdoor", "minecraft:weathered_copper_trapdoor", "minecraft:oxidized_copper_trapdoor", "minecraft:waxed_copper_trapdoor", "minecraft:waxed_exposed_copper_trapdoor", "minecraft:waxed_weathered_copper_trapdoor", "minecraft:waxed_oxidized_copper_trapdoor"}},
+	{Block, "minecraft:underwater_bonemeals", []string{"minecraft:seagrass", "#minecraft:corals", "#minecraft:wall_corals"}},
+	{Block, "minecraft:unstable_bottom_center", []string{"#minecraft:fence_gates"}},
+	{Block, "minecraft:valid_spawn", []string{"minecraft:grass_block", "minecraft:podzol"}},
+	{Block, "minecraft:vibration_resonators", []string{"minecraft:amethyst_block"}},
+	{Block, "minecraft:wall_corals", []string{"minecraft:tube_coral_wall_fan", "minecraft:brain_coral_wall_fan", "minecraft:bubble_coral_wall_fan", "minecraft:fire_coral_wall_fan", "minecraft:horn_coral_wall_fan"}},
+	{Block, "minecraft:wall_hanging_signs", []string{"minecraft:oak_wall_hanging_sign", "minecraft:spruce_wall_hanging_sign", "minecraft:birch_wall_hanging_sign", "minecraft:jungle_wall_hanging_sign", "minecraft:acacia_wall_hanging_sign", "minecraft:dark_oak_wall_hanging_sign", "minecraft:crimson_wall_hanging_sign", "minecraft:warped_wall_hanging_sign", "minecraft:mangrove_wall_hanging_sign", "minecraft:bamboo_wall_hanging_sign", "minecraft:cherry_wall_hanging_sign"}},
+	{Block, "minecraft:wall_post_override", []string{"minecraft:torch", "minecraft:soul_torch", "minecraft:redstone_torch", "minecraft:tripwire", "#minecraft:signs", "#minecraft:banners", "#minecraft:pressure_plates"}},
+	{Block, "minecraft:wall_signs", []string{"minecraft:oak_wall_sign", "minecraft:spruce_wall_sign", "minecraft:birch_wall_sign", "minecraft:jungle_wall_sign", "minecraft:acacia_wall_sign", "minecraft:dark_oak_wall_sign", "minecraft:crimson_wall_sign", "minecraft:warped_wall_sign", "minecraft:mangrove_wall_sign", "minecraft:bamboo_wall_sign", "minecraft:cherry_wall_sign"}},
+	{Block, "minecraft:walls", []string{"minecraft:andesite_wall", "minecraft:blackstone_wall", "minecraft:brick_wall", "minecraft:cobbled_deepslate_wall", "minecraft:cobblestone_wall", "minecraft:deepslate_brick_wall", "minecraft:deepslate_tile_wall", "minecraft:diorite_wall", "minecraft:end_stone_brick_wall", "minecraft:granite_wall", "minecraft:mossy_cobblestone_wall", "minecraft:mossy_stone_brick_wall", "minecraft:mud_brick_wall", "minecraft:nether_brick_wall", "minecraft:polished_blackstone_brick_wall", "minecraft:polished_blackstone_wall", "minecraft:polished_deepslate_wall", "minecraft:polished_tuff_wall", "minecraft:prismarine_wall", "minecraft:red_nether_brick_wall", "minecraft:red_sandstone_wall", "minecraft:sandstone_wall", "minecraft:stone_brick_wall", "minecraft:tuff_brick_wall", "minecraft:tuff_wall"}},
+	{Block, "minecraft:warped_stems", []string{"minecraft:warped_stem", "minecraft:stripped_warped_stem", "minecraft:warped_hyphae", "minecraft:stripped_warped_hyphae"}},
+	{Block, "minecraft:wart_blocks", []string{"minecraft:nether_wart_block", "minecraft:warped_wart_block"}},
+	{Block, "minecraft:wither_immune", []string{"minecraft:barrier", "minecraft:bedrock", "minecraft:end_portal", "minecraft:end_portal_frame", "minecraft:end_gateway", "minecraft:command_block", "minecraft:repeating_command_block", "minecraft:chain_command_block", "minecraft:structure_block", "minecraft:jigsaw", "minecraft:moving_piston", "minecraft:light", "minecraft:reinforced_deepslate"}},
+	{Block, "minecraft:wither_summon_base_blocks", []string{"minecraft:soul_sand", "minecraft:soul_soil"}},
+	{Block, "minecraft:wolves_spawnable_on", []string{"minecraft:grass_block", "minecraft:snow", "minecraft:snow_block", "minecraft:coarse_dirt", "minecraft:podzol"}},
+	{Block, "minecraft:wooden_buttons", []string{"minecraft:oak_button", "minecraft:spruce_button", "minecraft:birch_button", "minecraft:jungle_button", "minecraft:acacia_button", "minecraft:dark_oak_button", "minecraft:crimson_button", "minecraft:warped_button", "minecraft:mangrove_button", "minecraft:bamboo_button", "minecraft:cherry_button"}},
+	{Block, "minecraft:wooden_doors", []string{"minecraft:oak_door", "minecraft:spruce_door", "minecraft:birch_door", "minecraft:jungle_door", "minecraft:acacia_door", "minecraft:dark_oak_door", "minecraft:crimson_door", "minecraft:warped_door", "minecraft:mangrove_door", "minecraft:bamboo_door", "minecraft:cherry_door"}},
+	{Block, "minecraft:wooden_fences", []string{"minecraft:oak_fence", "minecraft:spruce_fence", "minecraft:birch_fence", "minecraft:jungle_fence", "minecraft:acacia_fence", "minecraft:dark_oak_fence", "minecraft:crimson_fence", "minecraft:warped_fence", "minecraft:mangrove_fence", "minecraft:bamboo_fence", "minecraft:cherry_fence"}},
+	{Block, "minecraft:wooden_pressure_plates", []string{"minecraft:oak_pressure_plate", "minecraft:spruce_pressure_plate", "minecraft:birch_pressure_plate", "minecraft:jungle_pressure_plate", "minecraft:acacia_pressure_plate", "minecraft:dark_oak_pressure_plate", "minecraft:crimson_pressure_plate", "minecraft:warped_pressure_plate", "minecraft:mangrove_pressure_plate", "minecraft:bamboo_pressure_plate", "minecraft:cherry_pressure_plate"}},
+	{Block, "minecraft:wooden_slabs", []string{"minecraft:oak_slab", "minecraft:spruce_slab", "minecraft:birch_slab", "minecraft:jungle_slab", "minecraft:acacia_slab", "minecraft:dark_oak_slab", "minecraft:crimson_slab", "minecraft:warped_slab", "minecraft:mangrove_slab", "minecraft:bamboo_slab", "minecraft:cherry_slab"}},
+	{Block, "minecraft:wooden_stairs", []string{"minecraft:oak_stairs", "minecraft:spruce_stairs", "minecraft:birch_stairs", "minecraft:jungle_stairs", "minecraft:acacia_stairs", "minecraft:dark_oak_stairs", "minecraft:crimson_stairs", "minecraft:warped_stairs", "minecraft:mangrove_stairs", "minecraft:bamboo_stairs", "minecraft:cherry_stairs"}},
+	{Block, "minecraft:wooden_trapdoors", []string{"minecraft:oak_trapdoor", "minecraft:spruce_trapdoor", "minecraft:birch_trapdoor", "minecraft:jungle_trapdoor", "minecraft:acacia_trapdoor", "minecraft:dark_oak_trapdoor", "minecraft:crimson_trapdoor", "minecraft:warped_trapdoor", "minecraft:mangrove_trapdoor", "minecraft:bamboo_trapdoor", "minecraft:cherry_trapdoor"}},
+	{Block, "minecraft:wool", []string{"minecraft:white_wool", "minecraft:orange_wool", "minecraft:magenta_wool", "minecraft:light_blue_wool", "minecraft:yellow_wool", "minecraft:lime_wool", "minecraft:pink_wool", "minecraft:gray_wool", "minecraft:light_gray_wool", "minecraft:cyan_wool", "minecraft:purple_wool", "minecraft:blue_wool", "minecraft:brown_wool", "minecraft:green_wool", "minecraft:red_wool", "minecraft:black_wool"}},
+	{Block, "minecraft:wool_carpets", []string{"minecraft:white_carpet", "minecraft:orange_carpet", "minecraft:magenta_carpet", "minecraft:light_blue_carpet", "minecraft:yellow_carpet", "minecraft:lime_carpet", "minecraft:pink_carpet", "minecraft:gray_carpet", "minecraft:light_gray_carpet", "minecraft:cyan_carpet", "minecraft:purple_carpet", "minecraft:blue_carpet", "minecraft:brown_carpet", "minecraft:green_carpet", "minecraft:red_carpet", "minecraft:black_carpet"}},
+	{Item, "minecraft:acacia_logs", []string{"minecraft:acacia_log", "minecraft:acacia_wood", "minecraft:stripped_acacia_log", "minecraft:stripped_acacia_wood"}},
+	{Item, "minecraft:anvil", []string{"minecraft:anvil", "minecraft:chipped_anvil", "minecraft:damaged_anvil"}},
+	{Item, "minecraft:armadillo_food", []string{"minecraft:spider_eye"}},
+	{Item, "minecraft:arrows", []string{"minecraft:arrow", "minecraft:tipped_arrow", "minecraft:spectral_arrow"}},
+	{Item, "minecraft:axes", []string{"minecraft:wooden_axe", "minecraft:stone_axe", "minecraft:iron_axe", "minecraft:golden_axe", "minecraft:diamond_axe", "minecraft:netherite_axe"}},
+	{Item, "minecraft:axolotl_food", []string{"minecraft:tropical_fish_bucket"}},
+	{Item, "minecraft:bamboo_blocks", []string{"minecraft:bamboo_block", "minecraft:stripped_bamboo_block"}},
+	{Item, "minecraft:banners", []string{"minecraft:white_banner", "minecraft:orange_banner", "minecraft:magenta_banner", "minecraft:light_blue_banner", "minecraft:yellow_banner", "minecraft:lime_banner", "minecraft:pink_banner", "minecraft:gray_banner", "minecraft:light_gray_banner", "minecraft:cyan_banner", "minecraft:purple_banner", "minecraft:blue_banner", "minecraft:brown_banner", "minecraft:green_banner", "minecraft:red_banner", "minecraft:black_banner"}},
+	{Item, "minecraft:beacon_payment_items", []string{"minecraft:netherite_ingot", "minecraft:emerald", "minecraft:diamond", "minecraft:gold_ingot", "minecraft:iron_ingot"}},
+	{Item, "minecraft:beds", []string{"minecraft:white_bed", "minecraft:orange_bed", "minecraft:magenta_bed", "minecraft:light_blue_bed", "minecraft:yellow_bed", "minecraft:lime_bed", "minecraft:pink_bed", "minecraft:gray_bed", "minecraft:light_gray_bed", "minecraft:cyan_bed", "minecraft:purple_bed", "minecraft:blue_bed", "minecraft:brown_bed", "minecraft:green_bed", "minecraft:red_bed", "minecraft:black_bed"}},
+	{Item, "minecraft:bee_food", []string{"#minecraft:flowers"}},
+	{Item, "minecraft:birch_logs", []string{"minecraft:birch_log", "minecraft:birch_wood", "minecraft:stripped_birch_log", "minecraft:stripped_birch_wood"}},
+	{Item, "minecraft:boats", []string{"minecraft:oak_boat", "minecraft:spruce_boat", "minecraft:birch_boat", "minecraft:jungle_boat", "minecraft:acacia_boat", "minecraft:dark_oak_boat", "minecraft:mangrove_boat", "minecraft:cherry_boat", "minecraft:bamboo_raft", "#minecraft:chest_boats"}},
+	{Item, "minecraft:bookshelf_books", []string{"minecraft:book", "minecraft:written_book", "minecraft:enchanted_book", "minecraft:writable_book", "minecraft:knowledge_book"}},
+	{Item, "minecraft:breaks_decorated_pots", []string{"#minecraft:swords", "#minecraft:axes", "#minecraft:pickaxes", "#minecraft:shovels", "#minecraft:hoes", "minecraft:trident", "minecraft:mace"}},
+	{Item, "minecraft:buttons", []string{"#minecraft:wooden_buttons", "#minecraft:stone_buttons"}},
+	{Item, "minecraft:camel_food", []string{"minecraft:cactus"}},
+	{Item, "minecraft:candles", []string{"minecraft:candle", "minecraft:white_candle", "minecraft:orange_candle", "minecraft:magenta_candle", "minecraft:light_blue_candle", "minecraft:yellow_candle", "minecraft:lime_candle", "minecraft:pink_candle", "minecraft:gray_candle", "minecraft:light_gray_candle", "minecraft:cyan_candle", "minecraft:purple_candle", "minecraft:blue_candle", "minecraft:brown_candle", "minecraft:green_candle", "minecraft:red_candle", "minecraft:black_candle"}},
+	{Item, "minecraft:cat_food", []string{"minecraft:cod", "minecraft:salmon"}},
+	{Item, "minecraft:cherry_logs", []string{"minecraft:cherry_log", "minecraft:cherry_wood", "minecraft:stripped_cherry_log", "minecraft:stripped_cherry_wood"}},
+	{Item, "minecraft:chest_armor", []string{"minecraft:leather_chestplate", "minecraft:chainmail_chestplate", "minecraft:iron_chestplate", "minecraft:diamond_chestplate", "minecraft:golden_chestplate", "minecraft:netherite_chestplate"}},
+	{Item, "minecraft:chest_boats", []string{"minecraft:oak_chest_boat", "minecraft:spruce_chest_boat", "minecraft:birch_chest_boat", "minecraft:jungle_chest_boat", "minecraft:acacia_chest_boat", "minecraft:dark_oak_chest_boat", "minecraft:mangrove_chest_boat", "minecraft:cherry_chest_boat", "minecraft:bamboo_chest_raft"}},
+	{Item, "minecraft:chicken_food", []string{"minecraft:wheat_seeds", "minecraft:melon_seeds", "minecraft:pumpkin_seeds", "minecraft:beetroot_seeds", "minecraft:torchflower_seeds", "minecraft:pitcher_pod"}},
+	{Item, "minecraft:cluster_max_harvestables", []string{"minecraft:diamond_pickaxe", "minecraft:golden_pickaxe", "minecraft:iron_pickaxe", "minecraft:netherite_pickaxe", "minecraft:stone_pickaxe", "minecraft:wooden_pickaxe"}},
+	{Item, "minecraft:coal_ores", []string{"minecraft:coal_ore", "minecraft:deepslate_coal_ore"}},
+	{Item, "minecraft:coals", []string{"minecraft:coal", "minecraft:charcoal"}},
+	{Item, "minecraft:compasses", []string{"minecraft:compass", "minecraft:recovery_compass"}},
+	{Item, "minecraft:completes_find_tree_tutorial", []string{"#minecraft:logs", "#minecraft:leaves", "#minecraft:wart_blocks"}},
+	{Item, "minecraft:copper_ores", []string{"minecraft:copper_ore", "minecraft:deepslate_copper_ore"}},
+	{Item, "minecraft:cow_food", []string{"minecraft:wheat"}},
+	{Item, "minecraft:creeper_drop_music_discs", []string{"minecraft:music_disc_13", "minecraft:music_disc_cat", "minecraft:music_disc_blocks", "minecraft:music_disc_chirp", "minecraft:music_disc_far", "minecraft:music_disc_mall", "minecraft:music_disc_mellohi", "minecraft:music_disc_stal", "minecraft:music_disc_strad", "minecraft:music_disc_ward", "minecraft:music_disc_11", "minecraft:music_disc_wait"}},
+	{Item, "minecraft:creeper_igniters", []string{"minecraft:flint_and_steel", "minecraft:fire_charge"}},
+	{Item, "minecraft:crimson_stems", []string{"minecraft:crimson_stem", "minecraft:stripped_crimson_stem", "minecraft:crimson_hyphae", "minecraft:stripped_crimson_hyphae"}},
+	{Item, "minecraft:dampens_vibrations", []string{"#minecraft:wool", "#minecraft:wool_carpets"}},
+	{Item, "minecraft:dark_oak_logs", []string{"minecraft:dark_oak_log", "minecraft:dark_oak_wood", "minecraft:stripped_dark_oak_log", "minecraft:stripped_dark_oak_wood"}},
+	{Item, "minecraft:decorated_pot_ingredients", []string{"minecraft:brick", "#minecraft:decorated_pot_sherds"}},
+	{Item, "minecraft:decorated_pot_sherds", []string{"minecraft:angler_pottery_sherd", "minecraft:archer_pottery_sherd", "minecraft:arms_up_pottery_sherd", "minecraft:blade_pottery_sherd", "minecraft:brewer_pottery_sherd", "minecraft:burn_pottery_sherd", "minecraft:danger_pottery_sherd", "minecraft:explorer_pottery_sherd", "minecraft:flow_pottery_sherd", "minecraft:friend_pottery_sherd", "minecraft:guster_pottery_sherd", "minecraft:heart_pottery_sherd", "minecraft:heartbreak_pottery_sherd", "minecraft:howl_pottery_sherd", "minecraft:miner_pottery_sherd", "minecraft:mourner_pottery_sherd", "minecraft:plenty_pottery_sherd", "minecraft:prize_pottery_sherd", "minecraft:scrape_pottery_sherd", "minecraft:sheaf_pottery_sherd", "minecraft:shelter_pottery_sherd", "minecraft:skull_pottery_sherd", "minecraft:snort_pottery_sherd"}},
+	{Item, "minecraft:diamond_ores", []string{"minecraft:diamond_ore", "minecraft:deepslate_diamond_ore"}},
+	{Item, "minecraft:dirt", []string{"minecraft:dirt", "minecraft:grass_block", "minecraft:podzol", "minecraft:coarse_dirt", "minecraft:mycelium", "minecraft:rooted_dirt", "minecraft:moss_block", "minecraft:mud", "minecraft:muddy_mangrove_roots"}},
+	{Item, "minecraft:doors", []string{"#minecraft:wooden_doors", "minecraft:iron_door", "minecraft:copper_door", "minecraft:exposed_copper_door", "minecraft:weathered_copper_door", "minecraft:oxidized_copper_door", "minecraft:waxed_copper_door", "minecraft:waxed_exposed_copper_door", "minecraft:waxed_weathered_copper_door", "minecraft:waxed_oxidized_copper_door"}},
+	{Item, "minecraft:dyeable", []string{"minecraft:leather_helmet", "minecraft:leather_chestplate", "minecraft:leather_leggings", "minecraft:leather_boots", "minecraft:leather_horse_armor", "minecraft:wolf_armor"}},
+	{Item, "minecraft:emerald_ores", []string{"minecraft:emerald_ore", "minecraft:deepslate_emerald_ore"}},
+	{Item, "minecraft:enchantable/armor", []string{"#minecraft:enchantable/foot_armor", "#minecraft:enchantable/leg_armor", "#minecraft:enchantable/chest_armor", "#minecraft:enchantable/head_armor"}},
+	{Item, "minecraft:enchantable/bow", []string{"minecraft:bow"}},
+	{Item, "minecraft:enchantable/chest_armor", []string{"#minecraft:chest_armor"}},
+	{Item, "minecraft:enchantable/crossbow", []string{"minecraft:crossbow"}},
+	{Item, "minecraft:enchantable/durability", []string{"#minecraft:foot_armor", "#minecraft:leg_armor", "#minecraft:chest_armor", "#minecraft:head_armor", "minecraft:elytra", "minecraft:shield", "#minecraft:swords", "#minecraft:axes", "#minecraft:pickaxes", "#minecraft:shovels", "#minecraft:hoes", "minecraft:bow", "minecraft:crossbow", "minecraft:trident", "minecraft:flint_and_steel", "minecraft:shears", "minecraft:brush", "minecraft:fishing_rod", "minecraft:carrot_on_a_stick", "minecraft:warped_fungus_on_a_stick", "minecraft:mace"}},
+	{Item, "minecraft:enchantable/equippable", []string{"#minecraft:foot_armor", "#minecraft:leg_armor", "#minecraft:chest_armor", "#minecraft:head_armor", "minecraft:elytra", "#minecraft:skulls", "minecraft:carved_pumpkin"}},
+	{Item, "minecraft:enchantable/fire_aspect", []string{"#minecraft:enchantable/sword", "minecraft:mace"}},
+	{Item, "minecraft:enchantable/fishing", []string{"minecraft:fishing_rod"}},
+	{Item, "minecraft:enchantable/foot_armor", []string{"#minecraft:foot_armor"}},
+	{Item, "minecraft:enchantable/head_armor", []string{"#minecraft:head_armor"}},
+	{Item, "minecraft:enchantable/leg_armor", []string{"#minecraft:leg_armor"}},
+	{Item, "minecraft:enchantable/mace", []string{"minecraft:mace"}},
+	{Item, "minecraft:enchantable/mining", []string{"#minecraft:axes", "#minecraft:pickaxes", "#minecraft:shovels", "#minecraft:hoes", "minecraft:shears"}},
+	{Item, "minecraft:enchantable/mining_loot", []string{"#minecraft:axes", "#minecraft:pickaxes", "#minecraft:shovels", "#minecraft:hoes"}},
+	{Item, "minecraft:enchantable/sharp_weapon", []string{"#minecraft:swords", "#minecraft:axes"}},
+	{Item, "minecraft:enchantable/sword", []string{"#minecraft:swords"}},
+	{Item, "minecraft:enchantable/trident", []string{"minecraft:trident"}},
+	{Item, "minecraft:enchantable/vanishing", []string{"#minecraft:enchantable/durability", "minecraft:compass", "minecraft:carved_pumpkin", "#minecraft:skulls"}},
+	{Item, "minecraft:enchantable/weapon", []string{"#minecraft:enchantable/sharp_weapon", "minecraft:mace"}},
+	{Item, "minecraft:fence_gates", []string{"minecraft:oak_fence_gate", "minecraft:spruce_fence_gate", "minecraft:birch_fence_gate", "minecraft:jungle_fence_gate", "minecraft:acacia_fence_gate", "minecraft:dark_oak_fence_gate", "minecraft:crimson_fence_gate", "minecraft:warped_fence_gate", "minecraft:mangrove_fence_gate", "minecraft:bamboo_fence_gate", "minecraft:cherry_fence_gate"}},
+	{Item, "minecraft:fences", []string{"#minecraft:wooden_fences", "minecraft:nether_brick_fence"}},
+	{Item, "minecraft:fishes", []string{"minecraft:cod", "minecraft:cooked_cod", "minecraft:salmon", "minecraft:cooked_salmon", "minecraft:pufferfish", "minecraft:tropical_fish"}},
+	{Item, "minecraft:flowers", []string{"#minecraft:small_flowers", "#minecraft:tall_flowers", "minecraft:flowering_azalea_leaves", "minecraft:flowering_azalea", "minecraft:mangrove_propagule", "minecraft:cherry_leaves", "minecraft:pink_petals", "minecraft:chorus_flower", "minecraft:spore_blossom"}},
+	{Item, "minecraft:foot_armor", []string{"minecraft:leather_boots", "minecraft:chainmail_boots", "minecraft:iron_boots", "minecraft:diamond_boots", "minecraft:golden_boots", "minecraft:netherite_boots"}},
+	{Item, "minecraft:fox_food", []string{"minecraft:sweet_berries", "minecraft:glow_berries"}},
+	{Item, "minecraft:freeze_immune_wearables", []string{"minecraft:leather_boots", "minecraft:leather_leggings", "minecraft:leather_chestplate", "minecraft:leather_helmet", "minecraft:leather_horse_armor"}},
+	{Item, "minecraft:frog_food", []string{"minecraft:slime_ball"}},
+	{Item, "minecraft:goat_food", []string{"minecraft:wheat"}},
+	{Item, "minecraft:gold_ores", []string{"minecraft:gold_ore", "minecraft:nether_gold_ore", "minecraft:deepslate_gold_ore"}},
+	{Item, "minecraft:hanging_signs", []string{"minecraft:oak_hanging_sign", "minecraft:spruce_hanging_sign", "minecraft:birch_hanging_sign", "minecraft:jungle_hanging_sign", "minecraft:acacia_hanging_sign", "minecraft:dark_oak_hanging_sign", "minecraft:crimson_hanging_sign", "minecraft:warped_hanging_sign", "minecraft:mangrove_hanging_sign", "minecraft:bamboo_hanging_sign", "minecraft:cherry_hanging_sign"}},
+	{Item, "minecraft:head_armor", []string{"minecraft:leather_helmet", "minecraft:chainmail_helmet", "minecraft:iron_helmet", "minecraft:diamond_helmet", "minecraft:golden_helmet", "minecraft:netherite_helmet", "minecraft:turtle_helmet"}},
+	{Item, "minecraft:hoes", []string{"minecraft:wooden_hoe", "minecraft:stone_hoe", "minecraft:iron_hoe", "minecraft:golden_hoe", "minecraft:diamond_hoe", "minecraft:netherite_hoe"}},
+	{Item, "minecraft:hoglin_food", []string{"minecraft:crimson_fungus"}},
+	{Item, "minecraft:horse_food", []string{"minecraft:wheat", "minecraft:sugar", "minecraft:hay_block", "minecraft:apple", "minecraft:golden_carrot", "minecraft:golden_apple", "minecraft:enchanted_golden_apple", "minecraft:carrot"}},
+	{Item, "minecraft:horse_tempt_items", []string{"minecraft:golden_carrot", "minecraft:golden_apple", "minecraft:enchanted_golden_apple"}},
+	{Item, "minecraft:ignored_by_piglin_babies", []string{"minecraft:leather"}},
+	{Item, "minecraft:iron_ores", []string{"minecraft:iron_ore", "minecraft:deepslate_iron_ore"}},
+	{Item, "minecraft:jungle_logs", []string{"minecraft:jungle_log", "minecraft:jungle_wood", "minecraft:stripped_jungle_log", "minecraft:stripped_jungle_wood"}},
+	{Item, "minecraft:lapis_ores", []string{"minecraft:lapis_ore", "minecraft:deepslate_lapis_ore"}},
+	{Item, "minecraft:leaves", []string{"minecraft:jungle_leaves", "minecraft:oak_leaves", "minecraft:spruce_leaves", "minecraft:dark_oak_leaves", "minecraft:acacia_leaves", "minecraft:birch_leaves", "minecraft:azalea_leaves", "minecraft:flowering_azalea_leaves", "minecraft:mangrove_leaves", "minecraft:cherry_leaves"}},
+	{Item, "minecraft:lectern_books", []string{"minecraft:written_book", "minecraft:writable_book"}},
+	{Item, "minecraft:leg_armor", []string{"minecraft:leather_leggings", "minecraft:chainmail_leggings", "minecraft:iron_leggings", "minecraft:diamond_leggings", "minecraft:golden_leggings", "minecraft:netherite_leggings"}},
+	{Item, "minecraft:llama_food", []string{"minecraft:wheat", "minecraft:hay_block"}},
+	{Item, "minecraft:llama_tempt_items", []string{"minecraft:hay_block"}},
+	{Item, "minecraft:logs", []string{"#minecraft:logs_that_burn", "#minecraft:crimson_stems", "#minecraft:warped_stems"}},
+	{Item, "minecraft:logs_that_burn", []string{"#minecraft:oak_logs", "#minecraft:spruce_logs", "#minecraft:birch_logs", "#minecraft:jungle_logs", "#minecraft:acacia_logs", "#minecraft:dark_oak_logs", "#minecraft:mangrove_logs", "#minecraft:cherry_logs"}},
+	{Item, "minecraft:mangrove_logs", []string{"minecraft:mangrove_log", "minecraft:mangrove_wood", "minecraft:stripped_mangrove_log", "minecraft:stripped_mangrove_wood"}},
+	{Item, "minecraft:meat", []string{"minecraft:beef", "minecraft:chicken", "minecraft:cooked_beef", "minecraft:cooked_chicken", "minecraft:cooked_mutton", "minecraft:cooked_porkchop", "minecraft:cooked_rabbit", "minecraft:mutton", "minecraft:porkchop", "minecraft:rabbit", "minecraft:rotten_flesh"}},
+	{Item, "minecraft:non_flammable_wood", []string{"minecraft:warped_stem", "minecraft:stripped_warped_stem", "minecraft:warped_hyphae", "minecraft:stripped_warped_hyphae", "minecraft:crimson_stem", "minecraft:stripped_crimson_stem", "minecraft:crimson_hyphae", "minecraft:stripped_crimson_hyphae", "minecraft:crimson_planks", "minecraft:warped_planks", "minecraft:crimson_slab", "minecraft:warped_slab", "minecraft:crimson_pressure_plate", "minecraft:warped_pressure_plate", "minecraft:crimson_fence", "minecraft:warped_fence", "minecraft:crimson_trapdoor", "minecraft:warped_trapdoor", "minecraft:crimson_fence_gate", "minecraft:warped_fence_gate", "minecraft:crimson_stairs", "minecraft:warped_stairs", "minecraft:crimson_button", "minecraft:warped_button", "minecraft:crimson_door", "minecraft:warped_door", "minecraft:crimson_sign", "minecraft:warped_sign", "minecraft:crimson_hanging_sign", "minecraft:warped_hanging_sign"}},
+	{Item, "minecraft:noteblock_top_instruments", []string{"minecraft:zombie_head", "minecraft:skeleton_skull", "minecraft:creeper_head", "minecraft:dragon_head", "minecraft:wither_skeleton_skull", "minecraft:piglin_head", "minecraft:player_head"}},
+	{Item, "minecraft:oak_logs", []string{"minecraft:oak_log", "minecraft:oak_wood", "minecraft:stripped_oak_log", "minecraft:stripped_oak_wood"}},
+	{Item, "minecraft:ocelot_food", []string{"minecraft:cod", "minecraft:salmon"}},
+	{Item, "minecraft:panda_food", []string{"minecraft:bamboo"}},
+	{Item, "minecraft:parrot_food", []string{"minecraft:wheat_seeds", "minecraft:melon_seeds", "minecraft:pumpkin_seeds", "minecraft:beetroot_seeds", "minecraft:torchflower_seeds", "minecraft:pitcher_pod"}},
+	{Item, "minecraft:parrot_poisonous_food", []string{"minecraft:cookie"}},
+	{Item, "minecraft:pickaxes", []string{"minecraft:wooden_pickaxe", "minecraft:stone_pickaxe", "minecraft:iron_pickaxe", "minecraft:golden_pickaxe", "minecraft:diamond_pickaxe", "minecraft:netherite_pickaxe"}},
+	{Item, "minecraft:pig_food", []string{"minecraft:carrot", "minecraft:potato", "minecraft:beetroot"}},
+	{Item, "minecraft:piglin_food", []string{"minecraft:porkchop", "minecraft:cooked_porkchop"}},
+	{Item, "minecraft:piglin_loved", []string{"#minecraft:gold_ores", "minecraft:gold_block", "minecraft:gilded_blackstone", "minecraft:light_weighted_pressure_plate", "minecraft:gold_ingot", "minecraft:bell", "minecraft:clock", "minecraft:golden_carrot", "minecraft:glistering_melon_slice", "minecraft:golden_apple", "minecraft:enchanted_golden_apple", "minecraft:golden_helmet", "minecraft:golden_chestplate", "minecraft:golden_leggings", "minecraft:golden_boots", "minecraft:golden_horse_armor", "minecraft:golden_sword", "minecraft:golden_pickaxe", "minecraft:golden_shovel", "minecraft:golden_axe", "minecraft:golden_hoe", "minecraft:raw_gold", "minecraft:raw_gold_block"}},
+	{Item, "minecraft:piglin_repellents", []string{"minecraft:soul_torch", "minecraft:soul_lantern", "minecraft:soul_campfire"}},
+	{Item, "minecraft:planks", []string{"minecraft:oak_planks", "minecraft:spruce_planks", "minecraft:birch_planks", "minecraft:jungle_planks", "minecraft:acacia_planks", "minecraft:dark_oak_planks", "minecraft:crimson_planks", "minecraft:warped_planks", "minecraft:mangrove_planks", "minecraft:bamboo_planks", "minecraft:cherry_planks"}},
+	{Item, "minecraft:rabbit_food", []string{"minecraft:carrot", "minecraft:golden_carrot", "minecraft:dandelion"}},
+	{Item, "minecraft:rails", []string{"minecraft:rail", "minecraft:powered_rail", "minecraft:detector_rail", "minecraft:activator_rail"}},
+	{Item, "minecraft:redstone_ores", []string{"minecraft:redstone_ore", "minecraft:deepslate_redstone_ore"}},
+	{Item, "minecraft:sand", []string{"minecraft:sand", "minecraft:red_sand", "minecraft:suspicious_sand"}},
+	{Item, "minecraft:saplings", []string{"minecraft:oak_sapling", "minecraft:spruce_sapling", "minecraft:birch_sapling", "minecraft:jungle_sapling", "minecraft:acacia_sapling", "minecraft:dark_oak_sapling", "minecraft:azalea", "minecraft:flowering_azalea", "minecraft:mangrove_propagule", "minecraft:cherry_sapling"}},
+	{Item, "minecraft:sheep_food", []string{"minecraft:wheat"}},
+	{Item, "minecraft:shovels", []string{"minecraft:wooden_shovel", "minecraft:stone_shovel", "minecraft:iron_shovel", "minecraft:golden_shovel", "minecraft:diamond_shovel", "minecraft:netherite_shovel"}},
+	{Item, "minecraft:signs", []string{"minecraft:oak_sign", "minecraft:spruce_sign", "minecraft:birch_sign", "minecraft:jungle_sign", "minecraft:acacia_sign", "minecraft:dark_oak_sign", "minecraft:crimson_sign", "minecraft:warped_sign", "minecraft:mangrove_sign", "minecraft:bamboo_sign", "minecraft:cherry_sign"}},
+	{Item, "minecraft:skulls", []string{"minecraft:player_head", "minecraft:creeper_head", "minecraft:zombie_head", "minecraft:skeleton_skull", "minecraft:wither_skeleton_skull", "minecraft:dragon_head", "minecraft:piglin_head"}},
+	{Item, "minecraft:slabs", []string{"#minecraft:wooden_slabs", "minecraft:andesite_slab", "minecraft:bamboo_mosaic_slab", "minecraft:blackstone_slab", "minecraft:brick_slab", "minecraft:cobbled_deepslate_slab", "minecraft:cobblestone_slab", "minecraft:cut_copper_slab", "minecraft:cut_red_sandstone_slab", "minecraft:cut_sandstone_slab", "minecraft:dark_prismarine_slab", "minecraft:deepslate_brick_slab", "minecraft:deepslate_tile_slab", "minecraft:diorite_slab", "minecraft:end_stone_brick_slab", "minecraft:exposed_cut_copper_slab", "minecraft:granite_slab", "minecraft:mossy_cobblestone_slab", "minecraft:mossy_stone_brick_slab", "minecraft:mud_brick_slab", "minecraft:nether_brick_slab", "minecraft:oxidized_cut_copper_slab", "minecraft:petrified_oak_slab", "minecraft:polished_andesite_slab", "minecraft:polished_blackstone_brick_slab", "minecraft:polished_blackstone_slab", "minecraft:polished_deepslate_slab", "minecraft:polished_diorite_slab", "minecraft:polished_granite_slab", "minecraft:polished_tuff_slab", "minecraft:prismarine_brick_slab", "minecraft:prismarine_slab", "minecraft:purpur_slab", "minecraft:quartz_slab", "minecraft:red_nether_brick_slab", "minecraft:red_sandstone_slab", "minecraft:sandstone_slab", "minecraft:smooth_quartz_slab", "minecraft:smooth_red_sandstone_slab", "minecraft:smooth_sandstone_slab", "minecraft:smooth_stone_slab", "minecraft:stone_brick_slab", "minecraft:stone_slab", "minecraft:tuff_brick_slab", "minecraft:tuff_slab", "minecraft:waxed_cut_copper_slab", "minecraft:waxed_exposed_cut_copper_slab", "minecraft:waxed_oxidized_cut_copper_slab", "minecraft:waxed_weathered_cut_copper_slab", "minecraft:weathered_cut_copper_slab"}},
+	{Item, "minecraft:small_flowers", []string{"minecraft:dandelion", "minecraft:poppy", "minecraft:blue_orchid", "minecraft:allium", "minecraft:azure_bluet", "minecraft:red_tulip", "minecraft:orange_tulip", "minecraft:white_tulip", "minecraft:pink_tulip", "minecraft:oxeye_daisy", "minecraft:cornflower", "minecraft:lily_of_the_valley", "minecraft:wither_rose", "minecraft:torchflower"}},
+	{Item, "minecraft:smelts_to_glass", []string{"minecraft:sand", "minecraft:red_sand"}},
+	{Item, "minecraft:sniffer_food", []string{"minecraft:torchflower_seeds"}},
+	{Item, "minecraft:soul_fire_base_blocks", []string{"minecraft:soul_sand", "minecraft:soul_soil"}},
+	{Item, "minecraft:spruce_logs", []string{"minecraft:spruce_log", "minecraft:spruce_wood", "minecraft:stripped_spruce_log", "minecraft:stripped_spruce_wood"}},
+	{Item, "minecraft:stairs", []string{"#minecraft:wooden_stairs", "minecraft:andesite_stairs", "minecraft:bamboo_mosaic_stairs", "minecraft:blackstone_stairs", "minecraft:brick_stairs", "minecraft:cobbled_deepslate_stairs", "minecraft:cobblestone_stairs", "minecraft:cut_copper_stairs", "minecraft:dark_prismarine_stairs", "minecraft:deepslate_brick_stairs", "minecraft:deepslate_tile_stairs", "minecraft:diorite_stairs", "minecraft:end_stone_brick_stairs", "minecraft:exposed_cut_copper_stairs", "minecraft:granite_stairs", "minecraft:mossy_cobblestone_stairs", "minecraft:mossy_stone_brick_stairs", "minecraft:mud_brick_stairs", "minecraft:nether_brick_stairs", "minecraft:oxidized_cut_copper_stairs", "minecraft:polished_andesite_stairs", "minecraft:polished_blackstone_brick_stairs", "minecraft:polished_blackstone_stairs", "minecraft:polished_deepslate_stairs", "minecraft:polished_diorite_stairs", "minecraft:polished_granite_stairs", "minecraft:polished_tuff_stairs", "minecraft:prismarine_brick_stairs", "minecraft:prismarine_stairs", "minecraft:purpur_stairs", "minecraft:quartz_stairs", "minecraft:red_nether_brick_stairs", "minecraft:red_sandstone_stairs", "minecraft:sandstone_stairs", "minecraft:smooth_quartz_stairs", "minecraft:smooth_red_sandstone_stairs", "minecraft:smooth_sandstone_stairs", "minecraft:stone_brick_stairs", "minecraft:stone_stairs", "minecraft:tuff_brick_stairs", "minecraft:tuff_stairs", "minecraft:waxed_cut_copper_stairs", "minecraft:waxed_exposed_cut_copper_stairs", "minecraft:waxed_oxidized_cut_copper_stairs", "minecraft:waxed_weathered_cut_copper_stairs", "minecraft:weathered_cut_copper_stairs"}},
+	{Item, "minecraft:stone_bricks", []string{"minecraft:stone_bricks", "minecraft:mossy_stone_bricks", "minecraft:cracked_stone_bricks", "minecraft:chiseled_stone_bricks"}},
+	{Item, "minecraft:stone_buttons", []string{"minecraft:stone_button", "minecraft:polished_blackstone_button"}},
+	{Item, "minecraft:stone_crafting_materials", []string{"minecraft:cobblestone", "minecraft:blackstone", "minecraft:cobbled_deepslate"}},
+	{Item, "minecraft:stone_tool_materials", []string{"minecraft:cobblestone", "minecraft:blackstone", "minecraft:cobbled_deepslate"}},
+	{Item, "minecraft:strider_food", []string{"minecraft:warped_fungus"}},
+	{Item, "minecraft:strider_tempt_items", []string{"#minecraft:strider_food", "minecraft:warped_fungus_on_a_stick"}},
+	{Item, "minecraft:swords", []string{"minecraft:wooden_sword", "minecraft:stone_sword", "minecraft:iron_sword", "minecraft:golden_sword", "minecraft:diamond_sword", "minecraft:netherite_sword"}},
+	{Item, "minecraft:tall_flowers", []string{"minecraft:sunflower", "minecraft:lilac", "minecraft:peony", "minecraft:rose_bush", "minecraft:pitcher_plant"}},
+	{Item, "minecraft:terracotta", []string{"minecraft:terracotta", "minecraft:white_terracotta", "minecraft:orange_terracotta", "minecraft:magenta_terracotta", "minecraft:light_blue_terracotta", "minecraft:yellow_terracotta", "minecraft:lime_terracotta", "minecraft:pink_terracotta", "minecraft:gray_terracotta", "minecraft:light_gray_terracotta", "minecraft:cyan_terracotta", "minecraft:purple_terracotta", "minecraft:blue_terracotta", "minecraft:brown_terracotta", "minecraft:green_terracotta", "minecraft:red_terracotta", "minecraft:black_terracotta"}},
+	{Item, "minecraft:trapdoors", []string{"#minecraft:wooden_trapdoors", "minecraft:iron_trapdoor", "minecraft:copper_trapdoor", "minecraft:exposed_copper_trapdoor", "minecraft:weathered_copper_trapdoor", "minecraft:oxidized_copper_trapdoor", "minecraft:waxed_copper_trapdoor", "minecraft:waxed_exposed_copper_trapdoor", "minecraft:waxed_weathered_copper_trapdoor", "minecraft:waxed_oxidized_copper_trapdoor"}},
+	{Item, "minecraft:trim_materials", []string{"minecraft:amethyst_shard", "minecraft:copper_ingot", "minecraft:diamond", "minecraft:emerald", "minecraft:gold_ingot", "minecraft:iron_ingot", "minecraft:lapis_lazuli", "minecraft:netherite_ingot", "minecraft:quartz", "minecraft:redstone"}},
+	{Item, "minecraft:trim_templates", []string{"minecraft:bolt_armor_trim_smithing_template", "minecraft:coast_armor_trim_smithing_template", "minecraft:dune_armor_trim_smithing_template", "minecraft:eye_armor_trim_smithing_template", "minecraft:flow_armor_trim_smithing_template", "minecraft:host_armor_trim_smithing_template", "minecraft:raiser_armor_trim_smithing_template", "minecraft:rib_armor_trim_smithing_template", "minecraft:sentry_armor_trim_smithing_template", "minecraft:shaper_armor_trim_smithing_template", "minecraft:silence_armor_trim_smithing_template", "minecraft:snout_armor_trim_smithing_template", "minecraft:spire_armor_trim_smithing_template", "minecraft:tide_armor_trim_smithing_template", "minecraft:vex_armor_trim_smithing_template", "minecraft:ward_armor_trim_smithing_template", "minecraft:wayfinder_armor_trim_smithing_template", "minecraft:wild_armor_trim_smithing_template"}},
+	{Item, "minecraft:trimmable_armor", []string{"#minecraft:foot_armor", "#minecraft:leg_armor", "#minecraft:chest_armor", "#minecraft:head_armor"}},
+	{Item, "minecraft:turtle_food", []string{"minecraft:seagrass"}},
+	{Item, "minecraft:villager_plantable_seeds", []string{"minecraft:wheat_seeds", "minecraft:potato", "minecraft:carrot", "minecraft:beetroot_seeds", "minecraft:torchflower_seeds", "minecraft:pitcher_pod"}},
+	{Item, "minecraft:walls", []string{"minecraft:andesite_wall", "minecraft:blackstone_wall", "minecraft:brick_wall", "minecraft:cobbled_deepslate_wall", "minecraft:cobblestone_wall", "minecraft:deepslate_brick_wall", "minecraft:deepslate_tile_wall", "minecraft:diorite_wall", "minecraft:end_stone_brick_wall", "minecraft:granite_wall", "minecraft:mossy_cobblestone_wall", "minecraft:mossy_stone_brick_wall", "minecraft:mud_brick_wall", "minecraft:nether_brick_wall", "minecraft:polished_blackstone_brick_wall", "minecraft:polished_blackstone_wall", "minecraft:polished_deepslate_wall", "minecraft:polished_tuff_wall", "minecraft:prismarine_wall", "minecraft:red_nether_brick_wall", "minecraft:red_sandstone_wall", "minecraft:sandstone_wall", "minecraft:stone_brick_wall", "minecraft:tuff_brick_wall", "minecraft:tuff_wall"}},
+	{Item, "minecraft:warped_stems", []string{"minecraft:warped_stem", "minecraft:stripped_warped_stem", "minecraft:warped_hyphae", "minecraft:stripped_warped_hyphae"}},
+	{Item, "minecraft:wart_blocks", []string{"minecraft:nether_wart_block", "minecraft:warped_wart_block"}},
+	{Item, "minecraft:wolf_food", []string{"#minecraft:meat", "minecraft:cod", "minecraft:cooked_cod", "minecraft:salmon", "minecraft:cooked_salmon", "minecraft:tropical_fish", "minecraft:pufferfish", "minecraft:rabbit_stew"}},
+	{Item, "minecraft:wooden_buttons", []string{"minecraft:oak_button", "minecraft:spruce_button", "minecraft:birch_button", "minecraft:jungle_button", "minecraft:acacia_button", "minecraft:dark_oak_button", "minecraft:crimson_button", "minecraft:warped_button", "minecraft:mangrove_button", "minecraft:bamboo_button", "minecraft:cherry_button"}},
+	{Item, "minecraft:wooden_doors", []string{"minecraft:oak_door", "minecraft:spruce_door", "minecraft:birch_door", "minecraft:jungle_door", "minecraft:acacia_door", "minecraft:dark_oak_door", "minecraft:crimson_door", "minecraft:warped_door", "minecraft:mangrove_door", "minecraft:bamboo_door", "minecraft:cherry_door"}},
+	{Item, "minecraft:wooden_fences", []string{"minecraft:oak_fence", "minecraft:spruce_fence", "minecraft:birch_fence", "minecraft:jungle_fence", "minecraft:acacia_fence", "minecraft:dark_oak_fence", "minecraft:crimson_fence", "minecraft:warped_fence", "minecraft:mangrove_fence", "minecraft:bamboo_fence", "minecraft:cherry_fence"}},
+	{Item, "minecraft:wooden_pressure_plates", []string{"minecraft:oak_pressure_plate", "minecraft:spruce_pressure_plate", "minecraft:birch_pressure_plate", "minecraft:jungle_pressure_plate", "minecraft:acacia_pressure_plate", "minecraft:dark_oak_pressure_plate", "minecraft:crimson_pressure_plate", "minecraft:warped_pressure_plate", "minecraft:mangrove_pressure_plate", "minecraft:bamboo_pressure_plate", "minecraft:cherry_pressure_plate"}},
+	{Item, "minecraft:wooden_slabs", []string{"minecraft:oak_slab", "minecraft:spruce_slab", "minecraft:birch_slab", "minecraft:jungle_slab", "minecraft:acacia_slab", "minecraft:dark_oak_slab", "minecraft:crimson_slab", "minecraft:warped_slab", "minecraft:mangrove_slab", "minecraft:bamboo_slab", "minecraft:cherry_slab"}},
+	{Item, "minecraft:wooden_stairs", []string{"minecraft:oak_stairs", "minecraft:spruce_stairs", "minecraft:birch_stairs", "minecraft:jungle_stairs", "minecraft:acacia_stairs", "minecraft:dark_oak_stairs", "minecraft:crimson_stairs", "minecraft:warped_stairs", "minecraft:mangrove_stairs", "minecraft:bamboo_stairs", "minecraft:cherry_stairs"}},
+	{Item, "minecraft:wooden_trapdoors", []string{"minecraft:oak_trapdoor", "minecraft:spruce_trapdoor", "minecraft:birch_trapdoor", "minecraft:jungle_trapdoor", "minecraft:acacia_trapdoor", "minecraft:dark_oak_trapdoor", "minecraft:crimson_trapdoor", "minecraft:warped_trapdoor", "minecraft:mangrove_trapdoor", "minecraft:bamboo_trapdoor", "minecraft:cherry_trapdoor"}},
+	{Item, "minecraft:wool", []string{"minecraft:white_wool", "minecraft:orange_wool", "minecraft:magenta_wool", "minecraft:light_blue_wool", "minecraft:yellow_wool", "minecraft:lime_wool", "minecraft:pink_wool", "minecraft:gray_wool", "minecraft:light_gray_wool", "minecraft:cyan_wool", "minecraft:purple_wool", "minecraft:blue_wool", "minecraft:brown_wool", "minecraft:green_wool", "minecraft:red_wool", "minecraft:black_wool"}},
+	{Item, "minecraft:wool_carpets", []string{"minecraft:white_carpet", "minecraft:orange_carpet", "minecraft:magenta_carpet", "minecraft:light_blue_carpet", "minecraft:yellow_carpet", "minecraft:lime_carpet", "minecraft:pink_carpet", "minecraft:gray_carpet", "minecraft:light_gray_carpet", "minecraft:cyan_carpet", "minecraft:purple_carpet", "minecraft:blue_carpet", "minecraft:brown_carpet", "minecraft:green_carpet", "minecraft:red_carpet", "minecraft:black_carpet"}},
+	{Fluid, "minecraft:lava", []string{"minecraft:lava", "minecraft:flowing_lava"}},
+	{Fluid, "minecraft:water", []string{"minecraft:water", "minecraft:flowing_water"}},
+	{EntityType, "minecraft:aquatic", []string{"minecraft:turtle", "minecraft:axolotl", "minecraft:guardian", "minecraft:elder_guardian", "minecraft:cod", "minecraft:pufferfish", "minecraft:salmon", "minecraft:tropical_fish", "minecraft:dolphin", "minecraft:squid", "minecraft:glow_squid", "minecraft:tadpole"}},
+	{EntityType, "minecraft:arrows", []string{"minecraft:arrow", "minecraft:spectral_arrow"}},
+	{EntityType, "minecraft:arthropod", []string{"minecraft:bee", "minecraft:endermite", "minecraft:silverfish", "minecraft:spider", "minecraft:cave_spider"}},
+	{EntityType, "minecraft:axolotl_always_hostiles", []string{"minecraft:drowned", "minecraft:guardian", "minecraft:elder_guardian"}},
+	{EntityType, "minecraft:axolotl_hunt_targets", []string{"minecraft:tropical_fish", "minecraft:pufferfish", "minecraft:salmon", "minecraft:cod", "minecraft:squid", "minecraft:glow_squid", "minecraft:tadpole"}},
+	{EntityType, "minecraft:beehive_inhabitors", []string{"minecraft:bee"}},
+	{EntityType, "minecraft:can_breathe_under_water", []string{"#minecraft:undead", "minecraft:axolotl", "minecraft:frog", "minecraft:guardian", "minecraft:elder_guardian", "minecraft:turtle", "minecraft:glow_squid", "minecraft:cod", "minecraft:pufferfish", "minecraft:salmon", "minecraft:squid", "minecraft:tropical_fish", "minecraft:tadpole", "minecraft:armor_stand"}},
+	{EntityType, "minecraft:can_turn_in_boats", []string{"minecraft:breeze"}},
+	{EntityType, "minecraft:deflects_projectiles", []string{"minecraft:breeze"}},
+	{EntityType, "minecraft:dismounts_underwater", []string{"minecraft:camel", "minecraft:chicken", "minecraft:donkey", "minecraft:horse", "minecraft:llama", "minecraft:mule", "minecraft:pig", "minecraft:ravager", "minecraft:spider", "minecraft:strider", "minecraft:trader_llama", "minecraft:zombie_horse"}},
+	{EntityType, "minecraft:fall_damage_immune", []string{"minecraft:iron_golem", "minecraft:snow_golem", "minecraft:shulker", "minecraft:allay", "minecraft:bat", "minecraft:bee", "minecraft:blaze", "minecraft:cat", "minecraft:chicken", "minecraft:ghast", "minecraft:phantom", "minecraft:magma_cube", "minecraft:ocelot", "minecraft:parrot", "minecraft:wither", "minecraft:breeze"}},
+	{EntityType, "minecraft:freeze_hurts_extra_types", []string{"minecraft:strider", "minecraft:blaze", "minecraft:magma_cube"}},
+	{EntityType, "minecraft:freeze_immune_entity_types", []string{"minecraft:stray", "minecraft:polar_bear", "minecraft:snow_golem", "minecraft:wither"}},
+	{EntityType, "minecraft:frog_food", []string{"minecraft:slime", "minecraft:magma_cube"}},
+	{EntityType, "minecraft:ignores_poison_and_regen", []string{"#minecraft:undead"}},
+	{EntityType, "minecraft:illager", []string{"minecraft:evoker", "minecraft:illusioner", "minecraft:pillager", "minecraft:vindicator"}},
+	{EntityType, "minecraft:illager_friends", []string{"#minecraft:illager"}},
+	{EntityType, "minecraft:immune_to_infested", []string{"minecraft:silverfish"}},
+	{EntityType, "minecraft:immune_to_oozing", []string{"minecraft:slime"}},
+	{EntityType, "minecraft:impact_projectiles", []string{"#minecraft:arrows", "minecraft:firework_rocket", "minecraft:snowball", "minecraft:fireball", "minecraft:small_fireball", "minecraft:egg", "minecraft:trident", "minecraft:dragon_fireball", "minecraft:wither_skull", "minecraft:wind_charge", "minecraft:breeze_wind_charge"}},
+	{EntityType, "minecraft:inverted_healing_and_harm", []string{"#minecraft:undead"}},
+	{EntityType, "minecraft:no_anger_from_wind_charge", []string{"minecraft:breeze", "minecraft:skeleton", "minecraft:bogged", "minecraft:stray", "minecraft:zombie", "minecraft:husk", "minecraft:spider", "minecraft:cave_spider", "minecraft:slime"}},
+	{EntityType, "minecraft:non_controlling_rider", []string{"minecraft:slime", "minecraft:magma_cube"}},
+	{EntityType, "minecraft:powder_snow_walkable_mobs", []string{"minecraft:rabbit", "minecraft:endermite", "minecraft:silverfish", "minecraft:fox"}},
+	{EntityType, "minecraft:raiders", []string{"minecraft:evoker", "minecraft:pillager", "minecraft:ravager", "minecraft:vindicator", "minecraft:illusioner", "minecraft:witch"}},
+	{EntityType, "minecraft:redirectable_projectile", []string{"minecraft:fireball", "minecraft:wind_charge", "minecraft:breeze_wind_charge"}},
+	{EntityType, "minecraft:sensitive_to_bane_of_arthropods", []string{"#minecraft:arthropod"}},
+	{EntityType, "minecraft:sensitive_to_impaling", []string{"#minecraft:aquatic"}},
+	{EntityType, "minecraft:sensitive_to_smite", []string{"#minecraft:undead"}},
+	{EntityType, "minecraft:skeletons", []string{"minecraft:skeleton", "minecraft:stray", "minecraft:wither_skeleton", "minecraft:skeleton_horse", "minecraft:bogged"}},
+	{EntityType, "minecraft:undead", []string{"#minecraft:skeletons", "#minecraft:zombies", "minecraft:wither", "minecraft:phantom"}},
+	{EntityType, "minecraft:wither_friends", []string{"#minecraft:undead"}},
+	{EntityType, "minecraft:zombies", []string{"minecraft:zombie_horse", "minecraft:zombie", "minecraft:zombie_villager", "minecraft:zombified_piglin", "minecraft:zoglin", "minecraft:drowned", "minecraft:husk"}},
+	{GameEvent, "minecraft:allay_can_listen", []string{"minecraft:note_block_play"}},
+	{GameEvent, "minecraft:ignore_vibrations_sneaking", []string{"minecraft:hit_ground", "minecraft:projectile_shoot", "minecraft:step", "minecraft:swim", "minecraft:item_interact_start", "minecraft:item_interact_finish"}},
+	{GameEvent, "minecraft:shrieker_can_listen", []string{"minecraft:sculk_sensor_tendrils_clicking"}},
+	{GameEvent, "minecraft:vibrations", []string{"minecraft:block_attach", "minecraft:block_change", "minecraft:block_close", "minecraft:block_destroy", "minecraft:block_detach", "minecraft:block_open", "minecraft:block_place", "minecraft:block_activate", "minecraft:block_deactivate", "minecraft:container_close", "minecraft:container_open", "minecraft:drink", "minecraft:eat", "minecraft:elytra_glide", "minecraft:entity_damage", "minecraft:entity_die", "minecraft:entity_dismount", "minecraft:entity_interact", "minecraft:entity_mount", "minecraft:entity_place", "minecraft:entity_action", "minecraft:equip", "minecraft:explode", "minecraft:fluid_pickup", "minecraft:fluid_place", "minecraft:hit_ground", "minecraft:instrument_play", "minecraft:item_interact_finish", "minecraft:lightning_strike", "minecraft:note_block_play", "minecraft:prime_fuse", "minecraft:projectile_land", "minecraft:projectile_shoot", "minecraft:shear", "minecraft:splash", "minecraft:step", "minecraft:swim", "minecraft:teleport", "minecraft:unequip", "minecraft:resonate_1", "minecraft:resonate_2", "minecraft:resonate_3", "minecraft:resonate_4", "minecraft:resonate_5", "minecraft:resonate_6", "minecraft:resonate_7", "minecraft:resonate_8", "minecraft:resonate_9", "minecraft:resonate_10", "minecraft:resonate_11", "minecraft:resonate_12", "minecraft:resonate_13", "minecraft:resonate_14", "minecraft:resonate_15", "minecraft:flap"}},
+	{GameEvent, "minecraft:warden_can_listen", []string{"minecraft:block_attach", "minecraft:block_change", "minecraft:block_close", "minecraft:block_destroy", "minecraft:block_detach", "minecraft:block_open", "minecraft:block_place", "minecraft:block_activate", "minecraft:block_deactivate", "minecraft:container_close", "minecraft:container_open", "minecraft:drink", "minecraft:eat", "minecraft:elytra_glide", "minecraft:entity_damage", "minecraft:entity_die", "minecraft:entity_dismount", "minecraft:entity_interact", "minecraft:entity_mount", "minecraft:entity_place", "minecraft:entity_action", "minecraft:equip", "minecraft:explode", "minecraft:fluid_pickup", "minecraft:fluid_place", "minecraft:hit_ground", "minecraft:instrument_play", "minecraft:item_interact_finish", "minecraft:lightning_strike", "minecraft:note_block_play", "minecraft:prime_fuse", "minecraft:projectile_land", "minecraft:projectile_shoot", "minecraft:shear", "minecraft:splash", "minecraft:step", "minecraft:swim", "minecraft:teleport", "minecraft:unequip", "minecraft:resonate_1", "minecraft:resonate_2", "minecraft:resonate_3", "minecraft:resonate_4", "minecraft:resonate_5", "minecraft:resonate_6", "minecraft:resonate_7", "minecraft:resonate_8", "minecraft:resonate_9", "minecraft:resonate_10", "minecraft:resonate_11", "minecraft:resonate_12", "minecraft:resonate_13", "minecraft:resonate_14", "minecraft:resonate_15", "minecraft:shriek", "#minecraft:shrieker_can_listen"}},
+}
@@ -15,6 +15,11 @@ var ErrPacketTooBig = errors.New("packet too big")
 type TransportConfig struct {
 	MaxPacketLen       int32
 	MaxDecompressedLen int32
+
+	// Stats, if set, receives the traffic counters of the Transport,
+	// such as the stats a Server tracks for a connection. The Transport
+	// counts into stats of its own if nil.
+	Stats *TransportStats
 }
 
 type byteReader interface {
@@ -45,7 +50,13 @@ type Transport struct {
 	encryption           bool
 	encryptionSecret     []byte
 
-	cfg TransportConfig
+	cfg   TransportConfig
+	stats *TransportStats
+
+	tap       FrameTap
+	tapIn     []byte
+	tapOut    []byte
+	tapReader bytes.Reader
 }
 
 // NewTransport creates a Transport.
@@ -70,27 +81,76 @@ func NewTransport(r io.Reader, w io.Writer, cfg TransportConfig) Transport {
 		bw = bufio.NewWriter(w)
 	}
 
+	stats := cfg.Stats
+	if stats == nil {
+		stats = &TransportStats{}
+	}
+
 	t := Transport{
 		reader:               br,
 		writer:               bw,
 		fReader:              FrameReader{br, 0},
 		CompressionThreshold: -1,
 		cfg:                  cfg,
+		stats:                stats,
 	}
 
 	return t
 }
 
+// Stats returns the traffic counters of t.
+func (t *Transport) Stats() *TransportStats {
+	return t.stats
+}
+
 func (t *Transport) Recv() (r PayloadReader, err error) {
+	r, err = t.recv()
+	t.stats.countErr(err)
+	return
+}
+
+// SetTap installs tap to observe every frame received or sent by t.
+// A nil tap removes it.
+func (t *Transport) SetTap(tap FrameTap) {
+	t.tap = tap
+}
+
+func (t *Transport) recv() (r PayloadReader, err error) {
+	if t.fReader.remaining <= 0 {
+		t.fReader.src = t.reader
+	}
+
 	frameLength, err := t.fReader.Next()
 	if err != nil {
 		return nil, err
 	}
 
+	t.stats.FramesIn.Add(1)
+	t.stats.BytesIn.Add(uint64(frameLength) + uint64(varIntLen(frameLength)))
+
 	if frameLength > t.cfg.MaxPacketLen {
 		return nil, ErrPacketTooBig
 	}
 
+	if t.tap != nil {
+		// Buffer the whole frame for the tap, then decode from the buffer.
+		if cap(t.tapIn) < int(frameLength) {
+			t.tapIn = make([]byte, frameLength)
+		}
+		frame := t.tapIn[:frameLength]
+		if _, err = io.ReadFull(t.reader, frame); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			t.fReader.remaining = 0
+			return nil, err
+		}
+		t.tap.TapFrame(false, t.CompressionThreshold, frame)
+
+		t.tapReader.Reset(frame)
+		t.fReader.src = &t.tapReader
+	}
+
 	r = plainPayload{&t.fReader}
 
 	decompressedLen := int32(0)
@@ -115,13 +175,19 @@ func (t *Transport) Recv() (r PayloadReader, err error) {
 				return nil, err
 			}
 
-			r = &compressedPayload{t.zReader, &t.fReader, decompressedLen}
+			r = &compressedPayload{t.zReader, &t.fReader, decompressedLen, t.stats}
+
+			t.stats.CompressedIn.Add(1)
+			t.stats.CompressedWireBytesIn.Add(uint64(frameLength))
+			t.stats.CompressedRawBytesIn.Add(uint64(decompressedLen))
+			return r, err
 
 		} else if decompressedLen < 0 {
 			return nil, errors.New("invalid data length")
 		}
 	}
 
+	t.stats.UncompressedIn.Add(1)
 	return r, err
 }
 
@@ -130,6 +196,7 @@ func (t *Transport) Send(b []byte) error {
 
 	if t.CompressionThreshold >= 0 {
 		if length >= t.CompressionThreshold {
+			rawLength := length
 			t.zBuffer.Reset()
 			if t.zWriter == nil {
 				t.zWriter = zlib.NewWriter(&t.zBuffer)
@@ -141,17 +208,35 @@ func (t *Transport) Send(b []byte) error {
 			t.zWriter.Write(b)
 			t.zWriter.Close()
 
+			if t.tap != nil {
+				t.tap.TapFrame(true, t.CompressionThreshold, t.zBuffer.Bytes())
+			}
+
 			length = t.zBuffer.Len()
 			err := packet.WriteVarInt(t.writer, int32(length))
 			if err != nil {
 				return err
 			}
 			_, err = t.zBuffer.WriteTo(t.writer)
+			if err == nil {
+				err = t.flush()
+			}
+			if err == nil {
+				t.countSent(length)
+				t.stats.CompressedOut.Add(1)
+				t.stats.CompressedRawBytesOut.Add(uint64(rawLength))
+				t.stats.CompressedWireBytesOut.Add(uint64(length))
+			}
 			return err
 
 		} else {
 			length += 1
 
+			if t.tap != nil {
+				t.tapOut = append(append(t.tapOut[:0], 0), b...)
+				t.tap.TapFrame(true, t.CompressionThreshold, t.tapOut)
+			}
+
 			err := packet.WriteVarInt(t.writer, int32(length))
 			if err != nil {
 				return err
@@ -162,10 +247,21 @@ func (t *Transport) Send(b []byte) error {
 			}
 
 			_, err = t.writer.Write(b)
+			if err == nil {
+				err = t.flush()
+			}
+			if err == nil {
+				t.countSent(length)
+				t.stats.UncompressedOut.Add(1)
+			}
 			return err
 		}
 	}
 
+	if t.tap != nil {
+		t.tap.TapFrame(true, t.CompressionThreshold, b)
+	}
+
 	err := packet.WriteVarInt(t.writer, int32(length))
 	if err != nil {
 		return err
@@ -175,12 +271,28 @@ func (t *Transport) Send(b []byte) error {
 		return err
 	}
 
-	if bw, ok := t.writer.(*bufio.Writer); ok {
-		err = bw.Flush()
+	err = t.flush()
+	if err == nil {
+		t.countSent(length)
+		t.stats.UncompressedOut.Add(1)
 	}
 	return err
 }
 
+// flush pushes out frames buffered by the bufio.Writer NewTransport
+// wrapped the writer with, if any.
+func (t *Transport) flush() error {
+	if bw, ok := t.writer.(*bufio.Writer); ok {
+		return bw.Flush()
+	}
+	return nil
+}
+
+func (t *Transport) countSent(frameLength int) {
+	t.stats.FramesOut.Add(1)
+	t.stats.BytesOut.Add(uint64(frameLength) + uint64(varIntLen(int32(frameLength))))
+}
+
 func (t *Transport) EnableEncryption(secret []byte) {
 	t.encryptionSecret = secret
 	t.encryption = true
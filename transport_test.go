@@ -609,3 +609,96 @@ func TestTransport_RecvCapturedPacket(t *testing.T) {
 		t.Errorf("got %q, want %q", got, want)
 	}
 }
+
+// TestTransport_Stats verifies that frame, byte and compression counters
+// reflect traffic on both directions of a transport.
+func TestTransport_Stats(t *testing.T) {
+	var buf bytes.Buffer
+	tr := NewTransport(&buf, &buf, defaultConfig())
+	tr.CompressionThreshold = 50
+
+	packets := [][]byte{
+		[]byte("short"),
+		bytes.Repeat([]byte("a"), 100),
+	}
+
+	for _, p := range packets {
+		if err := tr.Send(p); err != nil {
+			t.Fatalf("Send: %v", err)
+		}
+	}
+	wireLen := uint64(buf.Len())
+
+	for i := range packets {
+		pr, err := tr.Recv()
+		if err != nil {
+			t.Fatalf("Recv[%d]: %v", i, err)
+		}
+		if _, err := io.ReadAll(pr); err != nil {
+			t.Fatalf("ReadAll[%d]: %v", i, err)
+		}
+		if err := pr.Close(); err != nil {
+			t.Fatalf("Close[%d]: %v", i, err)
+		}
+	}
+
+	v := tr.Stats().Snapshot()
+	if v.FramesIn != 2 || v.FramesOut != 2 {
+		t.Errorf("frames: got in=%d out=%d, want 2, 2", v.FramesIn, v.FramesOut)
+	}
+	if v.BytesIn != wireLen || v.BytesOut != wireLen {
+		t.Errorf("bytes: got in=%d out=%d, want %d", v.BytesIn, v.BytesOut, wireLen)
+	}
+	if v.CompressedIn != 1 || v.UncompressedIn != 1 {
+		t.Errorf("inbound: got compressed=%d uncompressed=%d, want 1, 1", v.CompressedIn, v.UncompressedIn)
+	}
+	if v.CompressedRawBytesOut != 100 || v.CompressedRawBytesIn != 100 {
+		t.Errorf("raw bytes: got in=%d out=%d, want 100", v.CompressedRawBytesIn, v.CompressedRawBytesOut)
+	}
+	if r := v.CompressionRatioIn(); r <= 0 || r >= 1 {
+		t.Errorf("CompressionRatioIn: got %f, want in (0, 1)", r)
+	}
+}
+
+// TestTransport_StatsErrors verifies that decode errors are counted by kind.
+func TestTransport_StatsErrors(t *testing.T) {
+	var buf, frameBuf bytes.Buffer
+	cfg := TransportConfig{
+		MaxPacketLen:       100,
+		MaxDecompressedLen: 200,
+	}
+	tr := NewTransport(&buf, &buf, cfg)
+
+	if err := tr.Send(make([]byte, 200)); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if _, err := tr.Recv(); err != ErrPacketTooBig {
+		t.Fatalf("Recv: got %v, want ErrPacketTooBig", err)
+	}
+
+	buf.Reset()
+	tr = NewTransport(&buf, &buf, defaultConfig())
+	tr.CompressionThreshold = 10
+
+	payload := bytes.Repeat([]byte("compressed data "), 10)
+	packet.WriteVarInt(&frameBuf, int32(len(payload)))
+	frameBuf.Write(compress(payload))
+	frameBuf.Write([]byte("trailing data"))
+	packet.WriteVarInt(&buf, int32(frameBuf.Len()))
+	frameBuf.WriteTo(&buf)
+
+	pr, err := tr.Recv()
+	if err != nil {
+		t.Fatalf("Recv: %v", err)
+	}
+	io.ReadAll(pr)
+	pr.Close()
+
+	v := tr.Stats().Snapshot()
+	if v.ErrZlibTrailingData != 1 {
+		t.Errorf("ErrZlibTrailingData: got %d, want 1", v.ErrZlibTrailingData)
+	}
+	if v.ErrOther != 0 {
+		t.Errorf("ErrOther: got %d, want 0", v.ErrOther)
+	}
+}
@@ -0,0 +1,229 @@
+package vanilla
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrUnknownBlock = errors.New("vanilla: unknown block")
+	ErrInvalidState = errors.New("vanilla: invalid block state")
+)
+
+// Property is a block state property and its values, in state order.
+type Property struct {
+	Name   string
+	Values []string
+}
+
+// Block is a block type and its states.
+//
+// The states of a block are numbered from MinState through every
+// combination of property values, properties sorted by name, the first
+// most significant: the states of a block with properties a, b of 2 and 3
+// values are a0b0, a0b1, a0b2, a1b0 and so on.
+type Block struct {
+	Name       string // Such as "minecraft:oak_log".
+	ID         int32  // ID in the minecraft:block registry.
+	Properties []Property
+
+	MinState, MaxState int32
+	DefaultState       int32
+}
+
+var blocksByName = func() map[string]*Block {
+	m := make(map[string]*Block, len(blocks))
+	for i := range blocks {
+		m[blocks[i].Name] = &blocks[i]
+	}
+	return m
+}()
+
+// BlockByName returns the block name, such as "minecraft:stone".
+func BlockByName(name string) (*Block, bool) {
+	b, ok := blocksByName[name]
+	return b, ok
+}
+
+// BlockOf returns the block of a state.
+func BlockOf(state int32) (*Block, bool) {
+	i := sort.Search(len(blocks), func(i int) bool { return blocks[i].MaxState >= state })
+	if i == len(blocks) || state < blocks[i].MinState {
+		return nil, false
+	}
+	return &blocks[i], true
+}
+
+// NumBlocks returns the number of blocks.
+func NumBlocks() int {
+	return len(blocks)
+}
+
+// NumStates returns the number of block states.
+func NumStates() int {
+	return int(blocks[len(blocks)-1].MaxState) + 1
+}
+
+// indices returns the value indices of state, which must be of b.
+func (b *Block) indices(state int32) []int {
+	idx := make([]int, len(b.Properties))
+	rem := int(state - b.MinState)
+	for i := len(b.Properties) - 1; i >= 0; i-- {
+		n := len(b.Properties[i].Values)
+		idx[i], rem = rem%n, rem/n
+	}
+	return idx
+}
+
+func (b *Block) state(idx []int) (state int32) {
+	for i, p := range b.Properties {
+		state = state*int32(len(p.Values)) + int32(idx[i])
+	}
+	return b.MinState + state
+}
+
+func (b *Block) has(state int32) bool {
+	return state >= b.MinState && state <= b.MaxState
+}
+
+// State returns the state of b with properties props, those absent taking
+// the values of the default state. It fails on unknown properties or
+// values.
+func (b *Block) State(props map[string]string) (int32, bool) {
+	state := b.DefaultState
+	for name, value := range props {
+		var ok bool
+		if state, ok = b.With(state, name, value); !ok {
+			return 0, false
+		}
+	}
+	return state, true
+}
+
+// With returns state, a state of b, with property name set to value.
+func (b *Block) With(state int32, name, value string) (int32, bool) {
+	if !b.has(state) {
+		return 0, false
+	}
+	for i, p := range b.Properties {
+		if p.Name != name {
+			continue
+		}
+		for j, v := range p.Values {
+			if v == value {
+				idx := b.indices(state)
+				idx[i] = j
+				return b.state(idx), true
+			}
+		}
+		return 0, false
+	}
+	return 0, false
+}
+
+// Value returns the value of property name in state, a state of b.
+func (b *Block) Value(state int32, name string) (string, bool) {
+	if !b.has(state) {
+		return "", false
+	}
+	for i, p := range b.Properties {
+		if p.Name == name {
+			return p.Values[b.indices(state)[i]], true
+		}
+	}
+	return "", false
+}
+
+// Values returns the properties of state, a state of b, or nil if b has
+// no properties.
+func (b *Block) Values(state int32) map[string]string {
+	if !b.has(state) || len(b.Properties) == 0 {
+		return nil
+	}
+	props := make(map[string]string, len(b.Properties))
+	for i, j := range b.indices(state) {
+		props[b.Properties[i].Name] = b.Properties[i].Values[j]
+	}
+	return props
+}
+
+// StateID returns the state of block name with properties props, see
+// Block.State.
+func StateID(name string, props map[string]string) (int32, bool) {
+	b, ok := BlockByName(name)
+	if !ok {
+		return 0, false
+	}
+	return b.State(props)
+}
+
+// StateOf returns the block name and properties of a state.
+func StateOf(state int32) (name string, props map[string]string, ok bool) {
+	b, ok := BlockOf(state)
+	if !ok {
+		return
+	}
+	return b.Name, b.Values(state), true
+}
+
+// ParseState parses a state in the syntax of commands, such as
+// "minecraft:oak_log[axis=x]". Properties not given take their default
+// values, and names default to the minecraft namespace.
+func ParseState(s string) (int32, error) {
+	name, rest, found := strings.Cut(s, "[")
+	if !strings.Contains(name, ":") {
+		name = "minecraft:" + name
+	}
+	b, ok := BlockByName(name)
+	if !ok {
+		return 0, fmt.Errorf("%w %s", ErrUnknownBlock, name)
+	}
+	if !found {
+		return b.DefaultState, nil
+	}
+
+	rest, ok = strings.CutSuffix(rest, "]")
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidState, s)
+	}
+	state := b.DefaultState
+	if rest == "" {
+		return state, nil
+	}
+	for _, kv := range strings.Split(rest, ",") {
+		k, v, _ := strings.Cut(kv, "=")
+		if state, ok = b.With(state, strings.TrimSpace(k), strings.TrimSpace(v)); !ok {
+			return 0, fmt.Errorf("%w: %s", ErrInvalidState, s)
+		}
+	}
+	return state, nil
+}
+
+// FormatState returns state in the syntax of ParseState, with every
+// property, or "" if state is unknown.
+func FormatState(state int32) string {
+	b, ok := BlockOf(state)
+	if !ok {
+		return ""
+	}
+	if len(b.Properties) == 0 {
+		return b.Name
+	}
+
+	var sb strings.Builder
+	sb.WriteString(b.Name)
+	for i, j := range b.indices(state) {
+		if i == 0 {
+			sb.WriteByte('[')
+		} else {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(b.Properties[i].Name)
+		sb.WriteByte('=')
+		sb.WriteString(b.Properties[i].Values[j])
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
@@ -0,0 +1,106 @@
+package vanilla
+
+import (
+	"github.com/gstoney/mcproto/anvil"
+	"github.com/gstoney/mcproto/registry"
+	"github.com/gstoney/mcproto/tags"
+)
+
+// A Registry is a registry built into the game, whose entries are
+// numbered in order.
+type Registry struct {
+	names []string
+	ids   map[string]int32
+}
+
+func newRegistry(names []string) *Registry {
+	r := &Registry{names: names, ids: make(map[string]int32, len(names))}
+	for i, name := range names {
+		r.ids[name] = int32(i)
+	}
+	return r
+}
+
+// Registries of 1.21.1.
+var (
+	Items       = newRegistry(items)
+	EntityTypes = newRegistry(entityTypes)
+	Fluids      = newRegistry(fluids)
+	GameEvents  = newRegistry(gameEvents)
+	SoundEvents = newRegistry(soundEvents)
+)
+
+// ID returns the network ID of entry name, such as "minecraft:stone".
+func (r *Registry) ID(name string) (int32, bool) {
+	id, ok := r.ids[name]
+	return id, ok
+}
+
+// Name returns the entry of network ID id.
+func (r *Registry) Name(id int32) (string, bool) {
+	if id < 0 || int(id) >= len(r.names) {
+		return "", false
+	}
+	return r.names[id], true
+}
+
+// Len returns the number of entries.
+func (r *Registry) Len() int {
+	return len(r.names)
+}
+
+// Resolver maps the names of world files to network IDs and back, with
+// the block states of this package and the entries of Biomes, such as the
+// minecraft:worldgen/biome registry of registry.Vanilla. Its EntryID
+// resolves the entries of tags, such as those of tags.Vanilla.
+type Resolver struct {
+	Biomes *registry.Registry
+}
+
+var (
+	_ anvil.Resolver = Resolver{}
+	_ anvil.Namer    = Resolver{}
+	_ tags.IDs       = Resolver{}.EntryID
+)
+
+func (r Resolver) BlockState(name string, props map[string]string) (int32, bool) {
+	return StateID(name, props)
+}
+
+func (r Resolver) BlockStateName(id int32) (string, map[string]string, bool) {
+	return StateOf(id)
+}
+
+func (r Resolver) Biome(name string) (int32, bool) {
+	i := r.Biomes.Index(name)
+	return int32(i), i >= 0
+}
+
+func (r Resolver) BiomeName(id int32) (string, bool) {
+	if id < 0 || int(id) >= len(r.Biomes.Entries) {
+		return "", false
+	}
+	return r.Biomes.Entries[id].ID, true
+}
+
+// EntryID returns the network ID of entry in registry, one of the block,
+// item, entity type, fluid and game event registries.
+func (r Resolver) EntryID(registry, entry string) (int32, bool) {
+	switch registry {
+	case tags.Block:
+		b, ok := BlockByName(entry)
+		if !ok {
+			return 0, false
+		}
+		return b.ID, true
+	case tags.Item:
+		return Items.ID(entry)
+	case tags.EntityType:
+		return EntityTypes.ID(entry)
+	case tags.Fluid:
+		return Fluids.ID(entry)
+	case tags.GameEvent:
+		return GameEvents.ID(entry)
+	}
+	return 0, false
+}
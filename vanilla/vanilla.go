@@ -0,0 +1,9 @@
+//go:generate go run ../codegen/gen_vanilla_data.go -- ../testdata/reports .
+
+// Package vanilla holds the network IDs of Minecraft 1.21.1 defined in
+// code rather than sent in registries: block states, items, entity types,
+// fluids, game events and sound events.
+//
+// The tables are generated from the reports of the vanilla data generator
+// in testdata/reports, see codegen/gen_vanilla_data.go.
+package vanilla
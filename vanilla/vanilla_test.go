@@ -0,0 +1,236 @@
+package vanilla
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gstoney/mcproto/registry"
+	"github.com/gstoney/mcproto/tags"
+)
+
+func TestStateID(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		props map[string]string
+		want  int32
+	}{
+		{"minecraft:air", nil, 0},
+		{"minecraft:grass_block", nil, 9},
+		{"minecraft:grass_block", map[string]string{"snowy": "true"}, 8},
+		{"minecraft:bedrock", nil, 79},
+		{"minecraft:water", map[string]string{"level": "15"}, 95},
+		{"minecraft:oak_log", map[string]string{"axis": "x"}, 130},
+		{"minecraft:mangrove_propagule", nil, 44},
+		{"minecraft:mangrove_propagule", map[string]string{"age": "4", "hanging": "true", "waterlogged": "true"}, 71},
+		{"minecraft:glass", nil, 519},
+		{"minecraft:chest", nil, 2955},
+		{"minecraft:heavy_core", nil, 26683},
+		{"minecraft:heavy_core", map[string]string{"waterlogged": "true"}, 26682},
+	} {
+		got, ok := StateID(tc.name, tc.props)
+		if !ok || got != tc.want {
+			t.Errorf("StateID(%s, %v): got %d %v, want %d", tc.name, tc.props, got, ok, tc.want)
+			continue
+		}
+
+		name, props, ok := StateOf(got)
+		b, _ := BlockByName(tc.name)
+		if !ok || name != tc.name || !reflect.DeepEqual(props, b.Values(got)) {
+			t.Errorf("StateOf(%d): got %s %v %v", got, name, props, ok)
+		}
+		for k, v := range tc.props {
+			if props[k] != v {
+				t.Errorf("StateOf(%d): got %s=%s, want %s", got, k, props[k], v)
+			}
+		}
+	}
+
+	for _, tc := range []struct {
+		name  string
+		props map[string]string
+	}{
+		{"minecraft:nothing", nil},
+		{"minecraft:stone", map[string]string{"snowy": "true"}},
+		{"minecraft:oak_log", map[string]string{"axis": "w"}},
+	} {
+		if got, ok := StateID(tc.name, tc.props); ok {
+			t.Errorf("StateID(%s, %v): got %d, want none", tc.name, tc.props, got)
+		}
+	}
+	if NumStates() != 26684 || NumBlocks() != 1060 {
+		t.Errorf("got %d states of %d blocks, want 26684 of 1060", NumStates(), NumBlocks())
+	}
+	if _, _, ok := StateOf(int32(NumStates())); ok {
+		t.Errorf("StateOf(%d): got a state past the last", NumStates())
+	}
+}
+
+func TestBlock_With(t *testing.T) {
+	b, ok := BlockByName("minecraft:mangrove_propagule")
+	if !ok {
+		t.Fatal("mangrove_propagule not found")
+	}
+	state := b.DefaultState
+	for _, kv := range [][2]string{{"stage", "1"}, {"age", "3"}, {"hanging", "true"}} {
+		if state, ok = b.With(state, kv[0], kv[1]); !ok {
+			t.Fatalf("With(%s=%s) failed", kv[0], kv[1])
+		}
+	}
+	want := map[string]string{"age": "3", "hanging": "true", "stage": "1", "waterlogged": "false"}
+	if got := b.Values(state); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if v, ok := b.Value(state, "age"); !ok || v != "3" {
+		t.Errorf("Value(age): got %q %v", v, ok)
+	}
+	if _, ok := b.With(0, "age", "1"); ok {
+		t.Error("With accepted a state of another block")
+	}
+}
+
+func TestParseState(t *testing.T) {
+	for s, want := range map[string]int32{
+		"stone":                          1,
+		"minecraft:oak_log[axis=z]":      132,
+		"minecraft:oak_log[]":            131,
+		"podzol[ snowy = true ]":         12,
+		"minecraft:lava[level=1]":        97,
+		"minecraft:suspicious_sand":      113,
+		"minecraft:mangrove_log[axis=y]": 152,
+	} {
+		got, err := ParseState(s)
+		if err != nil || got != want {
+			t.Errorf("ParseState(%q): got %d %v, want %d", s, got, err, want)
+		}
+	}
+	for s, want := range map[string]error{
+		"minecraft:nothing":         ErrUnknownBlock,
+		"minecraft:oak_log[axis=x":  ErrInvalidState,
+		"minecraft:oak_log[size=x]": ErrInvalidState,
+	} {
+		if _, err := ParseState(s); !errors.Is(err, want) {
+			t.Errorf("ParseState(%q): got %v, want %v", s, err, want)
+		}
+	}
+
+	for state, want := range map[int32]string{
+		1:   "minecraft:stone",
+		75:  "minecraft:mangrove_propagule[age=4,hanging=false,stage=0,waterlogged=true]",
+		131: "minecraft:oak_log[axis=y]",
+		-1:  "",
+	} {
+		if got := FormatState(state); got != want {
+			t.Errorf("FormatState(%d): got %q, want %q", state, got, want)
+		}
+		if got, err := ParseState(want); want != "" && (err != nil || got != state) {
+			t.Errorf("ParseState(%q): got %d %v, want %d", want, got, err, state)
+		}
+	}
+}
+
+// TestBlocks verifies that the states of every block are contiguous.
+func TestBlocks(t *testing.T) {
+	next := int32(0)
+	for i := range NumBlocks() {
+		b := &blocks[i]
+		if b.ID != int32(i) || b.MinState != next || !b.has(b.DefaultState) {
+			t.Errorf("block %s: ID %d, states %d to %d, default %d", b.Name, b.ID, b.MinState, b.MaxState, b.DefaultState)
+		}
+		for s := b.MinState; s <= b.MaxState; s++ {
+			if got, ok := b.State(b.Values(s)); !ok || got != s {
+				t.Errorf("%s: state %d round trips to %d", b.Name, s, got)
+			}
+		}
+		next = b.MaxState + 1
+	}
+	if int(next) != NumStates() {
+		t.Errorf("got %d states, want %d", next, NumStates())
+	}
+}
+
+func TestRegistries(t *testing.T) {
+	for _, tc := range []struct {
+		r    *Registry
+		name string
+		id   int32
+	}{
+		{Items, "minecraft:air", 0},
+		{Items, "minecraft:grass_block", 27},
+		{Items, "minecraft:breeze_rod", 1332},
+		{EntityTypes, "minecraft:player", 128},
+		{EntityTypes, "minecraft:zombie", 124},
+		{SoundEvents, "minecraft:ambient.cave", 7},
+		{SoundEvents, "minecraft:event.mob_effect.raid_omen", 1614},
+	} {
+		if id, ok := tc.r.ID(tc.name); !ok || id != tc.id {
+			t.Errorf("ID(%s): got %d %v, want %d", tc.name, id, ok, tc.id)
+		}
+		if name, ok := tc.r.Name(tc.id); !ok || name != tc.name {
+			t.Errorf("Name(%d): got %s %v, want %s", tc.id, name, ok, tc.name)
+		}
+	}
+	if Items.Len() != 1333 {
+		t.Errorf("got %d items, want 1333", Items.Len())
+	}
+	if SoundEvents.Len() != 1615 {
+		t.Errorf("got %d sound events, want 1615", SoundEvents.Len())
+	}
+	if _, ok := Items.Name(int32(Items.Len())); ok {
+		t.Error("Name accepted an ID past the last")
+	}
+}
+
+func TestResolver(t *testing.T) {
+	biomes := registry.Vanilla().Registry("minecraft:worldgen/biome")
+	r := Resolver{Biomes: biomes}
+
+	id, ok := r.Biome("minecraft:plains")
+	if name, ok2 := r.BiomeName(id); !ok || !ok2 || name != "minecraft:plains" {
+		t.Errorf("plains: got %d %s", id, name)
+	}
+	if id, ok := r.BlockState("minecraft:dirt", nil); !ok || id != 10 {
+		t.Errorf("dirt: got %d %v", id, ok)
+	}
+}
+
+// TestResolver_EntryID verifies that every entry of the vanilla tags is
+// known, and that only the tags empty in vanilla resolve to nothing.
+func TestResolver_EntryID(t *testing.T) {
+	s := tags.Vanilla()
+	rts, err := s.RegistryTags(Resolver{}.EntryID)
+	if err != nil {
+		t.Fatalf("RegistryTags: %v", err)
+	}
+	for _, rt := range rts {
+		for _, tag := range rt.Tags {
+			entries, _ := s.Resolve(rt.Registry, tag.Name)
+			empty := tag.Name == "minecraft:incorrect_for_netherite_tool" || tag.Name == "minecraft:incorrect_for_diamond_tool"
+			if (len(tag.Entries) == 0) != empty || len(tag.Entries) != len(entries) {
+				t.Errorf("%s %s: got IDs %v for %v", rt.Registry, tag.Name, tag.Entries, entries)
+			}
+		}
+	}
+
+	r := Resolver{}
+	for _, tc := range []struct {
+		registry, entry string
+		want            int32
+	}{
+		{tags.Block, "minecraft:stone", 1},
+		{tags.Block, "minecraft:oak_log", 46},
+		{tags.Item, "minecraft:grass_block", 27},
+		{tags.EntityType, "minecraft:player", 128},
+		{tags.Fluid, "minecraft:lava", 4},
+		{tags.GameEvent, "minecraft:step", 41},
+	} {
+		if id, ok := r.EntryID(tc.registry, tc.entry); !ok || id != tc.want {
+			t.Errorf("EntryID(%s, %s): got %d %v, want %d", tc.registry, tc.entry, id, ok, tc.want)
+		}
+	}
+	for _, reg := range []string{"minecraft:sound_event", "minecraft:nothing"} {
+		if _, ok := r.EntryID(reg, "minecraft:stone"); ok {
+			t.Errorf("EntryID(%s): got an ID", reg)
+		}
+	}
+}
@@ -0,0 +1,4224 @@
+// Code generated by gen_vanilla_data.go; DO NOT EDIT.
+
+package vanilla
+
+var blocks = []Block{
+	{Name: "minecraft:air", ID: 0, MinState: 0, MaxState: 0, DefaultState: 0},
+	{Name: "minecraft:stone", ID: 1, MinState: 1, MaxState: 1, DefaultState: 1},
+	{Name: "minecraft:granite", ID: 2, MinState: 2, MaxState: 2, DefaultState: 2},
+	{Name: "minecraft:polished_granite", ID: 3, MinState: 3, MaxState: 3, DefaultState: 3},
+	{Name: "minecraft:diorite", ID: 4, MinState: 4, MaxState: 4, DefaultState: 4},
+	{Name: "minecraft:polished_diorite", ID: 5, MinState: 5, MaxState: 5, DefaultState: 5},
+	{Name: "minecraft:andesite", ID: 6, MinState: 6, MaxState: 6, DefaultState: 6},
+	{Name: "minecraft:polished_andesite", ID: 7, MinState: 7, MaxState: 7, DefaultState: 7},
+	{Name: "minecraft:grass_block", ID: 8, Properties: []Property{{"snowy", []string{"true", "false"}}}, MinState: 8, MaxState: 9, DefaultState: 9},
+	{Name: "minecraft:dirt", ID: 9, MinState: 10, MaxState: 10, DefaultState: 10},
+	{Name: "minecraft:coarse_dirt", ID: 10, MinState: 11, MaxState: 11, DefaultState: 11},
+	{Name: "minecraft:podzol", ID: 11, Properties: []Property{{"snowy", []string{"true", "false"}}}, MinState: 12, MaxState: 13, DefaultState: 13},
+	{Name: "minecraft:cobblestone", ID: 12, MinState: 14, MaxState: 14, DefaultState: 14},
+	{Name: "minecraft:oak_planks", ID: 13, MinState: 15, MaxState: 15, DefaultState: 15},
+	{Name: "minecraft:spruce_planks", ID: 14, MinState: 16, MaxState: 16, DefaultState: 16},
+	{Name: "minecraft:birch_planks", ID: 15, MinState: 17, MaxState: 17, DefaultState: 17},
+	{Name: "minecraft:jungle_planks", ID: 16, MinState: 18, MaxState: 18, DefaultState: 18},
+	{Name: "minecraft:acacia_planks", ID: 17, MinState: 19, MaxState: 19, DefaultState: 19},
+	{Name: "minecraft:cherry_planks", ID: 18, MinState: 20, MaxState: 20, DefaultState: 20},
+	{Name: "minecraft:dark_oak_planks", ID: 19, MinState: 21, MaxState: 21, DefaultState: 21},
+	{Name: "minecraft:mangrove_planks", ID: 20, MinState: 22, MaxState: 22, DefaultState: 22},
+	{Name: "minecraft:bamboo_planks", ID: 21, MinState: 23, MaxState: 23, DefaultState: 23},
+	{Name: "minecraft:bamboo_mosaic", ID: 22, MinState: 24, MaxState: 24, DefaultState: 24},
+	{Name: "minecraft:oak_sapling", ID: 23, Properties: []Property{{"stage", []string{"0", "1"}}}, MinState: 25, MaxState: 26, DefaultState: 25},
+	{Name: "minecraft:spruce_sapling", ID: 24, Properties: []Property{{"stage", []string{"0", "1"}}}, MinState: 27, MaxState: 28, DefaultState: 27},
+	{Name: "minecraft:birch_sapling", ID: 25, Properties: []Property{{"stage", []string{"0", "1"}}}, MinState: 29, MaxState: 30, DefaultState: 29},
+	{Name: "minecraft:jungle_sapling", ID: 26, Properties: []Property{{"stage", []string{"0", "1"}}}, MinState: 31, MaxState: 32, DefaultState: 31},
+	{Name: "minecraft:acacia_sapling", ID: 27, Properties: []Property{{"stage", []string{"0", "1"}}}, MinState: 33, MaxState: 34, DefaultState: 33},
+	{Name: "minecraft:cherry_sapling", ID: 28, Properties: []Property{{"stage", []string{"0", "1"}}}, MinState: 35, MaxState: 36, DefaultState: 35},
+	{Name: "minecraft:dark_oak_sapling", ID: 29, Properties: []Property{{"stage", []string{"0", "1"}}}, MinState: 37, MaxState: 38, DefaultState: 37},
+	{Name: "minecraft:mangrove_propagule", ID: 30, Properties: []Property{{"age", []string{"0", "1", "2", "3", "4"}}, {"hanging", []string{"true", "false"}}, {"stage", []string{"0", "1"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 39, MaxState: 78, DefaultState: 44},
+	{Name: "minecraft:bedrock", ID: 31, MinState: 79, MaxState: 79, DefaultState: 79},
+	{Name: "minecraft:water", ID: 32, Properties: []Property{{"level", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 80, MaxState: 95, DefaultState: 80},
+	{Name: "minecraft:lava", ID: 33, Properties: []Property{{"level", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 96, MaxState: 111, DefaultState: 96},
+	{Name: "minecraft:sand", ID: 34, MinState: 112, MaxState: 112, DefaultState: 112},
+	{Name: "minecraft:suspicious_sand", ID: 35, Properties: []Property{{"dusted", []string{"0", "1", "2", "3"}}}, MinState: 113, MaxState: 116, DefaultState: 113},
+	{Name: "minecraft:red_sand", ID: 36, MinState: 117, MaxState: 117, DefaultState: 117},
+	{Name: "minecraft:gravel", ID: 37, MinState: 118, MaxState: 118, DefaultState: 118},
+	{Name: "minecraft:suspicious_gravel", ID: 38, Properties: []Property{{"dusted", []string{"0", "1", "2", "3"}}}, MinState: 119, MaxState: 122, DefaultState: 119},
+	{Name: "minecraft:gold_ore", ID: 39, MinState: 123, MaxState: 123, DefaultState: 123},
+	{Name: "minecraft:deepslate_gold_ore", ID: 40, MinState: 124, MaxState: 124, DefaultState: 124},
+	{Name: "minecraft:iron_ore", ID: 41, MinState: 125, MaxState: 125, DefaultState: 125},
+	{Name: "minecraft:deepslate_iron_ore", ID: 42, MinState: 126, MaxState: 126, DefaultState: 126},
+	{Name: "minecraft:coal_ore", ID: 43, MinState: 127, MaxState: 127, DefaultState: 127},
+	{Name: "minecraft:deepslate_coal_ore", ID: 44, MinState: 128, MaxState: 128, DefaultState: 128},
+	{Name: "minecraft:nether_gold_ore", ID: 45, MinState: 129, MaxState: 129, DefaultState: 129},
+	{Name: "minecraft:oak_log", ID: 46, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 130, MaxState: 132, DefaultState: 131},
+	{Name: "minecraft:spruce_log", ID: 47, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 133, MaxState: 135, DefaultState: 134},
+	{Name: "minecraft:birch_log", ID: 48, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 136, MaxState: 138, DefaultState: 137},
+	{Name: "minecraft:jungle_log", ID: 49, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 139, MaxState: 141, DefaultState: 140},
+	{Name: "minecraft:acacia_log", ID: 50, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 142, MaxState: 144, DefaultState: 143},
+	{Name: "minecraft:cherry_log", ID: 51, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 145, MaxState: 147, DefaultState: 146},
+	{Name: "minecraft:dark_oak_log", ID: 52, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 148, MaxState: 150, DefaultState: 149},
+	{Name: "minecraft:mangrove_log", ID: 53, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 151, MaxState: 153, DefaultState: 152},
+	{Name: "minecraft:mangrove_roots", ID: 54, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 154, MaxState: 155, DefaultState: 155},
+	{Name: "minecraft:muddy_mangrove_roots", ID: 55, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 156, MaxState: 158, DefaultState: 157},
+	{Name: "minecraft:bamboo_block", ID: 56, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 159, MaxState: 161, DefaultState: 160},
+	{Name: "minecraft:stripped_spruce_log", ID: 57, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 162, MaxState: 164, DefaultState: 163},
+	{Name: "minecraft:stripped_birch_log", ID: 58, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 165, MaxState: 167, DefaultState: 166},
+	{Name: "minecraft:stripped_jungle_log", ID: 59, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 168, MaxState: 170, DefaultState: 169},
+	{Name: "minecraft:stripped_acacia_log", ID: 60, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 171, MaxState: 173, DefaultState: 172},
+	{Name: "minecraft:stripped_cherry_log", ID: 61, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 174, MaxState: 176, DefaultState: 175},
+	{Name: "minecraft:stripped_dark_oak_log", ID: 62, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 177, MaxState: 179, DefaultState: 178},
+	{Name: "minecraft:stripped_oak_log", ID: 63, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 180, MaxState: 182, DefaultState: 181},
+	{Name: "minecraft:stripped_mangrove_log", ID: 64, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 183, MaxState: 185, DefaultState: 184},
+	{Name: "minecraft:stripped_bamboo_block", ID: 65, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 186, MaxState: 188, DefaultState: 187},
+	{Name: "minecraft:oak_wood", ID: 66, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 189, MaxState: 191, DefaultState: 190},
+	{Name: "minecraft:spruce_wood", ID: 67, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 192, MaxState: 194, DefaultState: 193},
+	{Name: "minecraft:birch_wood", ID: 68, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 195, MaxState: 197, DefaultState: 196},
+	{Name: "minecraft:jungle_wood", ID: 69, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 198, MaxState: 200, DefaultState: 199},
+	{Name: "minecraft:acacia_wood", ID: 70, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 201, MaxState: 203, DefaultState: 202},
+	{Name: "minecraft:cherry_wood", ID: 71, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 204, MaxState: 206, DefaultState: 205},
+	{Name: "minecraft:dark_oak_wood", ID: 72, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 207, MaxState: 209, DefaultState: 208},
+	{Name: "minecraft:mangrove_wood", ID: 73, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 210, MaxState: 212, DefaultState: 211},
+	{Name: "minecraft:stripped_oak_wood", ID: 74, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 213, MaxState: 215, DefaultState: 214},
+	{Name: "minecraft:stripped_spruce_wood", ID: 75, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 216, MaxState: 218, DefaultState: 217},
+	{Name: "minecraft:stripped_birch_wood", ID: 76, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 219, MaxState: 221, DefaultState: 220},
+	{Name: "minecraft:stripped_jungle_wood", ID: 77, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 222, MaxState: 224, DefaultState: 223},
+	{Name: "minecraft:stripped_acacia_wood", ID: 78, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 225, MaxState: 227, DefaultState: 226},
+	{Name: "minecraft:stripped_cherry_wood", ID: 79, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 228, MaxState: 230, DefaultState: 229},
+	{Name: "minecraft:stripped_dark_oak_wood", ID: 80, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 231, MaxState: 233, DefaultState: 232},
+	{Name: "minecraft:stripped_mangrove_wood", ID: 81, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 234, MaxState: 236, DefaultState: 235},
+	{Name: "minecraft:oak_leaves", ID: 82, Properties: []Property{{"distance", []string{"1", "2", "3", "4", "5", "6", "7"}}, {"persistent", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 237, MaxState: 264, DefaultState: 264},
+	{Name: "minecraft:spruce_leaves", ID: 83, Properties: []Property{{"distance", []string{"1", "2", "3", "4", "5", "6", "7"}}, {"persistent", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 265, MaxState: 292, DefaultState: 292},
+	{Name: "minecraft:birch_leaves", ID: 84, Properties: []Property{{"distance", []string{"1", "2", "3", "4", "5", "6", "7"}}, {"persistent", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 293, MaxState: 320, DefaultState: 320},
+	{Name: "minecraft:jungle_leaves", ID: 85, Properties: []Property{{"distance", []string{"1", "2", "3", "4", "5", "6", "7"}}, {"persistent", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 321, MaxState: 348, DefaultState: 348},
+	{Name: "minecraft:acacia_leaves", ID: 86, Properties: []Property{{"distance", []string{"1", "2", "3", "4", "5", "6", "7"}}, {"persistent", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 349, MaxState: 376, DefaultState: 376},
+	{Name: "minecraft:cherry_leaves", ID: 87, Properties: []Property{{"distance", []string{"1", "2", "3", "4", "5", "6", "7"}}, {"persistent", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 377, MaxState: 404, DefaultState: 404},
+	{Name: "minecraft:dark_oak_leaves", ID: 88, Properties: []Property{{"distance", []string{"1", "2", "3", "4", "5", "6", "7"}}, {"persistent", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 405, MaxState: 432, DefaultState: 432},
+	{Name: "minecraft:mangrove_leaves", ID: 89, Properties: []Property{{"distance", []string{"1", "2", "3", "4", "5", "6", "7"}}, {"persistent", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 433, MaxState: 460, DefaultState: 460},
+	{Name: "minecraft:azalea_leaves", ID: 90, Properties: []Property{{"distance", []string{"1", "2", "3", "4", "5", "6", "7"}}, {"persistent", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 461, MaxState: 488, DefaultState: 488},
+	{Name: "minecraft:flowering_azalea_leaves", ID: 91, Properties: []Property{{"distance", []string{"1", "2", "3", "4", "5", "6", "7"}}, {"persistent", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 489, MaxState: 516, DefaultState: 516},
+	{Name: "minecraft:sponge", ID: 92, MinState: 517, MaxState: 517, DefaultState: 517},
+	{Name: "minecraft:wet_sponge", ID: 93, MinState: 518, MaxState: 518, DefaultState: 518},
+	{Name: "minecraft:glass", ID: 94, MinState: 519, MaxState: 519, DefaultState: 519},
+	{Name: "minecraft:lapis_ore", ID: 95, MinState: 520, MaxState: 520, DefaultState: 520},
+	{Name: "minecraft:deepslate_lapis_ore", ID: 96, MinState: 521, MaxState: 521, DefaultState: 521},
+	{Name: "minecraft:lapis_block", ID: 97, MinState: 522, MaxState: 522, DefaultState: 522},
+	{Name: "minecraft:dispenser", ID: 98, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}, {"triggered", []string{"true", "false"}}}, MinState: 523, MaxState: 534, DefaultState: 524},
+	{Name: "minecraft:sandstone", ID: 99, MinState: 535, MaxState: 535, DefaultState: 535},
+	{Name: "minecraft:chiseled_sandstone", ID: 100, MinState: 536, MaxState: 536, DefaultState: 536},
+	{Name: "minecraft:cut_sandstone", ID: 101, MinState: 537, MaxState: 537, DefaultState: 537},
+	{Name: "minecraft:note_block", ID: 102, Properties: []Property{{"instrument", []string{"harp", "basedrum", "snare", "hat", "bass", "flute", "bell", "guitar", "chime", "xylophone", "iron_xylophone", "cow_bell", "didgeridoo", "bit", "banjo", "pling", "zombie", "skeleton", "creeper", "dragon", "wither_skeleton", "piglin", "custom_head"}}, {"note", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24"}}, {"powered", []string{"true", "false"}}}, MinState: 538, MaxState: 1687, DefaultState: 539},
+	{Name: "minecraft:white_bed", ID: 103, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"occupied", []string{"true", "false"}}, {"part", []string{"head", "foot"}}}, MinState: 1688, MaxState: 1703, DefaultState: 1691},
+	{Name: "minecraft:orange_bed", ID: 104, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"occupied", []string{"true", "false"}}, {"part", []string{"head", "foot"}}}, MinState: 1704, MaxState: 1719, DefaultState: 1707},
+	{Name: "minecraft:magenta_bed", ID: 105, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"occupied", []string{"true", "false"}}, {"part", []string{"head", "foot"}}}, MinState: 1720, MaxState: 1735, DefaultState: 1723},
+	{Name: "minecraft:light_blue_bed", ID: 106, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"occupied", []string{"true", "false"}}, {"part", []string{"head", "foot"}}}, MinState: 1736, MaxState: 1751, DefaultState: 1739},
+	{Name: "minecraft:yellow_bed", ID: 107, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"occupied", []string{"true", "false"}}, {"part", []string{"head", "foot"}}}, MinState: 1752, MaxState: 1767, DefaultState: 1755},
+	{Name: "minecraft:lime_bed", ID: 108, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"occupied", []string{"true", "false"}}, {"part", []string{"head", "foot"}}}, MinState: 1768, MaxState: 1783, DefaultState: 1771},
+	{Name: "minecraft:pink_bed", ID: 109, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"occupied", []string{"true", "false"}}, {"part", []string{"head", "foot"}}}, MinState: 1784, MaxState: 1799, DefaultState: 1787},
+	{Name: "minecraft:gray_bed", ID: 110, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"occupied", []string{"true", "false"}}, {"part", []string{"head", "foot"}}}, MinState: 1800, MaxState: 1815, DefaultState: 1803},
+	{Name: "minecraft:light_gray_bed", ID: 111, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"occupied", []string{"true", "false"}}, {"part", []string{"head", "foot"}}}, MinState: 1816, MaxState: 1831, DefaultState: 1819},
+	{Name: "minecraft:cyan_bed", ID: 112, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"occupied", []string{"true", "false"}}, {"part", []string{"head", "foot"}}}, MinState: 1832, MaxState: 1847, DefaultState: 1835},
+	{Name: "minecraft:purple_bed", ID: 113, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"occupied", []string{"true", "false"}}, {"part", []string{"head", "foot"}}}, MinState: 1848, MaxState: 1863, DefaultState: 1851},
+	{Name: "minecraft:blue_bed", ID: 114, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"occupied", []string{"true", "false"}}, {"part", []string{"head", "foot"}}}, MinState: 1864, MaxState: 1879, DefaultState: 1867},
+	{Name: "minecraft:brown_bed", ID: 115, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"occupied", []string{"true", "false"}}, {"part", []string{"head", "foot"}}}, MinState: 1880, MaxState: 1895, DefaultState: 1883},
+	{Name: "minecraft:green_bed", ID: 116, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"occupied", []string{"true", "false"}}, {"part", []string{"head", "foot"}}}, MinState: 1896, MaxState: 1911, DefaultState: 1899},
+	{Name: "minecraft:red_bed", ID: 117, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"occupied", []string{"true", "false"}}, {"part", []string{"head", "foot"}}}, MinState: 1912, MaxState: 1927, DefaultState: 1915},
+	{Name: "minecraft:black_bed", ID: 118, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"occupied", []string{"true", "false"}}, {"part", []string{"head", "foot"}}}, MinState: 1928, MaxState: 1943, DefaultState: 1931},
+	{Name: "minecraft:powered_rail", ID: 119, Properties: []Property{{"powered", []string{"true", "false"}}, {"shape", []string{"north_south", "east_west", "ascending_east", "ascending_west", "ascending_north", "ascending_south"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 1944, MaxState: 1967, DefaultState: 1957},
+	{Name: "minecraft:detector_rail", ID: 120, Properties: []Property{{"powered", []string{"true", "false"}}, {"shape", []string{"north_south", "east_west", "ascending_east", "ascending_west", "ascending_north", "ascending_south"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 1968, MaxState: 1991, DefaultState: 1981},
+	{Name: "minecraft:sticky_piston", ID: 121, Properties: []Property{{"extended", []string{"true", "false"}}, {"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 1992, MaxState: 2003, DefaultState: 1998},
+	{Name: "minecraft:cobweb", ID: 122, MinState: 2004, MaxState: 2004, DefaultState: 2004},
+	{Name: "minecraft:short_grass", ID: 123, MinState: 2005, MaxState: 2005, DefaultState: 2005},
+	{Name: "minecraft:fern", ID: 124, MinState: 2006, MaxState: 2006, DefaultState: 2006},
+	{Name: "minecraft:dead_bush", ID: 125, MinState: 2007, MaxState: 2007, DefaultState: 2007},
+	{Name: "minecraft:seagrass", ID: 126, MinState: 2008, MaxState: 2008, DefaultState: 2008},
+	{Name: "minecraft:tall_seagrass", ID: 127, Properties: []Property{{"half", []string{"upper", "lower"}}}, MinState: 2009, MaxState: 2010, DefaultState: 2010},
+	{Name: "minecraft:piston", ID: 128, Properties: []Property{{"extended", []string{"true", "false"}}, {"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 2011, MaxState: 2022, DefaultState: 2017},
+	{Name: "minecraft:piston_head", ID: 129, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}, {"short", []string{"true", "false"}}, {"type", []string{"normal", "sticky"}}}, MinState: 2023, MaxState: 2046, DefaultState: 2025},
+	{Name: "minecraft:white_wool", ID: 130, MinState: 2047, MaxState: 2047, DefaultState: 2047},
+	{Name: "minecraft:orange_wool", ID: 131, MinState: 2048, MaxState: 2048, DefaultState: 2048},
+	{Name: "minecraft:magenta_wool", ID: 132, MinState: 2049, MaxState: 2049, DefaultState: 2049},
+	{Name: "minecraft:light_blue_wool", ID: 133, MinState: 2050, MaxState: 2050, DefaultState: 2050},
+	{Name: "minecraft:yellow_wool", ID: 134, MinState: 2051, MaxState: 2051, DefaultState: 2051},
+	{Name: "minecraft:lime_wool", ID: 135, MinState: 2052, MaxState: 2052, DefaultState: 2052},
+	{Name: "minecraft:pink_wool", ID: 136, MinState: 2053, MaxState: 2053, DefaultState: 2053},
+	{Name: "minecraft:gray_wool", ID: 137, MinState: 2054, MaxState: 2054, DefaultState: 2054},
+	{Name: "minecraft:light_gray_wool", ID: 138, MinState: 2055, MaxState: 2055, DefaultState: 2055},
+	{Name: "minecraft:cyan_wool", ID: 139, MinState: 2056, MaxState: 2056, DefaultState: 2056},
+	{Name: "minecraft:purple_wool", ID: 140, MinState: 2057, MaxState: 2057, DefaultState: 2057},
+	{Name: "minecraft:blue_wool", ID: 141, MinState: 2058, MaxState: 2058, DefaultState: 2058},
+	{Name: "minecraft:brown_wool", ID: 142, MinState: 2059, MaxState: 2059, DefaultState: 2059},
+	{Name: "minecraft:green_wool", ID: 143, MinState: 2060, MaxState: 2060, DefaultState: 2060},
+	{Name: "minecraft:red_wool", ID: 144, MinState: 2061, MaxState: 2061, DefaultState: 2061},
+	{Name: "minecraft:black_wool", ID: 145, MinState: 2062, MaxState: 2062, DefaultState: 2062},
+	{Name: "minecraft:moving_piston", ID: 146, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}, {"type", []string{"normal", "sticky"}}}, MinState: 2063, MaxState: 2074, DefaultState: 2063},
+	{Name: "minecraft:dandelion", ID: 147, MinState: 2075, MaxState: 2075, DefaultState: 2075},
+	{Name: "minecraft:torchflower", ID: 148, MinState: 2076, MaxState: 2076, DefaultState: 2076},
+	{Name: "minecraft:poppy", ID: 149, MinState: 2077, MaxState: 2077, DefaultState: 2077},
+	{Name: "minecraft:blue_orchid", ID: 150, MinState: 2078, MaxState: 2078, DefaultState: 2078},
+	{Name: "minecraft:allium", ID: 151, MinState: 2079, MaxState: 2079, DefaultState: 2079},
+	{Name: "minecraft:azure_bluet", ID: 152, MinState: 2080, MaxState: 2080, DefaultState: 2080},
+	{Name: "minecraft:red_tulip", ID: 153, MinState: 2081, MaxState: 2081, DefaultState: 2081},
+	{Name: "minecraft:orange_tulip", ID: 154, MinState: 2082, MaxState: 2082, DefaultState: 2082},
+	{Name: "minecraft:white_tulip", ID: 155, MinState: 2083, MaxState: 2083, DefaultState: 2083},
+	{Name: "minecraft:pink_tulip", ID: 156, MinState: 2084, MaxState: 2084, DefaultState: 2084},
+	{Name: "minecraft:oxeye_daisy", ID: 157, MinState: 2085, MaxState: 2085, DefaultState: 2085},
+	{Name: "minecraft:cornflower", ID: 158, MinState: 2086, MaxState: 2086, DefaultState: 2086},
+	{Name: "minecraft:wither_rose", ID: 159, MinState: 2087, MaxState: 2087, DefaultState: 2087},
+	{Name: "minecraft:lily_of_the_valley", ID: 160, MinState: 2088, MaxState: 2088, DefaultState: 2088},
+	{Name: "minecraft:brown_mushroom", ID: 161, MinState: 2089, MaxState: 2089, DefaultState: 2089},
+	{Name: "minecraft:red_mushroom", ID: 162, MinState: 2090, MaxState: 2090, DefaultState: 2090},
+	{Name: "minecraft:gold_block", ID: 163, MinState: 2091, MaxState: 2091, DefaultState: 2091},
+	{Name: "minecraft:iron_block", ID: 164, MinState: 2092, MaxState: 2092, DefaultState: 2092},
+	{Name: "minecraft:bricks", ID: 165, MinState: 2093, MaxState: 2093, DefaultState: 2093},
+	{Name: "minecraft:tnt", ID: 166, Properties: []Property{{"unstable", []string{"true", "false"}}}, MinState: 2094, MaxState: 2095, DefaultState: 2095},
+	{Name: "minecraft:bookshelf", ID: 167, MinState: 2096, MaxState: 2096, DefaultState: 2096},
+	{Name: "minecraft:chiseled_bookshelf", ID: 168, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"slot_0_occupied", []string{"true", "false"}}, {"slot_1_occupied", []string{"true", "false"}}, {"slot_2_occupied", []string{"true", "false"}}, {"slot_3_occupied", []string{"true", "false"}}, {"slot_4_occupied", []string{"true", "false"}}, {"slot_5_occupied", []string{"true", "false"}}}, MinState: 2097, MaxState: 2352, DefaultState: 2160},
+	{Name: "minecraft:mossy_cobblestone", ID: 169, MinState: 2353, MaxState: 2353, DefaultState: 2353},
+	{Name: "minecraft:obsidian", ID: 170, MinState: 2354, MaxState: 2354, DefaultState: 2354},
+	{Name: "minecraft:torch", ID: 171, MinState: 2355, MaxState: 2355, DefaultState: 2355},
+	{Name: "minecraft:wall_torch", ID: 172, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 2356, MaxState: 2359, DefaultState: 2356},
+	{Name: "minecraft:fire", ID: 173, Properties: []Property{{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"up", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 2360, MaxState: 2871, DefaultState: 2391},
+	{Name: "minecraft:soul_fire", ID: 174, MinState: 2872, MaxState: 2872, DefaultState: 2872},
+	{Name: "minecraft:spawner", ID: 175, MinState: 2873, MaxState: 2873, DefaultState: 2873},
+	{Name: "minecraft:oak_stairs", ID: 176, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 2874, MaxState: 2953, DefaultState: 2885},
+	{Name: "minecraft:chest", ID: 177, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"type", []string{"single", "left", "right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 2954, MaxState: 2977, DefaultState: 2955},
+	{Name: "minecraft:redstone_wire", ID: 178, Properties: []Property{{"east", []string{"up", "side", "none"}}, {"north", []string{"up", "side", "none"}}, {"power", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"south", []string{"up", "side", "none"}}, {"west", []string{"up", "side", "none"}}}, MinState: 2978, MaxState: 4273, DefaultState: 4138},
+	{Name: "minecraft:diamond_ore", ID: 179, MinState: 4274, MaxState: 4274, DefaultState: 4274},
+	{Name: "minecraft:deepslate_diamond_ore", ID: 180, MinState: 4275, MaxState: 4275, DefaultState: 4275},
+	{Name: "minecraft:diamond_block", ID: 181, MinState: 4276, MaxState: 4276, DefaultState: 4276},
+	{Name: "minecraft:crafting_table", ID: 182, MinState: 4277, MaxState: 4277, DefaultState: 4277},
+	{Name: "minecraft:wheat", ID: 183, Properties: []Property{{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7"}}}, MinState: 4278, MaxState: 4285, DefaultState: 4278},
+	{Name: "minecraft:farmland", ID: 184, Properties: []Property{{"moisture", []string{"0", "1", "2", "3", "4", "5", "6", "7"}}}, MinState: 4286, MaxState: 4293, DefaultState: 4286},
+	{Name: "minecraft:furnace", ID: 185, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"lit", []string{"true", "false"}}}, MinState: 4294, MaxState: 4301, DefaultState: 4295},
+	{Name: "minecraft:oak_sign", ID: 186, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4302, MaxState: 4333, DefaultState: 4303},
+	{Name: "minecraft:spruce_sign", ID: 187, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4334, MaxState: 4365, DefaultState: 4335},
+	{Name: "minecraft:birch_sign", ID: 188, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4366, MaxState: 4397, DefaultState: 4367},
+	{Name: "minecraft:acacia_sign", ID: 189, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4398, MaxState: 4429, DefaultState: 4399},
+	{Name: "minecraft:cherry_sign", ID: 190, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4430, MaxState: 4461, DefaultState: 4431},
+	{Name: "minecraft:jungle_sign", ID: 191, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4462, MaxState: 4493, DefaultState: 4463},
+	{Name: "minecraft:dark_oak_sign", ID: 192, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4494, MaxState: 4525, DefaultState: 4495},
+	{Name: "minecraft:mangrove_sign", ID: 193, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4526, MaxState: 4557, DefaultState: 4527},
+	{Name: "minecraft:bamboo_sign", ID: 194, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4558, MaxState: 4589, DefaultState: 4559},
+	{Name: "minecraft:oak_door", ID: 195, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"upper", "lower"}}, {"hinge", []string{"left", "right"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 4590, MaxState: 4653, DefaultState: 4601},
+	{Name: "minecraft:ladder", ID: 196, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4654, MaxState: 4661, DefaultState: 4655},
+	{Name: "minecraft:rail", ID: 197, Properties: []Property{{"shape", []string{"north_south", "east_west", "ascending_east", "ascending_west", "ascending_north", "ascending_south", "south_east", "south_west", "north_west", "north_east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4662, MaxState: 4681, DefaultState: 4663},
+	{Name: "minecraft:cobblestone_stairs", ID: 198, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4682, MaxState: 4761, DefaultState: 4693},
+	{Name: "minecraft:oak_wall_sign", ID: 199, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4762, MaxState: 4769, DefaultState: 4763},
+	{Name: "minecraft:spruce_wall_sign", ID: 200, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4770, MaxState: 4777, DefaultState: 4771},
+	{Name: "minecraft:birch_wall_sign", ID: 201, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4778, MaxState: 4785, DefaultState: 4779},
+	{Name: "minecraft:acacia_wall_sign", ID: 202, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4786, MaxState: 4793, DefaultState: 4787},
+	{Name: "minecraft:cherry_wall_sign", ID: 203, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4794, MaxState: 4801, DefaultState: 4795},
+	{Name: "minecraft:jungle_wall_sign", ID: 204, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4802, MaxState: 4809, DefaultState: 4803},
+	{Name: "minecraft:dark_oak_wall_sign", ID: 205, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4810, MaxState: 4817, DefaultState: 4811},
+	{Name: "minecraft:mangrove_wall_sign", ID: 206, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4818, MaxState: 4825, DefaultState: 4819},
+	{Name: "minecraft:bamboo_wall_sign", ID: 207, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4826, MaxState: 4833, DefaultState: 4827},
+	{Name: "minecraft:oak_hanging_sign", ID: 208, Properties: []Property{{"attached", []string{"true", "false"}}, {"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4834, MaxState: 4897, DefaultState: 4867},
+	{Name: "minecraft:spruce_hanging_sign", ID: 209, Properties: []Property{{"attached", []string{"true", "false"}}, {"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4898, MaxState: 4961, DefaultState: 4931},
+	{Name: "minecraft:birch_hanging_sign", ID: 210, Properties: []Property{{"attached", []string{"true", "false"}}, {"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 4962, MaxState: 5025, DefaultState: 4995},
+	{Name: "minecraft:acacia_hanging_sign", ID: 211, Properties: []Property{{"attached", []string{"true", "false"}}, {"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 5026, MaxState: 5089, DefaultState: 5059},
+	{Name: "minecraft:cherry_hanging_sign", ID: 212, Properties: []Property{{"attached", []string{"true", "false"}}, {"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 5090, MaxState: 5153, DefaultState: 5123},
+	{Name: "minecraft:jungle_hanging_sign", ID: 213, Properties: []Property{{"attached", []string{"true", "false"}}, {"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 5154, MaxState: 5217, DefaultState: 5187},
+	{Name: "minecraft:dark_oak_hanging_sign", ID: 214, Properties: []Property{{"attached", []string{"true", "false"}}, {"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 5218, MaxState: 5281, DefaultState: 5251},
+	{Name: "minecraft:crimson_hanging_sign", ID: 215, Properties: []Property{{"attached", []string{"true", "false"}}, {"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 5282, MaxState: 5345, DefaultState: 5315},
+	{Name: "minecraft:warped_hanging_sign", ID: 216, Properties: []Property{{"attached", []string{"true", "false"}}, {"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 5346, MaxState: 5409, DefaultState: 5379},
+	{Name: "minecraft:mangrove_hanging_sign", ID: 217, Properties: []Property{{"attached", []string{"true", "false"}}, {"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 5410, MaxState: 5473, DefaultState: 5443},
+	{Name: "minecraft:bamboo_hanging_sign", ID: 218, Properties: []Property{{"attached", []string{"true", "false"}}, {"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 5474, MaxState: 5537, DefaultState: 5507},
+	{Name: "minecraft:oak_wall_hanging_sign", ID: 219, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 5538, MaxState: 5545, DefaultState: 5539},
+	{Name: "minecraft:spruce_wall_hanging_sign", ID: 220, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 5546, MaxState: 5553, DefaultState: 5547},
+	{Name: "minecraft:birch_wall_hanging_sign", ID: 221, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 5554, MaxState: 5561, DefaultState: 5555},
+	{Name: "minecraft:acacia_wall_hanging_sign", ID: 222, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 5562, MaxState: 5569, DefaultState: 5563},
+	{Name: "minecraft:cherry_wall_hanging_sign", ID: 223, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 5570, MaxState: 5577, DefaultState: 5571},
+	{Name: "minecraft:jungle_wall_hanging_sign", ID: 224, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 5578, MaxState: 5585, DefaultState: 5579},
+	{Name: "minecraft:dark_oak_wall_hanging_sign", ID: 225, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 5586, MaxState: 5593, DefaultState: 5587},
+	{Name: "minecraft:crimson_wall_hanging_sign", ID: 226, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 5594, MaxState: 5601, DefaultState: 5595},
+	{Name: "minecraft:warped_wall_hanging_sign", ID: 227, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 5602, MaxState: 5609, DefaultState: 5603},
+	{Name: "minecraft:mangrove_wall_hanging_sign", ID: 228, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 5610, MaxState: 5617, DefaultState: 5611},
+	{Name: "minecraft:bamboo_wall_hanging_sign", ID: 229, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 5618, MaxState: 5625, DefaultState: 5619},
+	{Name: "minecraft:lever", ID: 230, Properties: []Property{{"face", []string{"floor", "wall", "ceiling"}}, {"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 5626, MaxState: 5649, DefaultState: 5635},
+	{Name: "minecraft:stone_pressure_plate", ID: 231, Properties: []Property{{"powered", []string{"true", "false"}}}, MinState: 5650, MaxState: 5651, DefaultState: 5651},
+	{Name: "minecraft:iron_door", ID: 232, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"upper", "lower"}}, {"hinge", []string{"left", "right"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 5652, MaxState: 5715, DefaultState: 5663},
+	{Name: "minecraft:oak_pressure_plate", ID: 233, Properties: []Property{{"powered", []string{"true", "false"}}}, MinState: 5716, MaxState: 5717, DefaultState: 5717},
+	{Name: "minecraft:spruce_pressure_plate", ID: 234, Properties: []Property{{"powered", []string{"true", "false"}}}, MinState: 5718, MaxState: 5719, DefaultState: 5719},
+	{Name: "minecraft:birch_pressure_plate", ID: 235, Properties: []Property{{"powered", []string{"true", "false"}}}, MinState: 5720, MaxState: 5721, DefaultState: 5721},
+	{Name: "minecraft:jungle_pressure_plate", ID: 236, Properties: []Property{{"powered", []string{"true", "false"}}}, MinState: 5722, MaxState: 5723, DefaultState: 5723},
+	{Name: "minecraft:acacia_pressure_plate", ID: 237, Properties: []Property{{"powered", []string{"true", "false"}}}, MinState: 5724, MaxState: 5725, DefaultState: 5725},
+	{Name: "minecraft:cherry_pressure_plate", ID: 238, Properties: []Property{{"powered", []string{"true", "false"}}}, MinState: 5726, MaxState: 5727, DefaultState: 5727},
+	{Name: "minecraft:dark_oak_pressure_plate", ID: 239, Properties: []Property{{"powered", []string{"true", "false"}}}, MinState: 5728, MaxState: 5729, DefaultState: 5729},
+	{Name: "minecraft:mangrove_pressure_plate", ID: 240, Properties: []Property{{"powered", []string{"true", "false"}}}, MinState: 5730, MaxState: 5731, DefaultState: 5731},
+	{Name: "minecraft:bamboo_pressure_plate", ID: 241, Properties: []Property{{"powered", []string{"true", "false"}}}, MinState: 5732, MaxState: 5733, DefaultState: 5733},
+	{Name: "minecraft:redstone_ore", ID: 242, Properties: []Property{{"lit", []string{"true", "false"}}}, MinState: 5734, MaxState: 5735, DefaultState: 5735},
+	{Name: "minecraft:deepslate_redstone_ore", ID: 243, Properties: []Property{{"lit", []string{"true", "false"}}}, MinState: 5736, MaxState: 5737, DefaultState: 5737},
+	{Name: "minecraft:redstone_torch", ID: 244, Properties: []Property{{"lit", []string{"true", "false"}}}, MinState: 5738, MaxState: 5739, DefaultState: 5738},
+	{Name: "minecraft:redstone_wall_torch", ID: 245, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"lit", []string{"true", "false"}}}, MinState: 5740, MaxState: 5747, DefaultState: 5740},
+	{Name: "minecraft:stone_button", ID: 246, Properties: []Property{{"face", []string{"floor", "wall", "ceiling"}}, {"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 5748, MaxState: 5771, DefaultState: 5757},
+	{Name: "minecraft:snow", ID: 247, Properties: []Property{{"layers", []string{"1", "2", "3", "4", "5", "6", "7", "8"}}}, MinState: 5772, MaxState: 5779, DefaultState: 5772},
+	{Name: "minecraft:ice", ID: 248, MinState: 5780, MaxState: 5780, DefaultState: 5780},
+	{Name: "minecraft:snow_block", ID: 249, MinState: 5781, MaxState: 5781, DefaultState: 5781},
+	{Name: "minecraft:cactus", ID: 250, Properties: []Property{{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 5782, MaxState: 5797, DefaultState: 5782},
+	{Name: "minecraft:clay", ID: 251, MinState: 5798, MaxState: 5798, DefaultState: 5798},
+	{Name: "minecraft:sugar_cane", ID: 252, Properties: []Property{{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 5799, MaxState: 5814, DefaultState: 5799},
+	{Name: "minecraft:jukebox", ID: 253, Properties: []Property{{"has_record", []string{"true", "false"}}}, MinState: 5815, MaxState: 5816, DefaultState: 5816},
+	{Name: "minecraft:oak_fence", ID: 254, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 5817, MaxState: 5848, DefaultState: 5848},
+	{Name: "minecraft:netherrack", ID: 255, MinState: 5849, MaxState: 5849, DefaultState: 5849},
+	{Name: "minecraft:soul_sand", ID: 256, MinState: 5850, MaxState: 5850, DefaultState: 5850},
+	{Name: "minecraft:soul_soil", ID: 257, MinState: 5851, MaxState: 5851, DefaultState: 5851},
+	{Name: "minecraft:basalt", ID: 258, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 5852, MaxState: 5854, DefaultState: 5853},
+	{Name: "minecraft:polished_basalt", ID: 259, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 5855, MaxState: 5857, DefaultState: 5856},
+	{Name: "minecraft:soul_torch", ID: 260, MinState: 5858, MaxState: 5858, DefaultState: 5858},
+	{Name: "minecraft:soul_wall_torch", ID: 261, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 5859, MaxState: 5862, DefaultState: 5859},
+	{Name: "minecraft:glowstone", ID: 262, MinState: 5863, MaxState: 5863, DefaultState: 5863},
+	{Name: "minecraft:nether_portal", ID: 263, Properties: []Property{{"axis", []string{"x", "z"}}}, MinState: 5864, MaxState: 5865, DefaultState: 5864},
+	{Name: "minecraft:carved_pumpkin", ID: 264, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 5866, MaxState: 5869, DefaultState: 5866},
+	{Name: "minecraft:jack_o_lantern", ID: 265, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 5870, MaxState: 5873, DefaultState: 5870},
+	{Name: "minecraft:cake", ID: 266, Properties: []Property{{"bites", []string{"0", "1", "2", "3", "4", "5", "6"}}}, MinState: 5874, MaxState: 5880, DefaultState: 5874},
+	{Name: "minecraft:repeater", ID: 267, Properties: []Property{{"delay", []string{"1", "2", "3", "4"}}, {"facing", []string{"north", "south", "west", "east"}}, {"locked", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 5881, MaxState: 5944, DefaultState: 5884},
+	{Name: "minecraft:white_stained_glass", ID: 268, MinState: 5945, MaxState: 5945, DefaultState: 5945},
+	{Name: "minecraft:orange_stained_glass", ID: 269, MinState: 5946, MaxState: 5946, DefaultState: 5946},
+	{Name: "minecraft:magenta_stained_glass", ID: 270, MinState: 5947, MaxState: 5947, DefaultState: 5947},
+	{Name: "minecraft:light_blue_stained_glass", ID: 271, MinState: 5948, MaxState: 5948, DefaultState: 5948},
+	{Name: "minecraft:yellow_stained_glass", ID: 272, MinState: 5949, MaxState: 5949, DefaultState: 5949},
+	{Name: "minecraft:lime_stained_glass", ID: 273, MinState: 5950, MaxState: 5950, DefaultState: 5950},
+	{Name: "minecraft:pink_stained_glass", ID: 274, MinState: 5951, MaxState: 5951, DefaultState: 5951},
+	{Name: "minecraft:gray_stained_glass", ID: 275, MinState: 5952, MaxState: 5952, DefaultState: 5952},
+	{Name: "minecraft:light_gray_stained_glass", ID: 276, MinState: 5953, MaxState: 5953, DefaultState: 5953},
+	{Name: "minecraft:cyan_stained_glass", ID: 277, MinState: 5954, MaxState: 5954, DefaultState: 5954},
+	{Name: "minecraft:purple_stained_glass", ID: 278, MinState: 5955, MaxState: 5955, DefaultState: 5955},
+	{Name: "minecraft:blue_stained_glass", ID: 279, MinState: 5956, MaxState: 5956, DefaultState: 5956},
+	{Name: "minecraft:brown_stained_glass", ID: 280, MinState: 5957, MaxState: 5957, DefaultState: 5957},
+	{Name: "minecraft:green_stained_glass", ID: 281, MinState: 5958, MaxState: 5958, DefaultState: 5958},
+	{Name: "minecraft:red_stained_glass", ID: 282, MinState: 5959, MaxState: 5959, DefaultState: 5959},
+	{Name: "minecraft:black_stained_glass", ID: 283, MinState: 5960, MaxState: 5960, DefaultState: 5960},
+	{Name: "minecraft:oak_trapdoor", ID: 284, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 5961, MaxState: 6024, DefaultState: 5976},
+	{Name: "minecraft:spruce_trapdoor", ID: 285, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 6025, MaxState: 6088, DefaultState: 6040},
+	{Name: "minecraft:birch_trapdoor", ID: 286, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 6089, MaxState: 6152, DefaultState: 6104},
+	{Name: "minecraft:jungle_trapdoor", ID: 287, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 6153, MaxState: 6216, DefaultState: 6168},
+	{Name: "minecraft:acacia_trapdoor", ID: 288, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 6217, MaxState: 6280, DefaultState: 6232},
+	{Name: "minecraft:cherry_trapdoor", ID: 289, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 6281, MaxState: 6344, DefaultState: 6296},
+	{Name: "minecraft:dark_oak_trapdoor", ID: 290, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 6345, MaxState: 6408, DefaultState: 6360},
+	{Name: "minecraft:mangrove_trapdoor", ID: 291, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 6409, MaxState: 6472, DefaultState: 6424},
+	{Name: "minecraft:bamboo_trapdoor", ID: 292, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 6473, MaxState: 6536, DefaultState: 6488},
+	{Name: "minecraft:stone_bricks", ID: 293, MinState: 6537, MaxState: 6537, DefaultState: 6537},
+	{Name: "minecraft:mossy_stone_bricks", ID: 294, MinState: 6538, MaxState: 6538, DefaultState: 6538},
+	{Name: "minecraft:cracked_stone_bricks", ID: 295, MinState: 6539, MaxState: 6539, DefaultState: 6539},
+	{Name: "minecraft:chiseled_stone_bricks", ID: 296, MinState: 6540, MaxState: 6540, DefaultState: 6540},
+	{Name: "minecraft:packed_mud", ID: 297, MinState: 6541, MaxState: 6541, DefaultState: 6541},
+	{Name: "minecraft:mud_bricks", ID: 298, MinState: 6542, MaxState: 6542, DefaultState: 6542},
+	{Name: "minecraft:infested_stone", ID: 299, MinState: 6543, MaxState: 6543, DefaultState: 6543},
+	{Name: "minecraft:infested_cobblestone", ID: 300, MinState: 6544, MaxState: 6544, DefaultState: 6544},
+	{Name: "minecraft:infested_stone_bricks", ID: 301, MinState: 6545, MaxState: 6545, DefaultState: 6545},
+	{Name: "minecraft:infested_mossy_stone_bricks", ID: 302, MinState: 6546, MaxState: 6546, DefaultState: 6546},
+	{Name: "minecraft:infested_cracked_stone_bricks", ID: 303, MinState: 6547, MaxState: 6547, DefaultState: 6547},
+	{Name: "minecraft:infested_chiseled_stone_bricks", ID: 304, MinState: 6548, MaxState: 6548, DefaultState: 6548},
+	{Name: "minecraft:brown_mushroom_block", ID: 305, Properties: []Property{{"down", []string{"true", "false"}}, {"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"up", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 6549, MaxState: 6612, DefaultState: 6549},
+	{Name: "minecraft:red_mushroom_block", ID: 306, Properties: []Property{{"down", []string{"true", "false"}}, {"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"up", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 6613, MaxState: 6676, DefaultState: 6613},
+	{Name: "minecraft:mushroom_stem", ID: 307, Properties: []Property{{"down", []string{"true", "false"}}, {"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"up", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 6677, MaxState: 6740, DefaultState: 6677},
+	{Name: "minecraft:iron_bars", ID: 308, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 6741, MaxState: 6772, DefaultState: 6772},
+	{Name: "minecraft:chain", ID: 309, Properties: []Property{{"axis", []string{"x", "y", "z"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 6773, MaxState: 6778, DefaultState: 6776},
+	{Name: "minecraft:glass_pane", ID: 310, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 6779, MaxState: 6810, DefaultState: 6810},
+	{Name: "minecraft:pumpkin", ID: 311, MinState: 6811, MaxState: 6811, DefaultState: 6811},
+	{Name: "minecraft:melon", ID: 312, MinState: 6812, MaxState: 6812, DefaultState: 6812},
+	{Name: "minecraft:attached_pumpkin_stem", ID: 313, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 6813, MaxState: 6816, DefaultState: 6813},
+	{Name: "minecraft:attached_melon_stem", ID: 314, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 6817, MaxState: 6820, DefaultState: 6817},
+	{Name: "minecraft:pumpkin_stem", ID: 315, Properties: []Property{{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7"}}}, MinState: 6821, MaxState: 6828, DefaultState: 6821},
+	{Name: "minecraft:melon_stem", ID: 316, Properties: []Property{{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7"}}}, MinState: 6829, MaxState: 6836, DefaultState: 6829},
+	{Name: "minecraft:vine", ID: 317, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"up", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 6837, MaxState: 6868, DefaultState: 6868},
+	{Name: "minecraft:glow_lichen", ID: 318, Properties: []Property{{"down", []string{"true", "false"}}, {"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 6869, MaxState: 6996, DefaultState: 6996},
+	{Name: "minecraft:oak_fence_gate", ID: 319, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"in_wall", []string{"true", "false"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 6997, MaxState: 7028, DefaultState: 7004},
+	{Name: "minecraft:brick_stairs", ID: 320, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 7029, MaxState: 7108, DefaultState: 7040},
+	{Name: "minecraft:stone_brick_stairs", ID: 321, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 7109, MaxState: 7188, DefaultState: 7120},
+	{Name: "minecraft:mud_brick_stairs", ID: 322, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 7189, MaxState: 7268, DefaultState: 7200},
+	{Name: "minecraft:mycelium", ID: 323, Properties: []Property{{"snowy", []string{"true", "false"}}}, MinState: 7269, MaxState: 7270, DefaultState: 7270},
+	{Name: "minecraft:lily_pad", ID: 324, MinState: 7271, MaxState: 7271, DefaultState: 7271},
+	{Name: "minecraft:nether_bricks", ID: 325, MinState: 7272, MaxState: 7272, DefaultState: 7272},
+	{Name: "minecraft:nether_brick_fence", ID: 326, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 7273, MaxState: 7304, DefaultState: 7304},
+	{Name: "minecraft:nether_brick_stairs", ID: 327, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 7305, MaxState: 7384, DefaultState: 7316},
+	{Name: "minecraft:nether_wart", ID: 328, Properties: []Property{{"age", []string{"0", "1", "2", "3"}}}, MinState: 7385, MaxState: 7388, DefaultState: 7385},
+	{Name: "minecraft:enchanting_table", ID: 329, MinState: 7389, MaxState: 7389, DefaultState: 7389},
+	{Name: "minecraft:brewing_stand", ID: 330, Properties: []Property{{"has_bottle_0", []string{"true", "false"}}, {"has_bottle_1", []string{"true", "false"}}, {"has_bottle_2", []string{"true", "false"}}}, MinState: 7390, MaxState: 7397, DefaultState: 7397},
+	{Name: "minecraft:cauldron", ID: 331, MinState: 7398, MaxState: 7398, DefaultState: 7398},
+	{Name: "minecraft:water_cauldron", ID: 332, Properties: []Property{{"level", []string{"1", "2", "3"}}}, MinState: 7399, MaxState: 7401, DefaultState: 7399},
+	{Name: "minecraft:lava_cauldron", ID: 333, MinState: 7402, MaxState: 7402, DefaultState: 7402},
+	{Name: "minecraft:powder_snow_cauldron", ID: 334, Properties: []Property{{"level", []string{"1", "2", "3"}}}, MinState: 7403, MaxState: 7405, DefaultState: 7403},
+	{Name: "minecraft:end_portal", ID: 335, MinState: 7406, MaxState: 7406, DefaultState: 7406},
+	{Name: "minecraft:end_portal_frame", ID: 336, Properties: []Property{{"eye", []string{"true", "false"}}, {"facing", []string{"north", "south", "west", "east"}}}, MinState: 7407, MaxState: 7414, DefaultState: 7411},
+	{Name: "minecraft:end_stone", ID: 337, MinState: 7415, MaxState: 7415, DefaultState: 7415},
+	{Name: "minecraft:dragon_egg", ID: 338, MinState: 7416, MaxState: 7416, DefaultState: 7416},
+	{Name: "minecraft:redstone_lamp", ID: 339, Properties: []Property{{"lit", []string{"true", "false"}}}, MinState: 7417, MaxState: 7418, DefaultState: 7418},
+	{Name: "minecraft:cocoa", ID: 340, Properties: []Property{{"age", []string{"0", "1", "2"}}, {"facing", []string{"north", "south", "west", "east"}}}, MinState: 7419, MaxState: 7430, DefaultState: 7419},
+	{Name: "minecraft:sandstone_stairs", ID: 341, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 7431, MaxState: 7510, DefaultState: 7442},
+	{Name: "minecraft:emerald_ore", ID: 342, MinState: 7511, MaxState: 7511, DefaultState: 7511},
+	{Name: "minecraft:deepslate_emerald_ore", ID: 343, MinState: 7512, MaxState: 7512, DefaultState: 7512},
+	{Name: "minecraft:ender_chest", ID: 344, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 7513, MaxState: 7520, DefaultState: 7514},
+	{Name: "minecraft:tripwire_hook", ID: 345, Properties: []Property{{"attached", []string{"true", "false"}}, {"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 7521, MaxState: 7536, DefaultState: 7530},
+	{Name: "minecraft:tripwire", ID: 346, Properties: []Property{{"attached", []string{"true", "false"}}, {"disarmed", []string{"true", "false"}}, {"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"powered", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 7537, MaxState: 7664, DefaultState: 7664},
+	{Name: "minecraft:emerald_block", ID: 347, MinState: 7665, MaxState: 7665, DefaultState: 7665},
+	{Name: "minecraft:spruce_stairs", ID: 348, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 7666, MaxState: 7745, DefaultState: 7677},
+	{Name: "minecraft:birch_stairs", ID: 349, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 7746, MaxState: 7825, DefaultState: 7757},
+	{Name: "minecraft:jungle_stairs", ID: 350, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 7826, MaxState: 7905, DefaultState: 7837},
+	{Name: "minecraft:command_block", ID: 351, Properties: []Property{{"conditional", []string{"true", "false"}}, {"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 7906, MaxState: 7917, DefaultState: 7912},
+	{Name: "minecraft:beacon", ID: 352, MinState: 7918, MaxState: 7918, DefaultState: 7918},
+	{Name: "minecraft:cobblestone_wall", ID: 353, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 7919, MaxState: 8242, DefaultState: 7922},
+	{Name: "minecraft:mossy_cobblestone_wall", ID: 354, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 8243, MaxState: 8566, DefaultState: 8246},
+	{Name: "minecraft:flower_pot", ID: 355, MinState: 8567, MaxState: 8567, DefaultState: 8567},
+	{Name: "minecraft:potted_torchflower", ID: 356, MinState: 8568, MaxState: 8568, DefaultState: 8568},
+	{Name: "minecraft:potted_oak_sapling", ID: 357, MinState: 8569, MaxState: 8569, DefaultState: 8569},
+	{Name: "minecraft:potted_spruce_sapling", ID: 358, MinState: 8570, MaxState: 8570, DefaultState: 8570},
+	{Name: "minecraft:potted_birch_sapling", ID: 359, MinState: 8571, MaxState: 8571, DefaultState: 8571},
+	{Name: "minecraft:potted_jungle_sapling", ID: 360, MinState: 8572, MaxState: 8572, DefaultState: 8572},
+	{Name: "minecraft:potted_acacia_sapling", ID: 361, MinState: 8573, MaxState: 8573, DefaultState: 8573},
+	{Name: "minecraft:potted_cherry_sapling", ID: 362, MinState: 8574, MaxState: 8574, DefaultState: 8574},
+	{Name: "minecraft:potted_dark_oak_sapling", ID: 363, MinState: 8575, MaxState: 8575, DefaultState: 8575},
+	{Name: "minecraft:potted_mangrove_propagule", ID: 364, MinState: 8576, MaxState: 8576, DefaultState: 8576},
+	{Name: "minecraft:potted_fern", ID: 365, MinState: 8577, MaxState: 8577, DefaultState: 8577},
+	{Name: "minecraft:potted_dandelion", ID: 366, MinState: 8578, MaxState: 8578, DefaultState: 8578},
+	{Name: "minecraft:potted_poppy", ID: 367, MinState: 8579, MaxState: 8579, DefaultState: 8579},
+	{Name: "minecraft:potted_blue_orchid", ID: 368, MinState: 8580, MaxState: 8580, DefaultState: 8580},
+	{Name: "minecraft:potted_allium", ID: 369, MinState: 8581, MaxState: 8581, DefaultState: 8581},
+	{Name: "minecraft:potted_azure_bluet", ID: 370, MinState: 8582, MaxState: 8582, DefaultState: 8582},
+	{Name: "minecraft:potted_red_tulip", ID: 371, MinState: 8583, MaxState: 8583, DefaultState: 8583},
+	{Name: "minecraft:potted_orange_tulip", ID: 372, MinState: 8584, MaxState: 8584, DefaultState: 8584},
+	{Name: "minecraft:potted_white_tulip", ID: 373, MinState: 8585, MaxState: 8585, DefaultState: 8585},
+	{Name: "minecraft:potted_pink_tulip", ID: 374, MinState: 8586, MaxState: 8586, DefaultState: 8586},
+	{Name: "minecraft:potted_oxeye_daisy", ID: 375, MinState: 8587, MaxState: 8587, DefaultState: 8587},
+	{Name: "minecraft:potted_cornflower", ID: 376, MinState: 8588, MaxState: 8588, DefaultState: 8588},
+	{Name: "minecraft:potted_lily_of_the_valley", ID: 377, MinState: 8589, MaxState: 8589, DefaultState: 8589},
+	{Name: "minecraft:potted_wither_rose", ID: 378, MinState: 8590, MaxState: 8590, DefaultState: 8590},
+	{Name: "minecraft:potted_red_mushroom", ID: 379, MinState: 8591, MaxState: 8591, DefaultState: 8591},
+	{Name: "minecraft:potted_brown_mushroom", ID: 380, MinState: 8592, MaxState: 8592, DefaultState: 8592},
+	{Name: "minecraft:potted_dead_bush", ID: 381, MinState: 8593, MaxState: 8593, DefaultState: 8593},
+	{Name: "minecraft:potted_cactus", ID: 382, MinState: 8594, MaxState: 8594, DefaultState: 8594},
+	{Name: "minecraft:carrots", ID: 383, Properties: []Property{{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7"}}}, MinState: 8595, MaxState: 8602, DefaultState: 8595},
+	{Name: "minecraft:potatoes", ID: 384, Properties: []Property{{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7"}}}, MinState: 8603, MaxState: 8610, DefaultState: 8603},
+	{Name: "minecraft:oak_button", ID: 385, Properties: []Property{{"face", []string{"floor", "wall", "ceiling"}}, {"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 8611, MaxState: 8634, DefaultState: 8620},
+	{Name: "minecraft:spruce_button", ID: 386, Properties: []Property{{"face", []string{"floor", "wall", "ceiling"}}, {"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 8635, MaxState: 8658, DefaultState: 8644},
+	{Name: "minecraft:birch_button", ID: 387, Properties: []Property{{"face", []string{"floor", "wall", "ceiling"}}, {"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 8659, MaxState: 8682, DefaultState: 8668},
+	{Name: "minecraft:jungle_button", ID: 388, Properties: []Property{{"face", []string{"floor", "wall", "ceiling"}}, {"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 8683, MaxState: 8706, DefaultState: 8692},
+	{Name: "minecraft:acacia_button", ID: 389, Properties: []Property{{"face", []string{"floor", "wall", "ceiling"}}, {"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 8707, MaxState: 8730, DefaultState: 8716},
+	{Name: "minecraft:cherry_button", ID: 390, Properties: []Property{{"face", []string{"floor", "wall", "ceiling"}}, {"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 8731, MaxState: 8754, DefaultState: 8740},
+	{Name: "minecraft:dark_oak_button", ID: 391, Properties: []Property{{"face", []string{"floor", "wall", "ceiling"}}, {"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 8755, MaxState: 8778, DefaultState: 8764},
+	{Name: "minecraft:mangrove_button", ID: 392, Properties: []Property{{"face", []string{"floor", "wall", "ceiling"}}, {"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 8779, MaxState: 8802, DefaultState: 8788},
+	{Name: "minecraft:bamboo_button", ID: 393, Properties: []Property{{"face", []string{"floor", "wall", "ceiling"}}, {"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 8803, MaxState: 8826, DefaultState: 8812},
+	{Name: "minecraft:skeleton_skull", ID: 394, Properties: []Property{{"powered", []string{"true", "false"}}, {"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 8827, MaxState: 8858, DefaultState: 8843},
+	{Name: "minecraft:skeleton_wall_skull", ID: 395, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 8859, MaxState: 8866, DefaultState: 8860},
+	{Name: "minecraft:wither_skeleton_skull", ID: 396, Properties: []Property{{"powered", []string{"true", "false"}}, {"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 8867, MaxState: 8898, DefaultState: 8883},
+	{Name: "minecraft:wither_skeleton_wall_skull", ID: 397, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 8899, MaxState: 8906, DefaultState: 8900},
+	{Name: "minecraft:zombie_head", ID: 398, Properties: []Property{{"powered", []string{"true", "false"}}, {"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 8907, MaxState: 8938, DefaultState: 8923},
+	{Name: "minecraft:zombie_wall_head", ID: 399, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 8939, MaxState: 8946, DefaultState: 8940},
+	{Name: "minecraft:player_head", ID: 400, Properties: []Property{{"powered", []string{"true", "false"}}, {"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 8947, MaxState: 8978, DefaultState: 8963},
+	{Name: "minecraft:player_wall_head", ID: 401, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 8979, MaxState: 8986, DefaultState: 8980},
+	{Name: "minecraft:creeper_head", ID: 402, Properties: []Property{{"powered", []string{"true", "false"}}, {"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 8987, MaxState: 9018, DefaultState: 9003},
+	{Name: "minecraft:creeper_wall_head", ID: 403, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 9019, MaxState: 9026, DefaultState: 9020},
+	{Name: "minecraft:dragon_head", ID: 404, Properties: []Property{{"powered", []string{"true", "false"}}, {"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 9027, MaxState: 9058, DefaultState: 9043},
+	{Name: "minecraft:dragon_wall_head", ID: 405, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 9059, MaxState: 9066, DefaultState: 9060},
+	{Name: "minecraft:piglin_head", ID: 406, Properties: []Property{{"powered", []string{"true", "false"}}, {"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 9067, MaxState: 9098, DefaultState: 9083},
+	{Name: "minecraft:piglin_wall_head", ID: 407, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 9099, MaxState: 9106, DefaultState: 9100},
+	{Name: "minecraft:anvil", ID: 408, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 9107, MaxState: 9110, DefaultState: 9107},
+	{Name: "minecraft:chipped_anvil", ID: 409, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 9111, MaxState: 9114, DefaultState: 9111},
+	{Name: "minecraft:damaged_anvil", ID: 410, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 9115, MaxState: 9118, DefaultState: 9115},
+	{Name: "minecraft:trapped_chest", ID: 411, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"type", []string{"single", "left", "right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 9119, MaxState: 9142, DefaultState: 9120},
+	{Name: "minecraft:light_weighted_pressure_plate", ID: 412, Properties: []Property{{"power", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 9143, MaxState: 9158, DefaultState: 9143},
+	{Name: "minecraft:heavy_weighted_pressure_plate", ID: 413, Properties: []Property{{"power", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 9159, MaxState: 9174, DefaultState: 9159},
+	{Name: "minecraft:comparator", ID: 414, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"mode", []string{"compare", "subtract"}}, {"powered", []string{"true", "false"}}}, MinState: 9175, MaxState: 9190, DefaultState: 9176},
+	{Name: "minecraft:daylight_detector", ID: 415, Properties: []Property{{"inverted", []string{"true", "false"}}, {"power", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 9191, MaxState: 9222, DefaultState: 9207},
+	{Name: "minecraft:redstone_block", ID: 416, MinState: 9223, MaxState: 9223, DefaultState: 9223},
+	{Name: "minecraft:nether_quartz_ore", ID: 417, MinState: 9224, MaxState: 9224, DefaultState: 9224},
+	{Name: "minecraft:hopper", ID: 418, Properties: []Property{{"enabled", []string{"true", "false"}}, {"facing", []string{"down", "north", "south", "west", "east"}}}, MinState: 9225, MaxState: 9234, DefaultState: 9225},
+	{Name: "minecraft:quartz_block", ID: 419, MinState: 9235, MaxState: 9235, DefaultState: 9235},
+	{Name: "minecraft:chiseled_quartz_block", ID: 420, MinState: 9236, MaxState: 9236, DefaultState: 9236},
+	{Name: "minecraft:quartz_pillar", ID: 421, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 9237, MaxState: 9239, DefaultState: 9238},
+	{Name: "minecraft:quartz_stairs", ID: 422, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 9240, MaxState: 9319, DefaultState: 9251},
+	{Name: "minecraft:activator_rail", ID: 423, Properties: []Property{{"powered", []string{"true", "false"}}, {"shape", []string{"north_south", "east_west", "ascending_east", "ascending_west", "ascending_north", "ascending_south"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 9320, MaxState: 9343, DefaultState: 9333},
+	{Name: "minecraft:dropper", ID: 424, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}, {"triggered", []string{"true", "false"}}}, MinState: 9344, MaxState: 9355, DefaultState: 9345},
+	{Name: "minecraft:white_terracotta", ID: 425, MinState: 9356, MaxState: 9356, DefaultState: 9356},
+	{Name: "minecraft:orange_terracotta", ID: 426, MinState: 9357, MaxState: 9357, DefaultState: 9357},
+	{Name: "minecraft:magenta_terracotta", ID: 427, MinState: 9358, MaxState: 9358, DefaultState: 9358},
+	{Name: "minecraft:light_blue_terracotta", ID: 428, MinState: 9359, MaxState: 9359, DefaultState: 9359},
+	{Name: "minecraft:yellow_terracotta", ID: 429, MinState: 9360, MaxState: 9360, DefaultState: 9360},
+	{Name: "minecraft:lime_terracotta", ID: 430, MinState: 9361, MaxState: 9361, DefaultState: 9361},
+	{Name: "minecraft:pink_terracotta", ID: 431, MinState: 9362, MaxState: 9362, DefaultState: 9362},
+	{Name: "minecraft:gray_terracotta", ID: 432, MinState: 9363, MaxState: 9363, DefaultState: 9363},
+	{Name: "minecraft:light_gray_terracotta", ID: 433, MinState: 9364, MaxState: 9364, DefaultState: 9364},
+	{Name: "minecraft:cyan_terracotta", ID: 434, MinState: 9365, MaxState: 9365, DefaultState: 9365},
+	{Name: "minecraft:purple_terracotta", ID: 435, MinState: 9366, MaxState: 9366, DefaultState: 9366},
+	{Name: "minecraft:blue_terracotta", ID: 436, MinState: 9367, MaxState: 9367, DefaultState: 9367},
+	{Name: "minecraft:brown_terracotta", ID: 437, MinState: 9368, MaxState: 9368, DefaultState: 9368},
+	{Name: "minecraft:green_terracotta", ID: 438, MinState: 9369, MaxState: 9369, DefaultState: 9369},
+	{Name: "minecraft:red_terracotta", ID: 439, MinState: 9370, MaxState: 9370, DefaultState: 9370},
+	{Name: "minecraft:black_terracotta", ID: 440, MinState: 9371, MaxState: 9371, DefaultState: 9371},
+	{Name: "minecraft:white_stained_glass_pane", ID: 441, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 9372, MaxState: 9403, DefaultState: 9403},
+	{Name: "minecraft:orange_stained_glass_pane", ID: 442, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 9404, MaxState: 9435, DefaultState: 9435},
+	{Name: "minecraft:magenta_stained_glass_pane", ID: 443, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 9436, MaxState: 9467, DefaultState: 9467},
+	{Name: "minecraft:light_blue_stained_glass_pane", ID: 444, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 9468, MaxState: 9499, DefaultState: 9499},
+	{Name: "minecraft:yellow_stained_glass_pane", ID: 445, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 9500, MaxState: 9531, DefaultState: 9531},
+	{Name: "minecraft:lime_stained_glass_pane", ID: 446, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 9532, MaxState: 9563, DefaultState: 9563},
+	{Name: "minecraft:pink_stained_glass_pane", ID: 447, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 9564, MaxState: 9595, DefaultState: 9595},
+	{Name: "minecraft:gray_stained_glass_pane", ID: 448, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 9596, MaxState: 9627, DefaultState: 9627},
+	{Name: "minecraft:light_gray_stained_glass_pane", ID: 449, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 9628, MaxState: 9659, DefaultState: 9659},
+	{Name: "minecraft:cyan_stained_glass_pane", ID: 450, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 9660, MaxState: 9691, DefaultState: 9691},
+	{Name: "minecraft:purple_stained_glass_pane", ID: 451, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 9692, MaxState: 9723, DefaultState: 9723},
+	{Name: "minecraft:blue_stained_glass_pane", ID: 452, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 9724, MaxState: 9755, DefaultState: 9755},
+	{Name: "minecraft:brown_stained_glass_pane", ID: 453, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 9756, MaxState: 9787, DefaultState: 9787},
+	{Name: "minecraft:green_stained_glass_pane", ID: 454, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 9788, MaxState: 9819, DefaultState: 9819},
+	{Name: "minecraft:red_stained_glass_pane", ID: 455, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 9820, MaxState: 9851, DefaultState: 9851},
+	{Name: "minecraft:black_stained_glass_pane", ID: 456, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 9852, MaxState: 9883, DefaultState: 9883},
+	{Name: "minecraft:acacia_stairs", ID: 457, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 9884, MaxState: 9963, DefaultState: 9895},
+	{Name: "minecraft:cherry_stairs", ID: 458, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 9964, MaxState: 10043, DefaultState: 9975},
+	{Name: "minecraft:dark_oak_stairs", ID: 459, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 10044, MaxState: 10123, DefaultState: 10055},
+	{Name: "minecraft:mangrove_stairs", ID: 460, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 10124, MaxState: 10203, DefaultState: 10135},
+	{Name: "minecraft:bamboo_stairs", ID: 461, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 10204, MaxState: 10283, DefaultState: 10215},
+	{Name: "minecraft:bamboo_mosaic_stairs", ID: 462, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 10284, MaxState: 10363, DefaultState: 10295},
+	{Name: "minecraft:slime_block", ID: 463, MinState: 10364, MaxState: 10364, DefaultState: 10364},
+	{Name: "minecraft:barrier", ID: 464, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 10365, MaxState: 10366, DefaultState: 10366},
+	{Name: "minecraft:light", ID: 465, Properties: []Property{{"level", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 10367, MaxState: 10398, DefaultState: 10398},
+	{Name: "minecraft:iron_trapdoor", ID: 466, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 10399, MaxState: 10462, DefaultState: 10414},
+	{Name: "minecraft:prismarine", ID: 467, MinState: 10463, MaxState: 10463, DefaultState: 10463},
+	{Name: "minecraft:prismarine_bricks", ID: 468, MinState: 10464, MaxState: 10464, DefaultState: 10464},
+	{Name: "minecraft:dark_prismarine", ID: 469, MinState: 10465, MaxState: 10465, DefaultState: 10465},
+	{Name: "minecraft:prismarine_stairs", ID: 470, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 10466, MaxState: 10545, DefaultState: 10477},
+	{Name: "minecraft:prismarine_brick_stairs", ID: 471, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 10546, MaxState: 10625, DefaultState: 10557},
+	{Name: "minecraft:dark_prismarine_stairs", ID: 472, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 10626, MaxState: 10705, DefaultState: 10637},
+	{Name: "minecraft:prismarine_slab", ID: 473, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 10706, MaxState: 10711, DefaultState: 10709},
+	{Name: "minecraft:prismarine_brick_slab", ID: 474, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 10712, MaxState: 10717, DefaultState: 10715},
+	{Name: "minecraft:dark_prismarine_slab", ID: 475, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 10718, MaxState: 10723, DefaultState: 10721},
+	{Name: "minecraft:sea_lantern", ID: 476, MinState: 10724, MaxState: 10724, DefaultState: 10724},
+	{Name: "minecraft:hay_block", ID: 477, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 10725, MaxState: 10727, DefaultState: 10726},
+	{Name: "minecraft:white_carpet", ID: 478, MinState: 10728, MaxState: 10728, DefaultState: 10728},
+	{Name: "minecraft:orange_carpet", ID: 479, MinState: 10729, MaxState: 10729, DefaultState: 10729},
+	{Name: "minecraft:magenta_carpet", ID: 480, MinState: 10730, MaxState: 10730, DefaultState: 10730},
+	{Name: "minecraft:light_blue_carpet", ID: 481, MinState: 10731, MaxState: 10731, DefaultState: 10731},
+	{Name: "minecraft:yellow_carpet", ID: 482, MinState: 10732, MaxState: 10732, DefaultState: 10732},
+	{Name: "minecraft:lime_carpet", ID: 483, MinState: 10733, MaxState: 10733, DefaultState: 10733},
+	{Name: "minecraft:pink_carpet", ID: 484, MinState: 10734, MaxState: 10734, DefaultState: 10734},
+	{Name: "minecraft:gray_carpet", ID: 485, MinState: 10735, MaxState: 10735, DefaultState: 10735},
+	{Name: "minecraft:light_gray_carpet", ID: 486, MinState: 10736, MaxState: 10736, DefaultState: 10736},
+	{Name: "minecraft:cyan_carpet", ID: 487, MinState: 10737, MaxState: 10737, DefaultState: 10737},
+	{Name: "minecraft:purple_carpet", ID: 488, MinState: 10738, MaxState: 10738, DefaultState: 10738},
+	{Name: "minecraft:blue_carpet", ID: 489, MinState: 10739, MaxState: 10739, DefaultState: 10739},
+	{Name: "minecraft:brown_carpet", ID: 490, MinState: 10740, MaxState: 10740, DefaultState: 10740},
+	{Name: "minecraft:green_carpet", ID: 491, MinState: 10741, MaxState: 10741, DefaultState: 10741},
+	{Name: "minecraft:red_carpet", ID: 492, MinState: 10742, MaxState: 10742, DefaultState: 10742},
+	{Name: "minecraft:black_carpet", ID: 493, MinState: 10743, MaxState: 10743, DefaultState: 10743},
+	{Name: "minecraft:terracotta", ID: 494, MinState: 10744, MaxState: 10744, DefaultState: 10744},
+	{Name: "minecraft:coal_block", ID: 495, MinState: 10745, MaxState: 10745, DefaultState: 10745},
+	{Name: "minecraft:packed_ice", ID: 496, MinState: 10746, MaxState: 10746, DefaultState: 10746},
+	{Name: "minecraft:sunflower", ID: 497, Properties: []Property{{"half", []string{"upper", "lower"}}}, MinState: 10747, MaxState: 10748, DefaultState: 10748},
+	{Name: "minecraft:lilac", ID: 498, Properties: []Property{{"half", []string{"upper", "lower"}}}, MinState: 10749, MaxState: 10750, DefaultState: 10750},
+	{Name: "minecraft:rose_bush", ID: 499, Properties: []Property{{"half", []string{"upper", "lower"}}}, MinState: 10751, MaxState: 10752, DefaultState: 10752},
+	{Name: "minecraft:peony", ID: 500, Properties: []Property{{"half", []string{"upper", "lower"}}}, MinState: 10753, MaxState: 10754, DefaultState: 10754},
+	{Name: "minecraft:tall_grass", ID: 501, Properties: []Property{{"half", []string{"upper", "lower"}}}, MinState: 10755, MaxState: 10756, DefaultState: 10756},
+	{Name: "minecraft:large_fern", ID: 502, Properties: []Property{{"half", []string{"upper", "lower"}}}, MinState: 10757, MaxState: 10758, DefaultState: 10758},
+	{Name: "minecraft:white_banner", ID: 503, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 10759, MaxState: 10774, DefaultState: 10759},
+	{Name: "minecraft:orange_banner", ID: 504, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 10775, MaxState: 10790, DefaultState: 10775},
+	{Name: "minecraft:magenta_banner", ID: 505, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 10791, MaxState: 10806, DefaultState: 10791},
+	{Name: "minecraft:light_blue_banner", ID: 506, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 10807, MaxState: 10822, DefaultState: 10807},
+	{Name: "minecraft:yellow_banner", ID: 507, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 10823, MaxState: 10838, DefaultState: 10823},
+	{Name: "minecraft:lime_banner", ID: 508, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 10839, MaxState: 10854, DefaultState: 10839},
+	{Name: "minecraft:pink_banner", ID: 509, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 10855, MaxState: 10870, DefaultState: 10855},
+	{Name: "minecraft:gray_banner", ID: 510, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 10871, MaxState: 10886, DefaultState: 10871},
+	{Name: "minecraft:light_gray_banner", ID: 511, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 10887, MaxState: 10902, DefaultState: 10887},
+	{Name: "minecraft:cyan_banner", ID: 512, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 10903, MaxState: 10918, DefaultState: 10903},
+	{Name: "minecraft:purple_banner", ID: 513, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 10919, MaxState: 10934, DefaultState: 10919},
+	{Name: "minecraft:blue_banner", ID: 514, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 10935, MaxState: 10950, DefaultState: 10935},
+	{Name: "minecraft:brown_banner", ID: 515, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 10951, MaxState: 10966, DefaultState: 10951},
+	{Name: "minecraft:green_banner", ID: 516, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 10967, MaxState: 10982, DefaultState: 10967},
+	{Name: "minecraft:red_banner", ID: 517, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 10983, MaxState: 10998, DefaultState: 10983},
+	{Name: "minecraft:black_banner", ID: 518, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 10999, MaxState: 11014, DefaultState: 10999},
+	{Name: "minecraft:white_wall_banner", ID: 519, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 11015, MaxState: 11018, DefaultState: 11015},
+	{Name: "minecraft:orange_wall_banner", ID: 520, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 11019, MaxState: 11022, DefaultState: 11019},
+	{Name: "minecraft:magenta_wall_banner", ID: 521, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 11023, MaxState: 11026, DefaultState: 11023},
+	{Name: "minecraft:light_blue_wall_banner", ID: 522, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 11027, MaxState: 11030, DefaultState: 11027},
+	{Name: "minecraft:yellow_wall_banner", ID: 523, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 11031, MaxState: 11034, DefaultState: 11031},
+	{Name: "minecraft:lime_wall_banner", ID: 524, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 11035, MaxState: 11038, DefaultState: 11035},
+	{Name: "minecraft:pink_wall_banner", ID: 525, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 11039, MaxState: 11042, DefaultState: 11039},
+	{Name: "minecraft:gray_wall_banner", ID: 526, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 11043, MaxState: 11046, DefaultState: 11043},
+	{Name: "minecraft:light_gray_wall_banner", ID: 527, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 11047, MaxState: 11050, DefaultState: 11047},
+	{Name: "minecraft:cyan_wall_banner", ID: 528, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 11051, MaxState: 11054, DefaultState: 11051},
+	{Name: "minecraft:purple_wall_banner", ID: 529, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 11055, MaxState: 11058, DefaultState: 11055},
+	{Name: "minecraft:blue_wall_banner", ID: 530, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 11059, MaxState: 11062, DefaultState: 11059},
+	{Name: "minecraft:brown_wall_banner", ID: 531, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 11063, MaxState: 11066, DefaultState: 11063},
+	{Name: "minecraft:green_wall_banner", ID: 532, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 11067, MaxState: 11070, DefaultState: 11067},
+	{Name: "minecraft:red_wall_banner", ID: 533, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 11071, MaxState: 11074, DefaultState: 11071},
+	{Name: "minecraft:black_wall_banner", ID: 534, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 11075, MaxState: 11078, DefaultState: 11075},
+	{Name: "minecraft:red_sandstone", ID: 535, MinState: 11079, MaxState: 11079, DefaultState: 11079},
+	{Name: "minecraft:chiseled_red_sandstone", ID: 536, MinState: 11080, MaxState: 11080, DefaultState: 11080},
+	{Name: "minecraft:cut_red_sandstone", ID: 537, MinState: 11081, MaxState: 11081, DefaultState: 11081},
+	{Name: "minecraft:red_sandstone_stairs", ID: 538, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11082, MaxState: 11161, DefaultState: 11093},
+	{Name: "minecraft:oak_slab", ID: 539, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11162, MaxState: 11167, DefaultState: 11165},
+	{Name: "minecraft:spruce_slab", ID: 540, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11168, MaxState: 11173, DefaultState: 11171},
+	{Name: "minecraft:birch_slab", ID: 541, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11174, MaxState: 11179, DefaultState: 11177},
+	{Name: "minecraft:jungle_slab", ID: 542, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11180, MaxState: 11185, DefaultState: 11183},
+	{Name: "minecraft:acacia_slab", ID: 543, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11186, MaxState: 11191, DefaultState: 11189},
+	{Name: "minecraft:cherry_slab", ID: 544, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11192, MaxState: 11197, DefaultState: 11195},
+	{Name: "minecraft:dark_oak_slab", ID: 545, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11198, MaxState: 11203, DefaultState: 11201},
+	{Name: "minecraft:mangrove_slab", ID: 546, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11204, MaxState: 11209, DefaultState: 11207},
+	{Name: "minecraft:bamboo_slab", ID: 547, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11210, MaxState: 11215, DefaultState: 11213},
+	{Name: "minecraft:bamboo_mosaic_slab", ID: 548, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11216, MaxState: 11221, DefaultState: 11219},
+	{Name: "minecraft:stone_slab", ID: 549, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11222, MaxState: 11227, DefaultState: 11225},
+	{Name: "minecraft:smooth_stone_slab", ID: 550, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11228, MaxState: 11233, DefaultState: 11231},
+	{Name: "minecraft:sandstone_slab", ID: 551, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11234, MaxState: 11239, DefaultState: 11237},
+	{Name: "minecraft:cut_sandstone_slab", ID: 552, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11240, MaxState: 11245, DefaultState: 11243},
+	{Name: "minecraft:petrified_oak_slab", ID: 553, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11246, MaxState: 11251, DefaultState: 11249},
+	{Name: "minecraft:cobblestone_slab", ID: 554, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11252, MaxState: 11257, DefaultState: 11255},
+	{Name: "minecraft:brick_slab", ID: 555, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11258, MaxState: 11263, DefaultState: 11261},
+	{Name: "minecraft:stone_brick_slab", ID: 556, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11264, MaxState: 11269, DefaultState: 11267},
+	{Name: "minecraft:mud_brick_slab", ID: 557, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11270, MaxState: 11275, DefaultState: 11273},
+	{Name: "minecraft:nether_brick_slab", ID: 558, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11276, MaxState: 11281, DefaultState: 11279},
+	{Name: "minecraft:quartz_slab", ID: 559, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11282, MaxState: 11287, DefaultState: 11285},
+	{Name: "minecraft:red_sandstone_slab", ID: 560, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11288, MaxState: 11293, DefaultState: 11291},
+	{Name: "minecraft:cut_red_sandstone_slab", ID: 561, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11294, MaxState: 11299, DefaultState: 11297},
+	{Name: "minecraft:purpur_slab", ID: 562, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 11300, MaxState: 11305, DefaultState: 11303},
+	{Name: "minecraft:smooth_stone", ID: 563, MinState: 11306, MaxState: 11306, DefaultState: 11306},
+	{Name: "minecraft:smooth_sandstone", ID: 564, MinState: 11307, MaxState: 11307, DefaultState: 11307},
+	{Name: "minecraft:smooth_quartz", ID: 565, MinState: 11308, MaxState: 11308, DefaultState: 11308},
+	{Name: "minecraft:smooth_red_sandstone", ID: 566, MinState: 11309, MaxState: 11309, DefaultState: 11309},
+	{Name: "minecraft:spruce_fence_gate", ID: 567, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"in_wall", []string{"true", "false"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 11310, MaxState: 11341, DefaultState: 11317},
+	{Name: "minecraft:birch_fence_gate", ID: 568, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"in_wall", []string{"true", "false"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 11342, MaxState: 11373, DefaultState: 11349},
+	{Name: "minecraft:jungle_fence_gate", ID: 569, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"in_wall", []string{"true", "false"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 11374, MaxState: 11405, DefaultState: 11381},
+	{Name: "minecraft:acacia_fence_gate", ID: 570, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"in_wall", []string{"true", "false"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 11406, MaxState: 11437, DefaultState: 11413},
+	{Name: "minecraft:cherry_fence_gate", ID: 571, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"in_wall", []string{"true", "false"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 11438, MaxState: 11469, DefaultState: 11445},
+	{Name: "minecraft:dark_oak_fence_gate", ID: 572, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"in_wall", []string{"true", "false"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 11470, MaxState: 11501, DefaultState: 11477},
+	{Name: "minecraft:mangrove_fence_gate", ID: 573, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"in_wall", []string{"true", "false"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 11502, MaxState: 11533, DefaultState: 11509},
+	{Name: "minecraft:bamboo_fence_gate", ID: 574, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"in_wall", []string{"true", "false"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 11534, MaxState: 11565, DefaultState: 11541},
+	{Name: "minecraft:spruce_fence", ID: 575, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 11566, MaxState: 11597, DefaultState: 11597},
+	{Name: "minecraft:birch_fence", ID: 576, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 11598, MaxState: 11629, DefaultState: 11629},
+	{Name: "minecraft:jungle_fence", ID: 577, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 11630, MaxState: 11661, DefaultState: 11661},
+	{Name: "minecraft:acacia_fence", ID: 578, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 11662, MaxState: 11693, DefaultState: 11693},
+	{Name: "minecraft:cherry_fence", ID: 579, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 11694, MaxState: 11725, DefaultState: 11725},
+	{Name: "minecraft:dark_oak_fence", ID: 580, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 11726, MaxState: 11757, DefaultState: 11757},
+	{Name: "minecraft:mangrove_fence", ID: 581, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 11758, MaxState: 11789, DefaultState: 11789},
+	{Name: "minecraft:bamboo_fence", ID: 582, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 11790, MaxState: 11821, DefaultState: 11821},
+	{Name: "minecraft:spruce_door", ID: 583, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"upper", "lower"}}, {"hinge", []string{"left", "right"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 11822, MaxState: 11885, DefaultState: 11833},
+	{Name: "minecraft:birch_door", ID: 584, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"upper", "lower"}}, {"hinge", []string{"left", "right"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 11886, MaxState: 11949, DefaultState: 11897},
+	{Name: "minecraft:jungle_door", ID: 585, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"upper", "lower"}}, {"hinge", []string{"left", "right"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 11950, MaxState: 12013, DefaultState: 11961},
+	{Name: "minecraft:acacia_door", ID: 586, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"upper", "lower"}}, {"hinge", []string{"left", "right"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 12014, MaxState: 12077, DefaultState: 12025},
+	{Name: "minecraft:cherry_door", ID: 587, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"upper", "lower"}}, {"hinge", []string{"left", "right"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 12078, MaxState: 12141, DefaultState: 12089},
+	{Name: "minecraft:dark_oak_door", ID: 588, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"upper", "lower"}}, {"hinge", []string{"left", "right"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 12142, MaxState: 12205, DefaultState: 12153},
+	{Name: "minecraft:mangrove_door", ID: 589, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"upper", "lower"}}, {"hinge", []string{"left", "right"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 12206, MaxState: 12269, DefaultState: 12217},
+	{Name: "minecraft:bamboo_door", ID: 590, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"upper", "lower"}}, {"hinge", []string{"left", "right"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 12270, MaxState: 12333, DefaultState: 12281},
+	{Name: "minecraft:end_rod", ID: 591, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 12334, MaxState: 12339, DefaultState: 12338},
+	{Name: "minecraft:chorus_plant", ID: 592, Properties: []Property{{"down", []string{"true", "false"}}, {"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"up", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 12340, MaxState: 12403, DefaultState: 12403},
+	{Name: "minecraft:chorus_flower", ID: 593, Properties: []Property{{"age", []string{"0", "1", "2", "3", "4", "5"}}}, MinState: 12404, MaxState: 12409, DefaultState: 12404},
+	{Name: "minecraft:purpur_block", ID: 594, MinState: 12410, MaxState: 12410, DefaultState: 12410},
+	{Name: "minecraft:purpur_pillar", ID: 595, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 12411, MaxState: 12413, DefaultState: 12412},
+	{Name: "minecraft:purpur_stairs", ID: 596, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 12414, MaxState: 12493, DefaultState: 12425},
+	{Name: "minecraft:end_stone_bricks", ID: 597, MinState: 12494, MaxState: 12494, DefaultState: 12494},
+	{Name: "minecraft:torchflower_crop", ID: 598, Properties: []Property{{"age", []string{"0", "1"}}}, MinState: 12495, MaxState: 12496, DefaultState: 12495},
+	{Name: "minecraft:pitcher_crop", ID: 599, Properties: []Property{{"age", []string{"0", "1", "2", "3", "4"}}, {"half", []string{"upper", "lower"}}}, MinState: 12497, MaxState: 12506, DefaultState: 12498},
+	{Name: "minecraft:pitcher_plant", ID: 600, Properties: []Property{{"half", []string{"upper", "lower"}}}, MinState: 12507, MaxState: 12508, DefaultState: 12508},
+	{Name: "minecraft:beetroots", ID: 601, Properties: []Property{{"age", []string{"0", "1", "2", "3"}}}, MinState: 12509, MaxState: 12512, DefaultState: 12509},
+	{Name: "minecraft:dirt_path", ID: 602, MinState: 12513, MaxState: 12513, DefaultState: 12513},
+	{Name: "minecraft:end_gateway", ID: 603, MinState: 12514, MaxState: 12514, DefaultState: 12514},
+	{Name: "minecraft:repeating_command_block", ID: 604, Properties: []Property{{"conditional", []string{"true", "false"}}, {"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 12515, MaxState: 12526, DefaultState: 12521},
+	{Name: "minecraft:chain_command_block", ID: 605, Properties: []Property{{"conditional", []string{"true", "false"}}, {"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 12527, MaxState: 12538, DefaultState: 12533},
+	{Name: "minecraft:frosted_ice", ID: 606, Properties: []Property{{"age", []string{"0", "1", "2", "3"}}}, MinState: 12539, MaxState: 12542, DefaultState: 12539},
+	{Name: "minecraft:magma_block", ID: 607, MinState: 12543, MaxState: 12543, DefaultState: 12543},
+	{Name: "minecraft:nether_wart_block", ID: 608, MinState: 12544, MaxState: 12544, DefaultState: 12544},
+	{Name: "minecraft:red_nether_bricks", ID: 609, MinState: 12545, MaxState: 12545, DefaultState: 12545},
+	{Name: "minecraft:bone_block", ID: 610, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 12546, MaxState: 12548, DefaultState: 12547},
+	{Name: "minecraft:structure_void", ID: 611, MinState: 12549, MaxState: 12549, DefaultState: 12549},
+	{Name: "minecraft:observer", ID: 612, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}, {"powered", []string{"true", "false"}}}, MinState: 12550, MaxState: 12561, DefaultState: 12555},
+	{Name: "minecraft:shulker_box", ID: 613, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 12562, MaxState: 12567, DefaultState: 12566},
+	{Name: "minecraft:white_shulker_box", ID: 614, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 12568, MaxState: 12573, DefaultState: 12572},
+	{Name: "minecraft:orange_shulker_box", ID: 615, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 12574, MaxState: 12579, DefaultState: 12578},
+	{Name: "minecraft:magenta_shulker_box", ID: 616, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 12580, MaxState: 12585, DefaultState: 12584},
+	{Name: "minecraft:light_blue_shulker_box", ID: 617, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 12586, MaxState: 12591, DefaultState: 12590},
+	{Name: "minecraft:yellow_shulker_box", ID: 618, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 12592, MaxState: 12597, DefaultState: 12596},
+	{Name: "minecraft:lime_shulker_box", ID: 619, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 12598, MaxState: 12603, DefaultState: 12602},
+	{Name: "minecraft:pink_shulker_box", ID: 620, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 12604, MaxState: 12609, DefaultState: 12608},
+	{Name: "minecraft:gray_shulker_box", ID: 621, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 12610, MaxState: 12615, DefaultState: 12614},
+	{Name: "minecraft:light_gray_shulker_box", ID: 622, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 12616, MaxState: 12621, DefaultState: 12620},
+	{Name: "minecraft:cyan_shulker_box", ID: 623, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 12622, MaxState: 12627, DefaultState: 12626},
+	{Name: "minecraft:purple_shulker_box", ID: 624, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 12628, MaxState: 12633, DefaultState: 12632},
+	{Name: "minecraft:blue_shulker_box", ID: 625, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 12634, MaxState: 12639, DefaultState: 12638},
+	{Name: "minecraft:brown_shulker_box", ID: 626, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 12640, MaxState: 12645, DefaultState: 12644},
+	{Name: "minecraft:green_shulker_box", ID: 627, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 12646, MaxState: 12651, DefaultState: 12650},
+	{Name: "minecraft:red_shulker_box", ID: 628, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 12652, MaxState: 12657, DefaultState: 12656},
+	{Name: "minecraft:black_shulker_box", ID: 629, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}}, MinState: 12658, MaxState: 12663, DefaultState: 12662},
+	{Name: "minecraft:white_glazed_terracotta", ID: 630, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 12664, MaxState: 12667, DefaultState: 12664},
+	{Name: "minecraft:orange_glazed_terracotta", ID: 631, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 12668, MaxState: 12671, DefaultState: 12668},
+	{Name: "minecraft:magenta_glazed_terracotta", ID: 632, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 12672, MaxState: 12675, DefaultState: 12672},
+	{Name: "minecraft:light_blue_glazed_terracotta", ID: 633, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 12676, MaxState: 12679, DefaultState: 12676},
+	{Name: "minecraft:yellow_glazed_terracotta", ID: 634, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 12680, MaxState: 12683, DefaultState: 12680},
+	{Name: "minecraft:lime_glazed_terracotta", ID: 635, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 12684, MaxState: 12687, DefaultState: 12684},
+	{Name: "minecraft:pink_glazed_terracotta", ID: 636, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 12688, MaxState: 12691, DefaultState: 12688},
+	{Name: "minecraft:gray_glazed_terracotta", ID: 637, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 12692, MaxState: 12695, DefaultState: 12692},
+	{Name: "minecraft:light_gray_glazed_terracotta", ID: 638, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 12696, MaxState: 12699, DefaultState: 12696},
+	{Name: "minecraft:cyan_glazed_terracotta", ID: 639, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 12700, MaxState: 12703, DefaultState: 12700},
+	{Name: "minecraft:purple_glazed_terracotta", ID: 640, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 12704, MaxState: 12707, DefaultState: 12704},
+	{Name: "minecraft:blue_glazed_terracotta", ID: 641, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 12708, MaxState: 12711, DefaultState: 12708},
+	{Name: "minecraft:brown_glazed_terracotta", ID: 642, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 12712, MaxState: 12715, DefaultState: 12712},
+	{Name: "minecraft:green_glazed_terracotta", ID: 643, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 12716, MaxState: 12719, DefaultState: 12716},
+	{Name: "minecraft:red_glazed_terracotta", ID: 644, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 12720, MaxState: 12723, DefaultState: 12720},
+	{Name: "minecraft:black_glazed_terracotta", ID: 645, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 12724, MaxState: 12727, DefaultState: 12724},
+	{Name: "minecraft:white_concrete", ID: 646, MinState: 12728, MaxState: 12728, DefaultState: 12728},
+	{Name: "minecraft:orange_concrete", ID: 647, MinState: 12729, MaxState: 12729, DefaultState: 12729},
+	{Name: "minecraft:magenta_concrete", ID: 648, MinState: 12730, MaxState: 12730, DefaultState: 12730},
+	{Name: "minecraft:light_blue_concrete", ID: 649, MinState: 12731, MaxState: 12731, DefaultState: 12731},
+	{Name: "minecraft:yellow_concrete", ID: 650, MinState: 12732, MaxState: 12732, DefaultState: 12732},
+	{Name: "minecraft:lime_concrete", ID: 651, MinState: 12733, MaxState: 12733, DefaultState: 12733},
+	{Name: "minecraft:pink_concrete", ID: 652, MinState: 12734, MaxState: 12734, DefaultState: 12734},
+	{Name: "minecraft:gray_concrete", ID: 653, MinState: 12735, MaxState: 12735, DefaultState: 12735},
+	{Name: "minecraft:light_gray_concrete", ID: 654, MinState: 12736, MaxState: 12736, DefaultState: 12736},
+	{Name: "minecraft:cyan_concrete", ID: 655, MinState: 12737, MaxState: 12737, DefaultState: 12737},
+	{Name: "minecraft:purple_concrete", ID: 656, MinState: 12738, MaxState: 12738, DefaultState: 12738},
+	{Name: "minecraft:blue_concrete", ID: 657, MinState: 12739, MaxState: 12739, DefaultState: 12739},
+	{Name: "minecraft:brown_concrete", ID: 658, MinState: 12740, MaxState: 12740, DefaultState: 12740},
+	{Name: "minecraft:green_concrete", ID: 659, MinState: 12741, MaxState: 12741, DefaultState: 12741},
+	{Name: "minecraft:red_concrete", ID: 660, MinState: 12742, MaxState: 12742, DefaultState: 12742},
+	{Name: "minecraft:black_concrete", ID: 661, MinState: 12743, MaxState: 12743, DefaultState: 12743},
+	{Name: "minecraft:white_concrete_powder", ID: 662, MinState: 12744, MaxState: 12744, DefaultState: 12744},
+	{Name: "minecraft:orange_concrete_powder", ID: 663, MinState: 12745, MaxState: 12745, DefaultState: 12745},
+	{Name: "minecraft:magenta_concrete_powder", ID: 664, MinState: 12746, MaxState: 12746, DefaultState: 12746},
+	{Name: "minecraft:light_blue_concrete_powder", ID: 665, MinState: 12747, MaxState: 12747, DefaultState: 12747},
+	{Name: "minecraft:yellow_concrete_powder", ID: 666, MinState: 12748, MaxState: 12748, DefaultState: 12748},
+	{Name: "minecraft:lime_concrete_powder", ID: 667, MinState: 12749, MaxState: 12749, DefaultState: 12749},
+	{Name: "minecraft:pink_concrete_powder", ID: 668, MinState: 12750, MaxState: 12750, DefaultState: 12750},
+	{Name: "minecraft:gray_concrete_powder", ID: 669, MinState: 12751, MaxState: 12751, DefaultState: 12751},
+	{Name: "minecraft:light_gray_concrete_powder", ID: 670, MinState: 12752, MaxState: 12752, DefaultState: 12752},
+	{Name: "minecraft:cyan_concrete_powder", ID: 671, MinState: 12753, MaxState: 12753, DefaultState: 12753},
+	{Name: "minecraft:purple_concrete_powder", ID: 672, MinState: 12754, MaxState: 12754, DefaultState: 12754},
+	{Name: "minecraft:blue_concrete_powder", ID: 673, MinState: 12755, MaxState: 12755, DefaultState: 12755},
+	{Name: "minecraft:brown_concrete_powder", ID: 674, MinState: 12756, MaxState: 12756, DefaultState: 12756},
+	{Name: "minecraft:green_concrete_powder", ID: 675, MinState: 12757, MaxState: 12757, DefaultState: 12757},
+	{Name: "minecraft:red_concrete_powder", ID: 676, MinState: 12758, MaxState: 12758, DefaultState: 12758},
+	{Name: "minecraft:black_concrete_powder", ID: 677, MinState: 12759, MaxState: 12759, DefaultState: 12759},
+	{Name: "minecraft:kelp", ID: 678, Properties: []Property{{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25"}}}, MinState: 12760, MaxState: 12785, DefaultState: 12760},
+	{Name: "minecraft:kelp_plant", ID: 679, MinState: 12786, MaxState: 12786, DefaultState: 12786},
+	{Name: "minecraft:dried_kelp_block", ID: 680, MinState: 12787, MaxState: 12787, DefaultState: 12787},
+	{Name: "minecraft:turtle_egg", ID: 681, Properties: []Property{{"eggs", []string{"1", "2", "3", "4"}}, {"hatch", []string{"0", "1", "2"}}}, MinState: 12788, MaxState: 12799, DefaultState: 12788},
+	{Name: "minecraft:sniffer_egg", ID: 682, Properties: []Property{{"hatch", []string{"0", "1", "2"}}}, MinState: 12800, MaxState: 12802, DefaultState: 12800},
+	{Name: "minecraft:dead_tube_coral_block", ID: 683, MinState: 12803, MaxState: 12803, DefaultState: 12803},
+	{Name: "minecraft:dead_brain_coral_block", ID: 684, MinState: 12804, MaxState: 12804, DefaultState: 12804},
+	{Name: "minecraft:dead_bubble_coral_block", ID: 685, MinState: 12805, MaxState: 12805, DefaultState: 12805},
+	{Name: "minecraft:dead_fire_coral_block", ID: 686, MinState: 12806, MaxState: 12806, DefaultState: 12806},
+	{Name: "minecraft:dead_horn_coral_block", ID: 687, MinState: 12807, MaxState: 12807, DefaultState: 12807},
+	{Name: "minecraft:tube_coral_block", ID: 688, MinState: 12808, MaxState: 12808, DefaultState: 12808},
+	{Name: "minecraft:brain_coral_block", ID: 689, MinState: 12809, MaxState: 12809, DefaultState: 12809},
+	{Name: "minecraft:bubble_coral_block", ID: 690, MinState: 12810, MaxState: 12810, DefaultState: 12810},
+	{Name: "minecraft:fire_coral_block", ID: 691, MinState: 12811, MaxState: 12811, DefaultState: 12811},
+	{Name: "minecraft:horn_coral_block", ID: 692, MinState: 12812, MaxState: 12812, DefaultState: 12812},
+	{Name: "minecraft:dead_tube_coral", ID: 693, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 12813, MaxState: 12814, DefaultState: 12813},
+	{Name: "minecraft:dead_brain_coral", ID: 694, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 12815, MaxState: 12816, DefaultState: 12815},
+	{Name: "minecraft:dead_bubble_coral", ID: 695, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 12817, MaxState: 12818, DefaultState: 12817},
+	{Name: "minecraft:dead_fire_coral", ID: 696, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 12819, MaxState: 12820, DefaultState: 12819},
+	{Name: "minecraft:dead_horn_coral", ID: 697, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 12821, MaxState: 12822, DefaultState: 12821},
+	{Name: "minecraft:tube_coral", ID: 698, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 12823, MaxState: 12824, DefaultState: 12823},
+	{Name: "minecraft:brain_coral", ID: 699, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 12825, MaxState: 12826, DefaultState: 12825},
+	{Name: "minecraft:bubble_coral", ID: 700, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 12827, MaxState: 12828, DefaultState: 12827},
+	{Name: "minecraft:fire_coral", ID: 701, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 12829, MaxState: 12830, DefaultState: 12829},
+	{Name: "minecraft:horn_coral", ID: 702, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 12831, MaxState: 12832, DefaultState: 12831},
+	{Name: "minecraft:dead_tube_coral_fan", ID: 703, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 12833, MaxState: 12834, DefaultState: 12833},
+	{Name: "minecraft:dead_brain_coral_fan", ID: 704, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 12835, MaxState: 12836, DefaultState: 12835},
+	{Name: "minecraft:dead_bubble_coral_fan", ID: 705, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 12837, MaxState: 12838, DefaultState: 12837},
+	{Name: "minecraft:dead_fire_coral_fan", ID: 706, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 12839, MaxState: 12840, DefaultState: 12839},
+	{Name: "minecraft:dead_horn_coral_fan", ID: 707, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 12841, MaxState: 12842, DefaultState: 12841},
+	{Name: "minecraft:tube_coral_fan", ID: 708, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 12843, MaxState: 12844, DefaultState: 12843},
+	{Name: "minecraft:brain_coral_fan", ID: 709, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 12845, MaxState: 12846, DefaultState: 12845},
+	{Name: "minecraft:bubble_coral_fan", ID: 710, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 12847, MaxState: 12848, DefaultState: 12847},
+	{Name: "minecraft:fire_coral_fan", ID: 711, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 12849, MaxState: 12850, DefaultState: 12849},
+	{Name: "minecraft:horn_coral_fan", ID: 712, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 12851, MaxState: 12852, DefaultState: 12851},
+	{Name: "minecraft:dead_tube_coral_wall_fan", ID: 713, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 12853, MaxState: 12860, DefaultState: 12853},
+	{Name: "minecraft:dead_brain_coral_wall_fan", ID: 714, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 12861, MaxState: 12868, DefaultState: 12861},
+	{Name: "minecraft:dead_bubble_coral_wall_fan", ID: 715, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 12869, MaxState: 12876, DefaultState: 12869},
+	{Name: "minecraft:dead_fire_coral_wall_fan", ID: 716, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 12877, MaxState: 12884, DefaultState: 12877},
+	{Name: "minecraft:dead_horn_coral_wall_fan", ID: 717, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 12885, MaxState: 12892, DefaultState: 12885},
+	{Name: "minecraft:tube_coral_wall_fan", ID: 718, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 12893, MaxState: 12900, DefaultState: 12893},
+	{Name: "minecraft:brain_coral_wall_fan", ID: 719, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 12901, MaxState: 12908, DefaultState: 12901},
+	{Name: "minecraft:bubble_coral_wall_fan", ID: 720, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 12909, MaxState: 12916, DefaultState: 12909},
+	{Name: "minecraft:fire_coral_wall_fan", ID: 721, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 12917, MaxState: 12924, DefaultState: 12917},
+	{Name: "minecraft:horn_coral_wall_fan", ID: 722, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 12925, MaxState: 12932, DefaultState: 12925},
+	{Name: "minecraft:sea_pickle", ID: 723, Properties: []Property{{"pickles", []string{"1", "2", "3", "4"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 12933, MaxState: 12940, DefaultState: 12933},
+	{Name: "minecraft:blue_ice", ID: 724, MinState: 12941, MaxState: 12941, DefaultState: 12941},
+	{Name: "minecraft:conduit", ID: 725, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 12942, MaxState: 12943, DefaultState: 12942},
+	{Name: "minecraft:bamboo_sapling", ID: 726, MinState: 12944, MaxState: 12944, DefaultState: 12944},
+	{Name: "minecraft:bamboo", ID: 727, Properties: []Property{{"age", []string{"0", "1"}}, {"leaves", []string{"none", "small", "large"}}, {"stage", []string{"0", "1"}}}, MinState: 12945, MaxState: 12956, DefaultState: 12945},
+	{Name: "minecraft:potted_bamboo", ID: 728, MinState: 12957, MaxState: 12957, DefaultState: 12957},
+	{Name: "minecraft:void_air", ID: 729, MinState: 12958, MaxState: 12958, DefaultState: 12958},
+	{Name: "minecraft:cave_air", ID: 730, MinState: 12959, MaxState: 12959, DefaultState: 12959},
+	{Name: "minecraft:bubble_column", ID: 731, Properties: []Property{{"drag", []string{"true", "false"}}}, MinState: 12960, MaxState: 12961, DefaultState: 12960},
+	{Name: "minecraft:polished_granite_stairs", ID: 732, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 12962, MaxState: 13041, DefaultState: 12973},
+	{Name: "minecraft:smooth_red_sandstone_stairs", ID: 733, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 13042, MaxState: 13121, DefaultState: 13053},
+	{Name: "minecraft:mossy_stone_brick_stairs", ID: 734, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 13122, MaxState: 13201, DefaultState: 13133},
+	{Name: "minecraft:polished_diorite_stairs", ID: 735, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 13202, MaxState: 13281, DefaultState: 13213},
+	{Name: "minecraft:mossy_cobblestone_stairs", ID: 736, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 13282, MaxState: 13361, DefaultState: 13293},
+	{Name: "minecraft:end_stone_brick_stairs", ID: 737, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 13362, MaxState: 13441, DefaultState: 13373},
+	{Name: "minecraft:stone_stairs", ID: 738, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 13442, MaxState: 13521, DefaultState: 13453},
+	{Name: "minecraft:smooth_sandstone_stairs", ID: 739, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 13522, MaxState: 13601, DefaultState: 13533},
+	{Name: "minecraft:smooth_quartz_stairs", ID: 740, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 13602, MaxState: 13681, DefaultState: 13613},
+	{Name: "minecraft:granite_stairs", ID: 741, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 13682, MaxState: 13761, DefaultState: 13693},
+	{Name: "minecraft:andesite_stairs", ID: 742, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 13762, MaxState: 13841, DefaultState: 13773},
+	{Name: "minecraft:red_nether_brick_stairs", ID: 743, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 13842, MaxState: 13921, DefaultState: 13853},
+	{Name: "minecraft:polished_andesite_stairs", ID: 744, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 13922, MaxState: 14001, DefaultState: 13933},
+	{Name: "minecraft:diorite_stairs", ID: 745, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 14002, MaxState: 14081, DefaultState: 14013},
+	{Name: "minecraft:polished_granite_slab", ID: 746, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 14082, MaxState: 14087, DefaultState: 14085},
+	{Name: "minecraft:smooth_red_sandstone_slab", ID: 747, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 14088, MaxState: 14093, DefaultState: 14091},
+	{Name: "minecraft:mossy_stone_brick_slab", ID: 748, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 14094, MaxState: 14099, DefaultState: 14097},
+	{Name: "minecraft:polished_diorite_slab", ID: 749, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 14100, MaxState: 14105, DefaultState: 14103},
+	{Name: "minecraft:mossy_cobblestone_slab", ID: 750, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 14106, MaxState: 14111, DefaultState: 14109},
+	{Name: "minecraft:end_stone_brick_slab", ID: 751, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 14112, MaxState: 14117, DefaultState: 14115},
+	{Name: "minecraft:smooth_sandstone_slab", ID: 752, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 14118, MaxState: 14123, DefaultState: 14121},
+	{Name: "minecraft:smooth_quartz_slab", ID: 753, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 14124, MaxState: 14129, DefaultState: 14127},
+	{Name: "minecraft:granite_slab", ID: 754, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 14130, MaxState: 14135, DefaultState: 14133},
+	{Name: "minecraft:andesite_slab", ID: 755, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 14136, MaxState: 14141, DefaultState: 14139},
+	{Name: "minecraft:red_nether_brick_slab", ID: 756, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 14142, MaxState: 14147, DefaultState: 14145},
+	{Name: "minecraft:polished_andesite_slab", ID: 757, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 14148, MaxState: 14153, DefaultState: 14151},
+	{Name: "minecraft:diorite_slab", ID: 758, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 14154, MaxState: 14159, DefaultState: 14157},
+	{Name: "minecraft:brick_wall", ID: 759, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 14160, MaxState: 14483, DefaultState: 14163},
+	{Name: "minecraft:prismarine_wall", ID: 760, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 14484, MaxState: 14807, DefaultState: 14487},
+	{Name: "minecraft:red_sandstone_wall", ID: 761, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 14808, MaxState: 15131, DefaultState: 14811},
+	{Name: "minecraft:mossy_stone_brick_wall", ID: 762, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 15132, MaxState: 15455, DefaultState: 15135},
+	{Name: "minecraft:granite_wall", ID: 763, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 15456, MaxState: 15779, DefaultState: 15459},
+	{Name: "minecraft:stone_brick_wall", ID: 764, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 15780, MaxState: 16103, DefaultState: 15783},
+	{Name: "minecraft:mud_brick_wall", ID: 765, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 16104, MaxState: 16427, DefaultState: 16107},
+	{Name: "minecraft:nether_brick_wall", ID: 766, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 16428, MaxState: 16751, DefaultState: 16431},
+	{Name: "minecraft:andesite_wall", ID: 767, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 16752, MaxState: 17075, DefaultState: 16755},
+	{Name: "minecraft:red_nether_brick_wall", ID: 768, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 17076, MaxState: 17399, DefaultState: 17079},
+	{Name: "minecraft:sandstone_wall", ID: 769, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 17400, MaxState: 17723, DefaultState: 17403},
+	{Name: "minecraft:end_stone_brick_wall", ID: 770, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 17724, MaxState: 18047, DefaultState: 17727},
+	{Name: "minecraft:diorite_wall", ID: 771, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 18048, MaxState: 18371, DefaultState: 18051},
+	{Name: "minecraft:scaffolding", ID: 772, Properties: []Property{{"bottom", []string{"true", "false"}}, {"distance", []string{"0", "1", "2", "3", "4", "5", "6", "7"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 18372, MaxState: 18403, DefaultState: 18403},
+	{Name: "minecraft:loom", ID: 773, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 18404, MaxState: 18407, DefaultState: 18404},
+	{Name: "minecraft:barrel", ID: 774, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}, {"open", []string{"true", "false"}}}, MinState: 18408, MaxState: 18419, DefaultState: 18409},
+	{Name: "minecraft:smoker", ID: 775, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"lit", []string{"true", "false"}}}, MinState: 18420, MaxState: 18427, DefaultState: 18421},
+	{Name: "minecraft:blast_furnace", ID: 776, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"lit", []string{"true", "false"}}}, MinState: 18428, MaxState: 18435, DefaultState: 18429},
+	{Name: "minecraft:cartography_table", ID: 777, MinState: 18436, MaxState: 18436, DefaultState: 18436},
+	{Name: "minecraft:fletching_table", ID: 778, MinState: 18437, MaxState: 18437, DefaultState: 18437},
+	{Name: "minecraft:grindstone", ID: 779, Properties: []Property{{"face", []string{"floor", "wall", "ceiling"}}, {"facing", []string{"north", "south", "west", "east"}}}, MinState: 18438, MaxState: 18449, DefaultState: 18442},
+	{Name: "minecraft:lectern", ID: 780, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"has_book", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 18450, MaxState: 18465, DefaultState: 18453},
+	{Name: "minecraft:smithing_table", ID: 781, MinState: 18466, MaxState: 18466, DefaultState: 18466},
+	{Name: "minecraft:stonecutter", ID: 782, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}}, MinState: 18467, MaxState: 18470, DefaultState: 18467},
+	{Name: "minecraft:bell", ID: 783, Properties: []Property{{"attachment", []string{"floor", "ceiling", "single_wall", "double_wall"}}, {"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 18471, MaxState: 18502, DefaultState: 18472},
+	{Name: "minecraft:lantern", ID: 784, Properties: []Property{{"hanging", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 18503, MaxState: 18506, DefaultState: 18506},
+	{Name: "minecraft:soul_lantern", ID: 785, Properties: []Property{{"hanging", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 18507, MaxState: 18510, DefaultState: 18510},
+	{Name: "minecraft:campfire", ID: 786, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"lit", []string{"true", "false"}}, {"signal_fire", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 18511, MaxState: 18542, DefaultState: 18514},
+	{Name: "minecraft:soul_campfire", ID: 787, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"lit", []string{"true", "false"}}, {"signal_fire", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 18543, MaxState: 18574, DefaultState: 18546},
+	{Name: "minecraft:sweet_berry_bush", ID: 788, Properties: []Property{{"age", []string{"0", "1", "2", "3"}}}, MinState: 18575, MaxState: 18578, DefaultState: 18575},
+	{Name: "minecraft:warped_stem", ID: 789, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 18579, MaxState: 18581, DefaultState: 18580},
+	{Name: "minecraft:stripped_warped_stem", ID: 790, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 18582, MaxState: 18584, DefaultState: 18583},
+	{Name: "minecraft:warped_hyphae", ID: 791, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 18585, MaxState: 18587, DefaultState: 18586},
+	{Name: "minecraft:stripped_warped_hyphae", ID: 792, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 18588, MaxState: 18590, DefaultState: 18589},
+	{Name: "minecraft:warped_nylium", ID: 793, MinState: 18591, MaxState: 18591, DefaultState: 18591},
+	{Name: "minecraft:warped_fungus", ID: 794, MinState: 18592, MaxState: 18592, DefaultState: 18592},
+	{Name: "minecraft:warped_wart_block", ID: 795, MinState: 18593, MaxState: 18593, DefaultState: 18593},
+	{Name: "minecraft:warped_roots", ID: 796, MinState: 18594, MaxState: 18594, DefaultState: 18594},
+	{Name: "minecraft:nether_sprouts", ID: 797, MinState: 18595, MaxState: 18595, DefaultState: 18595},
+	{Name: "minecraft:crimson_stem", ID: 798, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 18596, MaxState: 18598, DefaultState: 18597},
+	{Name: "minecraft:stripped_crimson_stem", ID: 799, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 18599, MaxState: 18601, DefaultState: 18600},
+	{Name: "minecraft:crimson_hyphae", ID: 800, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 18602, MaxState: 18604, DefaultState: 18603},
+	{Name: "minecraft:stripped_crimson_hyphae", ID: 801, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 18605, MaxState: 18607, DefaultState: 18606},
+	{Name: "minecraft:crimson_nylium", ID: 802, MinState: 18608, MaxState: 18608, DefaultState: 18608},
+	{Name: "minecraft:crimson_fungus", ID: 803, MinState: 18609, MaxState: 18609, DefaultState: 18609},
+	{Name: "minecraft:shroomlight", ID: 804, MinState: 18610, MaxState: 18610, DefaultState: 18610},
+	{Name: "minecraft:weeping_vines", ID: 805, Properties: []Property{{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25"}}}, MinState: 18611, MaxState: 18636, DefaultState: 18611},
+	{Name: "minecraft:weeping_vines_plant", ID: 806, MinState: 18637, MaxState: 18637, DefaultState: 18637},
+	{Name: "minecraft:twisting_vines", ID: 807, Properties: []Property{{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25"}}}, MinState: 18638, MaxState: 18663, DefaultState: 18638},
+	{Name: "minecraft:twisting_vines_plant", ID: 808, MinState: 18664, MaxState: 18664, DefaultState: 18664},
+	{Name: "minecraft:crimson_roots", ID: 809, MinState: 18665, MaxState: 18665, DefaultState: 18665},
+	{Name: "minecraft:crimson_planks", ID: 810, MinState: 18666, MaxState: 18666, DefaultState: 18666},
+	{Name: "minecraft:warped_planks", ID: 811, MinState: 18667, MaxState: 18667, DefaultState: 18667},
+	{Name: "minecraft:crimson_slab", ID: 812, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 18668, MaxState: 18673, DefaultState: 18671},
+	{Name: "minecraft:warped_slab", ID: 813, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 18674, MaxState: 18679, DefaultState: 18677},
+	{Name: "minecraft:crimson_pressure_plate", ID: 814, Properties: []Property{{"powered", []string{"true", "false"}}}, MinState: 18680, MaxState: 18681, DefaultState: 18681},
+	{Name: "minecraft:warped_pressure_plate", ID: 815, Properties: []Property{{"powered", []string{"true", "false"}}}, MinState: 18682, MaxState: 18683, DefaultState: 18683},
+	{Name: "minecraft:crimson_fence", ID: 816, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 18684, MaxState: 18715, DefaultState: 18715},
+	{Name: "minecraft:warped_fence", ID: 817, Properties: []Property{{"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 18716, MaxState: 18747, DefaultState: 18747},
+	{Name: "minecraft:crimson_trapdoor", ID: 818, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 18748, MaxState: 18811, DefaultState: 18763},
+	{Name: "minecraft:warped_trapdoor", ID: 819, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 18812, MaxState: 18875, DefaultState: 18827},
+	{Name: "minecraft:crimson_fence_gate", ID: 820, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"in_wall", []string{"true", "false"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 18876, MaxState: 18907, DefaultState: 18883},
+	{Name: "minecraft:warped_fence_gate", ID: 821, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"in_wall", []string{"true", "false"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 18908, MaxState: 18939, DefaultState: 18915},
+	{Name: "minecraft:crimson_stairs", ID: 822, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 18940, MaxState: 19019, DefaultState: 18951},
+	{Name: "minecraft:warped_stairs", ID: 823, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 19020, MaxState: 19099, DefaultState: 19031},
+	{Name: "minecraft:crimson_button", ID: 824, Properties: []Property{{"face", []string{"floor", "wall", "ceiling"}}, {"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 19100, MaxState: 19123, DefaultState: 19109},
+	{Name: "minecraft:warped_button", ID: 825, Properties: []Property{{"face", []string{"floor", "wall", "ceiling"}}, {"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 19124, MaxState: 19147, DefaultState: 19133},
+	{Name: "minecraft:crimson_door", ID: 826, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"upper", "lower"}}, {"hinge", []string{"left", "right"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 19148, MaxState: 19211, DefaultState: 19159},
+	{Name: "minecraft:warped_door", ID: 827, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"upper", "lower"}}, {"hinge", []string{"left", "right"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 19212, MaxState: 19275, DefaultState: 19223},
+	{Name: "minecraft:crimson_sign", ID: 828, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 19276, MaxState: 19307, DefaultState: 19277},
+	{Name: "minecraft:warped_sign", ID: 829, Properties: []Property{{"rotation", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 19308, MaxState: 19339, DefaultState: 19309},
+	{Name: "minecraft:crimson_wall_sign", ID: 830, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 19340, MaxState: 19347, DefaultState: 19341},
+	{Name: "minecraft:warped_wall_sign", ID: 831, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 19348, MaxState: 19355, DefaultState: 19349},
+	{Name: "minecraft:structure_block", ID: 832, Properties: []Property{{"mode", []string{"save", "load", "corner", "data"}}}, MinState: 19356, MaxState: 19359, DefaultState: 19357},
+	{Name: "minecraft:jigsaw", ID: 833, Properties: []Property{{"orientation", []string{"down_east", "down_north", "down_south", "down_west", "up_east", "up_north", "up_south", "up_west", "west_up", "east_up", "north_up", "south_up"}}}, MinState: 19360, MaxState: 19371, DefaultState: 19370},
+	{Name: "minecraft:composter", ID: 834, Properties: []Property{{"level", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8"}}}, MinState: 19372, MaxState: 19380, DefaultState: 19372},
+	{Name: "minecraft:target", ID: 835, Properties: []Property{{"power", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}}, MinState: 19381, MaxState: 19396, DefaultState: 19381},
+	{Name: "minecraft:bee_nest", ID: 836, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"honey_level", []string{"0", "1", "2", "3", "4", "5"}}}, MinState: 19397, MaxState: 19420, DefaultState: 19397},
+	{Name: "minecraft:beehive", ID: 837, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"honey_level", []string{"0", "1", "2", "3", "4", "5"}}}, MinState: 19421, MaxState: 19444, DefaultState: 19421},
+	{Name: "minecraft:honey_block", ID: 838, MinState: 19445, MaxState: 19445, DefaultState: 19445},
+	{Name: "minecraft:honeycomb_block", ID: 839, MinState: 19446, MaxState: 19446, DefaultState: 19446},
+	{Name: "minecraft:netherite_block", ID: 840, MinState: 19447, MaxState: 19447, DefaultState: 19447},
+	{Name: "minecraft:ancient_debris", ID: 841, MinState: 19448, MaxState: 19448, DefaultState: 19448},
+	{Name: "minecraft:crying_obsidian", ID: 842, MinState: 19449, MaxState: 19449, DefaultState: 19449},
+	{Name: "minecraft:respawn_anchor", ID: 843, Properties: []Property{{"charges", []string{"0", "1", "2", "3", "4"}}}, MinState: 19450, MaxState: 19454, DefaultState: 19450},
+	{Name: "minecraft:potted_crimson_fungus", ID: 844, MinState: 19455, MaxState: 19455, DefaultState: 19455},
+	{Name: "minecraft:potted_warped_fungus", ID: 845, MinState: 19456, MaxState: 19456, DefaultState: 19456},
+	{Name: "minecraft:potted_crimson_roots", ID: 846, MinState: 19457, MaxState: 19457, DefaultState: 19457},
+	{Name: "minecraft:potted_warped_roots", ID: 847, MinState: 19458, MaxState: 19458, DefaultState: 19458},
+	{Name: "minecraft:lodestone", ID: 848, MinState: 19459, MaxState: 19459, DefaultState: 19459},
+	{Name: "minecraft:blackstone", ID: 849, MinState: 19460, MaxState: 19460, DefaultState: 19460},
+	{Name: "minecraft:blackstone_stairs", ID: 850, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 19461, MaxState: 19540, DefaultState: 19472},
+	{Name: "minecraft:blackstone_wall", ID: 851, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 19541, MaxState: 19864, DefaultState: 19544},
+	{Name: "minecraft:blackstone_slab", ID: 852, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 19865, MaxState: 19870, DefaultState: 19868},
+	{Name: "minecraft:polished_blackstone", ID: 853, MinState: 19871, MaxState: 19871, DefaultState: 19871},
+	{Name: "minecraft:polished_blackstone_bricks", ID: 854, MinState: 19872, MaxState: 19872, DefaultState: 19872},
+	{Name: "minecraft:cracked_polished_blackstone_bricks", ID: 855, MinState: 19873, MaxState: 19873, DefaultState: 19873},
+	{Name: "minecraft:chiseled_polished_blackstone", ID: 856, MinState: 19874, MaxState: 19874, DefaultState: 19874},
+	{Name: "minecraft:polished_blackstone_brick_slab", ID: 857, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 19875, MaxState: 19880, DefaultState: 19878},
+	{Name: "minecraft:polished_blackstone_brick_stairs", ID: 858, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 19881, MaxState: 19960, DefaultState: 19892},
+	{Name: "minecraft:polished_blackstone_brick_wall", ID: 859, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 19961, MaxState: 20284, DefaultState: 19964},
+	{Name: "minecraft:gilded_blackstone", ID: 860, MinState: 20285, MaxState: 20285, DefaultState: 20285},
+	{Name: "minecraft:polished_blackstone_stairs", ID: 861, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 20286, MaxState: 20365, DefaultState: 20297},
+	{Name: "minecraft:polished_blackstone_slab", ID: 862, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 20366, MaxState: 20371, DefaultState: 20369},
+	{Name: "minecraft:polished_blackstone_pressure_plate", ID: 863, Properties: []Property{{"powered", []string{"true", "false"}}}, MinState: 20372, MaxState: 20373, DefaultState: 20373},
+	{Name: "minecraft:polished_blackstone_button", ID: 864, Properties: []Property{{"face", []string{"floor", "wall", "ceiling"}}, {"facing", []string{"north", "south", "west", "east"}}, {"powered", []string{"true", "false"}}}, MinState: 20374, MaxState: 20397, DefaultState: 20383},
+	{Name: "minecraft:polished_blackstone_wall", ID: 865, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 20398, MaxState: 20721, DefaultState: 20401},
+	{Name: "minecraft:chiseled_nether_bricks", ID: 866, MinState: 20722, MaxState: 20722, DefaultState: 20722},
+	{Name: "minecraft:cracked_nether_bricks", ID: 867, MinState: 20723, MaxState: 20723, DefaultState: 20723},
+	{Name: "minecraft:quartz_bricks", ID: 868, MinState: 20724, MaxState: 20724, DefaultState: 20724},
+	{Name: "minecraft:candle", ID: 869, Properties: []Property{{"candles", []string{"1", "2", "3", "4"}}, {"lit", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 20725, MaxState: 20740, DefaultState: 20728},
+	{Name: "minecraft:white_candle", ID: 870, Properties: []Property{{"candles", []string{"1", "2", "3", "4"}}, {"lit", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 20741, MaxState: 20756, DefaultState: 20744},
+	{Name: "minecraft:orange_candle", ID: 871, Properties: []Property{{"candles", []string{"1", "2", "3", "4"}}, {"lit", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 20757, MaxState: 20772, DefaultState: 20760},
+	{Name: "minecraft:magenta_candle", ID: 872, Properties: []Property{{"candles", []string{"1", "2", "3", "4"}}, {"lit", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 20773, MaxState: 20788, DefaultState: 20776},
+	{Name: "minecraft:light_blue_candle", ID: 873, Properties: []Property{{"candles", []string{"1", "2", "3", "4"}}, {"lit", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 20789, MaxState: 20804, DefaultState: 20792},
+	{Name: "minecraft:yellow_candle", ID: 874, Properties: []Property{{"candles", []string{"1", "2", "3", "4"}}, {"lit", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 20805, MaxState: 20820, DefaultState: 20808},
+	{Name: "minecraft:lime_candle", ID: 875, Properties: []Property{{"candles", []string{"1", "2", "3", "4"}}, {"lit", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 20821, MaxState: 20836, DefaultState: 20824},
+	{Name: "minecraft:pink_candle", ID: 876, Properties: []Property{{"candles", []string{"1", "2", "3", "4"}}, {"lit", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 20837, MaxState: 20852, DefaultState: 20840},
+	{Name: "minecraft:gray_candle", ID: 877, Properties: []Property{{"candles", []string{"1", "2", "3", "4"}}, {"lit", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 20853, MaxState: 20868, DefaultState: 20856},
+	{Name: "minecraft:light_gray_candle", ID: 878, Properties: []Property{{"candles", []string{"1", "2", "3", "4"}}, {"lit", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 20869, MaxState: 20884, DefaultState: 20872},
+	{Name: "minecraft:cyan_candle", ID: 879, Properties: []Property{{"candles", []string{"1", "2", "3", "4"}}, {"lit", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 20885, MaxState: 20900, DefaultState: 20888},
+	{Name: "minecraft:purple_candle", ID: 880, Properties: []Property{{"candles", []string{"1", "2", "3", "4"}}, {"lit", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 20901, MaxState: 20916, DefaultState: 20904},
+	{Name: "minecraft:blue_candle", ID: 881, Properties: []Property{{"candles", []string{"1", "2", "3", "4"}}, {"lit", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 20917, MaxState: 20932, DefaultState: 20920},
+	{Name: "minecraft:brown_candle", ID: 882, Properties: []Property{{"candles", []string{"1", "2", "3", "4"}}, {"lit", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 20933, MaxState: 20948, DefaultState: 20936},
+	{Name: "minecraft:green_candle", ID: 883, Properties: []Property{{"candles", []string{"1", "2", "3", "4"}}, {"lit", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 20949, MaxState: 20964, DefaultState: 20952},
+	{Name: "minecraft:red_candle", ID: 884, Properties: []Property{{"candles", []string{"1", "2", "3", "4"}}, {"lit", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 20965, MaxState: 20980, DefaultState: 20968},
+	{Name: "minecraft:black_candle", ID: 885, Properties: []Property{{"candles", []string{"1", "2", "3", "4"}}, {"lit", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 20981, MaxState: 20996, DefaultState: 20984},
+	{Name: "minecraft:candle_cake", ID: 886, Properties: []Property{{"lit", []string{"true", "false"}}}, MinState: 20997, MaxState: 20998, DefaultState: 20998},
+	{Name: "minecraft:white_candle_cake", ID: 887, Properties: []Property{{"lit", []string{"true", "false"}}}, MinState: 20999, MaxState: 21000, DefaultState: 21000},
+	{Name: "minecraft:orange_candle_cake", ID: 888, Properties: []Property{{"lit", []string{"true", "false"}}}, MinState: 21001, MaxState: 21002, DefaultState: 21002},
+	{Name: "minecraft:magenta_candle_cake", ID: 889, Properties: []Property{{"lit", []string{"true", "false"}}}, MinState: 21003, MaxState: 21004, DefaultState: 21004},
+	{Name: "minecraft:light_blue_candle_cake", ID: 890, Properties: []Property{{"lit", []string{"true", "false"}}}, MinState: 21005, MaxState: 21006, DefaultState: 21006},
+	{Name: "minecraft:yellow_candle_cake", ID: 891, Properties: []Property{{"lit", []string{"true", "false"}}}, MinState: 21007, MaxState: 21008, DefaultState: 21008},
+	{Name: "minecraft:lime_candle_cake", ID: 892, Properties: []Property{{"lit", []string{"true", "false"}}}, MinState: 21009, MaxState: 21010, DefaultState: 21010},
+	{Name: "minecraft:pink_candle_cake", ID: 893, Properties: []Property{{"lit", []string{"true", "false"}}}, MinState: 21011, MaxState: 21012, DefaultState: 21012},
+	{Name: "minecraft:gray_candle_cake", ID: 894, Properties: []Property{{"lit", []string{"true", "false"}}}, MinState: 21013, MaxState: 21014, DefaultState: 21014},
+	{Name: "minecraft:light_gray_candle_cake", ID: 895, Properties: []Property{{"lit", []string{"true", "false"}}}, MinState: 21015, MaxState: 21016, DefaultState: 21016},
+	{Name: "minecraft:cyan_candle_cake", ID: 896, Properties: []Property{{"lit", []string{"true", "false"}}}, MinState: 21017, MaxState: 21018, DefaultState: 21018},
+	{Name: "minecraft:purple_candle_cake", ID: 897, Properties: []Property{{"lit", []string{"true", "false"}}}, MinState: 21019, MaxState: 21020, DefaultState: 21020},
+	{Name: "minecraft:blue_candle_cake", ID: 898, Properties: []Property{{"lit", []string{"true", "false"}}}, MinState: 21021, MaxState: 21022, DefaultState: 21022},
+	{Name: "minecraft:brown_candle_cake", ID: 899, Properties: []Property{{"lit", []string{"true", "false"}}}, MinState: 21023, MaxState: 21024, DefaultState: 21024},
+	{Name: "minecraft:green_candle_cake", ID: 900, Properties: []Property{{"lit", []string{"true", "false"}}}, MinState: 21025, MaxState: 21026, DefaultState: 21026},
+	{Name: "minecraft:red_candle_cake", ID: 901, Properties: []Property{{"lit", []string{"true", "false"}}}, MinState: 21027, MaxState: 21028, DefaultState: 21028},
+	{Name: "minecraft:black_candle_cake", ID: 902, Properties: []Property{{"lit", []string{"true", "false"}}}, MinState: 21029, MaxState: 21030, DefaultState: 21030},
+	{Name: "minecraft:amethyst_block", ID: 903, MinState: 21031, MaxState: 21031, DefaultState: 21031},
+	{Name: "minecraft:budding_amethyst", ID: 904, MinState: 21032, MaxState: 21032, DefaultState: 21032},
+	{Name: "minecraft:amethyst_cluster", ID: 905, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 21033, MaxState: 21044, DefaultState: 21042},
+	{Name: "minecraft:large_amethyst_bud", ID: 906, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 21045, MaxState: 21056, DefaultState: 21054},
+	{Name: "minecraft:medium_amethyst_bud", ID: 907, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 21057, MaxState: 21068, DefaultState: 21066},
+	{Name: "minecraft:small_amethyst_bud", ID: 908, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 21069, MaxState: 21080, DefaultState: 21078},
+	{Name: "minecraft:tuff", ID: 909, MinState: 21081, MaxState: 21081, DefaultState: 21081},
+	{Name: "minecraft:tuff_slab", ID: 910, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 21082, MaxState: 21087, DefaultState: 21085},
+	{Name: "minecraft:tuff_stairs", ID: 911, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 21088, MaxState: 21167, DefaultState: 21099},
+	{Name: "minecraft:tuff_wall", ID: 912, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 21168, MaxState: 21491, DefaultState: 21171},
+	{Name: "minecraft:polished_tuff", ID: 913, MinState: 21492, MaxState: 21492, DefaultState: 21492},
+	{Name: "minecraft:polished_tuff_slab", ID: 914, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 21493, MaxState: 21498, DefaultState: 21496},
+	{Name: "minecraft:polished_tuff_stairs", ID: 915, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 21499, MaxState: 21578, DefaultState: 21510},
+	{Name: "minecraft:polished_tuff_wall", ID: 916, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 21579, MaxState: 21902, DefaultState: 21582},
+	{Name: "minecraft:chiseled_tuff", ID: 917, MinState: 21903, MaxState: 21903, DefaultState: 21903},
+	{Name: "minecraft:tuff_bricks", ID: 918, MinState: 21904, MaxState: 21904, DefaultState: 21904},
+	{Name: "minecraft:tuff_brick_slab", ID: 919, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 21905, MaxState: 21910, DefaultState: 21908},
+	{Name: "minecraft:tuff_brick_stairs", ID: 920, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 21911, MaxState: 21990, DefaultState: 21922},
+	{Name: "minecraft:tuff_brick_wall", ID: 921, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 21991, MaxState: 22314, DefaultState: 21994},
+	{Name: "minecraft:chiseled_tuff_bricks", ID: 922, MinState: 22315, MaxState: 22315, DefaultState: 22315},
+	{Name: "minecraft:calcite", ID: 923, MinState: 22316, MaxState: 22316, DefaultState: 22316},
+	{Name: "minecraft:tinted_glass", ID: 924, MinState: 22317, MaxState: 22317, DefaultState: 22317},
+	{Name: "minecraft:powder_snow", ID: 925, MinState: 22318, MaxState: 22318, DefaultState: 22318},
+	{Name: "minecraft:sculk_sensor", ID: 926, Properties: []Property{{"power", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"sculk_sensor_phase", []string{"inactive", "active", "cooldown"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 22319, MaxState: 22414, DefaultState: 22320},
+	{Name: "minecraft:calibrated_sculk_sensor", ID: 927, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"power", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"}}, {"sculk_sensor_phase", []string{"inactive", "active", "cooldown"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 22415, MaxState: 22798, DefaultState: 22416},
+	{Name: "minecraft:sculk", ID: 928, MinState: 22799, MaxState: 22799, DefaultState: 22799},
+	{Name: "minecraft:sculk_vein", ID: 929, Properties: []Property{{"down", []string{"true", "false"}}, {"east", []string{"true", "false"}}, {"north", []string{"true", "false"}}, {"south", []string{"true", "false"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"true", "false"}}}, MinState: 22800, MaxState: 22927, DefaultState: 22927},
+	{Name: "minecraft:sculk_catalyst", ID: 930, Properties: []Property{{"bloom", []string{"true", "false"}}}, MinState: 22928, MaxState: 22929, DefaultState: 22929},
+	{Name: "minecraft:sculk_shrieker", ID: 931, Properties: []Property{{"can_summon", []string{"true", "false"}}, {"shrieking", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 22930, MaxState: 22937, DefaultState: 22937},
+	{Name: "minecraft:copper_block", ID: 932, MinState: 22938, MaxState: 22938, DefaultState: 22938},
+	{Name: "minecraft:exposed_copper", ID: 933, MinState: 22939, MaxState: 22939, DefaultState: 22939},
+	{Name: "minecraft:weathered_copper", ID: 934, MinState: 22940, MaxState: 22940, DefaultState: 22940},
+	{Name: "minecraft:oxidized_copper", ID: 935, MinState: 22941, MaxState: 22941, DefaultState: 22941},
+	{Name: "minecraft:copper_ore", ID: 936, MinState: 22942, MaxState: 22942, DefaultState: 22942},
+	{Name: "minecraft:deepslate_copper_ore", ID: 937, MinState: 22943, MaxState: 22943, DefaultState: 22943},
+	{Name: "minecraft:oxidized_cut_copper", ID: 938, MinState: 22944, MaxState: 22944, DefaultState: 22944},
+	{Name: "minecraft:weathered_cut_copper", ID: 939, MinState: 22945, MaxState: 22945, DefaultState: 22945},
+	{Name: "minecraft:exposed_cut_copper", ID: 940, MinState: 22946, MaxState: 22946, DefaultState: 22946},
+	{Name: "minecraft:cut_copper", ID: 941, MinState: 22947, MaxState: 22947, DefaultState: 22947},
+	{Name: "minecraft:oxidized_chiseled_copper", ID: 942, MinState: 22948, MaxState: 22948, DefaultState: 22948},
+	{Name: "minecraft:weathered_chiseled_copper", ID: 943, MinState: 22949, MaxState: 22949, DefaultState: 22949},
+	{Name: "minecraft:exposed_chiseled_copper", ID: 944, MinState: 22950, MaxState: 22950, DefaultState: 22950},
+	{Name: "minecraft:chiseled_copper", ID: 945, MinState: 22951, MaxState: 22951, DefaultState: 22951},
+	{Name: "minecraft:waxed_oxidized_chiseled_copper", ID: 946, MinState: 22952, MaxState: 22952, DefaultState: 22952},
+	{Name: "minecraft:waxed_weathered_chiseled_copper", ID: 947, MinState: 22953, MaxState: 22953, DefaultState: 22953},
+	{Name: "minecraft:waxed_exposed_chiseled_copper", ID: 948, MinState: 22954, MaxState: 22954, DefaultState: 22954},
+	{Name: "minecraft:waxed_chiseled_copper", ID: 949, MinState: 22955, MaxState: 22955, DefaultState: 22955},
+	{Name: "minecraft:oxidized_cut_copper_stairs", ID: 950, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 22956, MaxState: 23035, DefaultState: 22967},
+	{Name: "minecraft:weathered_cut_copper_stairs", ID: 951, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 23036, MaxState: 23115, DefaultState: 23047},
+	{Name: "minecraft:exposed_cut_copper_stairs", ID: 952, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 23116, MaxState: 23195, DefaultState: 23127},
+	{Name: "minecraft:cut_copper_stairs", ID: 953, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 23196, MaxState: 23275, DefaultState: 23207},
+	{Name: "minecraft:oxidized_cut_copper_slab", ID: 954, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 23276, MaxState: 23281, DefaultState: 23279},
+	{Name: "minecraft:weathered_cut_copper_slab", ID: 955, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 23282, MaxState: 23287, DefaultState: 23285},
+	{Name: "minecraft:exposed_cut_copper_slab", ID: 956, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 23288, MaxState: 23293, DefaultState: 23291},
+	{Name: "minecraft:cut_copper_slab", ID: 957, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 23294, MaxState: 23299, DefaultState: 23297},
+	{Name: "minecraft:waxed_copper_block", ID: 958, MinState: 23300, MaxState: 23300, DefaultState: 23300},
+	{Name: "minecraft:waxed_weathered_copper", ID: 959, MinState: 23301, MaxState: 23301, DefaultState: 23301},
+	{Name: "minecraft:waxed_exposed_copper", ID: 960, MinState: 23302, MaxState: 23302, DefaultState: 23302},
+	{Name: "minecraft:waxed_oxidized_copper", ID: 961, MinState: 23303, MaxState: 23303, DefaultState: 23303},
+	{Name: "minecraft:waxed_oxidized_cut_copper", ID: 962, MinState: 23304, MaxState: 23304, DefaultState: 23304},
+	{Name: "minecraft:waxed_weathered_cut_copper", ID: 963, MinState: 23305, MaxState: 23305, DefaultState: 23305},
+	{Name: "minecraft:waxed_exposed_cut_copper", ID: 964, MinState: 23306, MaxState: 23306, DefaultState: 23306},
+	{Name: "minecraft:waxed_cut_copper", ID: 965, MinState: 23307, MaxState: 23307, DefaultState: 23307},
+	{Name: "minecraft:waxed_oxidized_cut_copper_stairs", ID: 966, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 23308, MaxState: 23387, DefaultState: 23319},
+	{Name: "minecraft:waxed_weathered_cut_copper_stairs", ID: 967, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 23388, MaxState: 23467, DefaultState: 23399},
+	{Name: "minecraft:waxed_exposed_cut_copper_stairs", ID: 968, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 23468, MaxState: 23547, DefaultState: 23479},
+	{Name: "minecraft:waxed_cut_copper_stairs", ID: 969, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 23548, MaxState: 23627, DefaultState: 23559},
+	{Name: "minecraft:waxed_oxidized_cut_copper_slab", ID: 970, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 23628, MaxState: 23633, DefaultState: 23631},
+	{Name: "minecraft:waxed_weathered_cut_copper_slab", ID: 971, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 23634, MaxState: 23639, DefaultState: 23637},
+	{Name: "minecraft:waxed_exposed_cut_copper_slab", ID: 972, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 23640, MaxState: 23645, DefaultState: 23643},
+	{Name: "minecraft:waxed_cut_copper_slab", ID: 973, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 23646, MaxState: 23651, DefaultState: 23649},
+	{Name: "minecraft:copper_door", ID: 974, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"upper", "lower"}}, {"hinge", []string{"left", "right"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 23652, MaxState: 23715, DefaultState: 23663},
+	{Name: "minecraft:exposed_copper_door", ID: 975, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"upper", "lower"}}, {"hinge", []string{"left", "right"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 23716, MaxState: 23779, DefaultState: 23727},
+	{Name: "minecraft:oxidized_copper_door", ID: 976, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"upper", "lower"}}, {"hinge", []string{"left", "right"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 23780, MaxState: 23843, DefaultState: 23791},
+	{Name: "minecraft:weathered_copper_door", ID: 977, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"upper", "lower"}}, {"hinge", []string{"left", "right"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 23844, MaxState: 23907, DefaultState: 23855},
+	{Name: "minecraft:waxed_copper_door", ID: 978, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"upper", "lower"}}, {"hinge", []string{"left", "right"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 23908, MaxState: 23971, DefaultState: 23919},
+	{Name: "minecraft:waxed_exposed_copper_door", ID: 979, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"upper", "lower"}}, {"hinge", []string{"left", "right"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 23972, MaxState: 24035, DefaultState: 23983},
+	{Name: "minecraft:waxed_oxidized_copper_door", ID: 980, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"upper", "lower"}}, {"hinge", []string{"left", "right"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 24036, MaxState: 24099, DefaultState: 24047},
+	{Name: "minecraft:waxed_weathered_copper_door", ID: 981, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"upper", "lower"}}, {"hinge", []string{"left", "right"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 24100, MaxState: 24163, DefaultState: 24111},
+	{Name: "minecraft:copper_trapdoor", ID: 982, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 24164, MaxState: 24227, DefaultState: 24179},
+	{Name: "minecraft:exposed_copper_trapdoor", ID: 983, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 24228, MaxState: 24291, DefaultState: 24243},
+	{Name: "minecraft:oxidized_copper_trapdoor", ID: 984, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 24292, MaxState: 24355, DefaultState: 24307},
+	{Name: "minecraft:weathered_copper_trapdoor", ID: 985, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 24356, MaxState: 24419, DefaultState: 24371},
+	{Name: "minecraft:waxed_copper_trapdoor", ID: 986, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 24420, MaxState: 24483, DefaultState: 24435},
+	{Name: "minecraft:waxed_exposed_copper_trapdoor", ID: 987, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 24484, MaxState: 24547, DefaultState: 24499},
+	{Name: "minecraft:waxed_oxidized_copper_trapdoor", ID: 988, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 24548, MaxState: 24611, DefaultState: 24563},
+	{Name: "minecraft:waxed_weathered_copper_trapdoor", ID: 989, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"open", []string{"true", "false"}}, {"powered", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 24612, MaxState: 24675, DefaultState: 24627},
+	{Name: "minecraft:copper_grate", ID: 990, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 24676, MaxState: 24677, DefaultState: 24677},
+	{Name: "minecraft:exposed_copper_grate", ID: 991, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 24678, MaxState: 24679, DefaultState: 24679},
+	{Name: "minecraft:weathered_copper_grate", ID: 992, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 24680, MaxState: 24681, DefaultState: 24681},
+	{Name: "minecraft:oxidized_copper_grate", ID: 993, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 24682, MaxState: 24683, DefaultState: 24683},
+	{Name: "minecraft:waxed_copper_grate", ID: 994, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 24684, MaxState: 24685, DefaultState: 24685},
+	{Name: "minecraft:waxed_exposed_copper_grate", ID: 995, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 24686, MaxState: 24687, DefaultState: 24687},
+	{Name: "minecraft:waxed_weathered_copper_grate", ID: 996, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 24688, MaxState: 24689, DefaultState: 24689},
+	{Name: "minecraft:waxed_oxidized_copper_grate", ID: 997, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 24690, MaxState: 24691, DefaultState: 24691},
+	{Name: "minecraft:copper_bulb", ID: 998, Properties: []Property{{"lit", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 24692, MaxState: 24695, DefaultState: 24695},
+	{Name: "minecraft:exposed_copper_bulb", ID: 999, Properties: []Property{{"lit", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 24696, MaxState: 24699, DefaultState: 24699},
+	{Name: "minecraft:weathered_copper_bulb", ID: 1000, Properties: []Property{{"lit", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 24700, MaxState: 24703, DefaultState: 24703},
+	{Name: "minecraft:oxidized_copper_bulb", ID: 1001, Properties: []Property{{"lit", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 24704, MaxState: 24707, DefaultState: 24707},
+	{Name: "minecraft:waxed_copper_bulb", ID: 1002, Properties: []Property{{"lit", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 24708, MaxState: 24711, DefaultState: 24711},
+	{Name: "minecraft:waxed_exposed_copper_bulb", ID: 1003, Properties: []Property{{"lit", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 24712, MaxState: 24715, DefaultState: 24715},
+	{Name: "minecraft:waxed_weathered_copper_bulb", ID: 1004, Properties: []Property{{"lit", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 24716, MaxState: 24719, DefaultState: 24719},
+	{Name: "minecraft:waxed_oxidized_copper_bulb", ID: 1005, Properties: []Property{{"lit", []string{"true", "false"}}, {"powered", []string{"true", "false"}}}, MinState: 24720, MaxState: 24723, DefaultState: 24723},
+	{Name: "minecraft:lightning_rod", ID: 1006, Properties: []Property{{"facing", []string{"north", "east", "south", "west", "up", "down"}}, {"powered", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 24724, MaxState: 24747, DefaultState: 24743},
+	{Name: "minecraft:pointed_dripstone", ID: 1007, Properties: []Property{{"thickness", []string{"tip_merge", "tip", "frustum", "middle", "base"}}, {"vertical_direction", []string{"up", "down"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 24748, MaxState: 24767, DefaultState: 24753},
+	{Name: "minecraft:dripstone_block", ID: 1008, MinState: 24768, MaxState: 24768, DefaultState: 24768},
+	{Name: "minecraft:cave_vines", ID: 1009, Properties: []Property{{"age", []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25"}}, {"berries", []string{"true", "false"}}}, MinState: 24769, MaxState: 24820, DefaultState: 24770},
+	{Name: "minecraft:cave_vines_plant", ID: 1010, Properties: []Property{{"berries", []string{"true", "false"}}}, MinState: 24821, MaxState: 24822, DefaultState: 24822},
+	{Name: "minecraft:spore_blossom", ID: 1011, MinState: 24823, MaxState: 24823, DefaultState: 24823},
+	{Name: "minecraft:azalea", ID: 1012, MinState: 24824, MaxState: 24824, DefaultState: 24824},
+	{Name: "minecraft:flowering_azalea", ID: 1013, MinState: 24825, MaxState: 24825, DefaultState: 24825},
+	{Name: "minecraft:moss_carpet", ID: 1014, MinState: 24826, MaxState: 24826, DefaultState: 24826},
+	{Name: "minecraft:pink_petals", ID: 1015, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"flower_amount", []string{"1", "2", "3", "4"}}}, MinState: 24827, MaxState: 24842, DefaultState: 24827},
+	{Name: "minecraft:moss_block", ID: 1016, MinState: 24843, MaxState: 24843, DefaultState: 24843},
+	{Name: "minecraft:big_dripleaf", ID: 1017, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"tilt", []string{"none", "unstable", "partial", "full"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 24844, MaxState: 24875, DefaultState: 24845},
+	{Name: "minecraft:big_dripleaf_stem", ID: 1018, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 24876, MaxState: 24883, DefaultState: 24877},
+	{Name: "minecraft:small_dripleaf", ID: 1019, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"upper", "lower"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 24884, MaxState: 24899, DefaultState: 24887},
+	{Name: "minecraft:hanging_roots", ID: 1020, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 24900, MaxState: 24901, DefaultState: 24901},
+	{Name: "minecraft:rooted_dirt", ID: 1021, MinState: 24902, MaxState: 24902, DefaultState: 24902},
+	{Name: "minecraft:mud", ID: 1022, MinState: 24903, MaxState: 24903, DefaultState: 24903},
+	{Name: "minecraft:deepslate", ID: 1023, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 24904, MaxState: 24906, DefaultState: 24905},
+	{Name: "minecraft:cobbled_deepslate", ID: 1024, MinState: 24907, MaxState: 24907, DefaultState: 24907},
+	{Name: "minecraft:cobbled_deepslate_stairs", ID: 1025, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 24908, MaxState: 24987, DefaultState: 24919},
+	{Name: "minecraft:cobbled_deepslate_slab", ID: 1026, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 24988, MaxState: 24993, DefaultState: 24991},
+	{Name: "minecraft:cobbled_deepslate_wall", ID: 1027, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 24994, MaxState: 25317, DefaultState: 24997},
+	{Name: "minecraft:polished_deepslate", ID: 1028, MinState: 25318, MaxState: 25318, DefaultState: 25318},
+	{Name: "minecraft:polished_deepslate_stairs", ID: 1029, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 25319, MaxState: 25398, DefaultState: 25330},
+	{Name: "minecraft:polished_deepslate_slab", ID: 1030, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 25399, MaxState: 25404, DefaultState: 25402},
+	{Name: "minecraft:polished_deepslate_wall", ID: 1031, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 25405, MaxState: 25728, DefaultState: 25408},
+	{Name: "minecraft:deepslate_tiles", ID: 1032, MinState: 25729, MaxState: 25729, DefaultState: 25729},
+	{Name: "minecraft:deepslate_tile_stairs", ID: 1033, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 25730, MaxState: 25809, DefaultState: 25741},
+	{Name: "minecraft:deepslate_tile_slab", ID: 1034, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 25810, MaxState: 25815, DefaultState: 25813},
+	{Name: "minecraft:deepslate_tile_wall", ID: 1035, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 25816, MaxState: 26139, DefaultState: 25819},
+	{Name: "minecraft:deepslate_bricks", ID: 1036, MinState: 26140, MaxState: 26140, DefaultState: 26140},
+	{Name: "minecraft:deepslate_brick_stairs", ID: 1037, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"half", []string{"top", "bottom"}}, {"shape", []string{"straight", "inner_left", "inner_right", "outer_left", "outer_right"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 26141, MaxState: 26220, DefaultState: 26152},
+	{Name: "minecraft:deepslate_brick_slab", ID: 1038, Properties: []Property{{"type", []string{"top", "bottom", "double"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 26221, MaxState: 26226, DefaultState: 26224},
+	{Name: "minecraft:deepslate_brick_wall", ID: 1039, Properties: []Property{{"east", []string{"none", "low", "tall"}}, {"north", []string{"none", "low", "tall"}}, {"south", []string{"none", "low", "tall"}}, {"up", []string{"true", "false"}}, {"waterlogged", []string{"true", "false"}}, {"west", []string{"none", "low", "tall"}}}, MinState: 26227, MaxState: 26550, DefaultState: 26230},
+	{Name: "minecraft:chiseled_deepslate", ID: 1040, MinState: 26551, MaxState: 26551, DefaultState: 26551},
+	{Name: "minecraft:cracked_deepslate_bricks", ID: 1041, MinState: 26552, MaxState: 26552, DefaultState: 26552},
+	{Name: "minecraft:cracked_deepslate_tiles", ID: 1042, MinState: 26553, MaxState: 26553, DefaultState: 26553},
+	{Name: "minecraft:infested_deepslate", ID: 1043, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 26554, MaxState: 26556, DefaultState: 26555},
+	{Name: "minecraft:smooth_basalt", ID: 1044, MinState: 26557, MaxState: 26557, DefaultState: 26557},
+	{Name: "minecraft:raw_iron_block", ID: 1045, MinState: 26558, MaxState: 26558, DefaultState: 26558},
+	{Name: "minecraft:raw_copper_block", ID: 1046, MinState: 26559, MaxState: 26559, DefaultState: 26559},
+	{Name: "minecraft:raw_gold_block", ID: 1047, MinState: 26560, MaxState: 26560, DefaultState: 26560},
+	{Name: "minecraft:potted_azalea_bush", ID: 1048, MinState: 26561, MaxState: 26561, DefaultState: 26561},
+	{Name: "minecraft:potted_flowering_azalea_bush", ID: 1049, MinState: 26562, MaxState: 26562, DefaultState: 26562},
+	{Name: "minecraft:ochre_froglight", ID: 1050, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 26563, MaxState: 26565, DefaultState: 26564},
+	{Name: "minecraft:verdant_froglight", ID: 1051, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 26566, MaxState: 26568, DefaultState: 26567},
+	{Name: "minecraft:pearlescent_froglight", ID: 1052, Properties: []Property{{"axis", []string{"x", "y", "z"}}}, MinState: 26569, MaxState: 26571, DefaultState: 26570},
+	{Name: "minecraft:frogspawn", ID: 1053, MinState: 26572, MaxState: 26572, DefaultState: 26572},
+	{Name: "minecraft:reinforced_deepslate", ID: 1054, MinState: 26573, MaxState: 26573, DefaultState: 26573},
+	{Name: "minecraft:decorated_pot", ID: 1055, Properties: []Property{{"cracked", []string{"true", "false"}}, {"facing", []string{"north", "south", "west", "east"}}, {"waterlogged", []string{"true", "false"}}}, MinState: 26574, MaxState: 26589, DefaultState: 26583},
+	{Name: "minecraft:crafter", ID: 1056, Properties: []Property{{"crafting", []string{"true", "false"}}, {"orientation", []string{"down_east", "down_north", "down_south", "down_west", "up_east", "up_north", "up_south", "up_west", "west_up", "east_up", "north_up", "south_up"}}, {"triggered", []string{"true", "false"}}}, MinState: 26590, MaxState: 26637, DefaultState: 26635},
+	{Name: "minecraft:trial_spawner", ID: 1057, Properties: []Property{{"ominous", []string{"true", "false"}}, {"trial_spawner_state", []string{"inactive", "waiting_for_players", "active", "waiting_for_reward_ejection", "ejecting_reward", "cooldown"}}}, MinState: 26638, MaxState: 26649, DefaultState: 26644},
+	{Name: "minecraft:vault", ID: 1058, Properties: []Property{{"facing", []string{"north", "south", "west", "east"}}, {"ominous", []string{"true", "false"}}, {"vault_state", []string{"inactive", "active", "unlocking", "ejecting"}}}, MinState: 26650, MaxState: 26681, DefaultState: 26654},
+	{Name: "minecraft:heavy_core", ID: 1059, Properties: []Property{{"waterlogged", []string{"true", "false"}}}, MinState: 26682, MaxState: 26683, DefaultState: 26683},
+}
+
+var items = []string{
+	"minecraft:air",
+	"minecraft:stone",
+	"minecraft:granite",
+	"minecraft:polished_granite",
+	"minecraft:diorite",
+	"minecraft:polished_diorite",
+	"minecraft:andesite",
+	"minecraft:polished_andesite",
+	"minecraft:deepslate",
+	"minecraft:cobbled_deepslate",
+	"minecraft:polished_deepslate",
+	"minecraft:calcite",
+	"minecraft:tuff",
+	"minecraft:tuff_slab",
+	"minecraft:tuff_stairs",
+	"minecraft:tuff_wall",
+	"minecraft:chiseled_tuff",
+	"minecraft:polished_tuff",
+	"minecraft:polished_tuff_slab",
+	"minecraft:polished_tuff_stairs",
+	"minecraft:polished_tuff_wall",
+	"minecraft:tuff_bricks",
+	"minecraft:tuff_brick_slab",
+	"minecraft:tuff_brick_stairs",
+	"minecraft:tuff_brick_wall",
+	"minecraft:chiseled_tuff_bricks",
+	"minecraft:dripstone_block",
+	"minecraft:grass_block",
+	"minecraft:dirt",
+	"minecraft:coarse_dirt",
+	"minecraft:podzol",
+	"minecraft:rooted_dirt",
+	"minecraft:mud",
+	"minecraft:crimson_nylium",
+	"minecraft:warped_nylium",
+	"minecraft:cobblestone",
+	"minecraft:oak_planks",
+	"minecraft:spruce_planks",
+	"minecraft:birch_planks",
+	"minecraft:jungle_planks",
+	"minecraft:acacia_planks",
+	"minecraft:cherry_planks",
+	"minecraft:dark_oak_planks",
+	"minecraft:mangrove_planks",
+	"minecraft:bamboo_planks",
+	"minecraft:crimson_planks",
+	"minecraft:warped_planks",
+	"minecraft:bamboo_mosaic",
+	"minecraft:oak_sapling",
+	"minecraft:spruce_sapling",
+	"minecraft:birch_sapling",
+	"minecraft:jungle_sapling",
+	"minecraft:acacia_sapling",
+	"minecraft:cherry_sapling",
+	"minecraft:dark_oak_sapling",
+	"minecraft:mangrove_propagule",
+	"minecraft:bedrock",
+	"minecraft:sand",
+	"minecraft:suspicious_sand",
+	"minecraft:suspicious_gravel",
+	"minecraft:red_sand",
+	"minecraft:gravel",
+	"minecraft:coal_ore",
+	"minecraft:deepslate_coal_ore",
+	"minecraft:iron_ore",
+	"minecraft:deepslate_iron_ore",
+	"minecraft:copper_ore",
+	"minecraft:deepslate_copper_ore",
+	"minecraft:gold_ore",
+	"minecraft:deepslate_gold_ore",
+	"minecraft:redstone_ore",
+	"minecraft:deepslate_redstone_ore",
+	"minecraft:emerald_ore",
+	"minecraft:deepslate_emerald_ore",
+	"minecraft:lapis_ore",
+	"minecraft:deepslate_lapis_ore",
+	"minecraft:diamond_ore",
+	"minecraft:deepslate_diamond_ore",
+	"minecraft:nether_gold_ore",
+	"minecraft:nether_quartz_ore",
+	"minecraft:ancient_debris",
+	"minecraft:coal_block",
+	"minecraft:raw_iron_block",
+	"minecraft:raw_copper_block",
+	"minecraft:raw_gold_block",
+	"minecraft:heavy_core",
+	"minecraft:amethyst_block",
+	"minecraft:budding_amethyst",
+	"minecraft:iron_block",
+	"minecraft:copper_block",
+	"minecraft:gold_block",
+	"minecraft:diamond_block",
+	"minecraft:netherite_block",
+	"minecraft:exposed_copper",
+	"minecraft:weathered_copper",
+	"minecraft:oxidized_copper",
+	"minecraft:chiseled_copper",
+	"minecraft:exposed_chiseled_copper",
+	"minecraft:weathered_chiseled_copper",
+	"minecraft:oxidized_chiseled_copper",
+	"minecraft:cut_copper",
+	"minecraft:exposed_cut_copper",
+	"minecraft:weathered_cut_copper",
+	"minecraft:oxidized_cut_copper",
+	"minecraft:cut_copper_stairs",
+	"minecraft:exposed_cut_copper_stairs",
+	"minecraft:weathered_cut_copper_stairs",
+	"minecraft:oxidized_cut_copper_stairs",
+	"minecraft:cut_copper_slab",
+	"minecraft:exposed_cut_copper_slab",
+	"minecraft:weathered_cut_copper_slab",
+	"minecraft:oxidized_cut_copper_slab",
+	"minecraft:waxed_copper_block",
+	"minecraft:waxed_exposed_copper",
+	"minecraft:waxed_weathered_copper",
+	"minecraft:waxed_oxidized_copper",
+	"minecraft:waxed_chiseled_copper",
+	"minecraft:waxed_exposed_chiseled_copper",
+	"minecraft:waxed_weathered_chiseled_copper",
+	"minecraft:waxed_oxidized_chiseled_copper",
+	"minecraft:waxed_cut_copper",
+	"minecraft:waxed_exposed_cut_copper",
+	"minecraft:waxed_weathered_cut_copper",
+	"minecraft:waxed_oxidized_cut_copper",
+	"minecraft:waxed_cut_copper_stairs",
+	"minecraft:waxed_exposed_cut_copper_stairs",
+	"minecraft:waxed_weathered_cut_copper_stairs",
+	"minecraft:waxed_oxidized_cut_copper_stairs",
+	"minecraft:waxed_cut_copper_slab",
+	"minecraft:waxed_exposed_cut_copper_slab",
+	"minecraft:waxed_weathered_cut_copper_slab",
+	"minecraft:waxed_oxidized_cut_copper_slab",
+	"minecraft:oak_log",
+	"minecraft:spruce_log",
+	"minecraft:birch_log",
+	"minecraft:jungle_log",
+	"minecraft:acacia_log",
+	"minecraft:cherry_log",
+	"minecraft:dark_oak_log",
+	"minecraft:mangrove_log",
+	"minecraft:mangrove_roots",
+	"minecraft:muddy_mangrove_roots",
+	"minecraft:crimson_stem",
+	"minecraft:warped_stem",
+	"minecraft:bamboo_block",
+	"minecraft:stripped_oak_log",
+	"minecraft:stripped_spruce_log",
+	"minecraft:stripped_birch_log",
+	"minecraft:stripped_jungle_log",
+	"minecraft:stripped_acacia_log",
+	"minecraft:stripped_cherry_log",
+	"minecraft:stripped_dark_oak_log",
+	"minecraft:stripped_mangrove_log",
+	"minecraft:stripped_crimson_stem",
+	"minecraft:stripped_warped_stem",
+	"minecraft:stripped_oak_wood",
+	"minecraft:stripped_spruce_wood",
+	"minecraft:stripped_birch_wood",
+	"minecraft:stripped_jungle_wood",
+	"minecraft:stripped_acacia_wood",
+	"minecraft:stripped_cherry_wood",
+	"minecraft:stripped_dark_oak_wood",
+	"minecraft:stripped_mangrove_wood",
+	"minecraft:stripped_crimson_hyphae",
+	"minecraft:stripped_warped_hyphae",
+	"minecraft:stripped_bamboo_block",
+	"minecraft:oak_wood",
+	"minecraft:spruce_wood",
+	"minecraft:birch_wood",
+	"minecraft:jungle_wood",
+	"minecraft:acacia_wood",
+	"minecraft:cherry_wood",
+	"minecraft:dark_oak_wood",
+	"minecraft:mangrove_wood",
+	"minecraft:crimson_hyphae",
+	"minecraft:warped_hyphae",
+	"minecraft:oak_leaves",
+	"minecraft:spruce_leaves",
+	"minecraft:birch_leaves",
+	"minecraft:jungle_leaves",
+	"minecraft:acacia_leaves",
+	"minecraft:cherry_leaves",
+	"minecraft:dark_oak_leaves",
+	"minecraft:mangrove_leaves",
+	"minecraft:azalea_leaves",
+	"minecraft:flowering_azalea_leaves",
+	"minecraft:sponge",
+	"minecraft:wet_sponge",
+	"minecraft:glass",
+	"minecraft:tinted_glass",
+	"minecraft:lapis_block",
+	"minecraft:sandstone",
+	"minecraft:chiseled_sandstone",
+	"minecraft:cut_sandstone",
+	"minecraft:cobweb",
+	"minecraft:short_grass",
+	"minecraft:fern",
+	"minecraft:azalea",
+	"minecraft:flowering_azalea",
+	"minecraft:dead_bush",
+	"minecraft:seagrass",
+	"minecraft:sea_pickle",
+	"minecraft:white_wool",
+	"minecraft:orange_wool",
+	"minecraft:magenta_wool",
+	"minecraft:light_blue_wool",
+	"minecraft:yellow_wool",
+	"minecraft:lime_wool",
+	"minecraft:pink_wool",
+	"minecraft:gray_wool",
+	"minecraft:light_gray_wool",
+	"minecraft:cyan_wool",
+	"minecraft:purple_wool",
+	"minecraft:blue_wool",
+	"minecraft:brown_wool",
+	"minecraft:green_wool",
+	"minecraft:red_wool",
+	"minecraft:black_wool",
+	"minecraft:dandelion",
+	"minecraft:poppy",
+	"minecraft:blue_orchid",
+	"minecraft:allium",
+	"minecraft:azure_bluet",
+	"minecraft:red_tulip",
+	"minecraft:orange_tulip",
+	"minecraft:white_tulip",
+	"minecraft:pink_tulip",
+	"minecraft:oxeye_daisy",
+	"minecraft:cornflower",
+	"minecraft:lily_of_the_valley",
+	"minecraft:wither_rose",
+	"minecraft:torchflower",
+	"minecraft:pitcher_plant",
+	"minecraft:spore_blossom",
+	"minecraft:brown_mushroom",
+	"minecraft:red_mushroom",
+	"minecraft:crimson_fungus",
+	"minecraft:warped_fungus",
+	"minecraft:crimson_roots",
+	"minecraft:warped_roots",
+	"minecraft:nether_sprouts",
+	"minecraft:weeping_vines",
+	"minecraft:twisting_vines",
+	"minecraft:sugar_cane",
+	"minecraft:kelp",
+	"minecraft:moss_carpet",
+	"minecraft:pink_petals",
+	"minecraft:moss_block",
+	"minecraft:hanging_roots",
+	"minecraft:big_dripleaf",
+	"minecraft:small_dripleaf",
+	"minecraft:bamboo",
+	"minecraft:oak_slab",
+	"minecraft:spruce_slab",
+	"minecraft:birch_slab",
+	"minecraft:jungle_slab",
+	"minecraft:acacia_slab",
+	"minecraft:cherry_slab",
+	"minecraft:dark_oak_slab",
+	"minecraft:mangrove_slab",
+	"minecraft:bamboo_slab",
+	"minecraft:bamboo_mosaic_slab",
+	"minecraft:crimson_slab",
+	"minecraft:warped_slab",
+	"minecraft:stone_slab",
+	"minecraft:smooth_stone_slab",
+	"minecraft:sandstone_slab",
+	"minecraft:cut_sandstone_slab",
+	"minecraft:petrified_oak_slab",
+	"minecraft:cobblestone_slab",
+	"minecraft:brick_slab",
+	"minecraft:stone_brick_slab",
+	"minecraft:mud_brick_slab",
+	"minecraft:nether_brick_slab",
+	"minecraft:quartz_slab",
+	"minecraft:red_sandstone_slab",
+	"minecraft:cut_red_sandstone_slab",
+	"minecraft:purpur_slab",
+	"minecraft:prismarine_slab",
+	"minecraft:prismarine_brick_slab",
+	"minecraft:dark_prismarine_slab",
+	"minecraft:smooth_quartz",
+	"minecraft:smooth_red_sandstone",
+	"minecraft:smooth_sandstone",
+	"minecraft:smooth_stone",
+	"minecraft:bricks",
+	"minecraft:bookshelf",
+	"minecraft:chiseled_bookshelf",
+	"minecraft:decorated_pot",
+	"minecraft:mossy_cobblestone",
+	"minecraft:obsidian",
+	"minecraft:torch",
+	"minecraft:end_rod",
+	"minecraft:chorus_plant",
+	"minecraft:chorus_flower",
+	"minecraft:purpur_block",
+	"minecraft:purpur_pillar",
+	"minecraft:purpur_stairs",
+	"minecraft:spawner",
+	"minecraft:chest",
+	"minecraft:crafting_table",
+	"minecraft:farmland",
+	"minecraft:furnace",
+	"minecraft:ladder",
+	"minecraft:cobblestone_stairs",
+	"minecraft:snow",
+	"minecraft:ice",
+	"minecraft:snow_block",
+	"minecraft:cactus",
+	"minecraft:clay",
+	"minecraft:jukebox",
+	"minecraft:oak_fence",
+	"minecraft:spruce_fence",
+	"minecraft:birch_fence",
+	"minecraft:jungle_fence",
+	"minecraft:acacia_fence",
+	"minecraft:cherry_fence",
+	"minecraft:dark_oak_fence",
+	"minecraft:mangrove_fence",
+	"minecraft:bamboo_fence",
+	"minecraft:crimson_fence",
+	"minecraft:warped_fence",
+	"minecraft:pumpkin",
+	"minecraft:carved_pumpkin",
+	"minecraft:jack_o_lantern",
+	"minecraft:netherrack",
+	"minecraft:soul_sand",
+	"minecraft:soul_soil",
+	"minecraft:basalt",
+	"minecraft:polished_basalt",
+	"minecraft:smooth_basalt",
+	"minecraft:soul_torch",
+	"minecraft:glowstone",
+	"minecraft:infested_stone",
+	"minecraft:infested_cobblestone",
+	"minecraft:infested_stone_bricks",
+	"minecraft:infested_mossy_stone_bricks",
+	"minecraft:infested_cracked_stone_bricks",
+	"minecraft:infested_chiseled_stone_bricks",
+	"minecraft:infested_deepslate",
+	"minecraft:stone_bricks",
+	"minecraft:mossy_stone_bricks",
+	"minecraft:cracked_stone_bricks",
+	"minecraft:chiseled_stone_bricks",
+	"minecraft:packed_mud",
+	"minecraft:mud_bricks",
+	"minecraft:deepslate_bricks",
+	"minecraft:cracked_deepslate_bricks",
+	"minecraft:deepslate_tiles",
+	"minecraft:cracked_deepslate_tiles",
+	"minecraft:chiseled_deepslate",
+	"minecraft:reinforced_deepslate",
+	"minecraft:brown_mushroom_block",
+	"minecraft:red_mushroom_block",
+	"minecraft:mushroom_stem",
+	"minecraft:iron_bars",
+	"minecraft:chain",
+	"minecraft:glass_pane",
+	"minecraft:melon",
+	"minecraft:vine",
+	"minecraft:glow_lichen",
+	"minecraft:brick_stairs",
+	"minecraft:stone_brick_stairs",
+	"minecraft:mud_brick_stairs",
+	"minecraft:mycelium",
+	"minecraft:lily_pad",
+	"minecraft:nether_bricks",
+	"minecraft:cracked_nether_bricks",
+	"minecraft:chiseled_nether_bricks",
+	"minecraft:nether_brick_fence",
+	"minecraft:nether_brick_stairs",
+	"minecraft:sculk",
+	"minecraft:sculk_vein",
+	"minecraft:sculk_catalyst",
+	"minecraft:sculk_shrieker",
+	"minecraft:enchanting_table",
+	"minecraft:end_portal_frame",
+	"minecraft:end_stone",
+	"minecraft:end_stone_bricks",
+	"minecraft:dragon_egg",
+	"minecraft:sandstone_stairs",
+	"minecraft:ender_chest",
+	"minecraft:emerald_block",
+	"minecraft:oak_stairs",
+	"minecraft:spruce_stairs",
+	"minecraft:birch_stairs",
+	"minecraft:jungle_stairs",
+	"minecraft:acacia_stairs",
+	"minecraft:cherry_stairs",
+	"minecraft:dark_oak_stairs",
+	"minecraft:mangrove_stairs",
+	"minecraft:bamboo_stairs",
+	"minecraft:bamboo_mosaic_stairs",
+	"minecraft:crimson_stairs",
+	"minecraft:warped_stairs",
+	"minecraft:command_block",
+	"minecraft:beacon",
+	"minecraft:cobblestone_wall",
+	"minecraft:mossy_cobblestone_wall",
+	"minecraft:brick_wall",
+	"minecraft:prismarine_wall",
+	"minecraft:red_sandstone_wall",
+	"minecraft:mossy_stone_brick_wall",
+	"minecraft:granite_wall",
+	"minecraft:stone_brick_wall",
+	"minecraft:mud_brick_wall",
+	"minecraft:nether_brick_wall",
+	"minecraft:andesite_wall",
+	"minecraft:red_nether_brick_wall",
+	"minecraft:sandstone_wall",
+	"minecraft:end_stone_brick_wall",
+	"minecraft:diorite_wall",
+	"minecraft:blackstone_wall",
+	"minecraft:polished_blackstone_wall",
+	"minecraft:polished_blackstone_brick_wall",
+	"minecraft:cobbled_deepslate_wall",
+	"minecraft:polished_deepslate_wall",
+	"minecraft:deepslate_brick_wall",
+	"minecraft:deepslate_tile_wall",
+	"minecraft:anvil",
+	"minecraft:chipped_anvil",
+	"minecraft:damaged_anvil",
+	"minecraft:chiseled_quartz_block",
+	"minecraft:quartz_block",
+	"minecraft:quartz_bricks",
+	"minecraft:quartz_pillar",
+	"minecraft:quartz_stairs",
+	"minecraft:white_terracotta",
+	"minecraft:orange_terracotta",
+	"minecraft:magenta_terracotta",
+	"minecraft:light_blue_terracotta",
+	"minecraft:yellow_terracotta",
+	"minecraft:lime_terracotta",
+	"minecraft:pink_terracotta",
+	"minecraft:gray_terracotta",
+	"minecraft:light_gray_terracotta",
+	"minecraft:cyan_terracotta",
+	"minecraft:purple_terracotta",
+	"minecraft:blue_terracotta",
+	"minecraft:brown_terracotta",
+	"minecraft:green_terracotta",
+	"minecraft:red_terracotta",
+	"minecraft:black_terracotta",
+	"minecraft:barrier",
+	"minecraft:light",
+	"minecraft:hay_block",
+	"minecraft:white_carpet",
+	"minecraft:orange_carpet",
+	"minecraft:magenta_carpet",
+	"minecraft:light_blue_carpet",
+	"minecraft:yellow_carpet",
+	"minecraft:lime_carpet",
+	"minecraft:pink_carpet",
+	"minecraft:gray_carpet",
+	"minecraft:light_gray_carpet",
+	"minecraft:cyan_carpet",
+	"minecraft:purple_carpet",
+	"minecraft:blue_carpet",
+	"minecraft:brown_carpet",
+	"minecraft:green_carpet",
+	"minecraft:red_carpet",
+	"minecraft:black_carpet",
+	"minecraft:terracotta",
+	"minecraft:packed_ice",
+	"minecraft:dirt_path",
+	"minecraft:sunflower",
+	"minecraft:lilac",
+	"minecraft:rose_bush",
+	"minecraft:peony",
+	"minecraft:tall_grass",
+	"minecraft:large_fern",
+	"minecraft:white_stained_glass",
+	"minecraft:orange_stained_glass",
+	"minecraft:magenta_stained_glass",
+	"minecraft:light_blue_stained_glass",
+	"minecraft:yellow_stained_glass",
+	"minecraft:lime_stained_glass",
+	"minecraft:pink_stained_glass",
+	"minecraft:gray_stained_glass",
+	"minecraft:light_gray_stained_glass",
+	"minecraft:cyan_stained_glass",
+	"minecraft:purple_stained_glass",
+	"minecraft:blue_stained_glass",
+	"minecraft:brown_stained_glass",
+	"minecraft:green_stained_glass",
+	"minecraft:red_stained_glass",
+	"minecraft:black_stained_glass",
+	"minecraft:white_stained_glass_pane",
+	"minecraft:orange_stained_glass_pane",
+	"minecraft:magenta_stained_glass_pane",
+	"minecraft:light_blue_stained_glass_pane",
+	"minecraft:yellow_stained_glass_pane",
+	"minecraft:lime_stained_glass_pane",
+	"minecraft:pink_stained_glass_pane",
+	"minecraft:gray_stained_glass_pane",
+	"minecraft:light_gray_stained_glass_pane",
+	"minecraft:cyan_stained_glass_pane",
+	"minecraft:purple_stained_glass_pane",
+	"minecraft:blue_stained_glass_pane",
+	"minecraft:brown_stained_glass_pane",
+	"minecraft:green_stained_glass_pane",
+	"minecraft:red_stained_glass_pane",
+	"minecraft:black_stained_glass_pane",
+	"minecraft:prismarine",
+	"minecraft:prismarine_bricks",
+	"minecraft:dark_prismarine",
+	"minecraft:prismarine_stairs",
+	"minecraft:prismarine_brick_stairs",
+	"minecraft:dark_prismarine_stairs",
+	"minecraft:sea_lantern",
+	"minecraft:red_sandstone",
+	"minecraft:chiseled_red_sandstone",
+	"minecraft:cut_red_sandstone",
+	"minecraft:red_sandstone_stairs",
+	"minecraft:repeating_command_block",
+	"minecraft:chain_command_block",
+	"minecraft:magma_block",
+	"minecraft:nether_wart_block",
+	"minecraft:warped_wart_block",
+	"minecraft:red_nether_bricks",
+	"minecraft:bone_block",
+	"minecraft:structure_void",
+	"minecraft:shulker_box",
+	"minecraft:white_shulker_box",
+	"minecraft:orange_shulker_box",
+	"minecraft:magenta_shulker_box",
+	"minecraft:light_blue_shulker_box",
+	"minecraft:yellow_shulker_box",
+	"minecraft:lime_shulker_box",
+	"minecraft:pink_shulker_box",
+	"minecraft:gray_shulker_box",
+	"minecraft:light_gray_shulker_box",
+	"minecraft:cyan_shulker_box",
+	"minecraft:purple_shulker_box",
+	"minecraft:blue_shulker_box",
+	"minecraft:brown_shulker_box",
+	"minecraft:green_shulker_box",
+	"minecraft:red_shulker_box",
+	"minecraft:black_shulker_box",
+	"minecraft:white_glazed_terracotta",
+	"minecraft:orange_glazed_terracotta",
+	"minecraft:magenta_glazed_terracotta",
+	"minecraft:light_blue_glazed_terracotta",
+	"minecraft:yellow_glazed_terracotta",
+	"minecraft:lime_glazed_terracotta",
+	"minecraft:pink_glazed_terracotta",
+	"minecraft:gray_glazed_terracotta",
+	"minecraft:light_gray_glazed_terracotta",
+	"minecraft:cyan_glazed_terracotta",
+	"minecraft:purple_glazed_terracotta",
+	"minecraft:blue_glazed_terracotta",
+	"minecraft:brown_glazed_terracotta",
+	"minecraft:green_glazed_terracotta",
+	"minecraft:red_glazed_terracotta",
+	"minecraft:black_glazed_terracotta",
+	"minecraft:white_concrete",
+	"minecraft:orange_concrete",
+	"minecraft:magenta_concrete",
+	"minecraft:light_blue_concrete",
+	"minecraft:yellow_concrete",
+	"minecraft:lime_concrete",
+	"minecraft:pink_concrete",
+	"minecraft:gray_concrete",
+	"minecraft:light_gray_concrete",
+	"minecraft:cyan_concrete",
+	"minecraft:purple_concrete",
+	"minecraft:blue_concrete",
+	"minecraft:brown_concrete",
+	"minecraft:green_concrete",
+	"minecraft:red_concrete",
+	"minecraft:black_concrete",
+	"minecraft:white_concrete_powder",
+	"minecraft:orange_concrete_powder",
+	"minecraft:magenta_concrete_powder",
+	"minecraft:light_blue_concrete_powder",
+	"minecraft:yellow_concrete_powder",
+	"minecraft:lime_concrete_powder",
+	"minecraft:pink_concrete_powder",
+	"minecraft:gray_concrete_powder",
+	"minecraft:light_gray_concrete_powder",
+	"minecraft:cyan_concrete_powder",
+	"minecraft:purple_concrete_powder",
+	"minecraft:blue_concrete_powder",
+	"minecraft:brown_concrete_powder",
+	"minecraft:green_concrete_powder",
+	"minecraft:red_concrete_powder",
+	"minecraft:black_concrete_powder",
+	"minecraft:turtle_egg",
+	"minecraft:sniffer_egg",
+	"minecraft:dead_tube_coral_block",
+	"minecraft:dead_brain_coral_block",
+	"minecraft:dead_bubble_coral_block",
+	"minecraft:dead_fire_coral_block",
+	"minecraft:dead_horn_coral_block",
+	"minecraft:tube_coral_block",
+	"minecraft:brain_coral_block",
+	"minecraft:bubble_coral_block",
+	"minecraft:fire_coral_block",
+	"minecraft:horn_coral_block",
+	"minecraft:tube_coral",
+	"minecraft:brain_coral",
+	"minecraft:bubble_coral",
+	"minecraft:fire_coral",
+	"minecraft:horn_coral",
+	"minecraft:dead_brain_coral",
+	"minecraft:dead_bubble_coral",
+	"minecraft:dead_fire_coral",
+	"minecraft:dead_horn_coral",
+	"minecraft:dead_tube_coral",
+	"minecraft:tube_coral_fan",
+	"minecraft:brain_coral_fan",
+	"minecraft:bubble_coral_fan",
+	"minecraft:fire_coral_fan",
+	"minecraft:horn_coral_fan",
+	"minecraft:dead_tube_coral_fan",
+	"minecraft:dead_brain_coral_fan",
+	"minecraft:dead_bubble_coral_fan",
+	"minecraft:dead_fire_coral_fan",
+	"minecraft:dead_horn_coral_fan",
+	"minecraft:blue_ice",
+	"minecraft:conduit",
+	"minecraft:polished_granite_stairs",
+	"minecraft:smooth_red_sandstone_stairs",
+	"minecraft:mossy_stone_brick_stairs",
+	"minecraft:polished_diorite_stairs",
+	"minecraft:mossy_cobblestone_stairs",
+	"minecraft:end_stone_brick_stairs",
+	"minecraft:stone_stairs",
+	"minecraft:smooth_sandstone_stairs",
+	"minecraft:smooth_quartz_stairs",
+	"minecraft:granite_stairs",
+	"minecraft:andesite_stairs",
+	"minecraft:red_nether_brick_stairs",
+	"minecraft:polished_andesite_stairs",
+	"minecraft:diorite_stairs",
+	"minecraft:cobbled_deepslate_stairs",
+	"minecraft:polished_deepslate_stairs",
+	"minecraft:deepslate_brick_stairs",
+	"minecraft:deepslate_tile_stairs",
+	"minecraft:polished_granite_slab",
+	"minecraft:smooth_red_sandstone_slab",
+	"minecraft:mossy_stone_brick_slab",
+	"minecraft:polished_diorite_slab",
+	"minecraft:mossy_cobblestone_slab",
+	"minecraft:end_stone_brick_slab",
+	"minecraft:smooth_sandstone_slab",
+	"minecraft:smooth_quartz_slab",
+	"minecraft:granite_slab",
+	"minecraft:andesite_slab",
+	"minecraft:red_nether_brick_slab",
+	"minecraft:polished_andesite_slab",
+	"minecraft:diorite_slab",
+	"minecraft:cobbled_deepslate_slab",
+	"minecraft:polished_deepslate_slab",
+	"minecraft:deepslate_brick_slab",
+	"minecraft:deepslate_tile_slab",
+	"minecraft:scaffolding",
+	"minecraft:redstone",
+	"minecraft:redstone_torch",
+	"minecraft:redstone_block",
+	"minecraft:repeater",
+	"minecraft:comparator",
+	"minecraft:piston",
+	"minecraft:sticky_piston",
+	"minecraft:slime_block",
+	"minecraft:honey_block",
+	"minecraft:observer",
+	"minecraft:hopper",
+	"minecraft:dispenser",
+	"minecraft:dropper",
+	"minecraft:lectern",
+	"minecraft:target",
+	"minecraft:lever",
+	"minecraft:lightning_rod",
+	"minecraft:daylight_detector",
+	"minecraft:sculk_sensor",
+	"minecraft:calibrated_sculk_sensor",
+	"minecraft:tripwire_hook",
+	"minecraft:trapped_chest",
+	"minecraft:tnt",
+	"minecraft:redstone_lamp",
+	"minecraft:note_block",
+	"minecraft:stone_button",
+	"minecraft:polished_blackstone_button",
+	"minecraft:oak_button",
+	"minecraft:spruce_button",
+	"minecraft:birch_button",
+	"minecraft:jungle_button",
+	"minecraft:acacia_button",
+	"minecraft:cherry_button",
+	"minecraft:dark_oak_button",
+	"minecraft:mangrove_button",
+	"minecraft:bamboo_button",
+	"minecraft:crimson_button",
+	"minecraft:warped_button",
+	"minecraft:stone_pressure_plate",
+	"minecraft:polished_blackstone_pressure_plate",
+	"minecraft:light_weighted_pressure_plate",
+	"minecraft:heavy_weighted_pressure_plate",
+	"minecraft:oak_pressure_plate",
+	"minecraft:spruce_pressure_plate",
+	"minecraft:birch_pressure_plate",
+	"minecraft:jungle_pressure_plate",
+	"minecraft:acacia_pressure_plate",
+	"minecraft:cherry_pressure_plate",
+	"minecraft:dark_oak_pressure_plate",
+	"minecraft:mangrove_pressure_plate",
+	"minecraft:bamboo_pressure_plate",
+	"minecraft:crimson_pressure_plate",
+	"minecraft:warped_pressure_plate",
+	"minecraft:iron_door",
+	"minecraft:oak_door",
+	"minecraft:spruce_door",
+	"minecraft:birch_door",
+	"minecraft:jungle_door",
+	"minecraft:acacia_door",
+	"minecraft:cherry_door",
+	"minecraft:dark_oak_door",
+	"minecraft:mangrove_door",
+	"minecraft:bamboo_door",
+	"minecraft:crimson_door",
+	"minecraft:warped_door",
+	"minecraft:copper_door",
+	"minecraft:exposed_copper_door",
+	"minecraft:weathered_copper_door",
+	"minecraft:oxidized_copper_door",
+	"minecraft:waxed_copper_door",
+	"minecraft:waxed_exposed_copper_door",
+	"minecraft:waxed_weathered_copper_door",
+	"minecraft:waxed_oxidized_copper_door",
+	"minecraft:iron_trapdoor",
+	"minecraft:oak_trapdoor",
+	"minecraft:spruce_trapdoor",
+	"minecraft:birch_trapdoor",
+	"minecraft:jungle_trapdoor",
+	"minecraft:acacia_trapdoor",
+	"minecraft:cherry_trapdoor",
+	"minecraft:dark_oak_trapdoor",
+	"minecraft:mangrove_trapdoor",
+	"minecraft:bamboo_trapdoor",
+	"minecraft:crimson_trapdoor",
+	"minecraft:warped_trapdoor",
+	"minecraft:copper_trapdoor",
+	"minecraft:exposed_copper_trapdoor",
+	"minecraft:weathered_copper_trapdoor",
+	"minecraft:oxidized_copper_trapdoor",
+	"minecraft:waxed_copper_trapdoor",
+	"minecraft:waxed_exposed_copper_trapdoor",
+	"minecraft:waxed_weathered_copper_trapdoor",
+	"minecraft:waxed_oxidized_copper_trapdoor",
+	"minecraft:oak_fence_gate",
+	"minecraft:spruce_fence_gate",
+	"minecraft:birch_fence_gate",
+	"minecraft:jungle_fence_gate",
+	"minecraft:acacia_fence_gate",
+	"minecraft:cherry_fence_gate",
+	"minecraft:dark_oak_fence_gate",
+	"minecraft:mangrove_fence_gate",
+	"minecraft:bamboo_fence_gate",
+	"minecraft:crimson_fence_gate",
+	"minecraft:warped_fence_gate",
+	"minecraft:powered_rail",
+	"minecraft:detector_rail",
+	"minecraft:rail",
+	"minecraft:activator_rail",
+	"minecraft:saddle",
+	"minecraft:minecart",
+	"minecraft:chest_minecart",
+	"minecraft:furnace_minecart",
+	"minecraft:tnt_minecart",
+	"minecraft:hopper_minecart",
+	"minecraft:carrot_on_a_stick",
+	"minecraft:warped_fungus_on_a_stick",
+	"minecraft:elytra",
+	"minecraft:oak_boat",
+	"minecraft:oak_chest_boat",
+	"minecraft:spruce_boat",
+	"minecraft:spruce_chest_boat",
+	"minecraft:birch_boat",
+	"minecraft:birch_chest_boat",
+	"minecraft:jungle_boat",
+	"minecraft:jungle_chest_boat",
+	"minecraft:acacia_boat",
+	"minecraft:acacia_chest_boat",
+	"minecraft:cherry_boat",
+	"minecraft:cherry_chest_boat",
+	"minecraft:dark_oak_boat",
+	"minecraft:dark_oak_chest_boat",
+	"minecraft:mangrove_boat",
+	"minecraft:mangrove_chest_boat",
+	"minecraft:bamboo_raft",
+	"minecraft:bamboo_chest_raft",
+	"minecraft:structure_block",
+	"minecraft:jigsaw",
+	"minecraft:turtle_helmet",
+	"minecraft:turtle_scute",
+	"minecraft:armadillo_scute",
+	"minecraft:wolf_armor",
+	"minecraft:flint_and_steel",
+	"minecraft:bowl",
+	"minecraft:apple",
+	"minecraft:bow",
+	"minecraft:arrow",
+	"minecraft:coal",
+	"minecraft:charcoal",
+	"minecraft:diamond",
+	"minecraft:emerald",
+	"minecraft:lapis_lazuli",
+	"minecraft:quartz",
+	"minecraft:amethyst_shard",
+	"minecraft:raw_iron",
+	"minecraft:iron_ingot",
+	"minecraft:raw_copper",
+	"minecraft:copper_ingot",
+	"minecraft:raw_gold",
+	"minecraft:gold_ingot",
+	"minecraft:netherite_ingot",
+	"minecraft:netherite_scrap",
+	"minecraft:wooden_sword",
+	"minecraft:wooden_shovel",
+	"minecraft:wooden_pickaxe",
+	"minecraft:wooden_axe",
+	"minecraft:wooden_hoe",
+	"minecraft:stone_sword",
+	"minecraft:stone_shovel",
+	"minecraft:stone_pickaxe",
+	"minecraft:stone_axe",
+	"minecraft:stone_hoe",
+	"minecraft:golden_sword",
+	"minecraft:golden_shovel",
+	"minecraft:golden_pickaxe",
+	"minecraft:golden_axe",
+	"minecraft:golden_hoe",
+	"minecraft:iron_sword",
+	"minecraft:iron_shovel",
+	"minecraft:iron_pickaxe",
+	"minecraft:iron_axe",
+	"minecraft:iron_hoe",
+	"minecraft:diamond_sword",
+	"minecraft:diamond_shovel",
+	"minecraft:diamond_pickaxe",
+	"minecraft:diamond_axe",
+	"minecraft:diamond_hoe",
+	"minecraft:netherite_sword",
+	"minecraft:netherite_shovel",
+	"minecraft:netherite_pickaxe",
+	"minecraft:netherite_axe",
+	"minecraft:netherite_hoe",
+	"minecraft:stick",
+	"minecraft:mushroom_stew",
+	"minecraft:string",
+	"minecraft:feather",
+	"minecraft:gunpowder",
+	"minecraft:wheat_seeds",
+	"minecraft:wheat",
+	"minecraft:bread",
+	"minecraft:leather_helmet",
+	"minecraft:leather_chestplate",
+	"minecraft:leather_leggings",
+	"minecraft:leather_boots",
+	"minecraft:chainmail_helmet",
+	"minecraft:chainmail_chestplate",
+	"minecraft:chainmail_leggings",
+	"minecraft:chainmail_boots",
+	"minecraft:iron_helmet",
+	"minecraft:iron_chestplate",
+	"minecraft:iron_leggings",
+	"minecraft:iron_boots",
+	"minecraft:diamond_helmet",
+	"minecraft:diamond_chestplate",
+	"minecraft:diamond_leggings",
+	"minecraft:diamond_boots",
+	"minecraft:golden_helmet",
+	"minecraft:golden_chestplate",
+	"minecraft:golden_leggings",
+	"minecraft:golden_boots",
+	"minecraft:netherite_helmet",
+	"minecraft:netherite_chestplate",
+	"minecraft:netherite_leggings",
+	"minecraft:netherite_boots",
+	"minecraft:flint",
+	"minecraft:porkchop",
+	"minecraft:cooked_porkchop",
+	"minecraft:painting",
+	"minecraft:golden_apple",
+	"minecraft:enchanted_golden_apple",
+	"minecraft:oak_sign",
+	"minecraft:spruce_sign",
+	"minecraft:birch_sign",
+	"minecraft:jungle_sign",
+	"minecraft:acacia_sign",
+	"minecraft:cherry_sign",
+	"minecraft:dark_oak_sign",
+	"minecraft:mangrove_sign",
+	"minecraft:bamboo_sign",
+	"minecraft:crimson_sign",
+	"minecraft:warped_sign",
+	"minecraft:oak_hanging_sign",
+	"minecraft:spruce_hanging_sign",
+	"minecraft:birch_hanging_sign",
+	"minecraft:jungle_hanging_sign",
+	"minecraft:acacia_hanging_sign",
+	"minecraft:cherry_hanging_sign",
+	"minecraft:dark_oak_hanging_sign",
+	"minecraft:mangrove_hanging_sign",
+	"minecraft:bamboo_hanging_sign",
+	"minecraft:crimson_hanging_sign",
+	"minecraft:warped_hanging_sign",
+	"minecraft:bucket",
+	"minecraft:water_bucket",
+	"minecraft:lava_bucket",
+	"minecraft:powder_snow_bucket",
+	"minecraft:snowball",
+	"minecraft:leather",
+	"minecraft:milk_bucket",
+	"minecraft:pufferfish_bucket",
+	"minecraft:salmon_bucket",
+	"minecraft:cod_bucket",
+	"minecraft:tropical_fish_bucket",
+	"minecraft:axolotl_bucket",
+	"minecraft:tadpole_bucket",
+	"minecraft:brick",
+	"minecraft:clay_ball",
+	"minecraft:dried_kelp_block",
+	"minecraft:paper",
+	"minecraft:book",
+	"minecraft:slime_ball",
+	"minecraft:egg",
+	"minecraft:compass",
+	"minecraft:recovery_compass",
+	"minecraft:bundle",
+	"minecraft:fishing_rod",
+	"minecraft:clock",
+	"minecraft:spyglass",
+	"minecraft:glowstone_dust",
+	"minecraft:cod",
+	"minecraft:salmon",
+	"minecraft:tropical_fish",
+	"minecraft:pufferfish",
+	"minecraft:cooked_cod",
+	"minecraft:cooked_salmon",
+	"minecraft:ink_sac",
+	"minecraft:glow_ink_sac",
+	"minecraft:cocoa_beans",
+	"minecraft:white_dye",
+	"minecraft:orange_dye",
+	"minecraft:magenta_dye",
+	"minecraft:light_blue_dye",
+	"minecraft:yellow_dye",
+	"minecraft:lime_dye",
+	"minecraft:pink_dye",
+	"minecraft:gray_dye",
+	"minecraft:light_gray_dye",
+	"minecraft:cyan_dye",
+	"minecraft:purple_dye",
+	"minecraft:blue_dye",
+	"minecraft:brown_dye",
+	"minecraft:green_dye",
+	"minecraft:red_dye",
+	"minecraft:black_dye",
+	"minecraft:bone_meal",
+	"minecraft:bone",
+	"minecraft:sugar",
+	"minecraft:cake",
+	"minecraft:white_bed",
+	"minecraft:orange_bed",
+	"minecraft:magenta_bed",
+	"minecraft:light_blue_bed",
+	"minecraft:yellow_bed",
+	"minecraft:lime_bed",
+	"minecraft:pink_bed",
+	"minecraft:gray_bed",
+	"minecraft:light_gray_bed",
+	"minecraft:cyan_bed",
+	"minecraft:purple_bed",
+	"minecraft:blue_bed",
+	"minecraft:brown_bed",
+	"minecraft:green_bed",
+	"minecraft:red_bed",
+	"minecraft:black_bed",
+	"minecraft:cookie",
+	"minecraft:crafter",
+	"minecraft:filled_map",
+	"minecraft:shears",
+	"minecraft:melon_slice",
+	"minecraft:dried_kelp",
+	"minecraft:pumpkin_seeds",
+	"minecraft:melon_seeds",
+	"minecraft:beef",
+	"minecraft:cooked_beef",
+	"minecraft:chicken",
+	"minecraft:cooked_chicken",
+	"minecraft:rotten_flesh",
+	"minecraft:ender_pearl",
+	"minecraft:blaze_rod",
+	"minecraft:ghast_tear",
+	"minecraft:gold_nugget",
+	"minecraft:nether_wart",
+	"minecraft:potion",
+	"minecraft:glass_bottle",
+	"minecraft:spider_eye",
+	"minecraft:fermented_spider_eye",
+	"minecraft:blaze_powder",
+	"minecraft:magma_cream",
+	"minecraft:brewing_stand",
+	"minecraft:cauldron",
+	"minecraft:ender_eye",
+	"minecraft:glistering_melon_slice",
+	"minecraft:armadillo_spawn_egg",
+	"minecraft:allay_spawn_egg",
+	"minecraft:axolotl_spawn_egg",
+	"minecraft:bat_spawn_egg",
+	"minecraft:bee_spawn_egg",
+	"minecraft:blaze_spawn_egg",
+	"minecraft:bogged_spawn_egg",
+	"minecraft:breeze_spawn_egg",
+	"minecraft:cat_spawn_egg",
+	"minecraft:camel_spawn_egg",
+	"minecraft:cave_spider_spawn_egg",
+	"minecraft:chicken_spawn_egg",
+	"minecraft:cod_spawn_egg",
+	"minecraft:cow_spawn_egg",
+	"minecraft:creeper_spawn_egg",
+	"minecraft:dolphin_spawn_egg",
+	"minecraft:donkey_spawn_egg",
+	"minecraft:drowned_spawn_egg",
+	"minecraft:elder_guardian_spawn_egg",
+	"minecraft:ender_dragon_spawn_egg",
+	"minecraft:enderman_spawn_egg",
+	"minecraft:endermite_spawn_egg",
+	"minecraft:evoker_spawn_egg",
+	"minecraft:fox_spawn_egg",
+	"minecraft:frog_spawn_egg",
+	"minecraft:ghast_spawn_egg",
+	"minecraft:glow_squid_spawn_egg",
+	"minecraft:goat_spawn_egg",
+	"minecraft:guardian_spawn_egg",
+	"minecraft:hoglin_spawn_egg",
+	"minecraft:horse_spawn_egg",
+	"minecraft:husk_spawn_egg",
+	"minecraft:iron_golem_spawn_egg",
+	"minecraft:llama_spawn_egg",
+	"minecraft:magma_cube_spawn_egg",
+	"minecraft:mooshroom_spawn_egg",
+	"minecraft:mule_spawn_egg",
+	"minecraft:ocelot_spawn_egg",
+	"minecraft:panda_spawn_egg",
+	"minecraft:parrot_spawn_egg",
+	"minecraft:phantom_spawn_egg",
+	"minecraft:pig_spawn_egg",
+	"minecraft:piglin_spawn_egg",
+	"minecraft:piglin_brute_spawn_egg",
+	"minecraft:pillager_spawn_egg",
+	"minecraft:polar_bear_spawn_egg",
+	"minecraft:pufferfish_spawn_egg",
+	"minecraft:rabbit_spawn_egg",
+	"minecraft:ravager_spawn_egg",
+	"minecraft:salmon_spawn_egg",
+	"minecraft:sheep_spawn_egg",
+	"minecraft:shulker_spawn_egg",
+	"minecraft:silverfish_spawn_egg",
+	"minecraft:skeleton_spawn_egg",
+	"minecraft:skeleton_horse_spawn_egg",
+	"minecraft:slime_spawn_egg",
+	"minecraft:sniffer_spawn_egg",
+	"minecraft:snow_golem_spawn_egg",
+	"minecraft:spider_spawn_egg",
+	"minecraft:squid_spawn_egg",
+	"minecraft:stray_spawn_egg",
+	"minecraft:strider_spawn_egg",
+	"minecraft:tadpole_spawn_egg",
+	"minecraft:trader_llama_spawn_egg",
+	"minecraft:tropical_fish_spawn_egg",
+	"minecraft:turtle_spawn_egg",
+	"minecraft:vex_spawn_egg",
+	"minecraft:villager_spawn_egg",
+	"minecraft:vindicator_spawn_egg",
+	"minecraft:wandering_trader_spawn_egg",
+	"minecraft:warden_spawn_egg",
+	"minecraft:witch_spawn_egg",
+	"minecraft:wither_spawn_egg",
+	"minecraft:wither_skeleton_spawn_egg",
+	"minecraft:wolf_spawn_egg",
+	"minecraft:zoglin_spawn_egg",
+	"minecraft:zombie_spawn_egg",
+	"minecraft:zombie_horse_spawn_egg",
+	"minecraft:zombie_villager_spawn_egg",
+	"minecraft:zombified_piglin_spawn_egg",
+	"minecraft:experience_bottle",
+	"minecraft:fire_charge",
+	"minecraft:wind_charge",
+	"minecraft:writable_book",
+	"minecraft:written_book",
+	"minecraft:mace",
+	"minecraft:item_frame",
+	"minecraft:glow_item_frame",
+	"minecraft:flower_pot",
+	"minecraft:carrot",
+	"minecraft:potato",
+	"minecraft:baked_potato",
+	"minecraft:poisonous_potato",
+	"minecraft:map",
+	"minecraft:golden_carrot",
+	"minecraft:skeleton_skull",
+	"minecraft:wither_skeleton_skull",
+	"minecraft:player_head",
+	"minecraft:zombie_head",
+	"minecraft:creeper_head",
+	"minecraft:dragon_head",
+	"minecraft:piglin_head",
+	"minecraft:nether_star",
+	"minecraft:pumpkin_pie",
+	"minecraft:firework_rocket",
+	"minecraft:firework_star",
+	"minecraft:enchanted_book",
+	"minecraft:nether_brick",
+	"minecraft:prismarine_shard",
+	"minecraft:prismarine_crystals",
+	"minecraft:rabbit",
+	"minecraft:cooked_rabbit",
+	"minecraft:rabbit_stew",
+	"minecraft:rabbit_foot",
+	"minecraft:rabbit_hide",
+	"minecraft:armor_stand",
+	"minecraft:iron_horse_armor",
+	"minecraft:golden_horse_armor",
+	"minecraft:diamond_horse_armor",
+	"minecraft:leather_horse_armor",
+	"minecraft:lead",
+	"minecraft:name_tag",
+	"minecraft:command_block_minecart",
+	"minecraft:mutton",
+	"minecraft:cooked_mutton",
+	"minecraft:white_banner",
+	"minecraft:orange_banner",
+	"minecraft:magenta_banner",
+	"minecraft:light_blue_banner",
+	"minecraft:yellow_banner",
+	"minecraft:lime_banner",
+	"minecraft:pink_banner",
+	"minecraft:gray_banner",
+	"minecraft:light_gray_banner",
+	"minecraft:cyan_banner",
+	"minecraft:purple_banner",
+	"minecraft:blue_banner",
+	"minecraft:brown_banner",
+	"minecraft:green_banner",
+	"minecraft:red_banner",
+	"minecraft:black_banner",
+	"minecraft:end_crystal",
+	"minecraft:chorus_fruit",
+	"minecraft:popped_chorus_fruit",
+	"minecraft:torchflower_seeds",
+	"minecraft:pitcher_pod",
+	"minecraft:beetroot",
+	"minecraft:beetroot_seeds",
+	"minecraft:beetroot_soup",
+	"minecraft:dragon_breath",
+	"minecraft:splash_potion",
+	"minecraft:spectral_arrow",
+	"minecraft:tipped_arrow",
+	"minecraft:lingering_potion",
+	"minecraft:shield",
+	"minecraft:totem_of_undying",
+	"minecraft:shulker_shell",
+	"minecraft:iron_nugget",
+	"minecraft:knowledge_book",
+	"minecraft:debug_stick",
+	"minecraft:music_disc_13",
+	"minecraft:music_disc_cat",
+	"minecraft:music_disc_blocks",
+	"minecraft:music_disc_chirp",
+	"minecraft:music_disc_creator",
+	"minecraft:music_disc_creator_music_box",
+	"minecraft:music_disc_far",
+	"minecraft:music_disc_mall",
+	"minecraft:music_disc_mellohi",
+	"minecraft:music_disc_stal",
+	"minecraft:music_disc_strad",
+	"minecraft:music_disc_ward",
+	"minecraft:music_disc_11",
+	"minecraft:music_disc_wait",
+	"minecraft:music_disc_otherside",
+	"minecraft:music_disc_relic",
+	"minecraft:music_disc_5",
+	"minecraft:music_disc_pigstep",
+	"minecraft:music_disc_precipice",
+	"minecraft:disc_fragment_5",
+	"minecraft:trident",
+	"minecraft:phantom_membrane",
+	"minecraft:nautilus_shell",
+	"minecraft:heart_of_the_sea",
+	"minecraft:crossbow",
+	"minecraft:suspicious_stew",
+	"minecraft:loom",
+	"minecraft:flower_banner_pattern",
+	"minecraft:creeper_banner_pattern",
+	"minecraft:skull_banner_pattern",
+	"minecraft:mojang_banner_pattern",
+	"minecraft:globe_banner_pattern",
+	"minecraft:piglin_banner_pattern",
+	"minecraft:flow_banner_pattern",
+	"minecraft:guster_banner_pattern",
+	"minecraft:goat_horn",
+	"minecraft:composter",
+	"minecraft:barrel",
+	"minecraft:smoker",
+	"minecraft:blast_furnace",
+	"minecraft:cartography_table",
+	"minecraft:fletching_table",
+	"minecraft:grindstone",
+	"minecraft:smithing_table",
+	"minecraft:stonecutter",
+	"minecraft:bell",
+	"minecraft:lantern",
+	"minecraft:soul_lantern",
+	"minecraft:sweet_berries",
+	"minecraft:glow_berries",
+	"minecraft:campfire",
+	"minecraft:soul_campfire",
+	"minecraft:shroomlight",
+	"minecraft:honeycomb",
+	"minecraft:bee_nest",
+	"minecraft:beehive",
+	"minecraft:honey_bottle",
+	"minecraft:honeycomb_block",
+	"minecraft:lodestone",
+	"minecraft:crying_obsidian",
+	"minecraft:blackstone",
+	"minecraft:blackstone_slab",
+	"minecraft:blackstone_stairs",
+	"minecraft:gilded_blackstone",
+	"minecraft:polished_blackstone",
+	"minecraft:polished_blackstone_slab",
+	"minecraft:polished_blackstone_stairs",
+	"minecraft:chiseled_polished_blackstone",
+	"minecraft:polished_blackstone_bricks",
+	"minecraft:polished_blackstone_brick_slab",
+	"minecraft:polished_blackstone_brick_stairs",
+	"minecraft:cracked_polished_blackstone_bricks",
+	"minecraft:respawn_anchor",
+	"minecraft:candle",
+	"minecraft:white_candle",
+	"minecraft:orange_candle",
+	"minecraft:magenta_candle",
+	"minecraft:light_blue_candle",
+	"minecraft:yellow_candle",
+	"minecraft:lime_candle",
+	"minecraft:pink_candle",
+	"minecraft:gray_candle",
+	"minecraft:light_gray_candle",
+	"minecraft:cyan_candle",
+	"minecraft:purple_candle",
+	"minecraft:blue_candle",
+	"minecraft:brown_candle",
+	"minecraft:green_candle",
+	"minecraft:red_candle",
+	"minecraft:black_candle",
+	"minecraft:small_amethyst_bud",
+	"minecraft:medium_amethyst_bud",
+	"minecraft:large_amethyst_bud",
+	"minecraft:amethyst_cluster",
+	"minecraft:pointed_dripstone",
+	"minecraft:ochre_froglight",
+	"minecraft:verdant_froglight",
+	"minecraft:pearlescent_froglight",
+	"minecraft:frogspawn",
+	"minecraft:echo_shard",
+	"minecraft:brush",
+	"minecraft:netherite_upgrade_smithing_template",
+	"minecraft:sentry_armor_trim_smithing_template",
+	"minecraft:dune_armor_trim_smithing_template",
+	"minecraft:coast_armor_trim_smithing_template",
+	"minecraft:wild_armor_trim_smithing_template",
+	"minecraft:ward_armor_trim_smithing_template",
+	"minecraft:eye_armor_trim_smithing_template",
+	"minecraft:vex_armor_trim_smithing_template",
+	"minecraft:tide_armor_trim_smithing_template",
+	"minecraft:snout_armor_trim_smithing_template",
+	"minecraft:rib_armor_trim_smithing_template",
+	"minecraft:spire_armor_trim_smithing_template",
+	"minecraft:wayfinder_armor_trim_smithing_template",
+	"minecraft:shaper_armor_trim_smithing_template",
+	"minecraft:silence_armor_trim_smithing_template",
+	"minecraft:raiser_armor_trim_smithing_template",
+	"minecraft:host_armor_trim_smithing_template",
+	"minecraft:flow_armor_trim_smithing_template",
+	"minecraft:bolt_armor_trim_smithing_template",
+	"minecraft:angler_pottery_sherd",
+	"minecraft:archer_pottery_sherd",
+	"minecraft:arms_up_pottery_sherd",
+	"minecraft:blade_pottery_sherd",
+	"minecraft:brewer_pottery_sherd",
+	"minecraft:burn_pottery_sherd",
+	"minecraft:danger_pottery_sherd",
+	"minecraft:explorer_pottery_sherd",
+	"minecraft:flow_pottery_sherd",
+	"minecraft:friend_pottery_sherd",
+	"minecraft:guster_pottery_sherd",
+	"minecraft:heart_pottery_sherd",
+	"minecraft:heartbreak_pottery_sherd",
+	"minecraft:howl_pottery_sherd",
+	"minecraft:miner_pottery_sherd",
+	"minecraft:mourner_pottery_sherd",
+	"minecraft:plenty_pottery_sherd",
+	"minecraft:prize_pottery_sherd",
+	"minecraft:scrape_pottery_sherd",
+	"minecraft:sheaf_pottery_sherd",
+	"minecraft:shelter_pottery_sherd",
+	"minecraft:skull_pottery_sherd",
+	"minecraft:snort_pottery_sherd",
+	"minecraft:copper_grate",
+	"minecraft:exposed_copper_grate",
+	"minecraft:weathered_copper_grate",
+	"minecraft:oxidized_copper_grate",
+	"minecraft:waxed_copper_grate",
+	"minecraft:waxed_exposed_copper_grate",
+	"minecraft:waxed_weathered_copper_grate",
+	"minecraft:waxed_oxidized_copper_grate",
+	"minecraft:copper_bulb",
+	"minecraft:exposed_copper_bulb",
+	"minecraft:weathered_copper_bulb",
+	"minecraft:oxidized_copper_bulb",
+	"minecraft:waxed_copper_bulb",
+	"minecraft:waxed_exposed_copper_bulb",
+	"minecraft:waxed_weathered_copper_bulb",
+	"minecraft:waxed_oxidized_copper_bulb",
+	"minecraft:trial_spawner",
+	"minecraft:trial_key",
+	"minecraft:ominous_trial_key",
+	"minecraft:vault",
+	"minecraft:ominous_bottle",
+	"minecraft:breeze_rod",
+}
+
+var entityTypes = []string{
+	"minecraft:allay",
+	"minecraft:area_effect_cloud",
+	"minecraft:armadillo",
+	"minecraft:armor_stand",
+	"minecraft:arrow",
+	"minecraft:axolotl",
+	"minecraft:bat",
+	"minecraft:bee",
+	"minecraft:blaze",
+	"minecraft:block_display",
+	"minecraft:boat",
+	"minecraft:bogged",
+	"minecraft:breeze",
+	"minecraft:breeze_wind_charge",
+	"minecraft:camel",
+	"minecraft:cat",
+	"minecraft:cave_spider",
+	"minecraft:chest_boat",
+	"minecraft:chest_minecart",
+	"minecraft:chicken",
+	"minecraft:cod",
+	"minecraft:command_block_minecart",
+	"minecraft:cow",
+	"minecraft:creeper",
+	"minecraft:dolphin",
+	"minecraft:donkey",
+	"minecraft:dragon_fireball",
+	"minecraft:drowned",
+	"minecraft:egg",
+	"minecraft:elder_guardian",
+	"minecraft:end_crystal",
+	"minecraft:ender_dragon",
+	"minecraft:ender_pearl",
+	"minecraft:enderman",
+	"minecraft:endermite",
+	"minecraft:evoker",
+	"minecraft:evoker_fangs",
+	"minecraft:experience_bottle",
+	"minecraft:experience_orb",
+	"minecraft:eye_of_ender",
+	"minecraft:falling_block",
+	"minecraft:firework_rocket",
+	"minecraft:fox",
+	"minecraft:frog",
+	"minecraft:furnace_minecart",
+	"minecraft:ghast",
+	"minecraft:giant",
+	"minecraft:glow_item_frame",
+	"minecraft:glow_squid",
+	"minecraft:goat",
+	"minecraft:guardian",
+	"minecraft:hoglin",
+	"minecraft:hopper_minecart",
+	"minecraft:horse",
+	"minecraft:husk",
+	"minecraft:illusioner",
+	"minecraft:interaction",
+	"minecraft:iron_golem",
+	"minecraft:item",
+	"minecraft:item_display",
+	"minecraft:item_frame",
+	"minecraft:ominous_item_spawner",
+	"minecraft:fireball",
+	"minecraft:leash_knot",
+	"minecraft:lightning_bolt",
+	"minecraft:llama",
+	"minecraft:llama_spit",
+	"minecraft:magma_cube",
+	"minecraft:marker",
+	"minecraft:minecart",
+	"minecraft:mooshroom",
+	"minecraft:mule",
+	"minecraft:ocelot",
+	"minecraft:painting",
+	"minecraft:panda",
+	"minecraft:parrot",
+	"minecraft:phantom",
+	"minecraft:pig",
+	"minecraft:piglin",
+	"minecraft:piglin_brute",
+	"minecraft:pillager",
+	"minecraft:polar_bear",
+	"minecraft:potion",
+	"minecraft:pufferfish",
+	"minecraft:rabbit",
+	"minecraft:ravager",
+	"minecraft:salmon",
+	"minecraft:sheep",
+	"minecraft:shulker",
+	"minecraft:shulker_bullet",
+	"minecraft:silverfish",
+	"minecraft:skeleton",
+	"minecraft:skeleton_horse",
+	"minecraft:slime",
+	"minecraft:small_fireball",
+	"minecraft:sniffer",
+	"minecraft:snow_golem",
+	"minecraft:snowball",
+	"minecraft:spawner_minecart",
+	"minecraft:spectral_arrow",
+	"minecraft:spider",
+	"minecraft:squid",
+	"minecraft:stray",
+	"minecraft:strider",
+	"minecraft:tadpole",
+	"minecraft:text_display",
+	"minecraft:tnt",
+	"minecraft:tnt_minecart",
+	"minecraft:trader_llama",
+	"minecraft:trident",
+	"minecraft:tropical_fish",
+	"minecraft:turtle",
+	"minecraft:vex",
+	"minecraft:villager",
+	"minecraft:vindicator",
+	"minecraft:wandering_trader",
+	"minecraft:warden",
+	"minecraft:wind_charge",
+	"minecraft:witch",
+	"minecraft:wither",
+	"minecraft:wither_skeleton",
+	"minecraft:wither_skull",
+	"minecraft:wolf",
+	"minecraft:zoglin",
+	"minecraft:zombie",
+	"minecraft:zombie_horse",
+	"minecraft:zombie_villager",
+	"minecraft:zombified_piglin",
+	"minecraft:player",
+	"minecraft:fishing_bobber",
+}
+
+var fluids = []string{
+	"minecraft:empty",
+	"minecraft:flowing_water",
+	"minecraft:water",
+	"minecraft:flowing_lava",
+	"minecraft:lava",
+}
+
+var gameEvents = []string{
+	"minecraft:block_activate",
+	"minecraft:block_attach",
+	"minecraft:block_change",
+	"minecraft:block_close",
+	"minecraft:block_deactivate",
+	"minecraft:block_destroy",
+	"minecraft:block_detach",
+	"minecraft:block_open",
+	"minecraft:block_place",
+	"minecraft:container_close",
+	"minecraft:container_open",
+	"minecraft:drink",
+	"minecraft:eat",
+	"minecraft:elytra_glide",
+	"minecraft:entity_damage",
+	"minecraft:entity_die",
+	"minecraft:entity_dismount",
+	"minecraft:entity_interact",
+	"minecraft:entity_mount",
+	"minecraft:entity_place",
+	"minecraft:entity_action",
+	"minecraft:equip",
+	"minecraft:explode",
+	"minecraft:flap",
+	"minecraft:fluid_pickup",
+	"minecraft:fluid_place",
+	"minecraft:hit_ground",
+	"minecraft:instrument_play",
+	"minecraft:item_interact_finish",
+	"minecraft:item_interact_start",
+	"minecraft:jukebox_play",
+	"minecraft:jukebox_stop_play",
+	"minecraft:lightning_strike",
+	"minecraft:note_block_play",
+	"minecraft:prime_fuse",
+	"minecraft:projectile_land",
+	"minecraft:projectile_shoot",
+	"minecraft:sculk_sensor_tendrils_clicking",
+	"minecraft:shear",
+	"minecraft:shriek",
+	"minecraft:splash",
+	"minecraft:step",
+	"minecraft:swim",
+	"minecraft:teleport",
+	"minecraft:unequip",
+	"minecraft:resonate_1",
+	"minecraft:resonate_2",
+	"minecraft:resonate_3",
+	"minecraft:resonate_4",
+	"minecraft:resonate_5",
+	"minecraft:resonate_6",
+	"minecraft:resonate_7",
+	"minecraft:resonate_8",
+	"minecraft:resonate_9",
+	"minecraft:resonate_10",
+	"minecraft:resonate_11",
+	"minecraft:resonate_12",
+	"minecraft:resonate_13",
+	"minecraft:resonate_14",
+	"minecraft:resonate_15",
+}
+
+var soundEvents = []string{
+	"minecraft:entity.allay.ambient_with_item",
+	"minecraft:entity.allay.ambient_without_item",
+	"minecraft:entity.allay.death",
+	"minecraft:entity.allay.hurt",
+	"minecraft:entity.allay.item_given",
+	"minecraft:entity.allay.item_taken",
+	"minecraft:entity.allay.item_thrown",
+	"minecraft:ambient.cave",
+	"minecraft:ambient.basalt_deltas.additions",
+	"minecraft:ambient.basalt_deltas.loop",
+	"minecraft:ambient.basalt_deltas.mood",
+	"minecraft:ambient.crimson_forest.additions",
+	"minecraft:ambient.crimson_forest.loop",
+	"minecraft:ambient.crimson_forest.mood",
+	"minecraft:ambient.nether_wastes.additions",
+	"minecraft:ambient.nether_wastes.loop",
+	"minecraft:ambient.nether_wastes.mood",
+	"minecraft:ambient.soul_sand_valley.additions",
+	"minecraft:ambient.soul_sand_valley.loop",
+	"minecraft:ambient.soul_sand_valley.mood",
+	"minecraft:ambient.warped_forest.additions",
+	"minecraft:ambient.warped_forest.loop",
+	"minecraft:ambient.warped_forest.mood",
+	"minecraft:ambient.underwater.enter",
+	"minecraft:ambient.underwater.exit",
+	"minecraft:ambient.underwater.loop",
+	"minecraft:ambient.underwater.loop.additions",
+	"minecraft:ambient.underwater.loop.additions.rare",
+	"minecraft:ambient.underwater.loop.additions.ultra_rare",
+	"minecraft:block.amethyst_block.break",
+	"minecraft:block.amethyst_block.chime",
+	"minecraft:block.amethyst_block.fall",
+	"minecraft:block.amethyst_block.hit",
+	"minecraft:block.amethyst_block.place",
+	"minecraft:block.amethyst_block.resonate",
+	"minecraft:block.amethyst_block.step",
+	"minecraft:block.amethyst_cluster.break",
+	"minecraft:block.amethyst_cluster.fall",
+	"minecraft:block.amethyst_cluster.hit",
+	"minecraft:block.amethyst_cluster.place",
+	"minecraft:block.amethyst_cluster.step",
+	"minecraft:block.ancient_debris.break",
+	"minecraft:block.ancient_debris.step",
+	"minecraft:block.ancient_debris.place",
+	"minecraft:block.ancient_debris.hit",
+	"minecraft:block.ancient_debris.fall",
+	"minecraft:block.anvil.break",
+	"minecraft:block.anvil.destroy",
+	"minecraft:block.anvil.fall",
+	"minecraft:block.anvil.hit",
+	"minecraft:block.anvil.land",
+	"minecraft:block.anvil.place",
+	"minecraft:block.anvil.step",
+	"minecraft:block.anvil.use",
+	"minecraft:entity.armadillo.eat",
+	"minecraft:entity.armadillo.hurt",
+	"minecraft:entity.armadillo.hurt_reduced",
+	"minecraft:entity.armadillo.ambient",
+	"minecraft:entity.armadillo.step",
+	"minecraft:entity.armadillo.death",
+	"minecraft:entity.armadillo.roll",
+	"minecraft:entity.armadillo.land",
+	"minecraft:entity.armadillo.scute_drop",
+	"minecraft:entity.armadillo.unroll_finish",
+	"minecraft:entity.armadillo.peek",
+	"minecraft:entity.armadillo.unroll_start",
+	"minecraft:entity.armadillo.brush",
+	"minecraft:item.armor.equip_chain",
+	"minecraft:item.armor.equip_diamond",
+	"minecraft:item.armor.equip_elytra",
+	"minecraft:item.armor.equip_generic",
+	"minecraft:item.armor.equip_gold",
+	"minecraft:item.armor.equip_iron",
+	"minecraft:item.armor.equip_leather",
+	"minecraft:item.armor.equip_netherite",
+	"minecraft:item.armor.equip_turtle",
+	"minecraft:item.armor.equip_wolf",
+	"minecraft:item.armor.unequip_wolf",
+	"minecraft:entity.armor_stand.break",
+	"minecraft:entity.armor_stand.fall",
+	"minecraft:entity.armor_stand.hit",
+	"minecraft:entity.armor_stand.place",
+	"minecraft:entity.arrow.hit",
+	"minecraft:entity.arrow.hit_player",
+	"minecraft:entity.arrow.shoot",
+	"minecraft:item.axe.strip",
+	"minecraft:item.axe.scrape",
+	"minecraft:item.axe.wax_off",
+	"minecraft:entity.axolotl.attack",
+	"minecraft:entity.axolotl.death",
+	"minecraft:entity.axolotl.hurt",
+	"minecraft:entity.axolotl.idle_air",
+	"minecraft:entity.axolotl.idle_water",
+	"minecraft:entity.axolotl.splash",
+	"minecraft:entity.axolotl.swim",
+	"minecraft:block.azalea.break",
+	"minecraft:block.azalea.fall",
+	"minecraft:block.azalea.hit",
+	"minecraft:block.azalea.place",
+	"minecraft:block.azalea.step",
+	"minecraft:block.azalea_leaves.break",
+	"minecraft:block.azalea_leaves.fall",
+	"minecraft:block.azalea_leaves.hit",
+	"minecraft:block.azalea_leaves.place",
+	"minecraft:block.azalea_leaves.step",
+	"minecraft:block.bamboo.break",
+	"minecraft:block.bamboo.fall",
+	"minecraft:block.bamboo.hit",
+	"minecraft:block.bamboo.place",
+	"minecraft:block.bamboo.step",
+	"minecraft:block.bamboo_sapling.break",
+	"minecraft:block.bamboo_sapling.hit",
+	"minecraft:block.bamboo_sapling.place",
+	"minecraft:block.bamboo_wood.break",
+	"minecraft:block.bamboo_wood.fall",
+	"minecraft:block.bamboo_wood.hit",
+	"minecraft:block.bamboo_wood.place",
+	"minecraft:block.bamboo_wood.step",
+	"minecraft:block.bamboo_wood_door.close",
+	"minecraft:block.bamboo_wood_door.open",
+	"minecraft:block.bamboo_wood_trapdoor.close",
+	"minecraft:block.bamboo_wood_trapdoor.open",
+	"minecraft:block.bamboo_wood_button.click_off",
+	"minecraft:block.bamboo_wood_button.click_on",
+	"minecraft:block.bamboo_wood_pressure_plate.click_off",
+	"minecraft:block.bamboo_wood_pressure_plate.click_on",
+	"minecraft:block.bamboo_wood_fence_gate.close",
+	"minecraft:block.bamboo_wood_fence_gate.open",
+	"minecraft:block.barrel.close",
+	"minecraft:block.barrel.open",
+	"minecraft:block.basalt.break",
+	"minecraft:block.basalt.step",
+	"minecraft:block.basalt.place",
+	"minecraft:block.basalt.hit",
+	"minecraft:block.basalt.fall",
+	"minecraft:entity.bat.ambient",
+	"minecraft:entity.bat.death",
+	"minecraft:entity.bat.hurt",
+	"minecraft:entity.bat.loop",
+	"minecraft:entity.bat.takeoff",
+	"minecraft:block.beacon.activate",
+	"minecraft:block.beacon.ambient",
+	"minecraft:block.beacon.deactivate",
+	"minecraft:block.beacon.power_select",
+	"minecraft:entity.bee.death",
+	"minecraft:entity.bee.hurt",
+	"minecraft:entity.bee.loop_aggressive",
+	"minecraft:entity.bee.loop",
+	"minecraft:entity.bee.sting",
+	"minecraft:entity.bee.pollinate",
+	"minecraft:block.beehive.drip",
+	"minecraft:block.beehive.enter",
+	"minecraft:block.beehive.exit",
+	"minecraft:block.beehive.shear",
+	"minecraft:block.beehive.work",
+	"minecraft:block.bell.use",
+	"minecraft:block.bell.resonate",
+	"minecraft:block.big_dripleaf.break",
+	"minecraft:block.big_dripleaf.fall",
+	"minecraft:block.big_dripleaf.hit",
+	"minecraft:block.big_dripleaf.place",
+	"minecraft:block.big_dripleaf.step",
+	"minecraft:entity.blaze.ambient",
+	"minecraft:entity.blaze.burn",
+	"minecraft:entity.blaze.death",
+	"minecraft:entity.blaze.hurt",
+	"minecraft:entity.blaze.shoot",
+	"minecraft:entity.boat.paddle_land",
+	"minecraft:entity.boat.paddle_water",
+	"minecraft:entity.bogged.ambient",
+	"minecraft:entity.bogged.death",
+	"minecraft:entity.bogged.hurt",
+	"minecraft:entity.bogged.shear",
+	"minecraft:entity.bogged.step",
+	"minecraft:block.bone_block.break",
+	"minecraft:block.bone_block.fall",
+	"minecraft:block.bone_block.hit",
+	"minecraft:block.bone_block.place",
+	"minecraft:block.bone_block.step",
+	"minecraft:item.bone_meal.use",
+	"minecraft:item.book.page_turn",
+	"minecraft:item.book.put",
+	"minecraft:block.blastfurnace.fire_crackle",
+	"minecraft:item.bottle.empty",
+	"minecraft:item.bottle.fill",
+	"minecraft:item.bottle.fill_dragonbreath",
+	"minecraft:entity.breeze.charge",
+	"minecraft:entity.breeze.deflect",
+	"minecraft:entity.breeze.inhale",
+	"minecraft:entity.breeze.idle_ground",
+	"minecraft:entity.breeze.idle_air",
+	"minecraft:entity.breeze.shoot",
+	"minecraft:entity.breeze.jump",
+	"minecraft:entity.breeze.land",
+	"minecraft:entity.breeze.slide",
+	"minecraft:entity.breeze.death",
+	"minecraft:entity.breeze.hurt",
+	"minecraft:entity.breeze.whirl",
+	"minecraft:entity.breeze.wind_burst",
+	"minecraft:block.brewing_stand.brew",
+	"minecraft:item.brush.brushing.generic",
+	"minecraft:item.brush.brushing.sand",
+	"minecraft:item.brush.brushing.gravel",
+	"minecraft:item.brush.brushing.sand.complete",
+	"minecraft:item.brush.brushing.gravel.complete",
+	"minecraft:block.bubble_column.bubble_pop",
+	"minecraft:block.bubble_column.upwards_ambient",
+	"minecraft:block.bubble_column.upwards_inside",
+	"minecraft:block.bubble_column.whirlpool_ambient",
+	"minecraft:block.bubble_column.whirlpool_inside",
+	"minecraft:item.bucket.empty",
+	"minecraft:item.bucket.empty_axolotl",
+	"minecraft:item.bucket.empty_fish",
+	"minecraft:item.bucket.empty_lava",
+	"minecraft:item.bucket.empty_powder_snow",
+	"minecraft:item.bucket.empty_tadpole",
+	"minecraft:item.bucket.fill",
+	"minecraft:item.bucket.fill_axolotl",
+	"minecraft:item.bucket.fill_fish",
+	"minecraft:item.bucket.fill_lava",
+	"minecraft:item.bucket.fill_powder_snow",
+	"minecraft:item.bucket.fill_tadpole",
+	"minecraft:item.bundle.drop_contents",
+	"minecraft:item.bundle.insert",
+	"minecraft:item.bundle.remove_one",
+	"minecraft:block.cake.add_candle",
+	"minecraft:block.calcite.break",
+	"minecraft:block.calcite.step",
+	"minecraft:block.calcite.place",
+	"minecraft:block.calcite.hit",
+	"minecraft:block.calcite.fall",
+	"minecraft:entity.camel.ambient",
+	"minecraft:entity.camel.dash",
+	"minecraft:entity.camel.dash_ready",
+	"minecraft:entity.camel.death",
+	"minecraft:entity.camel.eat",
+	"minecraft:entity.camel.hurt",
+	"minecraft:entity.camel.saddle",
+	"minecraft:entity.camel.sit",
+	"minecraft:entity.camel.stand",
+	"minecraft:entity.camel.step",
+	"minecraft:entity.camel.step_sand",
+	"minecraft:block.campfire.crackle",
+	"minecraft:block.candle.ambient",
+	"minecraft:block.candle.break",
+	"minecraft:block.candle.extinguish",
+	"minecraft:block.candle.fall",
+	"minecraft:block.candle.hit",
+	"minecraft:block.candle.place",
+	"minecraft:block.candle.step",
+	"minecraft:entity.cat.ambient",
+	"minecraft:entity.cat.stray_ambient",
+	"minecraft:entity.cat.death",
+	"minecraft:entity.cat.eat",
+	"minecraft:entity.cat.hiss",
+	"minecraft:entity.cat.beg_for_food",
+	"minecraft:entity.cat.hurt",
+	"minecraft:entity.cat.purr",
+	"minecraft:entity.cat.purreow",
+	"minecraft:block.cave_vines.break",
+	"minecraft:block.cave_vines.fall",
+	"minecraft:block.cave_vines.hit",
+	"minecraft:block.cave_vines.place",
+	"minecraft:block.cave_vines.step",
+	"minecraft:block.cave_vines.pick_berries",
+	"minecraft:block.chain.break",
+	"minecraft:block.chain.fall",
+	"minecraft:block.chain.hit",
+	"minecraft:block.chain.place",
+	"minecraft:block.chain.step",
+	"minecraft:block.cherry_wood.break",
+	"minecraft:block.cherry_wood.fall",
+	"minecraft:block.cherry_wood.hit",
+	"minecraft:block.cherry_wood.place",
+	"minecraft:block.cherry_wood.step",
+	"minecraft:block.cherry_sapling.break",
+	"minecraft:block.cherry_sapling.fall",
+	"minecraft:block.cherry_sapling.hit",
+	"minecraft:block.cherry_sapling.place",
+	"minecraft:block.cherry_sapling.step",
+	"minecraft:block.cherry_leaves.break",
+	"minecraft:block.cherry_leaves.fall",
+	"minecraft:block.cherry_leaves.hit",
+	"minecraft:block.cherry_leaves.place",
+	"minecraft:block.cherry_leaves.step",
+	"minecraft:block.cherry_wood_hanging_sign.step",
+	"minecraft:block.cherry_wood_hanging_sign.break",
+	"minecraft:block.cherry_wood_hanging_sign.fall",
+	"minecraft:block.cherry_wood_hanging_sign.hit",
+	"minecraft:block.cherry_wood_hanging_sign.place",
+	"minecraft:block.cherry_wood_door.close",
+	"minecraft:block.cherry_wood_door.open",
+	"minecraft:block.cherry_wood_trapdoor.close",
+	"minecraft:block.cherry_wood_trapdoor.open",
+	"minecraft:block.cherry_wood_button.click_off",
+	"minecraft:block.cherry_wood_button.click_on",
+	"minecraft:block.cherry_wood_pressure_plate.click_off",
+	"minecraft:block.cherry_wood_pressure_plate.click_on",
+	"minecraft:block.cherry_wood_fence_gate.close",
+	"minecraft:block.cherry_wood_fence_gate.open",
+	"minecraft:block.chest.close",
+	"minecraft:block.chest.locked",
+	"minecraft:block.chest.open",
+	"minecraft:entity.chicken.ambient",
+	"minecraft:entity.chicken.death",
+	"minecraft:entity.chicken.egg",
+	"minecraft:entity.chicken.hurt",
+	"minecraft:entity.chicken.step",
+	"minecraft:block.chiseled_bookshelf.break",
+	"minecraft:block.chiseled_bookshelf.fall",
+	"minecraft:block.chiseled_bookshelf.hit",
+	"minecraft:block.chiseled_bookshelf.insert",
+	"minecraft:block.chiseled_bookshelf.insert.enchanted",
+	"minecraft:block.chiseled_bookshelf.step",
+	"minecraft:block.chiseled_bookshelf.pickup",
+	"minecraft:block.chiseled_bookshelf.pickup.enchanted",
+	"minecraft:block.chiseled_bookshelf.place",
+	"minecraft:block.chorus_flower.death",
+	"minecraft:block.chorus_flower.grow",
+	"minecraft:item.chorus_fruit.teleport",
+	"minecraft:block.cobweb.break",
+	"minecraft:block.cobweb.step",
+	"minecraft:block.cobweb.place",
+	"minecraft:block.cobweb.hit",
+	"minecraft:block.cobweb.fall",
+	"minecraft:entity.cod.ambient",
+	"minecraft:entity.cod.death",
+	"minecraft:entity.cod.flop",
+	"minecraft:entity.cod.hurt",
+	"minecraft:block.comparator.click",
+	"minecraft:block.composter.empty",
+	"minecraft:block.composter.fill",
+	"minecraft:block.composter.fill_success",
+	"minecraft:block.composter.ready",
+	"minecraft:block.conduit.activate",
+	"minecraft:block.conduit.ambient",
+	"minecraft:block.conduit.ambient.short",
+	"minecraft:block.conduit.attack.target",
+	"minecraft:block.conduit.deactivate",
+	"minecraft:block.copper_bulb.break",
+	"minecraft:block.copper_bulb.step",
+	"minecraft:block.copper_bulb.place",
+	"minecraft:block.copper_bulb.hit",
+	"minecraft:block.copper_bulb.fall",
+	"minecraft:block.copper_bulb.turn_on",
+	"minecraft:block.copper_bulb.turn_off",
+	"minecraft:block.copper.break",
+	"minecraft:block.copper.step",
+	"minecraft:block.copper.place",
+	"minecraft:block.copper.hit",
+	"minecraft:block.copper.fall",
+	"minecraft:block.copper_door.close",
+	"minecraft:block.copper_door.open",
+	"minecraft:block.copper_grate.break",
+	"minecraft:block.copper_grate.step",
+	"minecraft:block.copper_grate.place",
+	"minecraft:block.copper_grate.hit",
+	"minecraft:block.copper_grate.fall",
+	"minecraft:block.copper_trapdoor.close",
+	"minecraft:block.copper_trapdoor.open",
+	"minecraft:block.coral_block.break",
+	"minecraft:block.coral_block.fall",
+	"minecraft:block.coral_block.hit",
+	"minecraft:block.coral_block.place",
+	"minecraft:block.coral_block.step",
+	"minecraft:entity.cow.ambient",
+	"minecraft:entity.cow.death",
+	"minecraft:entity.cow.hurt",
+	"minecraft:entity.cow.milk",
+	"minecraft:entity.cow.step",
+	"minecraft:block.crafter.craft",
+	"minecraft:block.crafter.fail",
+	"minecraft:entity.creeper.death",
+	"minecraft:entity.creeper.hurt",
+	"minecraft:entity.creeper.primed",
+	"minecraft:block.crop.break",
+	"minecraft:item.crop.plant",
+	"minecraft:item.crossbow.hit",
+	"minecraft:item.crossbow.loading_end",
+	"minecraft:item.crossbow.loading_middle",
+	"minecraft:item.crossbow.loading_start",
+	"minecraft:item.crossbow.quick_charge_1",
+	"minecraft:item.crossbow.quick_charge_2",
+	"minecraft:item.crossbow.quick_charge_3",
+	"minecraft:item.crossbow.shoot",
+	"minecraft:block.decorated_pot.break",
+	"minecraft:block.decorated_pot.fall",
+	"minecraft:block.decorated_pot.hit",
+	"minecraft:block.decorated_pot.insert",
+	"minecraft:block.decorated_pot.insert_fail",
+	"minecraft:block.decorated_pot.step",
+	"minecraft:block.decorated_pot.place",
+	"minecraft:block.decorated_pot.shatter",
+	"minecraft:block.deepslate_bricks.break",
+	"minecraft:block.deepslate_bricks.fall",
+	"minecraft:block.deepslate_bricks.hit",
+	"minecraft:block.deepslate_bricks.place",
+	"minecraft:block.deepslate_bricks.step",
+	"minecraft:block.deepslate.break",
+	"minecraft:block.deepslate.fall",
+	"minecraft:block.deepslate.hit",
+	"minecraft:block.deepslate.place",
+	"minecraft:block.deepslate.step",
+	"minecraft:block.deepslate_tiles.break",
+	"minecraft:block.deepslate_tiles.fall",
+	"minecraft:block.deepslate_tiles.hit",
+	"minecraft:block.deepslate_tiles.place",
+	"minecraft:block.deepslate_tiles.step",
+	"minecraft:block.dispenser.dispense",
+	"minecraft:block.dispenser.fail",
+	"minecraft:block.dispenser.launch",
+	"minecraft:entity.dolphin.ambient",
+	"minecraft:entity.dolphin.ambient_water",
+	"minecraft:entity.dolphin.attack",
+	"minecraft:entity.dolphin.death",
+	"minecraft:entity.dolphin.eat",
+	"minecraft:entity.dolphin.hurt",
+	"minecraft:entity.dolphin.jump",
+	"minecraft:entity.dolphin.play",
+	"minecraft:entity.dolphin.splash",
+	"minecraft:entity.dolphin.swim",
+	"minecraft:entity.donkey.ambient",
+	"minecraft:entity.donkey.angry",
+	"minecraft:entity.donkey.chest",
+	"minecraft:entity.donkey.death",
+	"minecraft:entity.donkey.eat",
+	"minecraft:entity.donkey.hurt",
+	"minecraft:entity.donkey.jump",
+	"minecraft:block.dripstone_block.break",
+	"minecraft:block.dripstone_block.step",
+	"minecraft:block.dripstone_block.place",
+	"minecraft:block.dripstone_block.hit",
+	"minecraft:block.dripstone_block.fall",
+	"minecraft:block.pointed_dripstone.break",
+	"minecraft:block.pointed_dripstone.step",
+	"minecraft:block.pointed_dripstone.place",
+	"minecraft:block.pointed_dripstone.hit",
+	"minecraft:block.pointed_dripstone.fall",
+	"minecraft:block.pointed_dripstone.land",
+	"minecraft:block.pointed_dripstone.drip_lava",
+	"minecraft:block.pointed_dripstone.drip_water",
+	"minecraft:block.pointed_dripstone.drip_lava_into_cauldron",
+	"minecraft:block.pointed_dripstone.drip_water_into_cauldron",
+	"minecraft:block.big_dripleaf.tilt_down",
+	"minecraft:block.big_dripleaf.tilt_up",
+	"minecraft:entity.drowned.ambient",
+	"minecraft:entity.drowned.ambient_water",
+	"minecraft:entity.drowned.death",
+	"minecraft:entity.drowned.death_water",
+	"minecraft:entity.drowned.hurt",
+	"minecraft:entity.drowned.hurt_water",
+	"minecraft:entity.drowned.shoot",
+	"minecraft:entity.drowned.step",
+	"minecraft:entity.drowned.swim",
+	"minecraft:item.dye.use",
+	"minecraft:entity.egg.throw",
+	"minecraft:entity.elder_guardian.ambient",
+	"minecraft:entity.elder_guardian.ambient_land",
+	"minecraft:entity.elder_guardian.curse",
+	"minecraft:entity.elder_guardian.death",
+	"minecraft:entity.elder_guardian.death_land",
+	"minecraft:entity.elder_guardian.flop",
+	"minecraft:entity.elder_guardian.hurt",
+	"minecraft:entity.elder_guardian.hurt_land",
+	"minecraft:item.elytra.flying",
+	"minecraft:block.enchantment_table.use",
+	"minecraft:block.ender_chest.close",
+	"minecraft:block.ender_chest.open",
+	"minecraft:block.end_gateway.spawn",
+	"minecraft:block.end_portal_frame.fill",
+	"minecraft:block.end_portal.spawn",
+	"minecraft:entity.ender_dragon.ambient",
+	"minecraft:entity.ender_dragon.death",
+	"minecraft:entity.dragon_fireball.explode",
+	"minecraft:entity.ender_dragon.flap",
+	"minecraft:entity.ender_dragon.growl",
+	"minecraft:entity.ender_dragon.hurt",
+	"minecraft:entity.ender_dragon.shoot",
+	"minecraft:entity.ender_eye.death",
+	"minecraft:entity.ender_eye.launch",
+	"minecraft:entity.enderman.ambient",
+	"minecraft:entity.enderman.death",
+	"minecraft:entity.enderman.hurt",
+	"minecraft:entity.enderman.scream",
+	"minecraft:entity.enderman.stare",
+	"minecraft:entity.enderman.teleport",
+	"minecraft:entity.endermite.ambient",
+	"minecraft:entity.endermite.death",
+	"minecraft:entity.endermite.hurt",
+	"minecraft:entity.endermite.step",
+	"minecraft:entity.ender_pearl.throw",
+	"minecraft:entity.evoker.ambient",
+	"minecraft:entity.evoker.cast_spell",
+	"minecraft:entity.evoker.celebrate",
+	"minecraft:entity.evoker.death",
+	"minecraft:entity.evoker_fangs.attack",
+	"minecraft:entity.evoker.hurt",
+	"minecraft:entity.evoker.prepare_attack",
+	"minecraft:entity.evoker.prepare_summon",
+	"minecraft:entity.evoker.prepare_wololo",
+	"minecraft:entity.experience_bottle.throw",
+	"minecraft:entity.experience_orb.pickup",
+	"minecraft:block.fence_gate.close",
+	"minecraft:block.fence_gate.open",
+	"minecraft:item.firecharge.use",
+	"minecraft:entity.firework_rocket.blast",
+	"minecraft:entity.firework_rocket.blast_far",
+	"minecraft:entity.firework_rocket.large_blast",
+	"minecraft:entity.firework_rocket.large_blast_far",
+	"minecraft:entity.firework_rocket.launch",
+	"minecraft:entity.firework_rocket.shoot",
+	"minecraft:entity.firework_rocket.twinkle",
+	"minecraft:entity.firework_rocket.twinkle_far",
+	"minecraft:block.fire.ambient",
+	"minecraft:block.fire.extinguish",
+	"minecraft:entity.fish.swim",
+	"minecraft:entity.fishing_bobber.retrieve",
+	"minecraft:entity.fishing_bobber.splash",
+	"minecraft:entity.fishing_bobber.throw",
+	"minecraft:item.flintandsteel.use",
+	"minecraft:block.flowering_azalea.break",
+	"minecraft:block.flowering_azalea.fall",
+	"minecraft:block.flowering_azalea.hit",
+	"minecraft:block.flowering_azalea.place",
+	"minecraft:block.flowering_azalea.step",
+	"minecraft:entity.fox.aggro",
+	"minecraft:entity.fox.ambient",
+	"minecraft:entity.fox.bite",
+	"minecraft:entity.fox.death",
+	"minecraft:entity.fox.eat",
+	"minecraft:entity.fox.hurt",
+	"minecraft:entity.fox.screech",
+	"minecraft:entity.fox.sleep",
+	"minecraft:entity.fox.sniff",
+	"minecraft:entity.fox.spit",
+	"minecraft:entity.fox.teleport",
+	"minecraft:block.suspicious_sand.break",
+	"minecraft:block.suspicious_sand.step",
+	"minecraft:block.suspicious_sand.place",
+	"minecraft:block.suspicious_sand.hit",
+	"minecraft:block.suspicious_sand.fall",
+	"minecraft:block.suspicious_gravel.break",
+	"minecraft:block.suspicious_gravel.step",
+	"minecraft:block.suspicious_gravel.place",
+	"minecraft:block.suspicious_gravel.hit",
+	"minecraft:block.suspicious_gravel.fall",
+	"minecraft:block.froglight.break",
+	"minecraft:block.froglight.fall",
+	"minecraft:block.froglight.hit",
+	"minecraft:block.froglight.place",
+	"minecraft:block.froglight.step",
+	"minecraft:block.frogspawn.step",
+	"minecraft:block.frogspawn.break",
+	"minecraft:block.frogspawn.fall",
+	"minecraft:block.frogspawn.hatch",
+	"minecraft:block.frogspawn.hit",
+	"minecraft:block.frogspawn.place",
+	"minecraft:entity.frog.ambient",
+	"minecraft:entity.frog.death",
+	"minecraft:entity.frog.eat",
+	"minecraft:entity.frog.hurt",
+	"minecraft:entity.frog.lay_spawn",
+	"minecraft:entity.frog.long_jump",
+	"minecraft:entity.frog.step",
+	"minecraft:entity.frog.tongue",
+	"minecraft:block.roots.break",
+	"minecraft:block.roots.step",
+	"minecraft:block.roots.place",
+	"minecraft:block.roots.hit",
+	"minecraft:block.roots.fall",
+	"minecraft:block.furnace.fire_crackle",
+	"minecraft:entity.generic.big_fall",
+	"minecraft:entity.generic.burn",
+	"minecraft:entity.generic.death",
+	"minecraft:entity.generic.drink",
+	"minecraft:entity.generic.eat",
+	"minecraft:entity.generic.explode",
+	"minecraft:entity.generic.extinguish_fire",
+	"minecraft:entity.generic.hurt",
+	"minecraft:entity.generic.small_fall",
+	"minecraft:entity.generic.splash",
+	"minecraft:entity.generic.swim",
+	"minecraft:entity.generic.wind_burst",
+	"minecraft:entity.ghast.ambient",
+	"minecraft:entity.ghast.death",
+	"minecraft:entity.ghast.hurt",
+	"minecraft:entity.ghast.scream",
+	"minecraft:entity.ghast.shoot",
+	"minecraft:entity.ghast.warn",
+	"minecraft:block.gilded_blackstone.break",
+	"minecraft:block.gilded_blackstone.fall",
+	"minecraft:block.gilded_blackstone.hit",
+	"minecraft:block.gilded_blackstone.place",
+	"minecraft:block.gilded_blackstone.step",
+	"minecraft:block.glass.break",
+	"minecraft:block.glass.fall",
+	"minecraft:block.glass.hit",
+	"minecraft:block.glass.place",
+	"minecraft:block.glass.step",
+	"minecraft:item.glow_ink_sac.use",
+	"minecraft:entity.glow_item_frame.add_item",
+	"minecraft:entity.glow_item_frame.break",
+	"minecraft:entity.glow_item_frame.place",
+	"minecraft:entity.glow_item_frame.remove_item",
+	"minecraft:entity.glow_item_frame.rotate_item",
+	"minecraft:entity.glow_squid.ambient",
+	"minecraft:entity.glow_squid.death",
+	"minecraft:entity.glow_squid.hurt",
+	"minecraft:entity.glow_squid.squirt",
+	"minecraft:entity.goat.ambient",
+	"minecraft:entity.goat.death",
+	"minecraft:entity.goat.eat",
+	"minecraft:entity.goat.hurt",
+	"minecraft:entity.goat.long_jump",
+	"minecraft:entity.goat.milk",
+	"minecraft:entity.goat.prepare_ram",
+	"minecraft:entity.goat.ram_impact",
+	"minecraft:entity.goat.horn_break",
+	"minecraft:item.goat_horn.play",
+	"minecraft:entity.goat.screaming.ambient",
+	"minecraft:entity.goat.screaming.death",
+	"minecraft:entity.goat.screaming.eat",
+	"minecraft:entity.goat.screaming.hurt",
+	"minecraft:entity.goat.screaming.long_jump",
+	"minecraft:entity.goat.screaming.milk",
+	"minecraft:entity.goat.screaming.prepare_ram",
+	"minecraft:entity.goat.screaming.ram_impact",
+	"minecraft:entity.goat.screaming.horn_break",
+	"minecraft:entity.goat.step",
+	"minecraft:block.grass.break",
+	"minecraft:block.grass.fall",
+	"minecraft:block.grass.hit",
+	"minecraft:block.grass.place",
+	"minecraft:block.grass.step",
+	"minecraft:block.gravel.break",
+	"minecraft:block.gravel.fall",
+	"minecraft:block.gravel.hit",
+	"minecraft:block.gravel.place",
+	"minecraft:block.gravel.step",
+	"minecraft:block.grindstone.use",
+	"minecraft:block.growing_plant.crop",
+	"minecraft:entity.guardian.ambient",
+	"minecraft:entity.guardian.ambient_land",
+	"minecraft:entity.guardian.attack",
+	"minecraft:entity.guardian.death",
+	"minecraft:entity.guardian.death_land",
+	"minecraft:entity.guardian.flop",
+	"minecraft:entity.guardian.hurt",
+	"minecraft:entity.guardian.hurt_land",
+	"minecraft:block.hanging_roots.break",
+	"minecraft:block.hanging_roots.fall",
+	"minecraft:block.hanging_roots.hit",
+	"minecraft:block.hanging_roots.place",
+	"minecraft:block.hanging_roots.step",
+	"minecraft:block.hanging_sign.step",
+	"minecraft:block.hanging_sign.break",
+	"minecraft:block.hanging_sign.fall",
+	"minecraft:block.hanging_sign.hit",
+	"minecraft:block.hanging_sign.place",
+	"minecraft:block.heavy_core.break",
+	"minecraft:block.heavy_core.fall",
+	"minecraft:block.heavy_core.hit",
+	"minecraft:block.heavy_core.place",
+	"minecraft:block.heavy_core.step",
+	"minecraft:block.nether_wood_hanging_sign.step",
+	"minecraft:block.nether_wood_hanging_sign.break",
+	"minecraft:block.nether_wood_hanging_sign.fall",
+	"minecraft:block.nether_wood_hanging_sign.hit",
+	"minecraft:block.nether_wood_hanging_sign.place",
+	"minecraft:block.bamboo_wood_hanging_sign.step",
+	"minecraft:block.bamboo_wood_hanging_sign.break",
+	"minecraft:block.bamboo_wood_hanging_sign.fall",
+	"minecraft:block.bamboo_wood_hanging_sign.hit",
+	"minecraft:block.bamboo_wood_hanging_sign.place",
+	"minecraft:block.trial_spawner.break",
+	"minecraft:block.trial_spawner.step",
+	"minecraft:block.trial_spawner.place",
+	"minecraft:block.trial_spawner.hit",
+	"minecraft:block.trial_spawner.fall",
+	"minecraft:block.trial_spawner.spawn_mob",
+	"minecraft:block.trial_spawner.about_to_spawn_item",
+	"minecraft:block.trial_spawner.spawn_item",
+	"minecraft:block.trial_spawner.spawn_item_begin",
+	"minecraft:block.trial_spawner.detect_player",
+	"minecraft:block.trial_spawner.ominous_activate",
+	"minecraft:block.trial_spawner.ambient",
+	"minecraft:block.trial_spawner.ambient_ominous",
+	"minecraft:block.trial_spawner.open_shutter",
+	"minecraft:block.trial_spawner.close_shutter",
+	"minecraft:block.trial_spawner.eject_item",
+	"minecraft:item.hoe.till",
+	"minecraft:entity.hoglin.ambient",
+	"minecraft:entity.hoglin.angry",
+	"minecraft:entity.hoglin.attack",
+	"minecraft:entity.hoglin.converted_to_zombified",
+	"minecraft:entity.hoglin.death",
+	"minecraft:entity.hoglin.hurt",
+	"minecraft:entity.hoglin.retreat",
+	"minecraft:entity.hoglin.step",
+	"minecraft:block.honey_block.break",
+	"minecraft:block.honey_block.fall",
+	"minecraft:block.honey_block.hit",
+	"minecraft:block.honey_block.place",
+	"minecraft:block.honey_block.slide",
+	"minecraft:block.honey_block.step",
+	"minecraft:item.honeycomb.wax_on",
+	"minecraft:item.honey_bottle.drink",
+	"minecraft:item.goat_horn.sound.0",
+	"minecraft:item.goat_horn.sound.1",
+	"minecraft:item.goat_horn.sound.2",
+	"minecraft:item.goat_horn.sound.3",
+	"minecraft:item.goat_horn.sound.4",
+	"minecraft:item.goat_horn.sound.5",
+	"minecraft:item.goat_horn.sound.6",
+	"minecraft:item.goat_horn.sound.7",
+	"minecraft:entity.horse.ambient",
+	"minecraft:entity.horse.angry",
+	"minecraft:entity.horse.armor",
+	"minecraft:entity.horse.breathe",
+	"minecraft:entity.horse.death",
+	"minecraft:entity.horse.eat",
+	"minecraft:entity.horse.gallop",
+	"minecraft:entity.horse.hurt",
+	"minecraft:entity.horse.jump",
+	"minecraft:entity.horse.land",
+	"minecraft:entity.horse.saddle",
+	"minecraft:entity.horse.step",
+	"minecraft:entity.horse.step_wood",
+	"minecraft:entity.hostile.big_fall",
+	"minecraft:entity.hostile.hurt",
+	"minecraft:entity.hostile.small_fall",
+	"minecraft:entity.hostile.splash",
+	"minecraft:entity.hostile.swim",
+	"minecraft:entity.husk.ambient",
+	"minecraft:entity.husk.converted_to_zombie",
+	"minecraft:entity.husk.death",
+	"minecraft:entity.husk.hurt",
+	"minecraft:entity.husk.step",
+	"minecraft:entity.illusioner.ambient",
+	"minecraft:entity.illusioner.cast_spell",
+	"minecraft:entity.illusioner.death",
+	"minecraft:entity.illusioner.hurt",
+	"minecraft:entity.illusioner.mirror_move",
+	"minecraft:entity.illusioner.prepare_blindness",
+	"minecraft:entity.illusioner.prepare_mirror",
+	"minecraft:item.ink_sac.use",
+	"minecraft:block.iron_door.close",
+	"minecraft:block.iron_door.open",
+	"minecraft:entity.iron_golem.attack",
+	"minecraft:entity.iron_golem.damage",
+	"minecraft:entity.iron_golem.death",
+	"minecraft:entity.iron_golem.hurt",
+	"minecraft:entity.iron_golem.repair",
+	"minecraft:entity.iron_golem.step",
+	"minecraft:block.iron_trapdoor.close",
+	"minecraft:block.iron_trapdoor.open",
+	"minecraft:entity.item_frame.add_item",
+	"minecraft:entity.item_frame.break",
+	"minecraft:entity.item_frame.place",
+	"minecraft:entity.item_frame.remove_item",
+	"minecraft:entity.item_frame.rotate_item",
+	"minecraft:entity.item.break",
+	"minecraft:entity.item.pickup",
+	"minecraft:block.ladder.break",
+	"minecraft:block.ladder.fall",
+	"minecraft:block.ladder.hit",
+	"minecraft:block.ladder.place",
+	"minecraft:block.ladder.step",
+	"minecraft:block.lantern.break",
+	"minecraft:block.lantern.fall",
+	"minecraft:block.lantern.hit",
+	"minecraft:block.lantern.place",
+	"minecraft:block.lantern.step",
+	"minecraft:block.large_amethyst_bud.break",
+	"minecraft:block.large_amethyst_bud.place",
+	"minecraft:block.lava.ambient",
+	"minecraft:block.lava.extinguish",
+	"minecraft:block.lava.pop",
+	"minecraft:entity.leash_knot.break",
+	"minecraft:entity.leash_knot.place",
+	"minecraft:block.lever.click",
+	"minecraft:entity.lightning_bolt.impact",
+	"minecraft:entity.lightning_bolt.thunder",
+	"minecraft:entity.lingering_potion.throw",
+	"minecraft:entity.llama.ambient",
+	"minecraft:entity.llama.angry",
+	"minecraft:entity.llama.chest",
+	"minecraft:entity.llama.death",
+	"minecraft:entity.llama.eat",
+	"minecraft:entity.llama.hurt",
+	"minecraft:entity.llama.spit",
+	"minecraft:entity.llama.step",
+	"minecraft:entity.llama.swag",
+	"minecraft:block.lodestone.break",
+	"minecraft:block.lodestone.fall",
+	"minecraft:block.lodestone.hit",
+	"minecraft:block.lodestone.place",
+	"minecraft:block.lodestone.step",
+	"minecraft:item.lodestone_compass.lock",
+	"minecraft:item.mace.smash_air",
+	"minecraft:item.mace.smash_ground",
+	"minecraft:item.mace.smash_ground_heavy",
+	"minecraft:entity.magma_cube.ambient",
+	"minecraft:entity.magma_cube.death",
+	"minecraft:entity.magma_cube.hurt",
+	"minecraft:entity.magma_cube.hurt_small",
+	"minecraft:entity.magma_cube.death_small",
+	"minecraft:entity.magma_cube.jump",
+	"minecraft:entity.magma_cube.squish",
+	"minecraft:entity.magma_cube.squish_small",
+	"minecraft:block.mangrove_roots.break",
+	"minecraft:block.mangrove_roots.fall",
+	"minecraft:block.mangrove_roots.hit",
+	"minecraft:block.mangrove_roots.place",
+	"minecraft:block.mangrove_roots.step",
+	"minecraft:block.medium_amethyst_bud.break",
+	"minecraft:block.medium_amethyst_bud.place",
+	"minecraft:block.metal.break",
+	"minecraft:block.metal.fall",
+	"minecraft:block.metal.hit",
+	"minecraft:block.metal.place",
+	"minecraft:block.metal_pressure_plate.click_off",
+	"minecraft:block.metal_pressure_plate.click_on",
+	"minecraft:block.metal.step",
+	"minecraft:entity.minecart.inside.underwater",
+	"minecraft:entity.minecart.inside",
+	"minecraft:entity.minecart.riding",
+	"minecraft:entity.mooshroom.convert",
+	"minecraft:entity.mooshroom.eat",
+	"minecraft:entity.mooshroom.milk",
+	"minecraft:entity.mooshroom.suspicious_milk",
+	"minecraft:entity.mooshroom.shear",
+	"minecraft:block.moss_carpet.break",
+	"minecraft:block.moss_carpet.fall",
+	"minecraft:block.moss_carpet.hit",
+	"minecraft:block.moss_carpet.place",
+	"minecraft:block.moss_carpet.step",
+	"minecraft:block.pink_petals.break",
+	"minecraft:block.pink_petals.fall",
+	"minecraft:block.pink_petals.hit",
+	"minecraft:block.pink_petals.place",
+	"minecraft:block.pink_petals.step",
+	"minecraft:block.moss.break",
+	"minecraft:block.moss.fall",
+	"minecraft:block.moss.hit",
+	"minecraft:block.moss.place",
+	"minecraft:block.moss.step",
+	"minecraft:block.mud.break",
+	"minecraft:block.mud.fall",
+	"minecraft:block.mud.hit",
+	"minecraft:block.mud.place",
+	"minecraft:block.mud.step",
+	"minecraft:block.mud_bricks.break",
+	"minecraft:block.mud_bricks.fall",
+	"minecraft:block.mud_bricks.hit",
+	"minecraft:block.mud_bricks.place",
+	"minecraft:block.mud_bricks.step",
+	"minecraft:block.muddy_mangrove_roots.break",
+	"minecraft:block.muddy_mangrove_roots.fall",
+	"minecraft:block.muddy_mangrove_roots.hit",
+	"minecraft:block.muddy_mangrove_roots.place",
+	"minecraft:block.muddy_mangrove_roots.step",
+	"minecraft:entity.mule.ambient",
+	"minecraft:entity.mule.angry",
+	"minecraft:entity.mule.chest",
+	"minecraft:entity.mule.death",
+	"minecraft:entity.mule.eat",
+	"minecraft:entity.mule.hurt",
+	"minecraft:entity.mule.jump",
+	"minecraft:music.creative",
+	"minecraft:music.credits",
+	"minecraft:music_disc.5",
+	"minecraft:music_disc.11",
+	"minecraft:music_disc.13",
+	"minecraft:music_disc.blocks",
+	"minecraft:music_disc.cat",
+	"minecraft:music_disc.chirp",
+	"minecraft:music_disc.far",
+	"minecraft:music_disc.mall",
+	"minecraft:music_disc.mellohi",
+	"minecraft:music_disc.pigstep",
+	"minecraft:music_disc.stal",
+	"minecraft:music_disc.strad",
+	"minecraft:music_disc.wait",
+	"minecraft:music_disc.ward",
+	"minecraft:music_disc.otherside",
+	"minecraft:music_disc.relic",
+	"minecraft:music_disc.creator",
+	"minecraft:music_disc.creator_music_box",
+	"minecraft:music_disc.precipice",
+	"minecraft:music.dragon",
+	"minecraft:music.end",
+	"minecraft:music.game",
+	"minecraft:music.menu",
+	"minecraft:music.nether.basalt_deltas",
+	"minecraft:music.nether.crimson_forest",
+	"minecraft:music.overworld.deep_dark",
+	"minecraft:music.overworld.dripstone_caves",
+	"minecraft:music.overworld.grove",
+	"minecraft:music.overworld.jagged_peaks",
+	"minecraft:music.overworld.lush_caves",
+	"minecraft:music.overworld.swamp",
+	"minecraft:music.overworld.forest",
+	"minecraft:music.overworld.old_growth_taiga",
+	"minecraft:music.overworld.meadow",
+	"minecraft:music.overworld.cherry_grove",
+	"minecraft:music.nether.nether_wastes",
+	"minecraft:music.overworld.frozen_peaks",
+	"minecraft:music.overworld.snowy_slopes",
+	"minecraft:music.nether.soul_sand_valley",
+	"minecraft:music.overworld.stony_peaks",
+	"minecraft:music.nether.warped_forest",
+	"minecraft:music.overworld.flower_forest",
+	"minecraft:music.overworld.desert",
+	"minecraft:music.overworld.badlands",
+	"minecraft:music.overworld.jungle",
+	"minecraft:music.overworld.sparse_jungle",
+	"minecraft:music.overworld.bamboo_jungle",
+	"minecraft:music.under_water",
+	"minecraft:block.nether_bricks.break",
+	"minecraft:block.nether_bricks.step",
+	"minecraft:block.nether_bricks.place",
+	"minecraft:block.nether_bricks.hit",
+	"minecraft:block.nether_bricks.fall",
+	"minecraft:block.nether_wart.break",
+	"minecraft:item.nether_wart.plant",
+	"minecraft:block.nether_wood.break",
+	"minecraft:block.nether_wood.fall",
+	"minecraft:block.nether_wood.hit",
+	"minecraft:block.nether_wood.place",
+	"minecraft:block.nether_wood.step",
+	"minecraft:block.nether_wood_door.close",
+	"minecraft:block.nether_wood_door.open",
+	"minecraft:block.nether_wood_trapdoor.close",
+	"minecraft:block.nether_wood_trapdoor.open",
+	"minecraft:block.nether_wood_button.click_off",
+	"minecraft:block.nether_wood_button.click_on",
+	"minecraft:block.nether_wood_pressure_plate.click_off",
+	"minecraft:block.nether_wood_pressure_plate.click_on",
+	"minecraft:block.nether_wood_fence_gate.close",
+	"minecraft:block.nether_wood_fence_gate.open",
+	"minecraft:intentionally_empty",
+	"minecraft:block.packed_mud.break",
+	"minecraft:block.packed_mud.fall",
+	"minecraft:block.packed_mud.hit",
+	"minecraft:block.packed_mud.place",
+	"minecraft:block.packed_mud.step",
+	"minecraft:block.stem.break",
+	"minecraft:block.stem.step",
+	"minecraft:block.stem.place",
+	"minecraft:block.stem.hit",
+	"minecraft:block.stem.fall",
+	"minecraft:block.nylium.break",
+	"minecraft:block.nylium.step",
+	"minecraft:block.nylium.place",
+	"minecraft:block.nylium.hit",
+	"minecraft:block.nylium.fall",
+	"minecraft:block.nether_sprouts.break",
+	"minecraft:block.nether_sprouts.step",
+	"minecraft:block.nether_sprouts.place",
+	"minecraft:block.nether_sprouts.hit",
+	"minecraft:block.nether_sprouts.fall",
+	"minecraft:block.fungus.break",
+	"minecraft:block.fungus.step",
+	"minecraft:block.fungus.place",
+	"minecraft:block.fungus.hit",
+	"minecraft:block.fungus.fall",
+	"minecraft:block.weeping_vines.break",
+	"minecraft:block.weeping_vines.step",
+	"minecraft:block.weeping_vines.place",
+	"minecraft:block.weeping_vines.hit",
+	"minecraft:block.weeping_vines.fall",
+	"minecraft:block.wart_block.break",
+	"minecraft:block.wart_block.step",
+	"minecraft:block.wart_block.place",
+	"minecraft:block.wart_block.hit",
+	"minecraft:block.wart_block.fall",
+	"minecraft:block.netherite_block.break",
+	"minecraft:block.netherite_block.step",
+	"minecraft:block.netherite_block.place",
+	"minecraft:block.netherite_block.hit",
+	"minecraft:block.netherite_block.fall",
+	"minecraft:block.netherrack.break",
+	"minecraft:block.netherrack.step",
+	"minecraft:block.netherrack.place",
+	"minecraft:block.netherrack.hit",
+	"minecraft:block.netherrack.fall",
+	"minecraft:block.note_block.basedrum",
+	"minecraft:block.note_block.bass",
+	"minecraft:block.note_block.bell",
+	"minecraft:block.note_block.chime",
+	"minecraft:block.note_block.flute",
+	"minecraft:block.note_block.guitar",
+	"minecraft:block.note_block.harp",
+	"minecraft:block.note_block.hat",
+	"minecraft:block.note_block.pling",
+	"minecraft:block.note_block.snare",
+	"minecraft:block.note_block.xylophone",
+	"minecraft:block.note_block.iron_xylophone",
+	"minecraft:block.note_block.cow_bell",
+	"minecraft:block.note_block.didgeridoo",
+	"minecraft:block.note_block.bit",
+	"minecraft:block.note_block.banjo",
+	"minecraft:block.note_block.imitate.zombie",
+	"minecraft:block.note_block.imitate.skeleton",
+	"minecraft:block.note_block.imitate.creeper",
+	"minecraft:block.note_block.imitate.ender_dragon",
+	"minecraft:block.note_block.imitate.wither_skeleton",
+	"minecraft:block.note_block.imitate.piglin",
+	"minecraft:entity.ocelot.hurt",
+	"minecraft:entity.ocelot.ambient",
+	"minecraft:entity.ocelot.death",
+	"minecraft:item.ominous_bottle.dispose",
+	"minecraft:entity.painting.break",
+	"minecraft:entity.painting.place",
+	"minecraft:entity.panda.pre_sneeze",
+	"minecraft:entity.panda.sneeze",
+	"minecraft:entity.panda.ambient",
+	"minecraft:entity.panda.death",
+	"minecraft:entity.panda.eat",
+	"minecraft:entity.panda.step",
+	"minecraft:entity.panda.cant_breed",
+	"minecraft:entity.panda.aggressive_ambient",
+	"minecraft:entity.panda.worried_ambient",
+	"minecraft:entity.panda.hurt",
+	"minecraft:entity.panda.bite",
+	"minecraft:entity.parrot.ambient",
+	"minecraft:entity.parrot.death",
+	"minecraft:entity.parrot.eat",
+	"minecraft:entity.parrot.fly",
+	"minecraft:entity.parrot.hurt",
+	"minecraft:entity.parrot.imitate.blaze",
+	"minecraft:entity.parrot.imitate.bogged",
+	"minecraft:entity.parrot.imitate.breeze",
+	"minecraft:entity.parrot.imitate.creeper",
+	"minecraft:entity.parrot.imitate.drowned",
+	"minecraft:entity.parrot.imitate.elder_guardian",
+	"minecraft:entity.parrot.imitate.ender_dragon",
+	"minecraft:entity.parrot.imitate.endermite",
+	"minecraft:entity.parrot.imitate.evoker",
+	"minecraft:entity.parrot.imitate.ghast",
+	"minecraft:entity.parrot.imitate.guardian",
+	"minecraft:entity.parrot.imitate.hoglin",
+	"minecraft:entity.parrot.imitate.husk",
+	"minecraft:entity.parrot.imitate.illusioner",
+	"minecraft:entity.parrot.imitate.magma_cube",
+	"minecraft:entity.parrot.imitate.phantom",
+	"minecraft:entity.parrot.imitate.piglin",
+	"minecraft:entity.parrot.imitate.piglin_brute",
+	"minecraft:entity.parrot.imitate.pillager",
+	"minecraft:entity.parrot.imitate.ravager",
+	"minecraft:entity.parrot.imitate.shulker",
+	"minecraft:entity.parrot.imitate.silverfish",
+	"minecraft:entity.parrot.imitate.skeleton",
+	"minecraft:entity.parrot.imitate.slime",
+	"minecraft:entity.parrot.imitate.spider",
+	"minecraft:entity.parrot.imitate.stray",
+	"minecraft:entity.parrot.imitate.vex",
+	"minecraft:entity.parrot.imitate.vindicator",
+	"minecraft:entity.parrot.imitate.warden",
+	"minecraft:entity.parrot.imitate.witch",
+	"minecraft:entity.parrot.imitate.wither",
+	"minecraft:entity.parrot.imitate.wither_skeleton",
+	"minecraft:entity.parrot.imitate.zoglin",
+	"minecraft:entity.parrot.imitate.zombie",
+	"minecraft:entity.parrot.imitate.zombie_villager",
+	"minecraft:entity.parrot.step",
+	"minecraft:entity.phantom.ambient",
+	"minecraft:entity.phantom.bite",
+	"minecraft:entity.phantom.death",
+	"minecraft:entity.phantom.flap",
+	"minecraft:entity.phantom.hurt",
+	"minecraft:entity.phantom.swoop",
+	"minecraft:entity.pig.ambient",
+	"minecraft:entity.pig.death",
+	"minecraft:entity.pig.hurt",
+	"minecraft:entity.pig.saddle",
+	"minecraft:entity.pig.step",
+	"minecraft:entity.piglin.admiring_item",
+	"minecraft:entity.piglin.ambient",
+	"minecraft:entity.piglin.angry",
+	"minecraft:entity.piglin.celebrate",
+	"minecraft:entity.piglin.death",
+	"minecraft:entity.piglin.jealous",
+	"minecraft:entity.piglin.hurt",
+	"minecraft:entity.piglin.retreat",
+	"minecraft:entity.piglin.step",
+	"minecraft:entity.piglin.converted_to_zombified",
+	"minecraft:entity.piglin_brute.ambient",
+	"minecraft:entity.piglin_brute.angry",
+	"minecraft:entity.piglin_brute.death",
+	"minecraft:entity.piglin_brute.hurt",
+	"minecraft:entity.piglin_brute.step",
+	"minecraft:entity.piglin_brute.converted_to_zombified",
+	"minecraft:entity.pillager.ambient",
+	"minecraft:entity.pillager.celebrate",
+	"minecraft:entity.pillager.death",
+	"minecraft:entity.pillager.hurt",
+	"minecraft:block.piston.contract",
+	"minecraft:block.piston.extend",
+	"minecraft:entity.player.attack.crit",
+	"minecraft:entity.player.attack.knockback",
+	"minecraft:entity.player.attack.nodamage",
+	"minecraft:entity.player.attack.strong",
+	"minecraft:entity.player.attack.sweep",
+	"minecraft:entity.player.attack.weak",
+	"minecraft:entity.player.big_fall",
+	"minecraft:entity.player.breath",
+	"minecraft:entity.player.burp",
+	"minecraft:entity.player.death",
+	"minecraft:entity.player.hurt",
+	"minecraft:entity.player.hurt_drown",
+	"minecraft:entity.player.hurt_freeze",
+	"minecraft:entity.player.hurt_on_fire",
+	"minecraft:entity.player.hurt_sweet_berry_bush",
+	"minecraft:entity.player.levelup",
+	"minecraft:entity.player.small_fall",
+	"minecraft:entity.player.splash",
+	"minecraft:entity.player.splash.high_speed",
+	"minecraft:entity.player.swim",
+	"minecraft:entity.polar_bear.ambient",
+	"minecraft:entity.polar_bear.ambient_baby",
+	"minecraft:entity.polar_bear.death",
+	"minecraft:entity.polar_bear.hurt",
+	"minecraft:entity.polar_bear.step",
+	"minecraft:entity.polar_bear.warning",
+	"minecraft:block.polished_deepslate.break",
+	"minecraft:block.polished_deepslate.fall",
+	"minecraft:block.polished_deepslate.hit",
+	"minecraft:block.polished_deepslate.place",
+	"minecraft:block.polished_deepslate.step",
+	"minecraft:block.portal.ambient",
+	"minecraft:block.portal.travel",
+	"minecraft:block.portal.trigger",
+	"minecraft:block.powder_snow.break",
+	"minecraft:block.powder_snow.fall",
+	"minecraft:block.powder_snow.hit",
+	"minecraft:block.powder_snow.place",
+	"minecraft:block.powder_snow.step",
+	"minecraft:entity.puffer_fish.ambient",
+	"minecraft:entity.puffer_fish.blow_out",
+	"minecraft:entity.puffer_fish.blow_up",
+	"minecraft:entity.puffer_fish.death",
+	"minecraft:entity.puffer_fish.flop",
+	"minecraft:entity.puffer_fish.hurt",
+	"minecraft:entity.puffer_fish.sting",
+	"minecraft:block.pumpkin.carve",
+	"minecraft:entity.rabbit.ambient",
+	"minecraft:entity.rabbit.attack",
+	"minecraft:entity.rabbit.death",
+	"minecraft:entity.rabbit.hurt",
+	"minecraft:entity.rabbit.jump",
+	"minecraft:event.raid.horn",
+	"minecraft:entity.ravager.ambient",
+	"minecraft:entity.ravager.attack",
+	"minecraft:entity.ravager.celebrate",
+	"minecraft:entity.ravager.death",
+	"minecraft:entity.ravager.hurt",
+	"minecraft:entity.ravager.step",
+	"minecraft:entity.ravager.stunned",
+	"minecraft:entity.ravager.roar",
+	"minecraft:block.nether_gold_ore.break",
+	"minecraft:block.nether_gold_ore.fall",
+	"minecraft:block.nether_gold_ore.hit",
+	"minecraft:block.nether_gold_ore.place",
+	"minecraft:block.nether_gold_ore.step",
+	"minecraft:block.nether_ore.break",
+	"minecraft:block.nether_ore.fall",
+	"minecraft:block.nether_ore.hit",
+	"minecraft:block.nether_ore.place",
+	"minecraft:block.nether_ore.step",
+	"minecraft:block.redstone_torch.burnout",
+	"minecraft:block.respawn_anchor.ambient",
+	"minecraft:block.respawn_anchor.charge",
+	"minecraft:block.respawn_anchor.deplete",
+	"minecraft:block.respawn_anchor.set_spawn",
+	"minecraft:block.rooted_dirt.break",
+	"minecraft:block.rooted_dirt.fall",
+	"minecraft:block.rooted_dirt.hit",
+	"minecraft:block.rooted_dirt.place",
+	"minecraft:block.rooted_dirt.step",
+	"minecraft:entity.salmon.ambient",
+	"minecraft:entity.salmon.death",
+	"minecraft:entity.salmon.flop",
+	"minecraft:entity.salmon.hurt",
+	"minecraft:block.sand.break",
+	"minecraft:block.sand.fall",
+	"minecraft:block.sand.hit",
+	"minecraft:block.sand.place",
+	"minecraft:block.sand.step",
+	"minecraft:block.scaffolding.break",
+	"minecraft:block.scaffolding.fall",
+	"minecraft:block.scaffolding.hit",
+	"minecraft:block.scaffolding.place",
+	"minecraft:block.scaffolding.step",
+	"minecraft:block.sculk.spread",
+	"minecraft:block.sculk.charge",
+	"minecraft:block.sculk.break",
+	"minecraft:block.sculk.fall",
+	"minecraft:block.sculk.hit",
+	"minecraft:block.sculk.place",
+	"minecraft:block.sculk.step",
+	"minecraft:block.sculk_catalyst.bloom",
+	"minecraft:block.sculk_catalyst.break",
+	"minecraft:block.sculk_catalyst.fall",
+	"minecraft:block.sculk_catalyst.hit",
+	"minecraft:block.sculk_catalyst.place",
+	"minecraft:block.sculk_catalyst.step",
+	"minecraft:block.sculk_sensor.clicking",
+	"minecraft:block.sculk_sensor.clicking_stop",
+	"minecraft:block.sculk_sensor.break",
+	"minecraft:block.sculk_sensor.fall",
+	"minecraft:block.sculk_sensor.hit",
+	"minecraft:block.sculk_sensor.place",
+	"minecraft:block.sculk_sensor.step",
+	"minecraft:block.sculk_shrieker.break",
+	"minecraft:block.sculk_shrieker.fall",
+	"minecraft:block.sculk_shrieker.hit",
+	"minecraft:block.sculk_shrieker.place",
+	"minecraft:block.sculk_shrieker.shriek",
+	"minecraft:block.sculk_shrieker.step",
+	"minecraft:block.sculk_vein.break",
+	"minecraft:block.sculk_vein.fall",
+	"minecraft:block.sculk_vein.hit",
+	"minecraft:block.sculk_vein.place",
+	"minecraft:block.sculk_vein.step",
+	"minecraft:entity.sheep.ambient",
+	"minecraft:entity.sheep.death",
+	"minecraft:entity.sheep.hurt",
+	"minecraft:entity.sheep.shear",
+	"minecraft:entity.sheep.step",
+	"minecraft:item.shield.block",
+	"minecraft:item.shield.break",
+	"minecraft:block.shroomlight.break",
+	"minecraft:block.shroomlight.step",
+	"minecraft:block.shroomlight.place",
+	"minecraft:block.shroomlight.hit",
+	"minecraft:block.shroomlight.fall",
+	"minecraft:item.shovel.flatten",
+	"minecraft:entity.shulker.ambient",
+	"minecraft:block.shulker_box.close",
+	"minecraft:block.shulker_box.open",
+	"minecraft:entity.shulker_bullet.hit",
+	"minecraft:entity.shulker_bullet.hurt",
+	"minecraft:entity.shulker.close",
+	"minecraft:entity.shulker.death",
+	"minecraft:entity.shulker.hurt",
+	"minecraft:entity.shulker.hurt_closed",
+	"minecraft:entity.shulker.open",
+	"minecraft:entity.shulker.shoot",
+	"minecraft:entity.shulker.teleport",
+	"minecraft:entity.silverfish.ambient",
+	"minecraft:entity.silverfish.death",
+	"minecraft:entity.silverfish.hurt",
+	"minecraft:entity.silverfish.step",
+	"minecraft:entity.skeleton.ambient",
+	"minecraft:entity.skeleton.converted_to_stray",
+	"minecraft:entity.skeleton.death",
+	"minecraft:entity.skeleton_horse.ambient",
+	"minecraft:entity.skeleton_horse.death",
+	"minecraft:entity.skeleton_horse.hurt",
+	"minecraft:entity.skeleton_horse.swim",
+	"minecraft:entity.skeleton_horse.ambient_water",
+	"minecraft:entity.skeleton_horse.gallop_water",
+	"minecraft:entity.skeleton_horse.jump_water",
+	"minecraft:entity.skeleton_horse.step_water",
+	"minecraft:entity.skeleton.hurt",
+	"minecraft:entity.skeleton.shoot",
+	"minecraft:entity.skeleton.step",
+	"minecraft:entity.slime.attack",
+	"minecraft:entity.slime.death",
+	"minecraft:entity.slime.hurt",
+	"minecraft:entity.slime.jump",
+	"minecraft:entity.slime.squish",
+	"minecraft:block.slime_block.break",
+	"minecraft:block.slime_block.fall",
+	"minecraft:block.slime_block.hit",
+	"minecraft:block.slime_block.place",
+	"minecraft:block.slime_block.step",
+	"minecraft:block.small_amethyst_bud.break",
+	"minecraft:block.small_amethyst_bud.place",
+	"minecraft:block.small_dripleaf.break",
+	"minecraft:block.small_dripleaf.fall",
+	"minecraft:block.small_dripleaf.hit",
+	"minecraft:block.small_dripleaf.place",
+	"minecraft:block.small_dripleaf.step",
+	"minecraft:block.soul_sand.break",
+	"minecraft:block.soul_sand.step",
+	"minecraft:block.soul_sand.place",
+	"minecraft:block.soul_sand.hit",
+	"minecraft:block.soul_sand.fall",
+	"minecraft:block.soul_soil.break",
+	"minecraft:block.soul_soil.step",
+	"minecraft:block.soul_soil.place",
+	"minecraft:block.soul_soil.hit",
+	"minecraft:block.soul_soil.fall",
+	"minecraft:particle.soul_escape",
+	"minecraft:block.spawner.break",
+	"minecraft:block.spawner.fall",
+	"minecraft:block.spawner.hit",
+	"minecraft:block.spawner.place",
+	"minecraft:block.spawner.step",
+	"minecraft:block.spore_blossom.break",
+	"minecraft:block.spore_blossom.fall",
+	"minecraft:block.spore_blossom.hit",
+	"minecraft:block.spore_blossom.place",
+	"minecraft:block.spore_blossom.step",
+	"minecraft:entity.strider.ambient",
+	"minecraft:entity.strider.happy",
+	"minecraft:entity.strider.retreat",
+	"minecraft:entity.strider.death",
+	"minecraft:entity.strider.hurt",
+	"minecraft:entity.strider.step",
+	"minecraft:entity.strider.step_lava",
+	"minecraft:entity.strider.eat",
+	"minecraft:entity.strider.saddle",
+	"minecraft:entity.slime.death_small",
+	"minecraft:entity.slime.hurt_small",
+	"minecraft:entity.slime.jump_small",
+	"minecraft:entity.slime.squish_small",
+	"minecraft:block.smithing_table.use",
+	"minecraft:block.smoker.smoke",
+	"minecraft:entity.sniffer.step",
+	"minecraft:entity.sniffer.eat",
+	"minecraft:entity.sniffer.idle",
+	"minecraft:entity.sniffer.hurt",
+	"minecraft:entity.sniffer.death",
+	"minecraft:entity.sniffer.drop_seed",
+	"minecraft:entity.sniffer.scenting",
+	"minecraft:entity.sniffer.sniffing",
+	"minecraft:entity.sniffer.searching",
+	"minecraft:entity.sniffer.digging",
+	"minecraft:entity.sniffer.digging_stop",
+	"minecraft:entity.sniffer.happy",
+	"minecraft:block.sniffer_egg.plop",
+	"minecraft:block.sniffer_egg.crack",
+	"minecraft:block.sniffer_egg.hatch",
+	"minecraft:entity.snowball.throw",
+	"minecraft:block.snow.break",
+	"minecraft:block.snow.fall",
+	"minecraft:block.snow.hit",
+	"minecraft:block.snow.place",
+	"minecraft:block.snow.step",
+	"minecraft:entity.snow_golem.ambient",
+	"minecraft:entity.snow_golem.death",
+	"minecraft:entity.snow_golem.hurt",
+	"minecraft:entity.snow_golem.shoot",
+	"minecraft:entity.snow_golem.shear",
+	"minecraft:entity.spider.ambient",
+	"minecraft:entity.spider.death",
+	"minecraft:entity.spider.hurt",
+	"minecraft:entity.spider.step",
+	"minecraft:entity.splash_potion.break",
+	"minecraft:entity.splash_potion.throw",
+	"minecraft:block.sponge.break",
+	"minecraft:block.sponge.fall",
+	"minecraft:block.sponge.hit",
+	"minecraft:block.sponge.place",
+	"minecraft:block.sponge.step",
+	"minecraft:block.sponge.absorb",
+	"minecraft:item.spyglass.use",
+	"minecraft:item.spyglass.stop_using",
+	"minecraft:entity.squid.ambient",
+	"minecraft:entity.squid.death",
+	"minecraft:entity.squid.hurt",
+	"minecraft:entity.squid.squirt",
+	"minecraft:block.stone.break",
+	"minecraft:block.stone_button.click_off",
+	"minecraft:block.stone_button.click_on",
+	"minecraft:block.stone.fall",
+	"minecraft:block.stone.hit",
+	"minecraft:block.stone.place",
+	"minecraft:block.stone_pressure_plate.click_off",
+	"minecraft:block.stone_pressure_plate.click_on",
+	"minecraft:block.stone.step",
+	"minecraft:entity.stray.ambient",
+	"minecraft:entity.stray.death",
+	"minecraft:entity.stray.hurt",
+	"minecraft:entity.stray.step",
+	"minecraft:block.sweet_berry_bush.break",
+	"minecraft:block.sweet_berry_bush.place",
+	"minecraft:block.sweet_berry_bush.pick_berries",
+	"minecraft:entity.tadpole.death",
+	"minecraft:entity.tadpole.flop",
+	"minecraft:entity.tadpole.grow_up",
+	"minecraft:entity.tadpole.hurt",
+	"minecraft:enchant.thorns.hit",
+	"minecraft:entity.tnt.primed",
+	"minecraft:item.totem.use",
+	"minecraft:item.trident.hit",
+	"minecraft:item.trident.hit_ground",
+	"minecraft:item.trident.return",
+	"minecraft:item.trident.riptide_1",
+	"minecraft:item.trident.riptide_2",
+	"minecraft:item.trident.riptide_3",
+	"minecraft:item.trident.throw",
+	"minecraft:item.trident.thunder",
+	"minecraft:block.tripwire.attach",
+	"minecraft:block.tripwire.click_off",
+	"minecraft:block.tripwire.click_on",
+	"minecraft:block.tripwire.detach",
+	"minecraft:entity.tropical_fish.ambient",
+	"minecraft:entity.tropical_fish.death",
+	"minecraft:entity.tropical_fish.flop",
+	"minecraft:entity.tropical_fish.hurt",
+	"minecraft:block.tuff.break",
+	"minecraft:block.tuff.step",
+	"minecraft:block.tuff.place",
+	"minecraft:block.tuff.hit",
+	"minecraft:block.tuff.fall",
+	"minecraft:block.tuff_bricks.break",
+	"minecraft:block.tuff_bricks.fall",
+	"minecraft:block.tuff_bricks.hit",
+	"minecraft:block.tuff_bricks.place",
+	"minecraft:block.tuff_bricks.step",
+	"minecraft:block.polished_tuff.break",
+	"minecraft:block.polished_tuff.fall",
+	"minecraft:block.polished_tuff.hit",
+	"minecraft:block.polished_tuff.place",
+	"minecraft:block.polished_tuff.step",
+	"minecraft:entity.turtle.ambient_land",
+	"minecraft:entity.turtle.death",
+	"minecraft:entity.turtle.death_baby",
+	"minecraft:entity.turtle.egg_break",
+	"minecraft:entity.turtle.egg_crack",
+	"minecraft:entity.turtle.egg_hatch",
+	"minecraft:entity.turtle.hurt",
+	"minecraft:entity.turtle.hurt_baby",
+	"minecraft:entity.turtle.lay_egg",
+	"minecraft:entity.turtle.shamble",
+	"minecraft:entity.turtle.shamble_baby",
+	"minecraft:entity.turtle.swim",
+	"minecraft:ui.button.click",
+	"minecraft:ui.loom.select_pattern",
+	"minecraft:ui.loom.take_result",
+	"minecraft:ui.cartography_table.take_result",
+	"minecraft:ui.stonecutter.take_result",
+	"minecraft:ui.stonecutter.select_recipe",
+	"minecraft:ui.toast.challenge_complete",
+	"minecraft:ui.toast.in",
+	"minecraft:ui.toast.out",
+	"minecraft:block.vault.activate",
+	"minecraft:block.vault.ambient",
+	"minecraft:block.vault.break",
+	"minecraft:block.vault.close_shutter",
+	"minecraft:block.vault.deactivate",
+	"minecraft:block.vault.eject_item",
+	"minecraft:block.vault.reject_rewarded_player",
+	"minecraft:block.vault.fall",
+	"minecraft:block.vault.hit",
+	"minecraft:block.vault.insert_item",
+	"minecraft:block.vault.insert_item_fail",
+	"minecraft:block.vault.open_shutter",
+	"minecraft:block.vault.place",
+	"minecraft:block.vault.step",
+	"minecraft:entity.vex.ambient",
+	"minecraft:entity.vex.charge",
+	"minecraft:entity.vex.death",
+	"minecraft:entity.vex.hurt",
+	"minecraft:entity.villager.ambient",
+	"minecraft:entity.villager.celebrate",
+	"minecraft:entity.villager.death",
+	"minecraft:entity.villager.hurt",
+	"minecraft:entity.villager.no",
+	"minecraft:entity.villager.trade",
+	"minecraft:entity.villager.yes",
+	"minecraft:entity.villager.work_armorer",
+	"minecraft:entity.villager.work_butcher",
+	"minecraft:entity.villager.work_cartographer",
+	"minecraft:entity.villager.work_cleric",
+	"minecraft:entity.villager.work_farmer",
+	"minecraft:entity.villager.work_fisherman",
+	"minecraft:entity.villager.work_fletcher",
+	"minecraft:entity.villager.work_leatherworker",
+	"minecraft:entity.villager.work_librarian",
+	"minecraft:entity.villager.work_mason",
+	"minecraft:entity.villager.work_shepherd",
+	"minecraft:entity.villager.work_toolsmith",
+	"minecraft:entity.villager.work_weaponsmith",
+	"minecraft:entity.vindicator.ambient",
+	"minecraft:entity.vindicator.celebrate",
+	"minecraft:entity.vindicator.death",
+	"minecraft:entity.vindicator.hurt",
+	"minecraft:block.vine.break",
+	"minecraft:block.vine.fall",
+	"minecraft:block.vine.hit",
+	"minecraft:block.vine.place",
+	"minecraft:block.vine.step",
+	"minecraft:block.lily_pad.place",
+	"minecraft:entity.wandering_trader.ambient",
+	"minecraft:entity.wandering_trader.death",
+	"minecraft:entity.wandering_trader.disappeared",
+	"minecraft:entity.wandering_trader.drink_milk",
+	"minecraft:entity.wandering_trader.drink_potion",
+	"minecraft:entity.wandering_trader.hurt",
+	"minecraft:entity.wandering_trader.no",
+	"minecraft:entity.wandering_trader.reappeared",
+	"minecraft:entity.wandering_trader.trade",
+	"minecraft:entity.wandering_trader.yes",
+	"minecraft:entity.warden.agitated",
+	"minecraft:entity.warden.ambient",
+	"minecraft:entity.warden.angry",
+	"minecraft:entity.warden.attack_impact",
+	"minecraft:entity.warden.death",
+	"minecraft:entity.warden.dig",
+	"minecraft:entity.warden.emerge",
+	"minecraft:entity.warden.heartbeat",
+	"minecraft:entity.warden.hurt",
+	"minecraft:entity.warden.listening",
+	"minecraft:entity.warden.listening_angry",
+	"minecraft:entity.warden.nearby_close",
+	"minecraft:entity.warden.nearby_closer",
+	"minecraft:entity.warden.nearby_closest",
+	"minecraft:entity.warden.roar",
+	"minecraft:entity.warden.sniff",
+	"minecraft:entity.warden.sonic_boom",
+	"minecraft:entity.warden.sonic_charge",
+	"minecraft:entity.warden.step",
+	"minecraft:entity.warden.tendril_clicks",
+	"minecraft:block.sign.waxed_interact_fail",
+	"minecraft:block.water.ambient",
+	"minecraft:weather.rain",
+	"minecraft:weather.rain.above",
+	"minecraft:block.wet_grass.break",
+	"minecraft:block.wet_grass.fall",
+	"minecraft:block.wet_grass.hit",
+	"minecraft:block.wet_grass.place",
+	"minecraft:block.wet_grass.step",
+	"minecraft:block.wet_sponge.break",
+	"minecraft:block.wet_sponge.dries",
+	"minecraft:block.wet_sponge.fall",
+	"minecraft:block.wet_sponge.hit",
+	"minecraft:block.wet_sponge.place",
+	"minecraft:block.wet_sponge.step",
+	"minecraft:entity.wind_charge.wind_burst",
+	"minecraft:entity.wind_charge.throw",
+	"minecraft:entity.witch.ambient",
+	"minecraft:entity.witch.celebrate",
+	"minecraft:entity.witch.death",
+	"minecraft:entity.witch.drink",
+	"minecraft:entity.witch.hurt",
+	"minecraft:entity.witch.throw",
+	"minecraft:entity.wither.ambient",
+	"minecraft:entity.wither.break_block",
+	"minecraft:entity.wither.death",
+	"minecraft:entity.wither.hurt",
+	"minecraft:entity.wither.shoot",
+	"minecraft:entity.wither_skeleton.ambient",
+	"minecraft:entity.wither_skeleton.death",
+	"minecraft:entity.wither_skeleton.hurt",
+	"minecraft:entity.wither_skeleton.step",
+	"minecraft:entity.wither.spawn",
+	"minecraft:item.wolf_armor.break",
+	"minecraft:item.wolf_armor.crack",
+	"minecraft:item.wolf_armor.damage",
+	"minecraft:item.wolf_armor.repair",
+	"minecraft:entity.wolf.ambient",
+	"minecraft:entity.wolf.death",
+	"minecraft:entity.wolf.growl",
+	"minecraft:entity.wolf.howl",
+	"minecraft:entity.wolf.hurt",
+	"minecraft:entity.wolf.pant",
+	"minecraft:entity.wolf.shake",
+	"minecraft:entity.wolf.step",
+	"minecraft:entity.wolf.whine",
+	"minecraft:block.wooden_door.close",
+	"minecraft:block.wooden_door.open",
+	"minecraft:block.wooden_trapdoor.close",
+	"minecraft:block.wooden_trapdoor.open",
+	"minecraft:block.wooden_button.click_off",
+	"minecraft:block.wooden_button.click_on",
+	"minecraft:block.wooden_pressure_plate.click_off",
+	"minecraft:block.wooden_pressure_plate.click_on",
+	"minecraft:block.wood.break",
+	"minecraft:block.wood.fall",
+	"minecraft:block.wood.hit",
+	"minecraft:block.wood.place",
+	"minecraft:block.wood.step",
+	"minecraft:block.wool.break",
+	"minecraft:block.wool.fall",
+	"minecraft:block.wool.hit",
+	"minecraft:block.wool.place",
+	"minecraft:block.wool.step",
+	"minecraft:entity.zoglin.ambient",
+	"minecraft:entity.zoglin.angry",
+	"minecraft:entity.zoglin.attack",
+	"minecraft:entity.zoglin.death",
+	"minecraft:entity.zoglin.hurt",
+	"minecraft:entity.zoglin.step",
+	"minecraft:entity.zombie.ambient",
+	"minecraft:entity.zombie.attack_wooden_door",
+	"minecraft:entity.zombie.attack_iron_door",
+	"minecraft:entity.zombie.break_wooden_door",
+	"minecraft:entity.zombie.converted_to_drowned",
+	"minecraft:entity.zombie.death",
+	"minecraft:entity.zombie.destroy_egg",
+	"minecraft:entity.zombie_horse.ambient",
+	"minecraft:entity.zombie_horse.death",
+	"minecraft:entity.zombie_horse.hurt",
+	"minecraft:entity.zombie.hurt",
+	"minecraft:entity.zombie.infect",
+	"minecraft:entity.zombified_piglin.ambient",
+	"minecraft:entity.zombified_piglin.angry",
+	"minecraft:entity.zombified_piglin.death",
+	"minecraft:entity.zombified_piglin.hurt",
+	"minecraft:entity.zombie.step",
+	"minecraft:entity.zombie_villager.ambient",
+	"minecraft:entity.zombie_villager.converted",
+	"minecraft:entity.zombie_villager.cure",
+	"minecraft:entity.zombie_villager.death",
+	"minecraft:entity.zombie_villager.hurt",
+	"minecraft:entity.zombie_villager.step",
+	"minecraft:event.mob_effect.bad_omen",
+	"minecraft:event.mob_effect.trial_omen",
+	"minecraft:event.mob_effect.raid_omen",
+}
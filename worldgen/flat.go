@@ -0,0 +1,152 @@
+package worldgen
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gstoney/mcproto/anvil"
+	"github.com/gstoney/mcproto/chunk"
+	"github.com/gstoney/mcproto/nbt"
+)
+
+// ClassicFlat is the default superflat preset.
+const ClassicFlat = "minecraft:bedrock,2*minecraft:dirt,minecraft:grass_block;minecraft:plains"
+
+// DefaultFlatBiome is the biome of presets not naming one.
+const DefaultFlatBiome = "minecraft:plains"
+
+var ErrInvalidPreset = errors.New("worldgen: invalid superflat preset")
+
+// Layer is a horizontal layer of a single block.
+type Layer struct {
+	Block  string // Such as "minecraft:dirt".
+	State  int32
+	Height int
+}
+
+// Flat generates superflat terrain: layers stacked from the bottom of the
+// world, in a single biome.
+type Flat struct {
+	Layers    []Layer
+	Biome     int32
+	BiomeName string
+}
+
+// ParseFlat parses a preset in the syntax of the vanilla Customize screen,
+// such as ClassicFlat: layers from the bottom up, separated by commas and
+// optionally prefixed with a count and '*', then a semicolon and a biome.
+// Names default to the minecraft namespace. r resolves default states of
+// blocks, called with no properties, and biomes.
+func ParseFlat(preset string, r anvil.Resolver) (*Flat, error) {
+	layers, biome, _ := strings.Cut(preset, ";")
+	biome, _, _ = strings.Cut(biome, ";") // Structures, ignored.
+	if biome = strings.TrimSpace(biome); biome == "" {
+		biome = DefaultFlatBiome
+	}
+
+	f := &Flat{BiomeName: identifier(biome)}
+	var ok bool
+	if f.Biome, ok = r.Biome(f.BiomeName); !ok {
+		return nil, fmt.Errorf("%w: unknown biome %s", ErrInvalidPreset, f.BiomeName)
+	}
+
+	if strings.TrimSpace(layers) == "" {
+		return f, nil
+	}
+	for _, s := range strings.Split(layers, ",") {
+		l := Layer{Height: 1}
+		block := strings.TrimSpace(s)
+		if count, name, found := strings.Cut(block, "*"); found {
+			n, err := strconv.Atoi(strings.TrimSpace(count))
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("%w: layer %q", ErrInvalidPreset, s)
+			}
+			l.Height, block = n, strings.TrimSpace(name)
+		}
+
+		l.Block = identifier(block)
+		if l.State, ok = r.BlockState(l.Block, nil); !ok {
+			return nil, fmt.Errorf("%w: unknown block %s", ErrInvalidPreset, l.Block)
+		}
+		f.Layers = append(f.Layers, l)
+	}
+	return f, nil
+}
+
+// identifier adds the minecraft namespace to name if it has none.
+func identifier(name string) string {
+	if strings.Contains(name, ":") {
+		return name
+	}
+	return "minecraft:" + name
+}
+
+// String returns f in the syntax of ParseFlat.
+func (f *Flat) String() string {
+	var b strings.Builder
+	for i, l := range f.Layers {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if l.Height != 1 {
+			fmt.Fprintf(&b, "%d*", l.Height)
+		}
+		b.WriteString(l.Block)
+	}
+	b.WriteByte(';')
+	b.WriteString(f.BiomeName)
+	return b.String()
+}
+
+// Height returns the total height of the layers. Players stand on flat
+// terrain at the bottom of the world plus Height.
+func (f *Flat) Height() (h int) {
+	for _, l := range f.Layers {
+		h += l.Height
+	}
+	return
+}
+
+// Generate fills c with the layers, cut at the top of the world.
+func (f *Flat) Generate(c *chunk.Column) {
+	for _, s := range c.Sections {
+		s.FillBiome(f.Biome)
+	}
+
+	y, top := c.MinY, c.MinY+c.Height()
+	for _, l := range f.Layers {
+		for range l.Height {
+			if y >= top {
+				return
+			}
+			if l.State != chunk.Air {
+				for z := range chunk.SectionWidth {
+					for x := range chunk.SectionWidth {
+						c.SetBlock(x, y, z, l.State)
+					}
+				}
+			}
+			y++
+		}
+	}
+}
+
+// Settings returns the minecraft:flat generator of f.
+func (f *Flat) Settings() nbt.Compound {
+	layers := nbt.List{}
+	for _, l := range f.Layers {
+		layers = append(layers, nbt.Compound{"block": l.Block, "height": int32(l.Height)})
+	}
+	return nbt.Compound{
+		"type": "minecraft:flat",
+		"settings": nbt.Compound{
+			"layers":              layers,
+			"biome":               f.BiomeName,
+			"features":            false,
+			"lakes":               false,
+			"structure_overrides": nbt.List{},
+		},
+	}
+}
@@ -0,0 +1,59 @@
+// Package worldgen generates terrain for servers without a vanilla world,
+// such as lobbies: superflat layers, or nothing at all.
+//
+// Generators fill columns of package chunk, ready to be sent with
+// Column.ChunkData or saved with package anvil:
+//
+//	g, err := worldgen.ParseFlat(worldgen.ClassicFlat, worldgen.VanillaResolver(biomes))
+//	c := worldgen.Generate(g, x, z, -64, 384)
+package worldgen
+
+import (
+	"github.com/gstoney/mcproto/anvil"
+	"github.com/gstoney/mcproto/chunk"
+	"github.com/gstoney/mcproto/nbt"
+	"github.com/gstoney/mcproto/registry"
+	"github.com/gstoney/mcproto/vanilla"
+)
+
+// A Generator produces the terrain of chunks.
+type Generator interface {
+	// Generate fills c, an empty column, with the terrain of chunk c.X,
+	// c.Z.
+	Generate(c *chunk.Column)
+
+	// Settings returns the generator as saved in level.dat, see
+	// anvil.Level.
+	Settings() nbt.Compound
+}
+
+// Generate returns chunk x, z of a world height blocks high from minY.
+func Generate(g Generator, x, z int32, minY, height int) *chunk.Column {
+	c := chunk.NewColumn(x, z, minY, height)
+	g.Generate(c)
+	return c
+}
+
+// Void generates empty chunks of a single biome, like the vanilla void
+// preset.
+type Void struct {
+	Biome     int32
+	BiomeName string // Such as "minecraft:the_void".
+}
+
+func (v *Void) Generate(c *chunk.Column) {
+	for _, s := range c.Sections {
+		s.FillBiome(v.Biome)
+	}
+}
+
+func (v *Void) Settings() nbt.Compound {
+	return (&Flat{Biome: v.Biome, BiomeName: v.BiomeName}).Settings()
+}
+
+// VanillaResolver resolves the block states of package vanilla and the
+// entries of biomes, such as the minecraft:worldgen/biome registry of
+// registry.Vanilla.
+func VanillaResolver(biomes *registry.Registry) anvil.Resolver {
+	return vanilla.Resolver{Biomes: biomes}
+}
@@ -0,0 +1,160 @@
+package worldgen
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gstoney/mcproto/chunk"
+	"github.com/gstoney/mcproto/nbt"
+	"github.com/gstoney/mcproto/registry"
+)
+
+func vanillaBiomes() *registry.Registry {
+	return registry.Vanilla().Registry("minecraft:worldgen/biome")
+}
+
+func TestParseFlat(t *testing.T) {
+	r := VanillaResolver(vanillaBiomes())
+	for _, tc := range []struct {
+		preset string
+		want   *Flat
+		str    string
+	}{
+		{ClassicFlat, &Flat{
+			Layers: []Layer{
+				{"minecraft:bedrock", 79, 1},
+				{"minecraft:dirt", 10, 2},
+				{"minecraft:grass_block", 9, 1},
+			},
+			Biome: 39, BiomeName: "minecraft:plains",
+		}, ClassicFlat},
+		{"bedrock, 3*stone", &Flat{
+			Layers: []Layer{
+				{"minecraft:bedrock", 79, 1},
+				{"minecraft:stone", 1, 3},
+			},
+			Biome: 39, BiomeName: "minecraft:plains",
+		}, "minecraft:bedrock,3*minecraft:stone;minecraft:plains"},
+		{"minecraft:bedrock,5*minecraft:stone,5*minecraft:dirt,5*minecraft:sand,90*minecraft:water;minecraft:deep_ocean", &Flat{
+			Layers: []Layer{
+				{"minecraft:bedrock", 79, 1},
+				{"minecraft:stone", 1, 5},
+				{"minecraft:dirt", 10, 5},
+				{"minecraft:sand", 112, 5},
+				{"minecraft:water", 80, 90},
+			},
+			Biome:     int32(vanillaBiomes().Index("minecraft:deep_ocean")),
+			BiomeName: "minecraft:deep_ocean",
+		}, "minecraft:bedrock,5*minecraft:stone,5*minecraft:dirt,5*minecraft:sand,90*minecraft:water;minecraft:deep_ocean"},
+		{"bedrock,3*sandstone,glass,snow;desert", &Flat{
+			Layers: []Layer{
+				{"minecraft:bedrock", 79, 1},
+				{"minecraft:sandstone", 535, 3},
+				{"minecraft:glass", 519, 1},
+				{"minecraft:snow", 5772, 1},
+			},
+			Biome:     int32(vanillaBiomes().Index("minecraft:desert")),
+			BiomeName: "minecraft:desert",
+		}, "minecraft:bedrock,3*minecraft:sandstone,minecraft:glass,minecraft:snow;minecraft:desert"},
+		{"minecraft:air;minecraft:the_void;village", &Flat{
+			Layers:    []Layer{{"minecraft:air", chunk.Air, 1}},
+			Biome:     int32(vanillaBiomes().Index("minecraft:the_void")),
+			BiomeName: "minecraft:the_void",
+		}, "minecraft:air;minecraft:the_void"},
+		{";the_void", &Flat{
+			Biome:     int32(vanillaBiomes().Index("minecraft:the_void")),
+			BiomeName: "minecraft:the_void",
+		}, ";minecraft:the_void"},
+	} {
+		got, err := ParseFlat(tc.preset, r)
+		if err != nil {
+			t.Errorf("ParseFlat(%q): %v", tc.preset, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("ParseFlat(%q): got %+v, want %+v", tc.preset, got, tc.want)
+		}
+		if s := got.String(); s != tc.str {
+			t.Errorf("String: got %q, want %q", s, tc.str)
+		}
+	}
+}
+
+func TestParseFlat_Errors(t *testing.T) {
+	r := VanillaResolver(vanillaBiomes())
+	for _, preset := range []string{
+		"0*minecraft:stone",
+		"x*minecraft:stone",
+		"minecraft:stone,,minecraft:dirt",
+		"mod:gizmo",
+		"minecraft:stone;minecraft:nowhere",
+	} {
+		if _, err := ParseFlat(preset, r); !errors.Is(err, ErrInvalidPreset) {
+			t.Errorf("ParseFlat(%q): got %v, want ErrInvalidPreset", preset, err)
+		}
+	}
+}
+
+func TestFlat_Generate(t *testing.T) {
+	f, err := ParseFlat(ClassicFlat, VanillaResolver(vanillaBiomes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := Generate(f, 1, 2, -64, 384)
+
+	for y, want := range map[int]int32{-64: 79, -63: 10, -62: 10, -61: 9, -60: chunk.Air, 300: chunk.Air} {
+		if got := c.Block(7, y, 3); got != want {
+			t.Errorf("block at y %d: got %d, want %d", y, got, want)
+		}
+	}
+	if got := c.Sections[23].Biome(0, 15, 0); got != 39 {
+		t.Errorf("got biome %d, want 39", got)
+	}
+	if got := f.Height(); got != 4 {
+		t.Errorf("Height: got %d, want 4", got)
+	}
+
+	p, err := c.ChunkData(chunk.SolidBlocks{})
+	if err != nil {
+		t.Fatalf("ChunkData: %v", err)
+	}
+	h, err := chunk.HeightmapOf(p.Heightmaps[chunk.MotionBlocking].([]int64), 384)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h[0] != 4 {
+		t.Errorf("got height %d, want 4", h[0])
+	}
+
+	// Layers beyond the top of the world are cut.
+	tall := &Flat{Layers: []Layer{{"minecraft:stone", 1, 1000}}}
+	c = Generate(tall, 0, 0, 0, 32)
+	if got := c.Block(0, 31, 0); got != 1 {
+		t.Errorf("got top block %d, want 1", got)
+	}
+}
+
+func TestVoid(t *testing.T) {
+	v := &Void{Biome: 5, BiomeName: "minecraft:the_void"}
+	c := Generate(v, 0, 0, -64, 384)
+	for i, s := range c.Sections {
+		if s.BlockCount() != 0 || s.Biome(0, 0, 0) != 5 {
+			t.Errorf("section %d: %d blocks, biome %d", i, s.BlockCount(), s.Biome(0, 0, 0))
+		}
+	}
+
+	want := nbt.Compound{
+		"type": "minecraft:flat",
+		"settings": nbt.Compound{
+			"layers":              nbt.List{},
+			"biome":               "minecraft:the_void",
+			"features":            false,
+			"lakes":               false,
+			"structure_overrides": nbt.List{},
+		},
+	}
+	if got := v.Settings(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Settings: got %v, want %v", got, want)
+	}
+}